@@ -201,6 +201,7 @@ import (
 
 	"github.com/neutron-org/neutron/v5/x/dex"
 	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	dexstreaming "github.com/neutron-org/neutron/v5/x/dex/streaming"
 	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
 
 	globalfeekeeper "github.com/neutron-org/neutron/v5/x/globalfee/keeper"
@@ -311,6 +312,8 @@ var (
 		tokenfactorytypes.ModuleName:                  {authtypes.Minter, authtypes.Burner},
 		crontypes.ModuleName:                          nil,
 		dextypes.ModuleName:                           {authtypes.Minter, authtypes.Burner},
+		dextypes.ProtocolFeeCollectorName:             nil,
+		dextypes.IncentivesModuleName:                 nil,
 		oracletypes.ModuleName:                        nil,
 		marketmaptypes.ModuleName:                     nil,
 		feemarkettypes.FeeCollectorName:               nil,
@@ -667,6 +670,7 @@ func New(
 		keys[feeburnertypes.MemStoreKey],
 		app.AccountKeeper,
 		&app.BankKeeper,
+		dextypes.ProtocolFeeCollectorName,
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 	feeBurnerModule := feeburner.NewAppModule(appCodec, *app.FeeBurnerKeeper)
@@ -709,15 +713,58 @@ func New(
 			app.TokenFactoryKeeper.Hooks(),
 		))
 
+	app.MarketMapKeeper = marketmapkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[marketmaptypes.StoreKey]),
+		appCodec,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName),
+	)
+	marketmapModule := marketmap.NewAppModule(appCodec, app.MarketMapKeeper)
+
+	oracleKeeper := oraclekeeper.NewKeeper(runtime.NewKVStoreService(keys[oracletypes.StoreKey]),
+		appCodec,
+		app.MarketMapKeeper,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName))
+	app.OracleKeeper = &oracleKeeper
+	oracleModule := oracle.NewAppModule(appCodec, *app.OracleKeeper)
+
+	app.MarketMapKeeper.SetHooks(app.OracleKeeper.Hooks())
+
 	app.DexKeeper = *dexkeeper.NewKeeper(
 		appCodec,
 		keys[dextypes.StoreKey],
 		keys[dextypes.MemStoreKey],
 		tkeys[dextypes.TStoreKey],
 		app.BankKeeper.WithMintCoinsRestriction(dextypes.NewDexDenomMintCoinsRestriction()),
+		contractmanager.NewSudoLimitWrapper(app.ContractManagerKeeper, &app.WasmKeeper),
+		&app.WasmKeeper,
+		app.OracleKeeper,
+		nil,
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
+	candleConfig, err := dextypes.ReadCandleConfigFromAppOpts(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading dex candles config: %s", err))
+	}
+	if candleConfig.Enabled {
+		candleStore, err := dexkeeper.OpenCandleStore(filepath.Join(homePath, "data"), candleConfig)
+		if err != nil {
+			panic(fmt.Sprintf("error while opening dex candle store: %s", err))
+		}
+		app.DexKeeper.SetCandleStore(candleStore)
+	}
+
+	streamConfig, err := dextypes.ReadStreamConfigFromAppOpts(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading dex stream config: %s", err))
+	}
+	if streamConfig.Enabled {
+		app.SetStreamingManager(storetypes.StreamingManager{
+			ABCIListeners: []storetypes.ABCIListener{dexstreaming.NewListener(streamConfig, homePath)},
+			StopNodeOnErr: streamConfig.StopNodeOnErr,
+		})
+	}
+
 	app.AuctionKeeper = auctionkeeper.NewKeeperWithRewardsAddressProvider(
 		appCodec,
 		keys[auctiontypes.StoreKey],
@@ -784,22 +831,6 @@ func New(
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
-	app.MarketMapKeeper = marketmapkeeper.NewKeeper(
-		runtime.NewKVStoreService(keys[marketmaptypes.StoreKey]),
-		appCodec,
-		authtypes.NewModuleAddress(adminmoduletypes.ModuleName),
-	)
-	marketmapModule := marketmap.NewAppModule(appCodec, app.MarketMapKeeper)
-
-	oracleKeeper := oraclekeeper.NewKeeper(runtime.NewKVStoreService(keys[oracletypes.StoreKey]),
-		appCodec,
-		app.MarketMapKeeper,
-		authtypes.NewModuleAddress(adminmoduletypes.ModuleName))
-	app.OracleKeeper = &oracleKeeper
-	oracleModule := oracle.NewAppModule(appCodec, *app.OracleKeeper)
-
-	app.MarketMapKeeper.SetHooks(app.OracleKeeper.Hooks())
-
 	app.CronKeeper = *cronkeeper.NewKeeper(
 		appCodec,
 		keys[crontypes.StoreKey],
@@ -80,6 +80,10 @@ func isSdkMessageWhitelisted(msg sdk.Msg) bool {
 		*crontypes.MsgRemoveSchedule,
 		*contractmanagertypes.MsgUpdateParams,
 		*dextypes.MsgUpdateParams,
+		*dextypes.MsgSetPairPaused,
+		*dextypes.MsgSetPairBatchAuction,
+		*dextypes.MsgSetDenomPaused,
+		*dextypes.MsgResetCircuitBreaker,
 		*banktypes.MsgUpdateParams,
 		*crisistypes.MsgUpdateParams,
 		*minttypes.MsgUpdateParams,
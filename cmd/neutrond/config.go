@@ -17,6 +17,8 @@ import (
 	oracleconfig "github.com/skip-mev/slinky/oracle/config"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
 )
 
 // This code is copied from the Juno implementation: https://github.com/CosmosContracts/juno/pull/601/files
@@ -35,10 +37,12 @@ type NeutronCustomClient struct {
 }
 
 // NeutronAppConfig defines the config structure of the neutrond app.toml file. Specifically,
-// it wraps the default app.toml config with additional slinky application config params.
+// it wraps the default app.toml config with additional slinky, dex candle and dex stream config params.
 type NeutronAppConfig struct {
 	serverconfig.Config
-	Oracle oracleconfig.AppConfig `mapstructure:"oracle" json:"oracle"`
+	Oracle     oracleconfig.AppConfig `mapstructure:"oracle" json:"oracle"`
+	DexCandles dextypes.CandleConfig  `mapstructure:"dex-candles" json:"dex-candles"`
+	DexStream  dextypes.StreamConfig  `mapstructure:"dex-stream" json:"dex-stream"`
 }
 
 // initAppConfig initializes a default application configuration for neutrond.
@@ -54,9 +58,12 @@ func initAppConfig() (*NeutronAppConfig, string) {
 	}
 
 	return &NeutronAppConfig{
-		Config: *srvConfig,
-		Oracle: oracleConfig,
-	}, serverconfig.DefaultConfigTemplate + oracleconfig.DefaultConfigTemplate
+		Config:     *srvConfig,
+		Oracle:     oracleConfig,
+		DexCandles: dextypes.DefaultCandleConfig(),
+		DexStream:  dextypes.DefaultStreamConfig(),
+	}, serverconfig.DefaultConfigTemplate + oracleconfig.DefaultConfigTemplate + dextypes.DefaultCandleConfigTemplate +
+		dextypes.DefaultStreamConfigTemplate
 }
 
 // ConfigCmd returns a CLI command to interactively create an application CLI
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/spf13/cobra"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func dexAuditCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dex-audit [genesis_file]",
+		Short: "Checks the dex invariants against an exported genesis file",
+		Long: `Checks that the dex module account balance covers the reserves of all pools and limit orders
+and the pending protocol fees, and that the supply of every pool share denom matches the balances of its shareholders.
+Any differences are printed and the command fails if an invariant is broken.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			appState, _, err := genutiltypes.GenesisStateFromGenFile(args[0])
+			if err != nil {
+				return fmt.Errorf("failed to unmarshal genesis state: %w", err)
+			}
+
+			var dexGenState dextypes.GenesisState
+			if err := clientCtx.Codec.UnmarshalJSON(appState[dextypes.ModuleName], &dexGenState); err != nil {
+				return fmt.Errorf("failed to unmarshal dex genesis state: %w", err)
+			}
+			bankGenState := banktypes.GetGenesisStateFromAppState(clientCtx.Codec, appState)
+
+			balanceDiffs, poolShareDiffs := dextypes.AuditGenesis(
+				dexGenState,
+				*bankGenState,
+				authtypes.NewModuleAddress(dextypes.ModuleName),
+			)
+
+			broken := len(poolShareDiffs) > 0
+			fmt.Println("dex module balance:")
+			for _, diff := range balanceDiffs {
+				fmt.Printf("\t%s\n", diff)
+				broken = broken || diff.IsDeficit()
+			}
+			fmt.Println("pool share supply:")
+			for _, diff := range poolShareDiffs {
+				fmt.Printf("\t%s\n", diff)
+			}
+
+			if broken {
+				return errors.New("dex invariants are broken")
+			}
+
+			return nil
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	dexstreaming "github.com/neutron-org/neutron/v5/x/dex/streaming"
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+const (
+	flagReplayHeight      = "height"
+	flagReplayPrintDeltas = "print-deltas"
+)
+
+var errReplayHeightReached = errors.New("replay height reached")
+
+func dexStreamReplayCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dex-stream-replay [stream_file]",
+		Short: "Replays a dex stream file and prints the resulting liquidity",
+		Long: `Replays the blocks of a dex stream written by a node with [dex-stream] enabled in app.toml and prints
+the PoolReserves and LimitOrderTranches in the book after the last block. The command fails if the stream
+is missing any blocks. Use --height to stop at an earlier block and --print-deltas to print every block as it is replayed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := cmd.Flags().GetInt64(flagReplayHeight)
+			if err != nil {
+				return err
+			}
+			printDeltas, err := cmd.Flags().GetBool(flagReplayPrintDeltas)
+			if err != nil {
+				return err
+			}
+
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			book := dexstreaming.NewBook()
+			err = dexstreaming.ReadBlocks(f, func(block *dextypes.DexStreamBlock) error {
+				if height > 0 && block.BlockHeight > height {
+					return errReplayHeightReached
+				}
+				if printDeltas {
+					line, err := dexstreaming.MarshalBlock(block)
+					if err != nil {
+						return err
+					}
+					fmt.Print(string(line))
+				}
+
+				return book.ApplyBlock(block)
+			})
+			if err != nil && !errors.Is(err, errReplayHeightReached) {
+				return fmt.Errorf("failed to replay dex stream at height %d: %w", book.Height, err)
+			}
+
+			fmt.Printf("height: %d\n", book.Height)
+			fmt.Printf("fills: %d deposits: %d expirations: %d\n", book.NumFills, book.NumDeposits, book.NumExpirations)
+			fmt.Println("liquidity:")
+			for _, tick := range book.Liquidity() {
+				if tick.TrancheKey != "" {
+					fmt.Printf("\t%s -> %s tick %d tranche %s: %s\n", tick.TradePairId.TakerDenom, tick.TradePairId.MakerDenom,
+						tick.TickIndexTakerToMaker, tick.TrancheKey, tick.ReservesMakerDenom)
+				} else {
+					fmt.Printf("\t%s -> %s tick %d fee %d: %s\n", tick.TradePairId.TakerDenom, tick.TradePairId.MakerDenom,
+						tick.TickIndexTakerToMaker, tick.Fee, tick.ReservesMakerDenom)
+				}
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagReplayHeight, 0, "Stop replaying after this block height (0 replays the whole stream)")
+	cmd.Flags().Bool(flagReplayPrintDeltas, false, "Print every replayed block")
+
+	return cmd
+}
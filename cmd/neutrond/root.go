@@ -148,7 +148,7 @@ func tempDir() string {
 
 func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig) {
 	debugCmd := debug.Cmd()
-	debugCmd.AddCommand(genContractAddressCmd())
+	debugCmd.AddCommand(genContractAddressCmd(), dexAuditCmd(), dexStreamReplayCmd())
 	gentxModule := app.ModuleBasics[genutiltypes.ModuleName].(genutil.AppModuleBasic)
 
 	rootCmd.AddCommand(
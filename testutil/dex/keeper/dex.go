@@ -21,6 +21,10 @@ import (
 )
 
 func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
+	return DexKeeperWithHooks(t, nil, nil)
+}
+
+func DexKeeperWithHooks(t testing.TB, wasmKeeper types.WasmKeeper, hooks types.DexHooks) (*keeper.Keeper, sdk.Context) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 	memStoreKey := storetypes.NewMemoryStoreKey(types.MemStoreKey)
 	tStoreKey := storetypes.NewTransientStoreKey(types.TStoreKey)
@@ -29,6 +33,7 @@ func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(memStoreKey, storetypes.StoreTypeMemory, nil)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, nil)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
@@ -40,6 +45,10 @@ func DexKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		memStoreKey,
 		tStoreKey,
 		nil,
+		wasmKeeper,
+		nil,
+		nil,
+		hooks,
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
@@ -21,6 +21,9 @@ import (
 	"github.com/neutron-org/neutron/v5/x/feeburner/types"
 )
 
+// ProtocolFeeCollectorName is the dex protocol fee collector module the test keeper collects from
+const ProtocolFeeCollectorName = "dex_protocol_fee_collector"
+
 func FeeburnerKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 	return FeeburnerKeeperWithDeps(t, nil, nil)
 }
@@ -44,6 +47,7 @@ func FeeburnerKeeperWithDeps(t testing.TB, accountKeeper types.AccountKeeper, ba
 		memStoreKey,
 		accountKeeper,
 		bankkeeper,
+		ProtocolFeeCollectorName,
 		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
 	)
 
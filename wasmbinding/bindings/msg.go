@@ -236,6 +236,27 @@ type Dex struct {
 	WithdrawFilledLimitOrder *dextypes.MsgWithdrawFilledLimitOrder `json:"withdraw_filled_limit_order"`
 	CancelLimitOrder         *dextypes.MsgCancelLimitOrder         `json:"cancel_limit_order"`
 	MultiHopSwap             *dextypes.MsgMultiHopSwap             `json:"multi_hop_swap"`
+	MultiHopSwapExactOut     *dextypes.MsgMultiHopSwapExactOut     `json:"multi_hop_swap_exact_out"`
+	BatchOps                 *MsgBatchOps                          `json:"batch_ops"`
+	AmendLimitOrder          *dextypes.MsgAmendLimitOrder          `json:"amend_limit_order"`
+	FlashSwap                *dextypes.MsgFlashSwap                `json:"flash_swap"`
+}
+
+// MsgBatchOps is a copy of dextypes.MsgBatchOps which uses the contract friendly MsgPlaceLimitOrder
+type MsgBatchOps struct {
+	Ops []BatchOp `json:"ops"`
+}
+
+// BatchOp is a copy of dextypes.BatchOp which uses the contract friendly MsgPlaceLimitOrder.
+// Exactly one operation must be set.
+type BatchOp struct {
+	AllowFailure             bool                                  `json:"allow_failure,omitempty"`
+	Deposit                  *dextypes.MsgDeposit                  `json:"deposit,omitempty"`
+	Withdrawal               *dextypes.MsgWithdrawal               `json:"withdrawal,omitempty"`
+	PlaceLimitOrder          *MsgPlaceLimitOrder                   `json:"place_limit_order,omitempty"`
+	WithdrawFilledLimitOrder *dextypes.MsgWithdrawFilledLimitOrder `json:"withdraw_filled_limit_order,omitempty"`
+	CancelLimitOrder         *dextypes.MsgCancelLimitOrder         `json:"cancel_limit_order,omitempty"`
+	MultiHopSwap             *dextypes.MsgMultiHopSwap             `json:"multi_hop_swap,omitempty"`
 }
 
 // MsgPlaceLimitOrder is a copy dextypes.MsgPlaceLimitOrder with altered ExpirationTime field,
@@ -254,4 +275,13 @@ type MsgPlaceLimitOrder struct {
 	MaxAmountOut   *math.Int `json:"max_amount_out"`
 	// Accepts standard decimals and decimals with scientific notation (ie. 1234.23E-7)
 	LimitSellPrice string `json:"limit_sell_price,omitempty"`
+	// triggerSellPrice is only valid iff orderType == STOP_LOSS or TAKE_PROFIT.
+	// Accepts standard decimals and decimals with scientific notation (ie. 1234.23E-7)
+	TriggerSellPrice string `json:"trigger_sell_price,omitempty"`
+	// oracle_peg is only valid iff orderType == GOOD_TIL_CANCELLED.
+	OraclePeg *dextypes.OraclePeg `json:"oracle_peg,omitempty"`
+	// expirationHeight is only valid iff orderType == GOOD_TIL_BLOCK.
+	ExpirationHeight uint64 `json:"expiration_height,omitempty"`
+	// autoWithdraw sends the proceeds to the receiver once the order is filled or expires.
+	AutoWithdraw bool `json:"auto_withdraw,omitempty"`
 }
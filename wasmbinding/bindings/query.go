@@ -261,6 +261,8 @@ type DexQuery struct {
 	PoolReserves *dextypes.QueryGetPoolReservesRequest `json:"pool_reserves"`
 	// Queries the simulated result of a multihop swap
 	EstimateMultiHopSwap *dextypes.QueryEstimateMultiHopSwapRequest `json:"estimate_multi_hop_swap"`
+	// Queries the amount in required by an exact-out multihop swap
+	EstimateMultiHopSwapExactOut *dextypes.QueryEstimateMultiHopSwapExactOutRequest `json:"estimate_multi_hop_swap_exact_out"`
 	// Queries the simulated result of a PlaceLimit order
 	EstimatePlaceLimitOrder *QueryEstimatePlaceLimitOrderRequest `json:"estimate_place_limit_order"`
 	// Queries a pool by pair, tick and fee
@@ -271,6 +273,16 @@ type DexQuery struct {
 	PoolMetadata *dextypes.QueryGetPoolMetadataRequest `json:"pool_metadata"`
 	// Queries a list of PoolMetadata items.
 	PoolMetadataAll *dextypes.QueryAllPoolMetadataRequest `json:"pool_metadata_all"`
+	// Queries the time weighted average price of a pair over a time window
+	TimeWeightedAveragePrice *dextypes.QueryTimeWeightedAveragePriceRequest `json:"time_weighted_average_price"`
+	// Queries a list of pending STOP_LOSS and TAKE_PROFIT orders for a given address
+	TriggerOrderAllByAddress *dextypes.QueryAllTriggerOrderByAddressRequest `json:"trigger_order_all_by_address"`
+	// Queries a list of oracle-pegged limit orders for a given address
+	PeggedOrderAllByAddress *dextypes.QueryAllPeggedOrderByAddressRequest `json:"pegged_order_all_by_address"`
+	// Queries aggregated price levels for one side of a pair's order book
+	OrderBook *dextypes.QueryOrderBookRequest `json:"order_book"`
+	// Queries candidate MultiHopSwap routes between two tokens ranked by simulated output
+	FindRoutes *dextypes.QueryFindRoutesRequest `json:"find_routes"`
 }
 
 // QueryEstimatePlaceLimitOrderRequest is a copy dextypes.QueryEstimatePlaceLimitOrderRequest with altered ExpirationTime field,
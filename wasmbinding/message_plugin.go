@@ -231,55 +231,121 @@ func (m *CustomMessenger) dispatchDexMsg(ctx sdk.Context, contractAddr sdk.AccAd
 		dex.Withdrawal.Creator = contractAddr.String()
 		return handleDexMsg(ctx, dex.Withdrawal, m.DexMsgServer.Withdrawal)
 	case dex.PlaceLimitOrder != nil:
-		msg := dextypes.MsgPlaceLimitOrder{
-			Creator:  contractAddr.String(),
-			Receiver: dex.PlaceLimitOrder.Receiver,
-			TokenIn:  dex.PlaceLimitOrder.TokenIn,
-			TokenOut: dex.PlaceLimitOrder.TokenOut,
-			//nolint: staticcheck // TODO: remove in next release
-			TickIndexInToOut: dex.PlaceLimitOrder.TickIndexInToOut,
-			AmountIn:         dex.PlaceLimitOrder.AmountIn,
-			MaxAmountOut:     dex.PlaceLimitOrder.MaxAmountOut,
-		}
-		orderTypeInt, ok := dextypes.LimitOrderType_value[dex.PlaceLimitOrder.OrderType]
-		if !ok {
-			return nil, nil, errors.Wrap(dextypes.ErrInvalidOrderType,
-				fmt.Sprintf(
-					"got \"%s\", expected one of %s",
-					dex.PlaceLimitOrder.OrderType,
-					strings.Join(maps.Keys(dextypes.LimitOrderType_value), ", ")),
-			)
-		}
-		msg.OrderType = dextypes.LimitOrderType(orderTypeInt)
-
-		if dex.PlaceLimitOrder.ExpirationTime != nil {
-			t := time.Unix(int64(*(dex.PlaceLimitOrder.ExpirationTime)), 0) //nolint:gosec
-			msg.ExpirationTime = &t
-		}
-
-		if limitPriceStr := dex.PlaceLimitOrder.LimitSellPrice; limitPriceStr != "" {
-			limitPriceDec, err := dexutils.ParsePrecDecScientificNotation(limitPriceStr)
-			if err != nil {
-				return nil, nil, errors.Wrapf(err, "cannot parse string %s for limit price", limitPriceStr)
-			}
-			msg.LimitSellPrice = &limitPriceDec
+		msg, err := convertPlaceLimitOrder(contractAddr, dex.PlaceLimitOrder)
+		if err != nil {
+			return nil, nil, err
 		}
 
-		return handleDexMsg(ctx, &msg, m.DexMsgServer.PlaceLimitOrder)
+		return handleDexMsg(ctx, msg, m.DexMsgServer.PlaceLimitOrder)
 	case dex.CancelLimitOrder != nil:
 		dex.CancelLimitOrder.Creator = contractAddr.String()
 		return handleDexMsg(ctx, dex.CancelLimitOrder, m.DexMsgServer.CancelLimitOrder)
+	case dex.AmendLimitOrder != nil:
+		dex.AmendLimitOrder.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.AmendLimitOrder, m.DexMsgServer.AmendLimitOrder)
+	case dex.FlashSwap != nil:
+		dex.FlashSwap.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.FlashSwap, m.DexMsgServer.FlashSwap)
 	case dex.WithdrawFilledLimitOrder != nil:
 		dex.WithdrawFilledLimitOrder.Creator = contractAddr.String()
 		return handleDexMsg(ctx, dex.WithdrawFilledLimitOrder, m.DexMsgServer.WithdrawFilledLimitOrder)
 	case dex.MultiHopSwap != nil:
 		dex.MultiHopSwap.Creator = contractAddr.String()
 		return handleDexMsg(ctx, dex.MultiHopSwap, m.DexMsgServer.MultiHopSwap)
+	case dex.MultiHopSwapExactOut != nil:
+		dex.MultiHopSwapExactOut.Creator = contractAddr.String()
+		return handleDexMsg(ctx, dex.MultiHopSwapExactOut, m.DexMsgServer.MultiHopSwapExactOut)
+	case dex.BatchOps != nil:
+		msg, err := convertBatchOps(contractAddr, dex.BatchOps)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return handleDexMsg(ctx, msg, m.DexMsgServer.BatchOps)
 	}
 
 	return nil, nil, sdkerrors.ErrUnknownRequest
 }
 
+// convertPlaceLimitOrder converts the contract friendly MsgPlaceLimitOrder into a dextypes.MsgPlaceLimitOrder
+func convertPlaceLimitOrder(contractAddr sdk.AccAddress, placeLimitOrder *bindings.MsgPlaceLimitOrder) (*dextypes.MsgPlaceLimitOrder, error) {
+	msg := &dextypes.MsgPlaceLimitOrder{
+		Creator:  contractAddr.String(),
+		Receiver: placeLimitOrder.Receiver,
+		TokenIn:  placeLimitOrder.TokenIn,
+		TokenOut: placeLimitOrder.TokenOut,
+		//nolint: staticcheck // TODO: remove in next release
+		TickIndexInToOut: placeLimitOrder.TickIndexInToOut,
+		AmountIn:         placeLimitOrder.AmountIn,
+		MaxAmountOut:     placeLimitOrder.MaxAmountOut,
+		OraclePeg:        placeLimitOrder.OraclePeg,
+		ExpirationHeight: placeLimitOrder.ExpirationHeight,
+		AutoWithdraw:     placeLimitOrder.AutoWithdraw,
+	}
+	orderTypeInt, ok := dextypes.LimitOrderType_value[placeLimitOrder.OrderType]
+	if !ok {
+		return nil, errors.Wrap(dextypes.ErrInvalidOrderType,
+			fmt.Sprintf(
+				"got \"%s\", expected one of %s",
+				placeLimitOrder.OrderType,
+				strings.Join(maps.Keys(dextypes.LimitOrderType_value), ", ")),
+		)
+	}
+	msg.OrderType = dextypes.LimitOrderType(orderTypeInt)
+
+	if placeLimitOrder.ExpirationTime != nil {
+		t := time.Unix(int64(*(placeLimitOrder.ExpirationTime)), 0) //nolint:gosec
+		msg.ExpirationTime = &t
+	}
+
+	if limitPriceStr := placeLimitOrder.LimitSellPrice; limitPriceStr != "" {
+		limitPriceDec, err := dexutils.ParsePrecDecScientificNotation(limitPriceStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse string %s for limit price", limitPriceStr)
+		}
+		msg.LimitSellPrice = &limitPriceDec
+	}
+
+	if triggerPriceStr := placeLimitOrder.TriggerSellPrice; triggerPriceStr != "" {
+		triggerPriceDec, err := dexutils.ParsePrecDecScientificNotation(triggerPriceStr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "cannot parse string %s for trigger price", triggerPriceStr)
+		}
+		msg.TriggerSellPrice = &triggerPriceDec
+	}
+
+	return msg, nil
+}
+
+// convertBatchOps converts the contract friendly MsgBatchOps into a dextypes.MsgBatchOps
+// with the contract set as the creator of every operation
+func convertBatchOps(contractAddr sdk.AccAddress, batchOps *bindings.MsgBatchOps) (*dextypes.MsgBatchOps, error) {
+	ops := make([]*dextypes.BatchOp, len(batchOps.Ops))
+	for i, op := range batchOps.Ops {
+		ops[i] = &dextypes.BatchOp{
+			AllowFailure:             op.AllowFailure,
+			Deposit:                  op.Deposit,
+			Withdrawal:               op.Withdrawal,
+			WithdrawFilledLimitOrder: op.WithdrawFilledLimitOrder,
+			CancelLimitOrder:         op.CancelLimitOrder,
+			MultiHopSwap:             op.MultiHopSwap,
+		}
+
+		if op.PlaceLimitOrder != nil {
+			msg, err := convertPlaceLimitOrder(contractAddr, op.PlaceLimitOrder)
+			if err != nil {
+				return nil, errors.Wrapf(err, "op %d", i)
+			}
+			ops[i].PlaceLimitOrder = msg
+		}
+	}
+
+	msg := dextypes.NewMsgBatchOps(contractAddr.String(), ops)
+	msg.SetCreator(contractAddr.String())
+
+	return msg, nil
+}
+
 func (m *CustomMessenger) ibcTransfer(ctx sdk.Context, contractAddr sdk.AccAddress, ibcTransferMsg transferwrappertypes.MsgTransfer) ([]sdk.Event, [][]byte, [][]*types.Any, error) {
 	ibcTransferMsg.Sender = contractAddr.String()
 
@@ -144,6 +144,8 @@ func (qp *QueryPlugin) DexQuery(ctx sdk.Context, query bindings.DexQuery) (data
 	switch {
 	case query.EstimateMultiHopSwap != nil:
 		data, err = dexQuery(ctx, query.EstimateMultiHopSwap, qp.dexKeeper.EstimateMultiHopSwap)
+	case query.EstimateMultiHopSwapExactOut != nil:
+		data, err = dexQuery(ctx, query.EstimateMultiHopSwapExactOut, qp.dexKeeper.EstimateMultiHopSwapExactOut)
 	case query.EstimatePlaceLimitOrder != nil:
 		q := dextypes.QueryEstimatePlaceLimitOrderRequest{
 			Creator:          query.EstimatePlaceLimitOrder.Creator,
@@ -179,6 +181,10 @@ func (qp *QueryPlugin) DexQuery(ctx sdk.Context, query bindings.DexQuery) (data
 		data, err = dexQuery(ctx, query.LimitOrderTranche, qp.dexKeeper.LimitOrderTranche)
 	case query.LimitOrderTrancheAll != nil:
 		data, err = dexQuery(ctx, query.LimitOrderTrancheAll, qp.dexKeeper.LimitOrderTrancheAll)
+	case query.OrderBook != nil:
+		data, err = dexQuery(ctx, query.OrderBook, qp.dexKeeper.OrderBook)
+	case query.FindRoutes != nil:
+		data, err = dexQuery(ctx, query.FindRoutes, qp.dexKeeper.FindRoutes)
 	case query.LimitOrderTrancheUserAll != nil:
 		data, err = dexQuery(ctx, query.LimitOrderTrancheUserAll, qp.dexKeeper.LimitOrderTrancheUserAll)
 	case query.Params != nil:
@@ -199,6 +205,12 @@ func (qp *QueryPlugin) DexQuery(ctx sdk.Context, query bindings.DexQuery) (data
 		data, err = dexQuery(ctx, query.PoolReserves, qp.dexKeeper.PoolReserves)
 	case query.TickLiquidityAll != nil:
 		data, err = dexQuery(ctx, query.TickLiquidityAll, qp.dexKeeper.TickLiquidityAll)
+	case query.TimeWeightedAveragePrice != nil:
+		data, err = dexQuery(ctx, query.TimeWeightedAveragePrice, qp.dexKeeper.TimeWeightedAveragePrice)
+	case query.TriggerOrderAllByAddress != nil:
+		data, err = dexQuery(ctx, query.TriggerOrderAllByAddress, qp.dexKeeper.TriggerOrderAllByAddress)
+	case query.PeggedOrderAllByAddress != nil:
+		data, err = dexQuery(ctx, query.PeggedOrderAllByAddress, qp.dexKeeper.PeggedOrderAllByAddress)
 	case query.UserDepositsAll != nil:
 		data, err = dexQuery(ctx, query.UserDepositsAll, qp.dexKeeper.UserDepositsAll)
 	default:
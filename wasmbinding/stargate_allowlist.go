@@ -90,6 +90,7 @@ func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
 		"/neutron.dex.Query/PoolReservesAll":                   &dextypes.QueryAllPoolReservesResponse{},
 		"/neutron.dex.Query/PoolReserves":                      &dextypes.QueryGetPoolReservesResponse{},
 		"/neutron.dex.Query/EstimateMultiHopSwap":              &dextypes.QueryEstimateMultiHopSwapResponse{},
+		"/neutron.dex.Query/EstimateMultiHopSwapExactOut":      &dextypes.QueryEstimateMultiHopSwapExactOutResponse{},
 		"/neutron.dex.Query/EstimatePlaceLimitOrder":           &dextypes.QueryEstimatePlaceLimitOrderResponse{},
 		"/neutron.dex.Query/Pool":                              &dextypes.QueryPoolResponse{},
 		"/neutron.dex.Query/PoolByID":                          &dextypes.QueryPoolResponse{},
@@ -101,6 +102,17 @@ func AcceptedStargateQueries() wasmkeeper.AcceptedQueries {
 		"/neutron.dex.Query/SimulateWithdrawFilledLimitOrder":  &dextypes.QuerySimulateWithdrawFilledLimitOrderResponse{},
 		"/neutron.dex.Query/SimulateCancelLimitOrder":          &dextypes.QuerySimulateCancelLimitOrderResponse{},
 		"/neutron.dex.Query/SimulateMultiHopSwap":              &dextypes.QuerySimulateMultiHopSwapResponse{},
+		"/neutron.dex.Query/SimulateMultiHopSwapExactOut":      &dextypes.QuerySimulateMultiHopSwapExactOutResponse{},
+		"/neutron.dex.Query/TimeWeightedAveragePrice":          &dextypes.QueryTimeWeightedAveragePriceResponse{},
+		"/neutron.dex.Query/TriggerOrderAllByAddress":          &dextypes.QueryAllTriggerOrderByAddressResponse{},
+		"/neutron.dex.Query/PeggedOrderAllByAddress":           &dextypes.QueryAllPeggedOrderByAddressResponse{},
+		"/neutron.dex.Query/ProtocolFees":                      &dextypes.QueryGetProtocolFeesResponse{},
+		"/neutron.dex.Query/ProtocolFeesAll":                   &dextypes.QueryAllProtocolFeesResponse{},
+		"/neutron.dex.Query/OrderBook":                         &dextypes.QueryOrderBookResponse{},
+		"/neutron.dex.Query/FindRoutes":                        &dextypes.QueryFindRoutesResponse{},
+		"/neutron.dex.Query/GaugeAll":                          &dextypes.QueryAllGaugeResponse{},
+		"/neutron.dex.Query/StakeAllByAddress":                 &dextypes.QueryAllStakeByAddressResponse{},
+		"/neutron.dex.Query/PendingRewards":                    &dextypes.QueryPendingRewardsResponse{},
 
 		// oracle
 		"/slinky.oracle.v1.Query/GetAllCurrencyPairs": &oracletypes.GetAllCurrencyPairsResponse{},
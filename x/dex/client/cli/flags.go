@@ -3,10 +3,24 @@ package cli
 import flag "github.com/spf13/pflag"
 
 const (
-	FlagMaxAmountOut    = "max-amount-out"
-	FlagIncludePoolData = "include-pool-data"
-	FlagCalcWithdraw    = "calc-withdraw"
-	FlagPrice           = "price"
+	FlagMaxAmountOut     = "max-amount-out"
+	FlagIncludePoolData  = "include-pool-data"
+	FlagCalcWithdraw     = "calc-withdraw"
+	FlagPrice            = "price"
+	FlagTriggerPrice     = "trigger-price"
+	FlagSplitRoutes      = "split-routes"
+	FlagInterval         = "interval"
+	FlagAmountIn         = "amount-in"
+	FlagDepth            = "depth"
+	FlagPriceGrouping    = "price-grouping"
+	FlagMaxHops          = "max-hops"
+	FlagPegCurrencyPair  = "peg-currency-pair"
+	FlagPegBaseDenom     = "peg-base-denom"
+	FlagPegBaseDecimals  = "peg-base-decimals"
+	FlagPegQuoteDecimals = "peg-quote-decimals"
+	FlagPegOffsetBps     = "peg-offset-bps"
+	FlagExpirationHeight = "expiration-height"
+	FlagAutoWithdraw     = "auto-withdraw"
 )
 
 func FlagSetMaxAmountOut() *flag.FlagSet {
@@ -21,6 +35,18 @@ func FlagSetPrice() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetTriggerPrice() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagTriggerPrice, "", "Trigger sell price for STOP_LOSS and TAKE_PROFIT orders")
+	return fs
+}
+
+func FlagSetSplitRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(FlagSplitRoutes, false, "Split amount-in across all routes to maximise the amount out")
+	return fs
+}
+
 func FlagSetIncludePoolData() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 	fs.Bool(FlagIncludePoolData, false, "Include pool data with response")
@@ -32,3 +58,50 @@ func FlagSetCalcWithdrawableAmount() *flag.FlagSet {
 	fs.Bool(FlagCalcWithdraw, false, "Calculate withdrawable amount")
 	return fs
 }
+
+func FlagSetInterval() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Int64(FlagInterval, 0, "Length of each candle in seconds (defaults to the node's candle interval)")
+	return fs
+}
+
+func FlagSetAmountIn() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagAmountIn, "", "New unfilled amount for the limit order")
+	return fs
+}
+
+func FlagSetOrderBook() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint64(FlagDepth, 0, "Maximum number of price levels to return (defaults to 20)")
+	fs.Uint64(FlagPriceGrouping, 0, "Number of ticks aggregated into each price level")
+	return fs
+}
+
+func FlagSetMaxHops() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint64(FlagMaxHops, 0, "Maximum number of swaps in a route (defaults to 3)")
+	return fs
+}
+
+func FlagSetExpirationHeight() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint64(FlagExpirationHeight, 0, "Block height at which a GOOD_TIL_BLOCK order expires")
+	return fs
+}
+
+func FlagSetAutoWithdraw() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Bool(FlagAutoWithdraw, false, "Send the proceeds of the order to the receiver once it is filled or expires")
+	return fs
+}
+
+func FlagSetOraclePeg() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagPegCurrencyPair, "", "Oracle currency pair (ie. ATOM/USD) that the GOOD_TIL_CANCELLED order is pegged to")
+	fs.String(FlagPegBaseDenom, "", "Denom (token-in or token-out) that corresponds to the base of the pegged currency pair")
+	fs.Uint64(FlagPegBaseDecimals, 0, "Number of decimals of the peg's base denom")
+	fs.Uint64(FlagPegQuoteDecimals, 0, "Number of decimals of the peg's quote denom")
+	fs.Int64(FlagPegOffsetBps, 0, "Offset of the sell price from the oracle price in basis points")
+	return fs
+}
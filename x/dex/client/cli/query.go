@@ -30,6 +30,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdShowLimitOrderTranche())
 	cmd.AddCommand(CmdListUserDeposits())
 	cmd.AddCommand(CmdListUserLimitOrders())
+	cmd.AddCommand(CmdListUserTriggerOrders())
+	cmd.AddCommand(CmdListUserPeggedOrders())
 	cmd.AddCommand(CmdListTickLiquidity())
 	cmd.AddCommand(CmdListInactiveLimitOrderTranche())
 	cmd.AddCommand(CmdShowInactiveLimitOrderTranche())
@@ -40,6 +42,15 @@ func GetQueryCmd(_ string) *cobra.Command {
 
 	cmd.AddCommand(CmdListPoolMetadata())
 	cmd.AddCommand(CmdShowPoolMetadata())
+	cmd.AddCommand(CmdShowTimeWeightedAveragePrice())
+	cmd.AddCommand(CmdListProtocolFees())
+	cmd.AddCommand(CmdShowProtocolFees())
+	cmd.AddCommand(CmdListCandles())
+	cmd.AddCommand(CmdShowOrderBook())
+	cmd.AddCommand(CmdFindRoutes())
+	cmd.AddCommand(CmdListGauges())
+	cmd.AddCommand(CmdListUserStakes())
+	cmd.AddCommand(CmdShowPendingRewards())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
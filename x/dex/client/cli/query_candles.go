@@ -0,0 +1,65 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdListCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "list-candles '[pair-id]' [start-time] ?[end-time]",
+		Short:   "lists the OHLCV candles of a pair between two unix timestamps. Candles are only served by nodes that have enabled them in app.toml. Make sure to wrap your pair-id in quotes otherwise the shell will interpret <> as a separator token",
+		Example: "list-candles 'tokenA<>tokenB' 1700000000 1700003600 --interval 300",
+		Args:    cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argPairID := args[0]
+
+			argStartTime, err := strconv.ParseInt(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var argEndTime int64
+			if len(args) == 3 {
+				argEndTime, err = strconv.ParseInt(args[2], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			interval, err := cmd.Flags().GetInt64(FlagInterval)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryCandlesRequest{
+				PairId:    argPairID,
+				Interval:  interval,
+				StartTime: argStartTime,
+				EndTime:   argEndTime,
+			}
+
+			res, err := queryClient.Candles(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetInterval())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
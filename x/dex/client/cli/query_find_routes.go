@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdFindRoutes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "find-routes [token-in] [token-out] [amount-in] ?(--max-hops)",
+		Short:   "finds multi-hop swap routes from token-in to token-out ranked by simulated amount out",
+		Example: "find-routes tokenA tokenC 1000 --max-hops 3",
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			amountIn, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-in")
+			}
+
+			maxHops, err := cmd.Flags().GetUint64(FlagMaxHops)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryFindRoutesRequest{
+				TokenIn:  args[0],
+				TokenOut: args[1],
+				AmountIn: amountIn,
+				MaxHops:  maxHops,
+			}
+
+			res, err := queryClient.FindRoutes(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetMaxHops())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
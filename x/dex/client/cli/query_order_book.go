@@ -0,0 +1,54 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdShowOrderBook() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show-order-book '[pair-id]' [token-in] ?(--depth) ?(--price-grouping)",
+		Short:   "shows aggregated price levels for one side of a pair's order book. Make sure to wrap your pair-id in quotes otherwise the shell will interpret <> as a separator token",
+		Example: "show-order-book 'tokenA<>tokenB' tokenA --depth 10 --price-grouping 5",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			depth, err := cmd.Flags().GetUint64(FlagDepth)
+			if err != nil {
+				return err
+			}
+
+			priceGrouping, err := cmd.Flags().GetUint64(FlagPriceGrouping)
+			if err != nil {
+				return err
+			}
+
+			params := &types.QueryOrderBookRequest{
+				PairId:        args[0],
+				TokenIn:       args[1],
+				Depth:         depth,
+				PriceGrouping: priceGrouping,
+			}
+
+			res, err := queryClient.OrderBook(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetOrderBook())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdShowTimeWeightedAveragePrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "show-time-weighted-average-price '[pair-id]' [token-in] [start-time] ?[end-time]",
+		Short:   "shows the time weighted average price of a pair between two unix timestamps. Make sure to wrap your pair-id in quotes otherwise the shell will interpret <> as a separator token",
+		Example: "show-time-weighted-average-price 'tokenA<>tokenB' tokenA 1700000000 1700003600",
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			argPairID := args[0]
+			argTokenIn := args[1]
+
+			argStartTime, err := strconv.ParseInt(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			var argEndTime int64
+			if len(args) == 4 {
+				argEndTime, err = strconv.ParseInt(args[3], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			params := &types.QueryTimeWeightedAveragePriceRequest{
+				PairId:    argPairID,
+				TokenIn:   argTokenIn,
+				StartTime: argStartTime,
+				EndTime:   argEndTime,
+			}
+
+			res, err := queryClient.TimeWeightedAveragePrice(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
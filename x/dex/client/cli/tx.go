@@ -28,7 +28,16 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdPlaceLimitOrder())
 	cmd.AddCommand(CmdWithdrawFilledLimitOrder())
 	cmd.AddCommand(CmdCancelLimitOrder())
+	cmd.AddCommand(CmdAmendLimitOrder())
 	cmd.AddCommand(CmdMultiHopSwap())
+	cmd.AddCommand(CmdMultiHopSwapExactOut())
+	cmd.AddCommand(CmdDepositRange())
+	cmd.AddCommand(CmdWithdrawRange())
+	cmd.AddCommand(CmdBatchOps())
+	cmd.AddCommand(CmdCreateGauge())
+	cmd.AddCommand(CmdStake())
+	cmd.AddCommand(CmdUnstake())
+	cmd.AddCommand(CmdClaim())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
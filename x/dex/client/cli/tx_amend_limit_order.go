@@ -0,0 +1,71 @@
+package cli
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdAmendLimitOrder() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "amend-limit-order [tranche-key] ?(--amount-in) ?(--price)",
+		Short:   "Broadcast message AmendLimitOrder",
+		Example: "amend-limit-order TRANCHEKEY123 --amount-in 50 --price 1.5 --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amountInArg, err := cmd.Flags().GetString(FlagAmountIn)
+			if err != nil {
+				return err
+			}
+
+			var amountInIntP *math.Int
+			if amountInArg != "" {
+				amountInInt, ok := math.NewIntFromString(amountInArg)
+				if !ok {
+					return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-in")
+				}
+				amountInIntP = &amountInInt
+			}
+
+			priceArg, err := cmd.Flags().GetString(FlagPrice)
+			if err != nil {
+				return err
+			}
+
+			var priceDecP *math_utils.PrecDec
+			if priceArg != "" {
+				priceDec, err := math_utils.NewPrecDecFromStr(priceArg)
+				if err != nil {
+					return err
+				}
+				priceDecP = &priceDec
+			}
+
+			msg := types.NewMsgAmendLimitOrder(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				amountInIntP,
+				priceDecP,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetAmountIn())
+	cmd.Flags().AddFlagSet(FlagSetPrice())
+
+	return cmd
+}
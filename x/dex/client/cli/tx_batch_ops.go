@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdBatchOps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-ops [ops-json-file]",
+		Short: "Broadcast message BatchOps",
+		Long: `Broadcast message BatchOps. The file must contain a JSON encoded MsgBatchOps, the creator of the batch and
+of every operation is set to the --from address. Example file:
+{
+  "ops": [
+    {"cancel_limit_order": {"tranche_key": "TRANCHEKEY123"}},
+    {"allow_failure": true, "place_limit_order": {"receiver": "neutron1...", "token_in": "tokenA", "token_out": "tokenB", "amount_in": "1000", "limit_sell_price": "1.5"}}
+  ]
+}`,
+		Example: "batch-ops ops.json --from alice",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchOps{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return err
+			}
+			msg.SetCreator(clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
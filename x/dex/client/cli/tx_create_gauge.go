@@ -0,0 +1,68 @@
+package cli
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdCreateGauge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "create-gauge [token-a] [token-b] [start-tick] [end-tick] [coins] [num-epochs]",
+		Short:   "Broadcast message create-gauge",
+		Example: "create-gauge tokenA tokenB [-10] 10 1000untrn 24 --from alice",
+		Args:    cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			pairID, err := types.NewPairID(args[0], args[1])
+			if err != nil {
+				return err
+			}
+
+			startTick, err := strconv.ParseInt(trimBrackets(args[2]), 10, 0)
+			if err != nil {
+				return err
+			}
+
+			endTick, err := strconv.ParseInt(trimBrackets(args[3]), 10, 0)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseCoinsNormalized(args[4])
+			if err != nil {
+				return err
+			}
+
+			numEpochs, err := strconv.ParseUint(args[5], 10, 0)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateGauge(
+				clientCtx.GetFromAddress().String(),
+				pairID,
+				startTick,
+				endTick,
+				coins,
+				numEpochs,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
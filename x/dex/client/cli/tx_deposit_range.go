@@ -0,0 +1,103 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdDepositRange() *cobra.Command {
+	cmd := &cobra.Command{
+		//nolint:lll
+		Use:     "deposit-range [receiver] [token-a] [token-b] [amount-a] [amount-b] [lower-tick-index] [upper-tick-index] [fee] [shape] [disable_autoswap] [fail_tx_on_BEL]",
+		Short:   "Broadcast message deposit-range",
+		Example: "deposit-range alice tokenA tokenB 1000 1000 [-100] 100 5 bell false false --from alice",
+		Args:    cobra.ExactArgs(11),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReceiver := args[0]
+			argTokenA := args[1]
+			argTokenB := args[2]
+
+			amountA, ok := math.NewIntFromString(args[3])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-a")
+			}
+
+			amountB, ok := math.NewIntFromString(args[4])
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Integer overflow for amount-b")
+			}
+
+			lowerTickIndex, err := strconv.ParseInt(trimBrackets(args[5]), 10, 0)
+			if err != nil {
+				return err
+			}
+
+			upperTickIndex, err := strconv.ParseInt(trimBrackets(args[6]), 10, 0)
+			if err != nil {
+				return err
+			}
+
+			fee, err := strconv.ParseUint(args[7], 10, 0)
+			if err != nil {
+				return err
+			}
+
+			shape, ok := types.RangeShape_value[strings.ToUpper(args[8])]
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrInvalidRange, "invalid shape %s", args[8])
+			}
+
+			disableAutoswap, err := strconv.ParseBool(args[9])
+			if err != nil {
+				return err
+			}
+
+			failTx, err := strconv.ParseBool(args[10])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositRange(
+				clientCtx.GetFromAddress().String(),
+				argReceiver,
+				argTokenA,
+				argTokenB,
+				amountA,
+				amountB,
+				lowerTickIndex,
+				upperTickIndex,
+				fee,
+				types.RangeShape(shape),
+				&types.DepositOptions{
+					DisableAutoswap: disableAutoswap,
+					FailTxOnBel:     failTx,
+				},
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// trimBrackets allows negative tick indexes to be passed as "[-10]" so they are not parsed as flags
+func trimBrackets(s string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(s, "["), "]")
+}
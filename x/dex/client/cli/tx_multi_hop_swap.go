@@ -63,10 +63,17 @@ func CmdMultiHopSwap() *cobra.Command {
 				pickBest,
 			)
 
+			splitRoutes, err := cmd.Flags().GetBool(FlagSplitRoutes)
+			if err != nil {
+				return err
+			}
+			msg.SplitRoutes = splitRoutes
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"strconv"
+	"strings"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func CmdMultiHopSwapExactOut() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-hop-swap-exact-out [receiver] [routes] [amount-out] [max-amount-in] [pick-best-route]",
+		Short: "Broadcast message multiHopSwapExactOut",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			argReceiever := args[0]
+			argRoutes := strings.Split(args[1], ";")
+			argAmountOut := args[2]
+			argMaxAmountIn := args[3]
+			argPickBest := args[4]
+
+			routesArr := make([][]string, len(argRoutes))
+			for i, route := range argRoutes {
+				routesArr[i] = strings.Split(route, ",")
+			}
+
+			amountOutInt, ok := math.NewIntFromString(argAmountOut)
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Invalid value for amount-out")
+			}
+
+			maxAmountInInt, ok := math.NewIntFromString(argMaxAmountIn)
+			if !ok {
+				return sdkerrors.Wrapf(types.ErrIntOverflowTx, "Invalid value for max-amount-in")
+			}
+
+			pickBest, err := strconv.ParseBool(argPickBest)
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgMultiHopSwapExactOut(
+				clientCtx.GetFromAddress().String(),
+				argReceiever,
+				routesArr,
+				amountOutInt,
+				maxAmountInInt,
+				pickBest,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -19,7 +19,7 @@ import (
 func CmdPlaceLimitOrder() *cobra.Command {
 	cmd := &cobra.Command{
 		//nolint:lll
-		Use:     "place-limit-order [receiver] [token-in] [token-out] [tick-index] [amount-in] ?[order-type] ?[expirationTime] ?(--max-amout-out) ?(--price)",
+		Use:     "place-limit-order [receiver] [token-in] [token-out] [tick-index] [amount-in] ?[order-type] ?[expirationTime] ?(--max-amout-out) ?(--price) ?(--trigger-price) ?(--expiration-height) ?(--peg-currency-pair) ?(--auto-withdraw)",
 		Short:   "Broadcast message PlaceLimitOrder",
 		Example: "place-limit-order alice tokenA tokenB [-10] tokenA 50 GOOD_TIL_TIME '01/02/2006 15:04:05' --max-amount-out 20 --from alice",
 		Args:    cobra.RangeArgs(5, 7),
@@ -93,6 +93,35 @@ func CmdPlaceLimitOrder() *cobra.Command {
 				priceDecP = &priceDec
 			}
 
+			triggerPriceArg, err := cmd.Flags().GetString(FlagTriggerPrice)
+			if err != nil {
+				return err
+			}
+
+			var triggerPriceDecP *math_utils.PrecDec
+			if triggerPriceArg != "" {
+				triggerPriceDec, err := math_utils.NewPrecDecFromStr(triggerPriceArg)
+				if err != nil {
+					return err
+				}
+				triggerPriceDecP = &triggerPriceDec
+			}
+
+			expirationHeight, err := cmd.Flags().GetUint64(FlagExpirationHeight)
+			if err != nil {
+				return err
+			}
+
+			oraclePeg, err := oraclePegFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			autoWithdraw, err := cmd.Flags().GetBool(FlagAutoWithdraw)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -110,6 +139,10 @@ func CmdPlaceLimitOrder() *cobra.Command {
 				maxAmountOutIntP,
 				priceDecP,
 			)
+			msg.TriggerSellPrice = triggerPriceDecP
+			msg.OraclePeg = oraclePeg
+			msg.ExpirationHeight = expirationHeight
+			msg.AutoWithdraw = autoWithdraw
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -118,6 +151,45 @@ func CmdPlaceLimitOrder() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().AddFlagSet(FlagSetMaxAmountOut())
 	cmd.Flags().AddFlagSet(FlagSetPrice())
+	cmd.Flags().AddFlagSet(FlagSetTriggerPrice())
+	cmd.Flags().AddFlagSet(FlagSetExpirationHeight())
+	cmd.Flags().AddFlagSet(FlagSetOraclePeg())
+	cmd.Flags().AddFlagSet(FlagSetAutoWithdraw())
 
 	return cmd
 }
+
+func oraclePegFromFlags(cmd *cobra.Command) (*types.OraclePeg, error) {
+	currencyPair, err := cmd.Flags().GetString(FlagPegCurrencyPair)
+	if err != nil || currencyPair == "" {
+		return nil, err
+	}
+
+	baseDenom, err := cmd.Flags().GetString(FlagPegBaseDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	baseDecimals, err := cmd.Flags().GetUint64(FlagPegBaseDecimals)
+	if err != nil {
+		return nil, err
+	}
+
+	quoteDecimals, err := cmd.Flags().GetUint64(FlagPegQuoteDecimals)
+	if err != nil {
+		return nil, err
+	}
+
+	offsetBps, err := cmd.Flags().GetInt64(FlagPegOffsetBps)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.OraclePeg{
+		CurrencyPair:  currencyPair,
+		BaseDenom:     baseDenom,
+		BaseDecimals:  baseDecimals,
+		QuoteDecimals: quoteDecimals,
+		OffsetBps:     offsetBps,
+	}, nil
+}
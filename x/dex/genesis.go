@@ -21,8 +21,7 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			k.SetLimitOrderTranche(ctx, tranche)
 			if tranche.HasExpiration() {
 				// re-create expiration record
-				loExpiration := keeper.NewLimitOrderExpiration(tranche)
-				k.SetLimitOrderExpiration(ctx, loExpiration)
+				k.SetTrancheExpiration(ctx, tranche)
 			}
 		}
 	}
@@ -42,8 +41,88 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		k.StorePoolIDRef(ctx, elem.Id, elem.PairId, elem.Tick, elem.Fee)
 	}
 
+	// Set all the priceAccumulator
+	for _, elem := range genState.PriceAccumulatorList {
+		k.SetPriceAccumulator(ctx, elem)
+	}
+
+	// Set all the triggerOrder
+	for _, elem := range genState.TriggerOrderList {
+		k.SetTriggerOrder(ctx, elem)
+	}
+
+	// Set all the peggedOrder
+	for _, elem := range genState.PeggedOrderList {
+		k.SetPeggedOrder(ctx, elem)
+	}
+
+	// Set all the rangePosition
+	for _, elem := range genState.RangePositionList {
+		k.SetRangePosition(ctx, elem)
+	}
+
+	// Set the pause registry
+	for _, elem := range genState.PausedPairList {
+		k.SetPairPaused(ctx, elem, true)
+	}
+	for _, elem := range genState.PausedDenomList {
+		k.SetDenomPaused(ctx, elem, true)
+	}
+
+	// Set the batch auction registry and queued orders
+	for _, elem := range genState.BatchAuctionPairList {
+		k.SetPairBatchAuction(ctx, elem, true)
+	}
+	for _, elem := range genState.BatchAuctionOrderList {
+		k.SetBatchAuctionOrder(ctx, elem)
+	}
+
+	// Set the auto withdraw queue; the index of auto withdraw orders is rebuilt from LimitOrderTrancheUserList
+	for _, elem := range genState.AutoWithdrawTrancheList {
+		k.SetAutoWithdrawTranche(ctx, elem)
+	}
+
+	// Set all the dynamicFee
+	for _, elem := range genState.DynamicFeeList {
+		k.SetDynamicFee(ctx, elem)
+	}
+
+	// Set all the circuitBreaker
+	for _, elem := range genState.CircuitBreakerList {
+		k.SetCircuitBreaker(ctx, elem)
+	}
+
+	// Set all the protocolFees
+	for _, elem := range genState.ProtocolFeesList {
+		k.SetProtocolFees(ctx, &elem)
+	}
+
+	// Set the protocol fees that have not yet been sent to the protocol fee collector
+	k.SetPendingProtocolFees(ctx, genState.PendingProtocolFees)
+
+	// Set all the gauge
+	for _, elem := range genState.GaugeList {
+		k.SetGauge(ctx, elem)
+	}
+
+	// Set all the stake
+	for _, elem := range genState.StakeList {
+		k.SetStake(ctx, elem)
+	}
+
+	// Set all the stakerRewards
+	for _, elem := range genState.StakerRewardsList {
+		k.SetStakerRewards(ctx, elem)
+	}
+
 	// Set poolMetadata count
 	k.SetPoolCount(ctx, genState.PoolCount)
+	// Set rangePosition count
+	k.SetRangePositionCount(ctx, genState.RangePositionCount)
+	// Set gauge count
+	k.SetGaugeCount(ctx, genState.GaugeCount)
+	// Set stake count
+	k.SetStakeCount(ctx, genState.StakeCount)
 	// this line is used by starport scaffolding # genesis/module/init
 	err := k.SetParams(ctx, genState.Params)
 	if err != nil {
@@ -60,6 +139,25 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.InactiveLimitOrderTrancheList = k.GetAllInactiveLimitOrderTranche(ctx)
 	genesis.PoolMetadataList = k.GetAllPoolMetadata(ctx)
 	genesis.PoolCount = k.GetPoolCount(ctx)
+	genesis.PriceAccumulatorList = k.GetAllPriceAccumulator(ctx)
+	genesis.TriggerOrderList = k.GetAllTriggerOrder(ctx)
+	genesis.PeggedOrderList = k.GetAllPeggedOrder(ctx)
+	genesis.RangePositionList = k.GetAllRangePosition(ctx)
+	genesis.RangePositionCount = k.GetRangePositionCount(ctx)
+	genesis.PausedPairList = k.GetAllPausedPairs(ctx)
+	genesis.PausedDenomList = k.GetAllPausedDenoms(ctx)
+	genesis.CircuitBreakerList = k.GetAllCircuitBreaker(ctx)
+	genesis.ProtocolFeesList = k.GetAllProtocolFees(ctx)
+	genesis.GaugeList = k.GetAllGauge(ctx)
+	genesis.GaugeCount = k.GetGaugeCount(ctx)
+	genesis.StakeList = k.GetAllStake(ctx)
+	genesis.StakeCount = k.GetStakeCount(ctx)
+	genesis.StakerRewardsList = k.GetAllStakerRewards(ctx)
+	genesis.BatchAuctionPairList = k.GetAllBatchAuctionPairs(ctx)
+	genesis.BatchAuctionOrderList = k.GetAllBatchAuctionOrder(ctx)
+	genesis.AutoWithdrawTrancheList = k.GetAllAutoWithdrawTranches(ctx)
+	genesis.DynamicFeeList = k.GetAllDynamicFee(ctx)
+	genesis.PendingProtocolFees = k.GetPendingProtocolFees(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/neutron-org/neutron/v5/testutil/common/nullify"
@@ -148,6 +149,45 @@ func TestGenesis(t *testing.T) {
 			},
 		},
 		PoolCount: 2,
+		PriceAccumulatorList: []*types.PriceAccumulator{
+			{
+				TradePairId:           types.MustNewTradePairID("TokenA", "TokenB"),
+				Timestamp:             time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+				TickCumulative:        0,
+				TickIndexTakerToMaker: 5,
+			},
+			{
+				TradePairId:           types.MustNewTradePairID("TokenA", "TokenB"),
+				Timestamp:             time.Date(2024, 1, 1, 1, 0, 10, 0, time.UTC),
+				TickCumulative:        50,
+				TickIndexTakerToMaker: -3,
+			},
+		},
+		PausedPairList:          []*types.PairID{types.MustNewPairID("TokenA", "TokenC")},
+		PausedDenomList:         []string{"TokenD"},
+		BatchAuctionPairList:    []*types.PairID{types.MustNewPairID("TokenA", "TokenB")},
+		AutoWithdrawTrancheList: []string{"0"},
+		DynamicFeeList: []*types.DynamicFee{
+			{
+				PairId:    types.MustNewPairID("TokenA", "TokenB"),
+				TickMoves: []types.DynamicFeeTickMove{{Height: 10, TickMove: 20}},
+				FeeBps:    20,
+			},
+		},
+		CircuitBreakerList: []*types.CircuitBreaker{
+			{
+				TradePairId:          types.MustNewTradePairID("TokenA", "TokenB"),
+				WindowStartHeight:    10,
+				WindowStartTickIndex: 5,
+			},
+		},
+		ProtocolFeesList: []types.PairProtocolFees{
+			{
+				PairId: &types.PairID{Token0: "TokenA", Token1: "TokenB"},
+				Fees:   sdk.NewCoins(sdk.NewInt64Coin("TokenA", 10)),
+			},
+		},
+		PendingProtocolFees: sdk.NewCoins(sdk.NewInt64Coin("TokenA", 4), sdk.NewInt64Coin("TokenB", 2)),
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -189,5 +229,14 @@ func TestGenesis(t *testing.T) {
 	)
 	require.ElementsMatch(t, genesisState.PoolMetadataList, got.PoolMetadataList)
 	require.Equal(t, genesisState.PoolCount, got.PoolCount)
+	require.ElementsMatch(t, genesisState.PriceAccumulatorList, got.PriceAccumulatorList)
+	require.ElementsMatch(t, genesisState.PausedPairList, got.PausedPairList)
+	require.ElementsMatch(t, genesisState.PausedDenomList, got.PausedDenomList)
+	require.ElementsMatch(t, genesisState.CircuitBreakerList, got.CircuitBreakerList)
+	require.ElementsMatch(t, genesisState.ProtocolFeesList, got.ProtocolFeesList)
+	require.ElementsMatch(t, genesisState.BatchAuctionPairList, got.BatchAuctionPairList)
+	require.ElementsMatch(t, genesisState.AutoWithdrawTrancheList, got.AutoWithdrawTrancheList)
+	require.ElementsMatch(t, genesisState.DynamicFeeList, got.DynamicFeeList)
+	require.Equal(t, genesisState.PendingProtocolFees, got.PendingProtocolFees)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
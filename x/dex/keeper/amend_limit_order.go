@@ -0,0 +1,251 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// AmendLimitOrderCore handles the logic for MsgAmendLimitOrder including bank operations and event emissions.
+// The order is canceled and its unfilled amount is immediately re-placed with the same order type and expiration.
+// If amountIn is set only that amount is re-placed; if tickIndexInToOut is set the order is moved to the new tick.
+// Filled proceeds and any unfilled amount that is not re-placed are returned to the caller.
+// Unfilled orders that are only reduced in size are shrunk in place so they keep their position in the book.
+func (k Keeper) AmendLimitOrderCore(
+	goCtx context.Context,
+	trancheKey string,
+	amountIn *math.Int,
+	tickIndexInToOut *int64,
+	callerAddr sdk.AccAddress,
+) (newTrancheKey string, makerCoinOut, takerCoinOut, takerFeeCoin sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	trancheUser, found := k.GetLimitOrderTrancheUser(ctx, callerAddr.String(), trancheKey)
+	if !found {
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrValidLimitOrderTrancheNotFound, "%s", trancheKey)
+	}
+
+	orderType := trancheUser.OrderType
+	if !orderType.IsGTC() && !orderType.IsGoodTil() && !orderType.IsGoodTilBlock() && !orderType.IsPostOnly() {
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidAmendOrderType, "%s", orderType.String())
+	}
+
+	tranche, wasFilled, found := k.FindLimitOrderTranche(
+		ctx,
+		&types.LimitOrderTrancheKey{
+			TradePairId:           trancheUser.TradePairId,
+			TickIndexTakerToMaker: trancheUser.TickIndexTakerToMaker,
+			TrancheKey:            trancheKey,
+		},
+	)
+	if !found {
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrValidLimitOrderTrancheNotFound, "%s", trancheKey)
+	}
+	goodTil := tranche.ExpirationTime
+	if orderType.IsGoodTil() && !goodTil.After(ctx.BlockTime()) {
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrExpirationTimeInPast,
+			"Current BlockTime: %s; ExpirationTime: %s",
+			ctx.BlockTime().String(),
+			goodTil.String(),
+		)
+	}
+	goodTilHeight := tranche.ExpirationHeight
+	if orderType.IsGoodTilBlock() && goodTilHeight <= uint64(ctx.BlockHeight()) { //nolint:gosec
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrExpirationHeightInPast,
+			"Current BlockHeight: %d; ExpirationHeight: %d",
+			ctx.BlockHeight(),
+			goodTilHeight,
+		)
+	}
+
+	currentTickIndexInToOut := trancheUser.TickIndexTakerToMaker * -1
+	if (tickIndexInToOut == nil || *tickIndexInToOut == currentTickIndexInToOut) && !wasFilled && tranche.TotalTakerDenom.IsZero() {
+		return k.shrinkLimitOrder(ctx, tranche, trancheUser, amountIn, callerAddr)
+	}
+
+	canceledMakerCoin, canceledTakerCoin, err := k.ExecuteCancelLimitOrder(ctx, trancheKey, callerAddr)
+	if err != nil {
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	unfilledAmount := canceledMakerCoin.Amount
+	if !unfilledAmount.IsPositive() {
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrAmendFilledLimitOrder, "%s", trancheKey)
+	}
+
+	newAmountIn := unfilledAmount
+	if amountIn != nil {
+		if amountIn.GT(unfilledAmount) {
+			return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(
+				types.ErrAmendAmountTooLarge,
+				"%s > %s",
+				amountIn.String(),
+				unfilledAmount.String(),
+			)
+		}
+		newAmountIn = *amountIn
+	}
+
+	newTickIndexInToOut := currentTickIndexInToOut
+	if tickIndexInToOut != nil {
+		newTickIndexInToOut = *tickIndexInToOut
+	}
+
+	makerDenom := trancheUser.TradePairId.MakerDenom
+	takerDenom := trancheUser.TradePairId.TakerDenom
+	takerTradePairID := trancheUser.TradePairId.Reversed()
+
+	// The re-placed order is funded entirely from the canceled maker reserves, so no tokens need to be sent in.
+	// POST_ONLY_REPRICE orders may be placed at a different tick than requested.
+	newTrancheKey, newTickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, _, _, _, err := k.ExecutePlaceLimitOrder(
+		ctx,
+		takerTradePairID,
+		newAmountIn,
+		newTickIndexInToOut,
+		orderType,
+		goodTil,
+		goodTilHeight,
+		nil,
+		nil,
+		callerAddr,
+	)
+	if err != nil {
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if trancheUser.AutoWithdraw {
+		k.EnableAutoWithdraw(ctx, callerAddr.String(), newTrancheKey)
+	}
+
+	makerCoinOut = sdk.NewCoin(makerDenom, unfilledAmount.Sub(totalIn))
+	takerCoinOut = sdk.NewCoin(takerDenom, canceledTakerCoin.Amount.Add(swapOutCoin.Amount))
+
+	coinsOut := sdk.NewCoins(makerCoinOut, takerCoinOut)
+	if !coinsOut.IsZero() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.ModuleName,
+			callerAddr,
+			coinsOut,
+		)
+		if err != nil {
+			return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	// This will never panic since PairID has already been successfully constructed during tranche creation
+	pairID := types.MustNewPairID(makerDenom, takerDenom)
+	ctx.EventManager().EmitEvent(types.AmendLimitOrderEvent(
+		callerAddr,
+		pairID.Token0,
+		pairID.Token1,
+		makerDenom,
+		takerDenom,
+		totalIn,
+		newTickIndexInToOut,
+		takerCoinOut.Amount,
+		makerCoinOut.Amount,
+		trancheKey,
+		newTrancheKey,
+		swapInCoin.Amount,
+		swapOutCoin.Amount,
+		takerFeeCoin.Amount,
+	))
+
+	if swapOutCoin.IsPositive() {
+		k.queueAfterSwap(ctx, callerAddr, callerAddr, swapInCoin, swapOutCoin)
+	}
+
+	return newTrancheKey, makerCoinOut, takerCoinOut, takerFeeCoin, nil
+}
+
+// shrinkLimitOrder reduces an unfilled limit order to amountIn without moving it out of its tranche. Since nothing
+// has been filled every share is backed by exactly one unit of maker reserves.
+func (k Keeper) shrinkLimitOrder(
+	ctx sdk.Context,
+	tranche *types.LimitOrderTranche,
+	trancheUser *types.LimitOrderTrancheUser,
+	amountIn *math.Int,
+	callerAddr sdk.AccAddress,
+) (trancheKey string, makerCoinOut, takerCoinOut, takerFeeCoin sdk.Coin, err error) {
+	unfilledAmount := trancheUser.SharesOwned
+	if !unfilledAmount.IsPositive() {
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrAmendFilledLimitOrder, "%s", trancheUser.TrancheKey)
+	}
+
+	newAmountIn := unfilledAmount
+	if amountIn != nil {
+		if amountIn.GT(unfilledAmount) {
+			return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(
+				types.ErrAmendAmountTooLarge,
+				"%s > %s",
+				amountIn.String(),
+				unfilledAmount.String(),
+			)
+		}
+		newAmountIn = *amountIn
+	}
+
+	tickIndexInToOut := trancheUser.TickIndexTakerToMaker * -1
+	limitBuyPrice, err := types.CalcPrice(tickIndexInToOut)
+	if err != nil {
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+	err = types.ValidateFairOutput(newAmountIn, limitBuyPrice)
+	if err != nil {
+		return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	amountOut := unfilledAmount.Sub(newAmountIn)
+	tranche.ReservesMakerDenom = tranche.ReservesMakerDenom.Sub(amountOut)
+	tranche.TotalMakerDenom = tranche.TotalMakerDenom.Sub(amountOut)
+	trancheUser.SharesOwned = newAmountIn
+
+	k.UpdateTrancheUser(ctx, trancheUser)
+	k.UpdateTranche(ctx, tranche)
+
+	makerDenom := trancheUser.TradePairId.MakerDenom
+	takerDenom := trancheUser.TradePairId.TakerDenom
+	makerCoinOut = sdk.NewCoin(makerDenom, amountOut)
+	takerCoinOut = sdk.NewCoin(takerDenom, math.ZeroInt())
+	takerFeeCoin = sdk.NewCoin(takerDenom, math.ZeroInt())
+
+	if makerCoinOut.IsPositive() {
+		err = k.bankKeeper.SendCoinsFromModuleToAccount(
+			ctx,
+			types.ModuleName,
+			callerAddr,
+			sdk.Coins{makerCoinOut},
+		)
+		if err != nil {
+			return "", sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+		}
+	}
+
+	trancheKey = trancheUser.TrancheKey
+
+	// This will never panic since PairID has already been successfully constructed during tranche creation
+	pairID := types.MustNewPairID(makerDenom, takerDenom)
+	ctx.EventManager().EmitEvent(types.AmendLimitOrderEvent(
+		callerAddr,
+		pairID.Token0,
+		pairID.Token1,
+		makerDenom,
+		takerDenom,
+		newAmountIn,
+		tickIndexInToOut,
+		takerCoinOut.Amount,
+		makerCoinOut.Amount,
+		trancheKey,
+		trancheKey,
+		math.ZeroInt(),
+		math.ZeroInt(),
+		takerFeeCoin.Amount,
+	))
+
+	return trancheKey, makerCoinOut, takerCoinOut, takerFeeCoin, nil
+}
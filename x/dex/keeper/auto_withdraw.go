@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// EnableAutoWithdraw marks the LimitOrderTrancheUser of address in trancheKey as auto_withdraw so that its proceeds
+// are sent to address once the LimitOrderTranche is filled or expires.
+func (k Keeper) EnableAutoWithdraw(ctx sdk.Context, address, trancheKey string) {
+	trancheUser, found := k.GetLimitOrderTrancheUser(ctx, address, trancheKey)
+	if !found || trancheUser.AutoWithdraw {
+		return
+	}
+
+	trancheUser.AutoWithdraw = true
+	k.SetLimitOrderTrancheUser(ctx, trancheUser)
+	ctx.GasMeter().ConsumeGas(types.AutoWithdrawOrderGas, "AutoWithdraw LimitOrder Fee")
+}
+
+// SetAutoWithdrawOrder adds an auto_withdraw LimitOrderTrancheUser to the index of auto_withdraw orders by TrancheKey
+func (k Keeper) SetAutoWithdrawOrder(ctx sdk.Context, trancheKey, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoWithdrawOrderKey(trancheKey, address), []byte(address))
+}
+
+func (k Keeper) RemoveAutoWithdrawOrder(ctx sdk.Context, trancheKey, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoWithdrawOrderKey(trancheKey, address))
+}
+
+// GetAutoWithdrawOrderAddresses returns the owners of all auto_withdraw LimitOrderTrancheUsers of trancheKey
+func (k Keeper) GetAutoWithdrawOrderAddresses(ctx sdk.Context, trancheKey string) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoWithdrawOrderTranchePrefix(trancheKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
+
+// SetAutoWithdrawTranche queues a filled or expired LimitOrderTranche for auto withdrawal
+func (k Keeper) SetAutoWithdrawTranche(ctx sdk.Context, trancheKey string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.AutoWithdrawTrancheKey(trancheKey), []byte(trancheKey))
+}
+
+func (k Keeper) RemoveAutoWithdrawTranche(ctx sdk.Context, trancheKey string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.AutoWithdrawTrancheKey(trancheKey))
+}
+
+// GetAllAutoWithdrawTranches returns the keys of all LimitOrderTranches queued for auto withdrawal
+func (k Keeper) GetAllAutoWithdrawTranches(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoWithdrawTrancheKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
+
+// QueueAutoWithdrawTranche queues a LimitOrderTranche that has just been filled or has expired if any of its
+// LimitOrderTrancheUsers are auto_withdraw.
+func (k Keeper) QueueAutoWithdrawTranche(ctx sdk.Context, trancheKey string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.AutoWithdrawOrderTranchePrefix(trancheKey))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	if iterator.Valid() {
+		k.SetAutoWithdrawTranche(ctx, trancheKey)
+	}
+}
+
+// AutoWithdrawLimitOrders withdraws the auto_withdraw LimitOrderTrancheUsers of every queued LimitOrderTranche and
+// sends the proceeds to their owners. Execution stops once AutoWithdrawAllowance gas has been consumed; the
+// remaining orders stay queued and are withdrawn in the following blocks.
+func (k Keeper) AutoWithdrawLimitOrders(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.Paused {
+		return
+	}
+
+	gasCutoff := ctx.GasMeter().GasConsumed() + params.AutoWithdrawAllowance
+	for {
+		trancheKey, found := k.firstAutoWithdrawTranche(ctx)
+		if !found {
+			return
+		}
+
+		for _, address := range k.GetAutoWithdrawOrderAddresses(ctx, trancheKey) {
+			gasConsumed := ctx.GasMeter().GasConsumed()
+			if gasConsumed >= gasCutoff {
+				ctx.EventManager().EmitEvent(types.AutoWithdrawHitLimitEvent(gasConsumed))
+				return
+			}
+
+			k.autoWithdrawLimitOrder(ctx, trancheKey, address)
+		}
+
+		k.RemoveAutoWithdrawTranche(ctx, trancheKey)
+	}
+}
+
+func (k Keeper) firstAutoWithdrawTranche(ctx sdk.Context) (trancheKey string, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AutoWithdrawTrancheKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return "", false
+	}
+
+	return string(iterator.Value()), true
+}
+
+func (k Keeper) autoWithdrawLimitOrder(ctx sdk.Context, trancheKey, address string) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	_, _, err := k.WithdrawFilledLimitOrderCore(cacheCtx, trancheKey, sdk.MustAccAddressFromBech32(address))
+	if err == nil {
+		writeCache()
+	} else {
+		// The order can still be withdrawn manually
+		k.Logger(ctx).Error("failed to auto withdraw limit order", "tranche_key", trancheKey, "address", address, "error", err)
+	}
+
+	// Filled and expired orders are withdrawn entirely so the order is never retried
+	k.RemoveAutoWithdrawOrder(ctx, trancheKey, address)
+}
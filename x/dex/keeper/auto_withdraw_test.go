@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) limitSellsAutoWithdraw(
+	account, receiver sdk.AccAddress,
+	tokenIn string,
+	tick, amountIn int,
+	orderType types.LimitOrderType,
+	goodTil *time.Time,
+) string {
+	tradePairID := types.NewTradePairIDFromTaker(defaultPairID, tokenIn)
+	tickIndexTakerToMaker := tradePairID.TickIndexTakerToMaker(int64(tick))
+
+	resp, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          account.String(),
+		Receiver:         receiver.String(),
+		TokenIn:          tradePairID.TakerDenom,
+		TokenOut:         tradePairID.MakerDenom,
+		TickIndexInToOut: tickIndexTakerToMaker,
+		AmountIn:         sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		OrderType:        orderType,
+		ExpirationTime:   goodTil,
+		AutoWithdraw:     true,
+	})
+	s.NoError(err)
+
+	return resp.TrancheKey
+}
+
+func (s *DexTestSuite) autoWithdrawLimitOrders() {
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.App.DexKeeper.AutoWithdrawLimitOrders(s.Ctx)
+}
+
+func (s *DexTestSuite) TestAutoWithdrawFilledLimitOrder() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice places an auto_withdraw order
+	trancheKey := s.limitSellsAutoWithdraw(s.alice, s.alice, "TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED, nil)
+
+	// WHEN bob fills the order
+	s.bobLimitSells("TokenB", -10, 10, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN the tranche is queued
+	s.Equal([]string{trancheKey}, s.App.DexKeeper.GetAllAutoWithdrawTranches(s.Ctx))
+
+	// WHEN auto withdrawals are processed
+	s.autoWithdrawLimitOrders()
+
+	// THEN alice receives her proceeds without withdrawing
+	s.assertAliceBalances(0, 10)
+	s.assertDexBalances(0, 0)
+	_, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), trancheKey)
+	s.False(found)
+	s.Empty(s.App.DexKeeper.GetAllAutoWithdrawTranches(s.Ctx))
+	s.Empty(s.App.DexKeeper.GetAutoWithdrawOrderAddresses(s.Ctx, trancheKey))
+}
+
+func (s *DexTestSuite) TestAutoWithdrawPartiallyFilledLimitOrderWaits() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 5)
+
+	// GIVEN an auto_withdraw order that is only partially filled
+	trancheKey := s.limitSellsAutoWithdraw(s.alice, s.alice, "TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED, nil)
+	s.bobLimitSells("TokenB", -10, 5, types.LimitOrderType_FILL_OR_KILL)
+
+	// WHEN auto withdrawals are processed
+	s.autoWithdrawLimitOrders()
+
+	// THEN nothing is withdrawn
+	s.assertAliceBalances(0, 0)
+	s.Empty(s.App.DexKeeper.GetAllAutoWithdrawTranches(s.Ctx))
+	s.Equal([]string{s.alice.String()}, s.App.DexKeeper.GetAutoWithdrawOrderAddresses(s.Ctx, trancheKey))
+}
+
+func (s *DexTestSuite) TestAutoWithdrawExpiredLimitOrder() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 5)
+	tomorrow := time.Now().AddDate(0, 0, 1)
+
+	// GIVEN a partially filled auto_withdraw GOOD_TIL_TIME order
+	s.limitSellsAutoWithdraw(s.alice, s.alice, "TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_TIME, &tomorrow)
+	s.bobLimitSells("TokenB", -10, 5, types.LimitOrderType_FILL_OR_KILL)
+
+	// WHEN the order expires and auto withdrawals are processed
+	s.beginBlockWithTime(time.Now().AddDate(0, 0, 2))
+	s.autoWithdrawLimitOrders()
+
+	// THEN alice receives both her proceeds and the unfilled amount
+	s.assertAliceBalances(5, 5)
+	s.assertDexBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestAutoWithdrawSendsToReceiver() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice places an auto_withdraw order for carol
+	s.limitSellsAutoWithdraw(s.alice, s.carol, "TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED, nil)
+
+	// WHEN the order is filled and auto withdrawals are processed
+	s.bobLimitSells("TokenB", -10, 10, types.LimitOrderType_FILL_OR_KILL)
+	s.autoWithdrawLimitOrders()
+
+	// THEN carol receives the proceeds
+	s.assertAliceBalances(0, 0)
+	s.assertCarolBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestAutoWithdrawOnlyWithdrawsAutoWithdrawOrders() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(10, 0)
+	s.fundCarolBalances(0, 20)
+
+	// GIVEN alice and bob share a tranche and only alice's order is auto_withdraw
+	trancheKey := s.limitSellsAutoWithdraw(s.alice, s.alice, "TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED, nil)
+	bobTrancheKey := s.bobLimitSells("TokenA", 0, 10)
+	s.Equal(trancheKey, bobTrancheKey)
+
+	// WHEN carol fills the tranche and auto withdrawals are processed
+	s.carolLimitSells("TokenB", -10, 20, types.LimitOrderType_FILL_OR_KILL)
+	s.autoWithdrawLimitOrders()
+
+	// THEN only alice is paid out
+	s.assertAliceBalances(0, 10)
+	s.assertBobBalances(0, 0)
+
+	// Bob can still withdraw manually
+	s.bobWithdrawsLimitSell(trancheKey)
+	s.assertBobBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestAutoWithdrawHitsGasLimit() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.AutoWithdrawAllowance = 0
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	trancheKey := s.limitSellsAutoWithdraw(s.alice, s.alice, "TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED, nil)
+	s.bobLimitSells("TokenB", -10, 10, types.LimitOrderType_FILL_OR_KILL)
+
+	// WHEN auto withdrawals are processed without any gas allowance
+	s.autoWithdrawLimitOrders()
+
+	// THEN the order stays queued
+	s.AssertEventEmitted(s.Ctx, types.EventTypeAutoWithdrawHitGasLimit, 1)
+	s.assertAliceBalances(0, 0)
+	s.Equal([]string{trancheKey}, s.App.DexKeeper.GetAllAutoWithdrawTranches(s.Ctx))
+
+	// WHEN the allowance is restored
+	params.AutoWithdrawAllowance = types.DefaultAutoWithdrawAllowance
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+	s.autoWithdrawLimitOrders()
+
+	// THEN the order is withdrawn in the next block
+	s.assertAliceBalances(0, 10)
+	s.Empty(s.App.DexKeeper.GetAllAutoWithdrawTranches(s.Ctx))
+}
+
+func (s *DexTestSuite) TestAutoWithdrawTakerOrderFails() {
+	s.fundAliceBalances(10, 0)
+
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:      s.alice.String(),
+		Receiver:     s.alice.String(),
+		TokenIn:      "TokenA",
+		TokenOut:     "TokenB",
+		AmountIn:     sdkmath.NewInt(10).Mul(denomMultiple),
+		OrderType:    types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+		AutoWithdraw: true,
+	})
+	s.ErrorIs(err, types.ErrAutoWithdrawOnWrongOrderType)
+}
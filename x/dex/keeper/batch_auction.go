@@ -0,0 +1,703 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetPairBatchAuction adds or removes a PairID from the batch auction registry
+func (k Keeper) SetPairBatchAuction(ctx sdk.Context, pairID *types.PairID, enabled bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BatchAuctionPairKeyPrefix))
+	key := []byte(pairID.CanonicalString())
+	if enabled {
+		store.Set(key, k.cdc.MustMarshal(pairID))
+	} else {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) IsPairBatchAuction(ctx sdk.Context, pairID *types.PairID) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BatchAuctionPairKeyPrefix))
+	return store.Has([]byte(pairID.CanonicalString()))
+}
+
+// GetAllBatchAuctionPairs returns all PairIDs in the batch auction registry
+func (k Keeper) GetAllBatchAuctionPairs(ctx sdk.Context) (list []*types.PairID) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BatchAuctionPairKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.PairID{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) SetBatchAuctionOrder(ctx sdk.Context, order *types.BatchAuctionOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(order.KeyMarshal(), k.cdc.MustMarshal(order))
+}
+
+func (k Keeper) RemoveBatchAuctionOrder(ctx sdk.Context, order *types.BatchAuctionOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(order.KeyMarshal())
+}
+
+// GetAllBatchAuctionOrder returns all queued BatchAuctionOrders ordered by pair and then by placement
+func (k Keeper) GetAllBatchAuctionOrder(ctx sdk.Context) (list []*types.BatchAuctionOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.BatchAuctionOrderKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.BatchAuctionOrder{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// PlaceBatchAuctionOrderCore escrows amountIn and queues a taker order on a pair in batch auction mode. The order is
+// filled when the pair's batch auction is cleared in EndBlock.
+func (k Keeper) PlaceBatchAuctionOrderCore(
+	goCtx context.Context,
+	tradePairID *types.TradePairID,
+	amountIn math.Int,
+	tickIndexInToOut int64,
+	orderType types.LimitOrderType,
+	maxAmountOut *math.Int,
+	minAvgSellPrice *math_utils.PrecDec,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+) (orderKey string, coinIn sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !orderType.IsTakerOnly() {
+		return orderKey, coinIn, sdkerrors.Wrapf(types.ErrInvalidBatchAuctionOrder, "%s", orderType)
+	}
+
+	// Orders are filled pro-rata at the clearing price so an exact output cannot be guaranteed
+	if maxAmountOut != nil {
+		return orderKey, coinIn, sdkerrors.Wrap(types.ErrInvalidBatchAuctionOrder, "max_amount_out is not supported")
+	}
+
+	pairID := tradePairID.MustPairID()
+	if err := k.AssertPairNotPaused(ctx, pairID); err != nil {
+		return orderKey, coinIn, err
+	}
+
+	order := &types.BatchAuctionOrder{
+		TradePairId:         tradePairID,
+		OrderKey:            NewTrancheKey(ctx),
+		OrderType:           orderType,
+		Creator:             callerAddr.String(),
+		Receiver:            receiverAddr.String(),
+		AmountIn:            amountIn,
+		TickIndexInToOut:    tickIndexInToOut,
+		MinAverageSellPrice: minAvgSellPrice,
+	}
+	if err := order.Validate(); err != nil {
+		return orderKey, coinIn, err
+	}
+
+	// Ensure that after rounding user will get at least 1 token out if the order is filled.
+	minSellPrice := order.MinSellPrice()
+	if math_utils.NewPrecDecFromInt(amountIn).Mul(minSellPrice).LT(math_utils.OnePrecDec()) {
+		return orderKey, coinIn, sdkerrors.Wrapf(
+			types.ErrTradeTooSmall,
+			"True output for %v tokens at price %v is less than 1",
+			amountIn,
+			minSellPrice,
+		)
+	}
+
+	coinIn = order.CoinIn()
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, sdk.Coins{coinIn})
+	if err != nil {
+		return orderKey, coinIn, err
+	}
+
+	k.SetBatchAuctionOrder(ctx, order)
+	// BatchAuctionOrders are cleared in EndBlock so we charge for the execution upfront
+	ctx.GasMeter().ConsumeGas(types.BatchAuctionOrderGas, "BatchAuction LimitOrder Fee")
+
+	ctx.EventManager().EmitEvent(types.CreatePlaceLimitOrderEvent(
+		callerAddr,
+		receiverAddr,
+		pairID.Token0,
+		pairID.Token1,
+		tradePairID.TakerDenom,
+		tradePairID.MakerDenom,
+		amountIn,
+		tickIndexInToOut,
+		orderType.String(),
+		minSellPrice,
+		math.ZeroInt(),
+		order.OrderKey,
+		math.ZeroInt(),
+		math.ZeroInt(),
+		math.ZeroInt(),
+	))
+
+	return order.OrderKey, coinIn, nil
+}
+
+// batchAuctionSide is the set of orders of a batch auction that sell the same denom
+type batchAuctionSide struct {
+	tradePairID *types.TradePairID
+	orders      []*types.BatchAuctionOrder
+	total       math.Int
+}
+
+func newBatchAuctionSide(tradePairID *types.TradePairID, orders []*types.BatchAuctionOrder) batchAuctionSide {
+	side := batchAuctionSide{tradePairID: tradePairID, total: math.ZeroInt()}
+	for _, order := range orders {
+		if *order.TradePairId == *tradePairID {
+			side.orders = append(side.orders, order)
+			side.total = side.total.Add(order.AmountIn)
+		}
+	}
+
+	return side
+}
+
+// batchAuctionSolution describes how a batch auction is cleared. The heavy side sells bookIn against the orderbook
+// for bookOut and heavyMatched to the light side in exchange for lightSold, such that both legs trade at the same price.
+// bookMaxIn is the swap amountIn that consumes bookIn; it can be greater than bookIn when the orderbook runs out.
+type batchAuctionSolution struct {
+	heavy        batchAuctionSide
+	light        batchAuctionSide
+	bookMaxIn    math.Int
+	bookIn       math.Int
+	bookOut      math.Int
+	heavyMatched math.Int
+	lightSold    math.Int
+}
+
+func (s batchAuctionSolution) heavySold() math.Int {
+	return s.bookIn.Add(s.heavyMatched)
+}
+
+func (s batchAuctionSolution) heavyOut() math.Int {
+	return s.bookOut.Add(s.lightSold)
+}
+
+// ClearingPrice returns the price of the auction in Token1 per Token0
+func (s batchAuctionSolution) ClearingPrice() math_utils.PrecDec {
+	sold, out := s.heavySold(), s.heavyOut()
+	if sold.IsZero() || out.IsZero() {
+		return math_utils.ZeroPrecDec()
+	}
+	if s.heavy.tradePairID.IsTakerDenomToken0() {
+		return math_utils.NewPrecDecFromInt(out).QuoInt(sold)
+	}
+
+	return math_utils.NewPrecDecFromInt(sold).QuoInt(out)
+}
+
+// batchAuctionFill is the outcome of a single BatchAuctionOrder
+type batchAuctionFill struct {
+	order   *types.BatchAuctionOrder
+	coinIn  sdk.Coin
+	coinOut sdk.Coin
+	refund  sdk.Coin
+	// price is the price in MakerDenom per TakerDenom received by the order's side of the auction
+	price math_utils.PrecDec
+	// err is set for orders that were excluded from the auction
+	err error
+}
+
+func refundBatchAuctionOrder(order *types.BatchAuctionOrder, err error) *batchAuctionFill {
+	return &batchAuctionFill{
+		order:   order,
+		coinIn:  sdk.NewCoin(order.TradePairId.TakerDenom, math.ZeroInt()),
+		coinOut: sdk.NewCoin(order.TradePairId.MakerDenom, math.ZeroInt()),
+		refund:  order.CoinIn(),
+		price:   math_utils.ZeroPrecDec(),
+		err:     err,
+	}
+}
+
+// fillSide splits the amount sold and received by a side pro-rata between its orders. Amounts sold are rounded up
+// and amounts received are rounded down so that any dust remains with the dex.
+func fillSide(side batchAuctionSide, sold, out math.Int) []*batchAuctionFill {
+	price := math_utils.ZeroPrecDec()
+	if sold.IsPositive() {
+		price = math_utils.NewPrecDecFromInt(out).QuoInt(sold)
+	}
+
+	fills := make([]*batchAuctionFill, len(side.orders))
+	for i, order := range side.orders {
+		orderSold := math.ZeroInt()
+		orderOut := math.ZeroInt()
+		if side.total.IsPositive() {
+			orderSold = math.MinInt(order.AmountIn.Mul(sold).Add(side.total).SubRaw(1).Quo(side.total), order.AmountIn)
+			orderOut = order.AmountIn.Mul(out).Quo(side.total)
+		}
+		fills[i] = &batchAuctionFill{
+			order:   order,
+			coinIn:  sdk.NewCoin(side.tradePairID.TakerDenom, orderSold),
+			coinOut: sdk.NewCoin(side.tradePairID.MakerDenom, orderOut),
+			refund:  sdk.NewCoin(side.tradePairID.TakerDenom, order.AmountIn.Sub(orderSold)),
+			price:   price,
+		}
+	}
+
+	return fills
+}
+
+func (s batchAuctionSolution) fills() []*batchAuctionFill {
+	return append(
+		fillSide(s.heavy, s.heavySold(), s.heavyOut()),
+		fillSide(s.light, s.lightSold, s.heavyMatched)...,
+	)
+}
+
+// batchAuctionViolations returns the fills whose order limits are not met. Orders whose minimum price is not met are
+// excluded before orders that are too small or cannot be completely filled, since excluding them changes the clearing
+// price and may resolve the other violations.
+func batchAuctionViolations(fills []*batchAuctionFill) (violations []*batchAuctionFill) {
+	for _, fill := range fills {
+		if fill.coinIn.IsPositive() && fill.price.LT(fill.order.MinSellPrice()) {
+			violations = append(violations, refundBatchAuctionOrder(fill.order, types.ErrLimitPriceNotSatisfied))
+		}
+	}
+	if len(violations) > 0 {
+		return violations
+	}
+
+	for _, fill := range fills {
+		if !fill.coinIn.IsPositive() {
+			continue
+		}
+		switch {
+		case fill.coinOut.Amount.IsZero():
+			violations = append(violations, refundBatchAuctionOrder(fill.order, types.ErrTradeTooSmall))
+		case fill.order.OrderType.IsFoK() && fill.refund.IsPositive():
+			violations = append(violations, refundBatchAuctionOrder(fill.order, types.ErrFoKLimitOrderNotFilled))
+		}
+	}
+
+	return violations
+}
+
+// simulateBatchAuctionSwap returns the amount consumed and received by a swap of amountIn against the orderbook
+// without modifying state. An error is returned once gasCutoff has been consumed.
+func (k Keeper) simulateBatchAuctionSwap(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	amountIn math.Int,
+	gasCutoff uint64,
+) (bookIn, bookOut math.Int, err error) {
+	if amountIn.IsZero() {
+		return math.ZeroInt(), math.ZeroInt(), nil
+	}
+	if ctx.GasMeter().GasConsumed() >= gasCutoff {
+		return math.Int{}, math.Int{}, types.ErrBatchAuctionGasLimit
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	result := k.swapBatchAuction(cacheCtx, tradePairID, amountIn)
+
+	return result.TotalIn.Amount, result.TotalOut.Amount, nil
+}
+
+// swapBatchAuction swaps the heavy side of a batch auction against the orderbook. Pair pauses are checked before
+// the auction is cleared. The oracle price guard of the pair bounds the swap like a limit price whatever its action,
+// so the auction trades less against the orderbook instead of being refunded. The circuit breaker does not apply:
+// every order carries its own limit price and the auction is cleared at most once per block. The tick move is still
+// recorded for the dynamic fee of the pair.
+func (k Keeper) swapBatchAuction(ctx sdk.Context, tradePairID *types.TradePairID, amountIn math.Int) types.SwapResult {
+	guard := k.beforeSwap(ctx, tradePairID, nil)
+	result := k.swapLiquidity(ctx, tradePairID, amountIn, nil, guard.limitPrice)
+	k.recordSwapTickMove(ctx, guard)
+
+	return result
+}
+
+// solveBatchAuctionSide finds the largest amount of the heavy side that can be sold against the orderbook at a price
+// no worse than the price at which the remainder of the heavy side is matched against the light side. That is the
+// largest R such that out(R) / R >= light.total / (heavy.total - R).
+func (k Keeper) solveBatchAuctionSide(
+	ctx sdk.Context,
+	heavy, light batchAuctionSide,
+	gasCutoff uint64,
+) (solution batchAuctionSolution, err error) {
+	solution = batchAuctionSolution{
+		heavy:        heavy,
+		light:        light,
+		bookMaxIn:    math.ZeroInt(),
+		bookIn:       math.ZeroInt(),
+		bookOut:      math.ZeroInt(),
+		heavyMatched: math.ZeroInt(),
+		lightSold:    math.ZeroInt(),
+	}
+	if heavy.total.IsZero() {
+		return solution, nil
+	}
+
+	bookPriceIsBetter := func(amountIn math.Int) (bool, math.Int, math.Int, error) {
+		bookIn, bookOut, err := k.simulateBatchAuctionSwap(ctx, heavy.tradePairID, amountIn, gasCutoff)
+		if err != nil {
+			return false, math.Int{}, math.Int{}, err
+		}
+		return bookOut.Mul(heavy.total.Sub(bookIn)).GTE(light.total.Mul(bookIn)), bookIn, bookOut, nil
+	}
+
+	bookMaxIn := heavy.total
+	ok, bookIn, bookOut, err := bookPriceIsBetter(bookMaxIn)
+	if err != nil {
+		return solution, err
+	}
+	if !ok {
+		// Binary search for the boundary where ok(lo) is true and ok(hi) is false
+		lo, hi := math.ZeroInt(), heavy.total
+		bookIn, bookOut = math.ZeroInt(), math.ZeroInt()
+		for hi.Sub(lo).GT(math.OneInt()) {
+			mid := lo.Add(hi).QuoRaw(2)
+			ok, midIn, midOut, err := bookPriceIsBetter(mid)
+			if err != nil {
+				return solution, err
+			}
+			if ok {
+				lo, bookIn, bookOut = mid, midIn, midOut
+			} else {
+				hi = mid
+			}
+		}
+		bookMaxIn = lo
+	}
+
+	solution.bookMaxIn, solution.bookIn, solution.bookOut = bookMaxIn, bookIn, bookOut
+	if light.total.IsPositive() && bookOut.IsPositive() {
+		// The light side buys the heavy denom at the orderbook price
+		solution.heavyMatched = light.total.Mul(bookIn).Quo(bookOut)
+	}
+	if solution.heavyMatched.IsPositive() {
+		solution.lightSold = light.total
+	}
+
+	return solution, nil
+}
+
+// solveBatchAuction determines the uniform price at which the orders are cleared. The side with the greater value
+// at the orderbook price sells its excess against the orderbook. If neither side is better off trading against the
+// orderbook the sides are matched against each other at a price within the orderbook's spread and the imbalance is
+// refunded.
+func (k Keeper) solveBatchAuction(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	orders []*types.BatchAuctionOrder,
+	gasCutoff uint64,
+) (solution batchAuctionSolution, err error) {
+	side0 := newBatchAuctionSide(types.MustNewTradePairID(pairID.Token0, pairID.Token1), orders)
+	side1 := newBatchAuctionSide(types.MustNewTradePairID(pairID.Token1, pairID.Token0), orders)
+
+	for _, sides := range [][2]batchAuctionSide{{side0, side1}, {side1, side0}} {
+		solution, err = k.solveBatchAuctionSide(ctx, sides[0], sides[1], gasCutoff)
+		if err != nil || solution.bookIn.IsPositive() {
+			return solution, err
+		}
+	}
+
+	solution = batchAuctionSolution{
+		heavy:        side0,
+		light:        side1,
+		bookMaxIn:    math.ZeroInt(),
+		bookIn:       math.ZeroInt(),
+		bookOut:      math.ZeroInt(),
+		heavyMatched: math.ZeroInt(),
+		lightSold:    math.ZeroInt(),
+	}
+	if side0.total.IsZero() || side1.total.IsZero() {
+		return solution, nil
+	}
+
+	price := k.clampBatchAuctionPrice(ctx, pairID, math_utils.NewPrecDecFromInt(side1.total).QuoInt(side0.total))
+	heavyMatched := math.MinInt(side0.total, math_utils.NewPrecDecFromInt(side1.total).Quo(price).TruncateInt())
+	lightSold := math.MinInt(side1.total, price.MulInt(heavyMatched).TruncateInt())
+	if heavyMatched.IsPositive() && lightSold.IsPositive() {
+		solution.heavyMatched, solution.lightSold = heavyMatched, lightSold
+	}
+
+	return solution, nil
+}
+
+// clampBatchAuctionPrice bounds a price in Token1 per Token0 by the best prices at which the orderbook buys and
+// sells Token0 so that orders matched against each other never trade at a worse price than the orderbook offers.
+func (k Keeper) clampBatchAuctionPrice(ctx sdk.Context, pairID *types.PairID, price math_utils.PrecDec) math_utils.PrecDec {
+	// MakerPrices are in TakerDenom per MakerDenom
+	if tickIndex, found := k.GetCurrTickIndexTakerToMaker(ctx, types.NewTradePairIDFromTaker(pairID, pairID.Token0)); found {
+		bid := math_utils.OnePrecDec().Quo(types.MustCalcPrice(tickIndex))
+		price = math_utils.MaxPrecDec(price, bid)
+	}
+	if tickIndex, found := k.GetCurrTickIndexTakerToMaker(ctx, types.NewTradePairIDFromTaker(pairID, pairID.Token1)); found {
+		ask := types.MustCalcPrice(tickIndex)
+		price = math_utils.MinPrecDec(price, ask)
+	}
+
+	return price
+}
+
+// executeBatchAuction clears a pair's orders. Every order whose limits cannot be met at the clearing price is excluded
+// and the auction is solved again until the remaining orders can all be filled. Every pass and every simulated swap
+// is charged against gasCutoff and ErrBatchAuctionGasLimit is returned once it has been consumed.
+func (k Keeper) executeBatchAuction(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	orders []*types.BatchAuctionOrder,
+	gasCutoff uint64,
+) (fills []*batchAuctionFill, solution batchAuctionSolution, err error) {
+	var excluded []*batchAuctionFill
+	active := orders
+	for {
+		if ctx.GasMeter().GasConsumed() >= gasCutoff {
+			return nil, solution, types.ErrBatchAuctionGasLimit
+		}
+
+		solution, err = k.solveBatchAuction(ctx, pairID, active, gasCutoff)
+		if err != nil {
+			return nil, solution, err
+		}
+
+		fills = solution.fills()
+		violations := batchAuctionViolations(fills)
+		if len(violations) == 0 {
+			break
+		}
+
+		excluded = append(excluded, violations...)
+		violating := make(map[*types.BatchAuctionOrder]bool, len(violations))
+		for _, violation := range violations {
+			violating[violation.order] = true
+		}
+		remaining := make([]*types.BatchAuctionOrder, 0, len(active)-len(violations))
+		for _, order := range active {
+			if !violating[order] {
+				remaining = append(remaining, order)
+			}
+		}
+		active = remaining
+	}
+
+	if solution.bookIn.IsPositive() {
+		// The swap is deterministic so it consumes and returns exactly the simulated amounts
+		k.swapBatchAuction(ctx, solution.heavy.tradePairID, solution.bookMaxIn)
+	}
+
+	return append(fills, excluded...), solution, nil
+}
+
+// ClearBatchAuctions clears the BatchAuctionOrders queued on each pair at a single uniform price per pair. Proceeds
+// and unfilled amounts are returned to their owners and contracts that placed orders are notified of the result.
+// Pairs are cleared in a round robin starting after the last pair cleared. Execution stops once BatchAuctionAllowance
+// gas has been consumed, including while a pair is being solved; the orders of that pair and of the remaining pairs
+// stay queued and are cleared in the following blocks. The orders of a pair that cannot be solved with a whole
+// BatchAuctionAllowance are refunded so that they cannot block the other pairs.
+func (k Keeper) ClearBatchAuctions(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	gasCutoff := ctx.GasMeter().GasConsumed() + params.BatchAuctionAllowance
+	// Contracts notified of their results may queue new orders so each pair is cleared at most once per block
+	cleared := make(map[types.PairID]bool)
+	for {
+		pairID, found := k.nextBatchAuctionPair(ctx)
+		if !found || cleared[*pairID] {
+			return
+		}
+
+		gasConsumed := ctx.GasMeter().GasConsumed()
+		if gasConsumed >= gasCutoff {
+			ctx.EventManager().EmitEvent(types.BatchAuctionHitLimitEvent(gasConsumed))
+			return
+		}
+
+		var err error
+		if params.Paused {
+			err = types.ErrDexPaused
+		} else {
+			err = k.AssertPairNotPaused(ctx, pairID)
+		}
+
+		pairOrders := k.GetBatchAuctionOrders(ctx, pairID)
+		if !k.clearBatchAuction(ctx, pairID, pairOrders, gasCutoff, len(cleared) == 0, err) {
+			ctx.EventManager().EmitEvent(types.BatchAuctionHitLimitEvent(ctx.GasMeter().GasConsumed()))
+			return
+		}
+		k.setBatchAuctionCursor(ctx, pairID)
+		cleared[*pairID] = true
+	}
+}
+
+// GetBatchAuctionOrders returns the BatchAuctionOrders queued on pairID in the order they were placed
+func (k Keeper) GetBatchAuctionOrders(ctx sdk.Context, pairID *types.PairID) (list []*types.BatchAuctionOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BatchAuctionOrderPairPrefix(pairID))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.BatchAuctionOrder{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// nextBatchAuctionPair returns the first pair with queued orders after the cursor, wrapping around to the first pair
+func (k Keeper) nextBatchAuctionPair(ctx sdk.Context) (pairID *types.PairID, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	orderPrefix := types.KeyPrefix(types.BatchAuctionOrderKeyPrefix)
+	end := storetypes.PrefixEndBytes(orderPrefix)
+
+	starts := [][]byte{orderPrefix}
+	if cursor := store.Get(types.KeyPrefix(types.BatchAuctionCursorKey)); cursor != nil {
+		starts = [][]byte{storetypes.PrefixEndBytes(cursor), orderPrefix}
+	}
+
+	for _, start := range starts {
+		iterator := store.Iterator(start, end)
+		if iterator.Valid() {
+			order := &types.BatchAuctionOrder{}
+			k.cdc.MustUnmarshal(iterator.Value(), order)
+			iterator.Close()
+
+			return order.TradePairId.MustPairID(), true
+		}
+		iterator.Close()
+	}
+
+	return nil, false
+}
+
+func (k Keeper) setBatchAuctionCursor(ctx sdk.Context, pairID *types.PairID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefix(types.BatchAuctionCursorKey), types.BatchAuctionOrderPairPrefix(pairID))
+}
+
+// clearBatchAuction clears the orders of a pair, or refunds them if err is set. If gasCutoff is reached before the
+// auction is solved nothing is written and false is returned, unless refundOnGasLimit is set in which case the orders
+// are refunded.
+func (k Keeper) clearBatchAuction(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	orders []*types.BatchAuctionOrder,
+	gasCutoff uint64,
+	refundOnGasLimit bool,
+	err error,
+) bool {
+	var fills []*batchAuctionFill
+	var solution batchAuctionSolution
+	if err == nil {
+		cacheCtx, writeCache := ctx.CacheContext()
+		fills, solution, err = k.executeBatchAuction(cacheCtx, pairID, orders, gasCutoff)
+		if errors.Is(err, types.ErrBatchAuctionGasLimit) && !refundOnGasLimit {
+			return false
+		}
+		if err == nil {
+			writeCache()
+		}
+	}
+
+	// Orders are removed before results are settled since contracts notified of their results may queue new orders
+	for _, order := range orders {
+		k.RemoveBatchAuctionOrder(ctx, order)
+	}
+
+	if err != nil {
+		fills = make([]*batchAuctionFill, len(orders))
+		for i, order := range orders {
+			fills[i] = refundBatchAuctionOrder(order, err)
+		}
+		ctx.EventManager().EmitEvent(types.BatchAuctionClearedEvent(
+			pairID,
+			math_utils.ZeroPrecDec(),
+			0,
+			sdk.NewCoin(pairID.Token0, math.ZeroInt()),
+			sdk.NewCoin(pairID.Token1, math.ZeroInt()),
+		))
+	} else {
+		ctx.EventManager().EmitEvent(types.BatchAuctionClearedEvent(
+			pairID,
+			solution.ClearingPrice(),
+			len(orders),
+			sdk.NewCoin(solution.heavy.tradePairID.TakerDenom, solution.bookIn),
+			sdk.NewCoin(solution.heavy.tradePairID.MakerDenom, solution.bookOut),
+		))
+	}
+
+	for _, fill := range fills {
+		k.settleBatchAuctionFill(ctx, fill)
+	}
+
+	return true
+}
+
+func (k Keeper) settleBatchAuctionFill(ctx sdk.Context, fill *batchAuctionFill) {
+	order := fill.order
+	creatorAddr := sdk.MustAccAddressFromBech32(order.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(order.Receiver)
+
+	if fill.coinOut.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiverAddr, sdk.Coins{fill.coinOut})
+		if err != nil {
+			k.Logger(ctx).Error("failed to send batch auction proceeds", "order_key", order.OrderKey, "error", err)
+		}
+	}
+	if fill.refund.IsPositive() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.Coins{fill.refund})
+		if err != nil {
+			k.Logger(ctx).Error("failed to refund batch auction order", "order_key", order.OrderKey, "error", err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(types.BatchAuctionOrderClearedEvent(order, fill.coinIn, fill.coinOut, fill.refund, fill.err))
+
+	if fill.coinOut.IsPositive() {
+		k.queueAfterSwap(ctx, creatorAddr, receiverAddr, fill.coinIn, fill.coinOut)
+	}
+
+	k.sudoBatchAuctionResult(ctx, creatorAddr, fill)
+}
+
+// sudoBatchAuctionResult notifies the creator of an order of its result if the creator is a contract. The wasm keeper
+// is expected to be wrapped in contractmanager's SudoLimitWrapper so errors are only logged here.
+func (k Keeper) sudoBatchAuctionResult(ctx sdk.Context, creatorAddr sdk.AccAddress, fill *batchAuctionFill) {
+	if k.wasmKeeper == nil || !k.wasmKeeper.HasContractInfo(ctx, creatorAddr) {
+		return
+	}
+
+	msgBz, err := json.Marshal(types.BatchAuctionSudoMsg{
+		BatchAuctionResult: &types.BatchAuctionResultMsg{
+			OrderKey: fill.order.OrderKey,
+			Receiver: fill.order.Receiver,
+			CoinIn:   fill.coinIn,
+			CoinOut:  fill.coinOut,
+			Refund:   fill.refund,
+		},
+	})
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal batch auction sudo msg", "error", err)
+		return
+	}
+
+	if _, err := k.wasmKeeper.Sudo(ctx, creatorAddr, msgBz); err != nil {
+		k.Logger(ctx).Debug("batch auction contract returned an error", "contract", creatorAddr, "error", err)
+	}
+}
@@ -0,0 +1,413 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// batchAuctionContract mocks a contract that places BatchAuctionOrders and handles BatchAuctionResult sudo msgs
+type batchAuctionContract struct {
+	addr    sdk.AccAddress
+	results []types.BatchAuctionResultMsg
+}
+
+func (c *batchAuctionContract) HasContractInfo(_ context.Context, contractAddr sdk.AccAddress) bool {
+	return contractAddr.Equals(c.addr)
+}
+
+func (c *batchAuctionContract) Sudo(_ context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	var sudoMsg types.BatchAuctionSudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	if sudoMsg.BatchAuctionResult != nil {
+		c.results = append(c.results, *sudoMsg.BatchAuctionResult)
+	}
+
+	return nil, nil
+}
+
+func (s *DexTestSuite) setPairBatchAuction(pairID *types.PairID, enabled bool) {
+	_, err := s.msgServer.SetPairBatchAuction(s.Ctx, &types.MsgSetPairBatchAuction{
+		Authority: s.App.DexKeeper.GetAuthority(),
+		PairId:    pairID,
+		Enabled:   enabled,
+	})
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) clearBatchAuctions() {
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.App.DexKeeper.ClearBatchAuctions(s.Ctx)
+}
+
+func (s *DexTestSuite) TestBatchAuctionQueuesTakerOrders() {
+	s.fundAliceBalances(0, 50)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN a maker order selling TokenB at tick 0
+	s.aliceLimitSells("TokenB", 0, 50)
+
+	// AND TokenA<>TokenB is in batch auction mode
+	s.setPairBatchAuction(defaultPairID, true)
+
+	// WHEN bob places an IOC order
+	trancheKey := s.bobLimitSells("TokenA", 10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN it is escrowed and queued without touching the orderbook
+	s.assertBobBalances(0, 0)
+	s.assertDexBalances(10, 50)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 50)
+	orders := s.App.DexKeeper.GetAllBatchAuctionOrder(s.Ctx)
+	s.Len(orders, 1)
+	s.Equal(trancheKey, orders[0].OrderKey)
+
+	// WHEN the batch auction is cleared
+	s.clearBatchAuctions()
+
+	// THEN bob's order is filled against the orderbook
+	s.assertBobBalances(0, 10)
+	s.assertDexBalances(10, 40)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 40)
+	s.Empty(s.App.DexKeeper.GetAllBatchAuctionOrder(s.Ctx))
+	s.AssertNEventValuesEmitted(types.BatchAuctionClearedEventKey, 1)
+	s.AssertNEventValuesEmitted(types.BatchAuctionOrderClearedEventKey, 1)
+}
+
+func (s *DexTestSuite) TestBatchAuctionMatchesOpposingOrders() {
+	s.fundBobBalances(10, 0)
+	s.fundCarolBalances(0, 10)
+	s.setPairBatchAuction(defaultPairID, true)
+
+	// GIVEN opposing orders and an empty orderbook
+	s.bobLimitSells("TokenA", 10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.carolLimitSells("TokenB", -10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN the batch auction is cleared
+	s.clearBatchAuctions()
+
+	// THEN the orders are matched against each other
+	s.assertBobBalances(0, 10)
+	s.assertCarolBalances(10, 0)
+	s.assertDexBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestBatchAuctionClearsAtUniformPrice() {
+	s.fundAliceBalances(0, 50)
+	s.fundBobBalances(30, 0)
+	s.fundCarolBalances(0, 10)
+	s.aliceLimitSells("TokenB", 0, 50)
+	s.setPairBatchAuction(defaultPairID, true)
+
+	// GIVEN bob sells more than carol buys
+	s.bobLimitSells("TokenA", 10, 30, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.carolLimitSells("TokenB", -10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN the batch auction is cleared
+	s.clearBatchAuctions()
+
+	// THEN carol is matched against bob and only the excess of bob's order is swapped against the orderbook
+	s.assertBobBalances(0, 30)
+	s.assertCarolBalances(10, 0)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 30)
+	s.assertDexBalances(20, 30)
+}
+
+func (s *DexTestSuite) TestBatchAuctionExcludesOrdersWithUnmetLimits() {
+	s.fundAliceBalances(0, 50)
+	s.fundBobBalances(30, 0)
+	s.fundCarolBalances(0, 10)
+	s.aliceLimitSells("TokenB", 0, 50)
+	s.setPairBatchAuction(defaultPairID, true)
+
+	// GIVEN carol requires a price of 2 TokenA per TokenB
+	s.bobLimitSells("TokenA", 10, 30, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	_, err := s.limitSellsWithMinAvgPrice(
+		s.carol,
+		"TokenB",
+		math_utils.MustNewPrecDecFromStr("0.5"),
+		10,
+		math_utils.MustNewPrecDecFromStr("2"),
+		types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+	)
+	s.NoError(err)
+
+	// WHEN the batch auction is cleared
+	s.clearBatchAuctions()
+
+	// THEN carol is refunded and bob is filled against the orderbook
+	s.assertCarolBalances(0, 10)
+	s.assertBobBalances(0, 30)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 20)
+}
+
+func (s *DexTestSuite) TestBatchAuctionExcludesAllOrdersWithUnmetLimits() {
+	s.fundAliceBalances(0, 50)
+	s.fundBobBalances(30, 0)
+	s.fundCarolBalances(0, 10)
+	s.fundDanBalances(0, 10)
+	s.aliceLimitSells("TokenB", 0, 50)
+	s.setPairBatchAuction(defaultPairID, true)
+
+	// GIVEN carol and dan both require a price of 2 TokenA per TokenB
+	s.bobLimitSells("TokenA", 10, 30, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	for _, addr := range []sdk.AccAddress{s.carol, s.dan} {
+		_, err := s.limitSellsWithMinAvgPrice(
+			addr,
+			"TokenB",
+			math_utils.MustNewPrecDecFromStr("0.5"),
+			10,
+			math_utils.MustNewPrecDecFromStr("2"),
+			types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+		)
+		s.NoError(err)
+	}
+
+	// WHEN the batch auction is cleared
+	s.clearBatchAuctions()
+
+	// THEN carol and dan are both refunded and bob is filled against the orderbook
+	s.assertCarolBalances(0, 10)
+	s.assertDanBalances(0, 10)
+	s.assertBobBalances(0, 30)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 20)
+}
+
+func (s *DexTestSuite) TestBatchAuctionHitsGasLimit() {
+	s.fundAliceBalances(0, 50)
+	s.fundBobBalances(10, 0)
+	s.aliceLimitSells("TokenB", 0, 50)
+	s.setPairBatchAuction(defaultPairID, true)
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.BatchAuctionAllowance = 0
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	s.bobLimitSells("TokenA", 10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN the batch auctions are cleared without any gas allowance
+	s.clearBatchAuctions()
+
+	// THEN the order stays queued
+	s.AssertEventEmitted(s.Ctx, types.EventTypeBatchAuctionHitGasLimit, 1)
+	s.assertBobBalances(0, 0)
+	s.Len(s.App.DexKeeper.GetAllBatchAuctionOrder(s.Ctx), 1)
+
+	// WHEN the allowance is restored
+	params.BatchAuctionAllowance = types.DefaultBatchAuctionAllowance
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+	s.clearBatchAuctions()
+
+	// THEN the order is cleared in the next block
+	s.assertBobBalances(0, 10)
+	s.Empty(s.App.DexKeeper.GetAllBatchAuctionOrder(s.Ctx))
+}
+
+func (s *DexTestSuite) TestBatchAuctionRefundedWhenAllowanceTooSmall() {
+	s.fundAliceBalances(0, 50)
+	s.fundBobBalances(20, 0)
+	s.aliceLimitSells("TokenB", 0, 50)
+	pairIDAC := &types.PairID{Token0: "TokenA", Token1: "TokenC"}
+	s.setPairBatchAuction(defaultPairID, true)
+	s.setPairBatchAuction(pairIDAC, true)
+
+	// GIVEN orders queued on two pairs
+	s.bobLimitSells("TokenA", 10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, types.NewMsgPlaceLimitOrder(
+		s.bob.String(),
+		s.bob.String(),
+		"TokenA",
+		"TokenC",
+		10,
+		sdkmath.NewInt(10).Mul(denomMultiple),
+		types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+		nil,
+		nil,
+		nil,
+	))
+	s.NoError(err)
+
+	// AND an allowance too small to solve any batch auction
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.BatchAuctionAllowance = 1
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// WHEN the batch auctions are cleared
+	s.clearBatchAuctions()
+
+	// THEN the first pair, which could not be solved with a whole allowance, is refunded
+	s.assertBobBalances(10, 0)
+
+	// AND the orders of the next pair stay queued
+	s.AssertEventEmitted(s.Ctx, types.EventTypeBatchAuctionHitGasLimit, 1)
+	orders := s.App.DexKeeper.GetAllBatchAuctionOrder(s.Ctx)
+	s.Len(orders, 1)
+	s.Equal(pairIDAC, orders[0].TradePairId.MustPairID())
+}
+
+func (s *DexTestSuite) TestBatchAuctionFoKNotFilledIsRefunded() {
+	s.fundAliceBalances(0, 5)
+	s.fundBobBalances(10, 0)
+	s.aliceLimitSells("TokenB", 0, 5)
+	s.setPairBatchAuction(defaultPairID, true)
+
+	// GIVEN a FOK order larger than the orderbook
+	s.bobLimitSells("TokenA", 10, 10, types.LimitOrderType_FILL_OR_KILL)
+
+	// WHEN the batch auction is cleared
+	s.clearBatchAuctions()
+
+	// THEN the order is refunded and the orderbook is untouched
+	s.assertBobBalances(10, 0)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 5)
+}
+
+func (s *DexTestSuite) TestBatchAuctionRejectsSwapsThatCannotBeQueued() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(10, 0)
+	s.fundCarolBalances(0, 50)
+	s.carolLimitSells("TokenB", 0, 50)
+	s.setPairBatchAuction(defaultPairID, true)
+
+	// Only taker limit orders are queued. Swaps that must complete within their tx fail instead of taking liquidity
+	// from the pair: multi-hop swaps routed through it
+	route := [][]string{{"TokenA", "TokenB"}}
+	s.bobMultiHopSwapFails(types.ErrPairInBatchAuction, route, 10, math_utils.MustNewPrecDecFromStr("0.9"), false)
+	_, err := s.aliceMultiHopSwapsExactOut(route, 5, 10, false)
+	s.ErrorIs(err, types.ErrPairInBatchAuction)
+
+	// flash swaps
+	s.useFlashSwapContract(&flashSwapContract{})
+	_, err = s.aliceFlashSwaps(10, nil)
+	s.ErrorIs(err, types.ErrPairInBatchAuction)
+
+	// and maker limit orders that would cross the orderbook
+	s.assertBobLimitSellFails(types.ErrPairInBatchAuction, "TokenA", 10, 10, types.LimitOrderType_GOOD_TIL_CANCELLED)
+
+	// AND nothing is queued
+	s.assertAliceBalances(10, 0)
+	s.assertBobBalances(10, 0)
+	s.Empty(s.App.DexKeeper.GetAllBatchAuctionOrder(s.Ctx))
+}
+
+func (s *DexTestSuite) TestBatchAuctionPairRejectsImmediateTakerLiquidity() {
+	s.fundAliceBalances(0, 50)
+	s.fundBobBalances(20, 0)
+	s.aliceLimitSells("TokenB", 0, 50)
+	s.setPairBatchAuction(defaultPairID, true)
+
+	// Maker orders that would cross the orderbook fail
+	s.assertBobLimitSellFails(types.ErrPairInBatchAuction, "TokenA", 10, 10)
+
+	// Maker orders that rest behind the orderbook succeed
+	s.bobLimitSells("TokenA", -10, 10)
+
+	// Orders with an exact output cannot be queued
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, types.NewMsgPlaceLimitOrder(
+		s.bob.String(),
+		s.bob.String(),
+		"TokenA",
+		"TokenB",
+		10,
+		sdkmath.NewInt(10).Mul(denomMultiple),
+		types.LimitOrderType_FILL_OR_KILL,
+		nil,
+		&denomMultiple,
+		nil,
+	))
+	s.ErrorIs(err, types.ErrInvalidBatchAuctionOrder)
+
+	// WHEN batch auction mode is disabled
+	s.setPairBatchAuction(defaultPairID, false)
+
+	// THEN taker orders are executed immediately
+	s.bobLimitSells("TokenA", 10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.assertBobBalances(0, 10)
+	s.Empty(s.App.DexKeeper.GetAllBatchAuctionOrder(s.Ctx))
+}
+
+func (s *DexTestSuite) TestBatchAuctionCappedByOraclePriceGuard() {
+	// GIVEN an oracle price guard that rejects swaps more than 1% from the oracle price
+	s.setupOraclePriceGuard(types.OraclePriceGuardAction_REJECT)
+	s.setPairBatchAuction(defaultPairID, true)
+	s.aliceLimitSells("TokenA", 300, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN the batch auction is cleared
+	s.clearBatchAuctions()
+
+	// THEN the guard caps the auction instead of rejecting it and the rest of alice's order is refunded
+	s.assertAliceBalances(10, 10)
+	s.assertLimitLiquidityAtTick("TokenB", 200, 10)
+}
+
+func (s *DexTestSuite) TestBatchAuctionNotSubjectToCircuitBreaker() {
+	s.fundAliceBalances(0, 20)
+	s.fundBobBalances(100, 100)
+	s.setCircuitBreakerParams(5, 10)
+	s.aliceLimitSells("TokenB", 0, 10)
+	s.aliceLimitSells("TokenB", 20, 10)
+	s.setPairBatchAuction(defaultPairID, true)
+
+	// GIVEN an order that moves the best price further than the circuit breaker allows
+	s.bobLimitSells("TokenA", 10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN the batch auction is cleared
+	s.clearBatchAuctions()
+
+	// THEN the order is filled
+	s.assertBobBalances(90, 110)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 0)
+}
+
+func (s *DexTestSuite) TestBatchAuctionNotifiesContracts() {
+	contract := &batchAuctionContract{addr: s.bob}
+	k := dexkeeper.NewKeeper(
+		s.App.AppCodec(),
+		s.App.GetKey(types.StoreKey),
+		s.App.GetMemKey(types.MemStoreKey),
+		s.App.GetTKey(types.TStoreKey),
+		s.App.BankKeeper,
+		contract,
+		nil,
+		nil,
+		nil,
+		s.App.DexKeeper.GetAuthority(),
+	)
+	s.msgServer = dexkeeper.NewMsgServerImpl(*k)
+
+	s.fundAliceBalances(0, 50)
+	s.fundBobBalances(10, 0)
+	s.fundCarolBalances(10, 0)
+	s.aliceLimitSells("TokenB", 0, 50)
+	s.setPairBatchAuction(defaultPairID, true)
+	bobOrderKey := s.bobLimitSells("TokenA", 10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.carolLimitSells("TokenA", 10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN the batch auction is cleared
+	k.ClearBatchAuctions(s.Ctx)
+
+	// THEN only the contract is notified of its result
+	s.Len(contract.results, 1)
+	result := contract.results[0]
+	s.Equal(bobOrderKey, result.OrderKey)
+	s.Equal(sdk.NewCoin("TokenA", sdkmath.NewInt(10).Mul(denomMultiple)), result.CoinIn)
+	s.Equal(sdk.NewCoin("TokenB", sdkmath.NewInt(10).Mul(denomMultiple)), result.CoinOut)
+	s.True(result.Refund.IsZero())
+	s.assertCarolBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestSetPairBatchAuctionUnauthorizedFails() {
+	_, err := s.msgServer.SetPairBatchAuction(s.Ctx, &types.MsgSetPairBatchAuction{
+		Authority: s.alice.String(),
+		PairId:    defaultPairID,
+		Enabled:   true,
+	})
+	s.ErrorContains(err, "invalid authority")
+	s.False(s.App.DexKeeper.IsPairBatchAuction(s.Ctx, defaultPairID))
+}
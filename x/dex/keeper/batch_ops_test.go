@@ -0,0 +1,179 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) newPlaceLimitOrderOp(tokenIn string, tickIndexNormalized int64, amountIn int64) *types.MsgPlaceLimitOrder {
+	tradePairID := types.NewTradePairIDFromTaker(defaultPairID, tokenIn)
+
+	return &types.MsgPlaceLimitOrder{
+		Creator:          s.alice.String(),
+		Receiver:         s.alice.String(),
+		TokenIn:          tradePairID.TakerDenom,
+		TokenOut:         tradePairID.MakerDenom,
+		TickIndexInToOut: tradePairID.TickIndexTakerToMaker(tickIndexNormalized),
+		AmountIn:         math.NewInt(amountIn).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_GOOD_TIL_CANCELLED,
+	}
+}
+
+func (s *DexTestSuite) TestBatchOpsRequote() {
+	s.fundAliceBalances(20, 0)
+
+	// GIVEN alice has a limit order at tick 0
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN alice cancels it and places two new orders in one batch
+	resp, err := s.msgServer.BatchOps(s.Ctx, &types.MsgBatchOps{
+		Creator: s.alice.String(),
+		Ops: []*types.BatchOp{
+			{CancelLimitOrder: &types.MsgCancelLimitOrder{Creator: s.alice.String(), TrancheKey: trancheKey}},
+			{PlaceLimitOrder: s.newPlaceLimitOrderOp("TokenA", 1, 15)},
+			{PlaceLimitOrder: s.newPlaceLimitOrderOp("TokenA", 2, 5)},
+		},
+	})
+	s.NoError(err)
+
+	// THEN all operations are executed in order
+	s.Len(resp.Results, 3)
+	s.Empty(resp.FailedOps)
+	s.Equal(math.NewInt(10).Mul(denomMultiple), resp.Results[0].CancelLimitOrder.MakerCoinOut.Amount)
+	s.NotEmpty(resp.Results[1].PlaceLimitOrder.TrancheKey)
+	s.NotEmpty(resp.Results[2].PlaceLimitOrder.TrancheKey)
+
+	s.assertAliceBalances(0, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 1, 15)
+	s.assertLimitLiquidityAtTick("TokenA", 2, 5)
+}
+
+func (s *DexTestSuite) TestBatchOpsAllowFailure() {
+	s.fundAliceBalances(10, 10)
+
+	// WHEN alice sends a batch with a failing operation that is allowed to fail
+	resp, err := s.msgServer.BatchOps(s.Ctx, &types.MsgBatchOps{
+		Creator: s.alice.String(),
+		Ops: []*types.BatchOp{
+			{PlaceLimitOrder: s.newPlaceLimitOrderOp("TokenA", 0, 10)},
+			{
+				AllowFailure:     true,
+				CancelLimitOrder: &types.MsgCancelLimitOrder{Creator: s.alice.String(), TrancheKey: "BADKEY"},
+			},
+			{
+				AllowFailure:    true,
+				PlaceLimitOrder: s.newPlaceLimitOrderOp("TokenB", 0, 100),
+			},
+			{PlaceLimitOrder: s.newPlaceLimitOrderOp("TokenB", 5, 10)},
+		},
+	})
+	s.NoError(err)
+
+	// THEN the failures are reported and the remaining operations are executed
+	s.Len(resp.Results, 4)
+	s.Len(resp.FailedOps, 2)
+	s.Equal(uint64(1), resp.FailedOps[0].OpIdx)
+	s.Contains(resp.FailedOps[0].Error, types.ErrValidLimitOrderTrancheNotFound.Error())
+	s.Equal(uint64(2), resp.FailedOps[1].OpIdx)
+	s.Nil(resp.Results[1].CancelLimitOrder)
+	s.Nil(resp.Results[2].PlaceLimitOrder)
+	s.NotNil(resp.Results[3].PlaceLimitOrder)
+
+	s.assertAliceBalances(0, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertLimitLiquidityAtTick("TokenB", 5, 10)
+}
+
+func (s *DexTestSuite) TestBatchOpsFailure() {
+	s.fundAliceBalances(10, 0)
+
+	// WHEN an operation without allow_failure fails
+	_, err := s.msgServer.BatchOps(s.Ctx, &types.MsgBatchOps{
+		Creator: s.alice.String(),
+		Ops: []*types.BatchOp{
+			{PlaceLimitOrder: s.newPlaceLimitOrderOp("TokenA", 0, 5)},
+			{CancelLimitOrder: &types.MsgCancelLimitOrder{Creator: s.alice.String(), TrancheKey: "BADKEY"}},
+		},
+	})
+
+	// THEN the entire batch fails
+	s.ErrorIs(err, types.ErrValidLimitOrderTrancheNotFound)
+}
+
+func (s *DexTestSuite) TestBatchOpsPaused() {
+	s.fundAliceBalances(10, 0)
+
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.Paused = true
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	_, err := s.msgServer.BatchOps(s.Ctx, &types.MsgBatchOps{
+		Creator: s.alice.String(),
+		Ops: []*types.BatchOp{
+			{AllowFailure: true, PlaceLimitOrder: s.newPlaceLimitOrderOp("TokenA", 0, 5)},
+		},
+	})
+	s.ErrorIs(err, types.ErrDexPaused)
+}
+
+func (s *DexTestSuite) TestBatchOpsValidate() {
+	tests := []struct {
+		name        string
+		msg         types.MsgBatchOps
+		expectedErr error
+	}{
+		{
+			"empty batch",
+			types.MsgBatchOps{Creator: s.alice.String()},
+			types.ErrEmptyBatchOps,
+		},
+		{
+			"no operation",
+			types.MsgBatchOps{
+				Creator: s.alice.String(),
+				Ops:     []*types.BatchOp{{AllowFailure: true}},
+			},
+			types.ErrInvalidBatchOp,
+		},
+		{
+			"multiple operations",
+			types.MsgBatchOps{
+				Creator: s.alice.String(),
+				Ops: []*types.BatchOp{{
+					PlaceLimitOrder:  s.newPlaceLimitOrderOp("TokenA", 0, 5),
+					CancelLimitOrder: &types.MsgCancelLimitOrder{Creator: s.alice.String(), TrancheKey: "KEY"},
+				}},
+			},
+			types.ErrInvalidBatchOp,
+		},
+		{
+			"creator mismatch",
+			types.MsgBatchOps{
+				Creator: s.bob.String(),
+				Ops: []*types.BatchOp{
+					{PlaceLimitOrder: s.newPlaceLimitOrderOp("TokenA", 0, 5)},
+				},
+			},
+			types.ErrBatchOpCreatorMismatch,
+		},
+		{
+			"invalid operation",
+			types.MsgBatchOps{
+				Creator: s.alice.String(),
+				Ops: []*types.BatchOp{
+					{PlaceLimitOrder: s.newPlaceLimitOrderOp("TokenA", 0, 0)},
+				},
+			},
+			types.ErrZeroLimitOrder,
+		},
+	}
+
+	for _, tt := range tests {
+		s.Run(tt.name, func() {
+			_, err := s.msgServer.BatchOps(s.Ctx, &tt.msg)
+			s.ErrorIs(err, tt.expectedErr)
+		})
+	}
+}
@@ -18,11 +18,21 @@ func (k Keeper) CancelLimitOrderCore(
 ) (makerCoinOut, takerCoinOut sdk.Coin, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Pending TriggerOrders are cancelled using the orderKey returned when they were placed
+	if triggerOrder, found := k.GetTriggerOrder(ctx, callerAddr.String(), trancheKey); found {
+		return k.CancelTriggerOrderCore(ctx, triggerOrder, callerAddr)
+	}
+
 	makerCoinOut, takerCoinOut, err = k.ExecuteCancelLimitOrder(ctx, trancheKey, callerAddr)
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, err
 	}
 
+	// Canceled orders are no longer moved with their oracle price
+	if peggedOrder, found := k.GetPeggedOrder(ctx, callerAddr.String(), trancheKey); found {
+		k.RemovePeggedOrder(ctx, peggedOrder)
+	}
+
 	coinsOut := sdk.NewCoins(makerCoinOut, takerCoinOut)
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(
 		ctx,
@@ -95,6 +105,9 @@ func (k Keeper) ExecuteCancelLimitOrder(
 	// Decrease the tranche TotalTakerDenom by the amount being removed
 	tranche.TotalTakerDenom = tranche.TotalTakerDenom.Sub(totalAmountOutTakerDenom)
 
+	// Pay out the user's share of the maker rebate earned by the filled portion of the order
+	takerAmountOut = takerAmountOut.Add(tranche.WithdrawMakerRebate(takerAmountOut))
+
 	// Set TrancheUser to 100% shares withdrawn
 	trancheUser.SharesWithdrawn = trancheUser.SharesOwned
 
@@ -113,7 +126,7 @@ func (k Keeper) ExecuteCancelLimitOrder(
 	}
 
 	if trancheUser.OrderType.HasExpiration() {
-		k.RemoveLimitOrderExpiration(ctx, *tranche.ExpirationTime, tranche.Key.KeyMarshal())
+		k.RemoveTrancheExpiration(ctx, tranche)
 	}
 
 	makerCoinOut = sdk.NewCoin(tradePairID.MakerDenom, makerAmountToReturn)
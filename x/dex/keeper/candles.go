@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"bytes"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// CandleStore is a node-local database of OHLCV candles. It is not part of the consensus state and
+// is only populated on nodes that enable it in app.toml.
+type CandleStore struct {
+	db     dbm.DB
+	config types.CandleConfig
+}
+
+func NewCandleStore(db dbm.DB, config types.CandleConfig) *CandleStore {
+	return &CandleStore{db: db, config: config}
+}
+
+// OpenCandleStore opens (or creates) the candle database in dataDir
+func OpenCandleStore(dataDir string, config types.CandleConfig) (*CandleStore, error) {
+	db, err := dbm.NewGoLevelDB(types.CandleStoreName, dataDir, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewCandleStore(db, config), nil
+}
+
+// SetCandleStore enables recording of candles. It must be called before the keeper is copied into other modules.
+func (k *Keeper) SetCandleStore(candleStore *CandleStore) {
+	k.candleStore = candleStore
+}
+
+// candleCtx returns a context that does not charge gas so that nodes recording candles consume
+// exactly the same gas as nodes that do not.
+func candleCtx(ctx sdk.Context) sdk.Context {
+	return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+}
+
+// RecordSwapCandle adds a swap against the liquidity of tradePairID to the candle of the current block.
+// It is a no-op unless the candle store is enabled.
+func (k Keeper) RecordSwapCandle(ctx sdk.Context, tradePairID *types.TradePairID, swapMetadata types.SwapMetadata) {
+	if k.candleStore == nil {
+		return
+	}
+
+	pairID := tradePairID.MustPairID()
+	interval := int64(k.candleStore.config.Interval.Seconds())
+	startTime := types.CandleStartTime(ctx.BlockTime().Unix(), interval)
+	candle, ok := types.NewCandleFromSwap(pairID, startTime, swapMetadata.TokenIn, swapMetadata.AmountIn, swapMetadata.AmountOut)
+	if !ok {
+		return
+	}
+
+	ctx = candleCtx(ctx)
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.PendingCandleKeyPrefix))
+	key := types.KeyPrefix(pairID.CanonicalString())
+	if b := store.Get(key); b != nil {
+		var pending types.Candle
+		k.cdc.MustUnmarshal(b, &pending)
+		pending.Merge(candle)
+		candle = pending
+	}
+	store.Set(key, k.cdc.MustMarshal(&candle))
+}
+
+// WriteCandles merges the candles of the current block into the candle store and prunes the candles
+// that have fallen out of the retention window. Failures are logged rather than returned since the
+// candle store is not part of the consensus state.
+func (k Keeper) WriteCandles(ctx sdk.Context) {
+	if k.candleStore == nil {
+		return
+	}
+
+	ctx = candleCtx(ctx)
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.PendingCandleKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var pendingKeys [][]byte
+	var candles []types.Candle
+	for ; iterator.Valid(); iterator.Next() {
+		var candle types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &candle)
+		candles = append(candles, candle)
+		pendingKeys = append(pendingKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range pendingKeys {
+		store.Delete(key)
+	}
+
+	if err := k.writeCandles(ctx, candles); err != nil {
+		k.Logger(ctx).Error("failed to write dex candles", "height", ctx.BlockHeight(), "error", err)
+	}
+}
+
+func (k Keeper) writeCandles(ctx sdk.Context, candles []types.Candle) error {
+	db := k.candleStore.db
+
+	// Blocks that are replayed after a restart have already been written
+	lastHeight, err := db.Get([]byte(types.CandleHeightKey))
+	if err != nil {
+		return err
+	}
+	if lastHeight != nil && sdk.BigEndianToUint64(lastHeight) >= uint64(ctx.BlockHeight()) { //nolint:gosec
+		return nil
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	for _, candle := range candles {
+		key := types.CandleKey(candle.PairId, candle.StartTime)
+		b, err := db.Get(key)
+		if err != nil {
+			return err
+		}
+		if b != nil {
+			var existing types.Candle
+			k.cdc.MustUnmarshal(b, &existing)
+			existing.Merge(candle)
+			candle = existing
+		}
+
+		if err := batch.Set(key, k.cdc.MustMarshal(&candle)); err != nil {
+			return err
+		}
+		if err := batch.Set(types.CandleTimeKey(candle.StartTime, candle.PairId), k.cdc.MustMarshal(candle.PairId)); err != nil {
+			return err
+		}
+	}
+
+	if err := k.pruneCandles(ctx, batch); err != nil {
+		return err
+	}
+
+	if err := batch.Set([]byte(types.CandleHeightKey), sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()))); err != nil { //nolint:gosec
+		return err
+	}
+
+	return batch.Write()
+}
+
+func (k Keeper) pruneCandles(ctx sdk.Context, batch dbm.Batch) error {
+	retention := int64(k.candleStore.config.Retention.Seconds())
+	cutoff := ctx.BlockTime().Unix() - retention
+	if cutoff <= 0 {
+		return nil
+	}
+
+	start := types.KeyPrefix(types.CandleTimeKeyPrefix)
+	end := append(types.KeyPrefix(types.CandleTimeKeyPrefix), sdk.Uint64ToBigEndian(uint64(cutoff))...)
+	iterator, err := k.candleStore.db.Iterator(start, end)
+	if err != nil {
+		return err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		startTime := int64(sdk.BigEndianToUint64(bytes.TrimPrefix(iterator.Key(), start)[:8])) //nolint:gosec
+		// Only prune candles that have ended before the cutoff
+		if startTime+int64(k.candleStore.config.Interval.Seconds()) > cutoff {
+			break
+		}
+
+		var pairID types.PairID
+		k.cdc.MustUnmarshal(iterator.Value(), &pairID)
+		if err := batch.Delete(types.CandleKey(&pairID, startTime)); err != nil {
+			return err
+		}
+		if err := batch.Delete(iterator.Key()); err != nil {
+			return err
+		}
+	}
+
+	return iterator.Error()
+}
+
+// GetCandles returns the candles of pairID that start in [startTime, endTime), aggregated to interval seconds.
+// The candle store must be enabled.
+func (k Keeper) GetCandles(pairID *types.PairID, interval, startTime, endTime int64) (candles []types.Candle, err error) {
+	iterator, err := k.candleStore.db.Iterator(
+		types.CandleKey(pairID, types.CandleStartTime(startTime, interval)),
+		types.CandleKey(pairID, endTime),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var candle types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &candle)
+		candle.StartTime = types.CandleStartTime(candle.StartTime, interval)
+
+		if n := len(candles); n > 0 && candles[n-1].StartTime == candle.StartTime {
+			candles[n-1].Merge(candle)
+			continue
+		}
+		candles = append(candles, candle)
+	}
+
+	return candles, iterator.Error()
+}
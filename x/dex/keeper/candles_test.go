@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) enableCandles(interval, retention time.Duration) {
+	config := types.CandleConfig{Enabled: true, Interval: interval, Retention: retention}
+	s.App.DexKeeper.SetCandleStore(dexkeeper.NewCandleStore(dbm.NewMemDB(), config))
+	s.msgServer = dexkeeper.NewMsgServerImpl(s.App.DexKeeper)
+}
+
+func (s *DexTestSuite) bobSwapsInBlock(height int64, blockTime time.Time, amountIn int) {
+	s.Ctx = s.Ctx.WithBlockHeight(height).WithBlockTime(blockTime)
+	s.bobMultiHopSwaps([][]string{{"TokenB", "TokenA"}}, amountIn, math_utils.MustNewPrecDecFromStr("0.9"), false)
+	s.App.DexKeeper.WriteCandles(s.Ctx)
+}
+
+func (s *DexTestSuite) queryCandles(interval, startTime, endTime int64) []types.Candle {
+	resp, err := s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{
+		PairId:    "TokenA<>TokenB",
+		Interval:  interval,
+		StartTime: startTime,
+		EndTime:   endTime,
+	})
+	s.NoError(err)
+	return resp.Candles
+}
+
+func (s *DexTestSuite) TestCandlesRecordedFromSwaps() {
+	s.enableCandles(time.Minute, time.Hour)
+	s.fundAliceBalances(100, 0)
+	s.fundBobBalances(0, 30)
+	t0 := time.Unix(1_000_020, 0).UTC()
+
+	// GIVEN TokenA liquidity at two ticks
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1), NewDeposit(90, 0, -100, 1))
+
+	// WHEN bob swaps in two blocks of the first minute and in one block of the second minute
+	s.bobSwapsInBlock(1, t0, 10)
+	s.bobSwapsInBlock(2, t0.Add(30*time.Second), 10)
+	s.bobSwapsInBlock(3, t0.Add(60*time.Second), 10)
+
+	// THEN the swaps of the first minute are merged into a single candle
+	candles := s.queryCandles(0, t0.Unix(), t0.Add(2*time.Minute).Unix())
+	s.Len(candles, 2)
+	s.Equal(t0.Unix(), candles[0].StartTime)
+	s.Equal(t0.Add(time.Minute).Unix(), candles[1].StartTime)
+	s.True(candles[0].Volume1.Equal(sdkmath.NewInt(20).Mul(denomMultiple)))
+	s.True(candles[1].Volume1.Equal(sdkmath.NewInt(10).Mul(denomMultiple)))
+	s.True(candles[0].Open.LT(candles[0].Close), "price of TokenA rises as liquidity is consumed")
+	s.True(candles[0].High.Equal(candles[0].Close))
+	s.True(candles[0].Low.Equal(candles[0].Open))
+
+	totalVolume0 := candles[0].Volume0.Add(candles[1].Volume0)
+	s.True(totalVolume0.Equal(s.App.BankKeeper.GetBalance(s.Ctx, s.bob, "TokenA").Amount))
+
+	// AND candles can be aggregated to a longer interval
+	aggregated := s.queryCandles(120, t0.Unix(), t0.Add(2*time.Minute).Unix())
+	s.Len(aggregated, 1)
+	s.Equal(t0.Unix(), aggregated[0].StartTime)
+	s.True(aggregated[0].Volume0.Equal(totalVolume0))
+	s.True(aggregated[0].Open.Equal(candles[0].Open))
+	s.True(aggregated[0].Close.Equal(candles[1].Close))
+	s.True(aggregated[0].Low.Equal(candles[0].Low))
+	s.True(aggregated[0].High.GTE(candles[0].High) && aggregated[0].High.GTE(candles[1].High))
+}
+
+func (s *DexTestSuite) TestCandlesReplayedBlockIgnored() {
+	s.enableCandles(time.Minute, time.Hour)
+	s.fundAliceBalances(100, 0)
+	s.fundBobBalances(0, 20)
+	t0 := time.Unix(1_000_020, 0).UTC()
+	s.aliceDeposits(NewDeposit(100, 0, 0, 1))
+
+	s.bobSwapsInBlock(1, t0, 10)
+	// WHEN the same height is written again
+	s.bobSwapsInBlock(1, t0, 10)
+
+	// THEN only the first write is recorded
+	candles := s.queryCandles(0, t0.Unix(), t0.Add(time.Minute).Unix())
+	s.Len(candles, 1)
+	s.True(candles[0].Volume1.Equal(sdkmath.NewInt(10).Mul(denomMultiple)))
+}
+
+func (s *DexTestSuite) TestCandlesPruned() {
+	s.enableCandles(time.Minute, time.Hour)
+	s.fundAliceBalances(100, 0)
+	s.fundBobBalances(0, 20)
+	t0 := time.Unix(1_000_020, 0).UTC()
+	s.aliceDeposits(NewDeposit(100, 0, 0, 1))
+
+	s.bobSwapsInBlock(1, t0, 10)
+
+	// WHEN a block is written after the retention window
+	s.bobSwapsInBlock(2, t0.Add(2*time.Hour), 10)
+
+	// THEN the old candle is pruned
+	s.Empty(s.queryCandles(0, t0.Unix(), t0.Add(time.Minute).Unix()))
+	s.Len(s.queryCandles(0, t0.Add(2*time.Hour).Unix(), t0.Add(2*time.Hour+time.Minute).Unix()), 1)
+}
+
+func (s *DexTestSuite) TestCandlesQueryFails() {
+	// Candles are disabled by default
+	_, err := s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{PairId: "TokenA<>TokenB", EndTime: 60})
+	s.Equal(codes.Unavailable, status.Code(err))
+
+	s.enableCandles(time.Minute, time.Hour)
+
+	_, err = s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{PairId: "TokenA<>TokenB", Interval: 90, EndTime: 60})
+	s.ErrorIs(err, types.ErrInvalidCandleInterval)
+
+	_, err = s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{PairId: "TokenA<>TokenB", StartTime: 60, EndTime: 60})
+	s.ErrorIs(err, types.ErrInvalidCandleWindow)
+
+	_, err = s.App.DexKeeper.Candles(s.Ctx, &types.QueryCandlesRequest{PairId: "TokenA<>TokenB", EndTime: 60 * (types.MaxCandlesPerQuery + 1)})
+	s.ErrorIs(err, types.ErrInvalidCandleWindow)
+}
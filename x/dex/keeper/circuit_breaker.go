@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/dex/utils"
+)
+
+// SetCircuitBreaker set a specific circuitBreaker in the store
+func (k Keeper) SetCircuitBreaker(ctx sdk.Context, breaker *types.CircuitBreaker) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(breaker)
+	store.Set(types.CircuitBreakerKey(breaker.TradePairId), b)
+}
+
+// GetCircuitBreaker returns the circuitBreaker of a TradePairID
+func (k Keeper) GetCircuitBreaker(ctx sdk.Context, tradePairID *types.TradePairID) (breaker *types.CircuitBreaker, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.CircuitBreakerKey(tradePairID))
+	if b == nil {
+		return nil, false
+	}
+
+	breaker = &types.CircuitBreaker{}
+	k.cdc.MustUnmarshal(b, breaker)
+
+	return breaker, true
+}
+
+// GetAllCircuitBreaker returns all circuitBreakers
+func (k Keeper) GetAllCircuitBreaker(ctx sdk.Context) (list []*types.CircuitBreaker) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.CircuitBreakerKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.CircuitBreaker{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ResetCircuitBreaker clears the circuit breakers of both directions of the pair
+func (k Keeper) ResetCircuitBreaker(ctx sdk.Context, pairID *types.PairID) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.CircuitBreakerKey(types.NewTradePairIDFromMaker(pairID, pairID.Token0)))
+	store.Delete(types.CircuitBreakerKey(types.NewTradePairIDFromMaker(pairID, pairID.Token1)))
+}
+
+// UpdateCircuitBreaker is called after every swap with the best tick of the TradePairID prior to the swap.
+// If the swap has moved the best tick more than CircuitBreakerMaxTickMove ticks from the start of the current
+// window an error is returned so that only the offending swap is rejected. A new window starts automatically
+// CircuitBreakerWindow blocks after the current one.
+func (k Keeper) UpdateCircuitBreaker(ctx sdk.Context, tradePairID *types.TradePairID, tickIndexBefore int64) error {
+	params := k.GetParams(ctx)
+	if params.CircuitBreakerMaxTickMove == 0 {
+		return nil
+	}
+
+	height := ctx.BlockHeight()
+	window := int64(params.CircuitBreakerWindow) //nolint:gosec
+	breaker, found := k.GetCircuitBreaker(ctx, tradePairID)
+	newWindow := !found || height >= breaker.WindowStartHeight+window
+	if newWindow {
+		breaker = &types.CircuitBreaker{
+			TradePairId:          tradePairID,
+			WindowStartHeight:    height,
+			WindowStartTickIndex: tickIndexBefore,
+		}
+	}
+
+	tickIndexAfter, tickFound := k.GetCurrTickIndexTakerToMaker(ctx, tradePairID)
+	if tickFound && utils.Abs(tickIndexAfter-breaker.WindowStartTickIndex) > params.CircuitBreakerMaxTickMove {
+		return sdkerrors.Wrapf(
+			types.ErrCircuitBreakerTripped,
+			"tick would move from %d to %d, the window resets at height %d",
+			breaker.WindowStartTickIndex,
+			tickIndexAfter,
+			breaker.WindowStartHeight+window,
+		)
+	}
+
+	if newWindow {
+		k.SetCircuitBreaker(ctx, breaker)
+	}
+
+	return nil
+}
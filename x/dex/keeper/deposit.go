@@ -57,6 +57,8 @@ func (k Keeper) DepositCore(
 		return nil, nil, nil, nil, err
 	}
 
+	k.queueAfterDeposit(ctx, callerAddr, receiverAddr, pairID, totalAmountReserve0, totalAmountReserve1, sharesIssued)
+
 	return amounts0Deposited, amounts1Deposited, sharesIssued, failedDeposits, nil
 }
 
@@ -85,6 +87,10 @@ func (k Keeper) ExecuteDeposit(
 	amounts1Deposited = make([]math.Int, len(amounts1))
 	sharesIssued = sdk.Coins{}
 
+	if err := k.AssertPairNotPaused(ctx, pairID); err != nil {
+		return nil, nil, math.ZeroInt(), math.ZeroInt(), nil, nil, nil, err
+	}
+
 	for i := 0; i < len(amounts0); i++ {
 		amounts0Deposited[i] = math.ZeroInt()
 		amounts1Deposited[i] = math.ZeroInt()
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// DepositRangeCore handles core logic for MsgDepositRange. The range is expanded into single tick deposits which are
+// executed via ExecuteDeposit. The resulting shares are held by the dex module and tracked by a single RangePosition.
+func (k Keeper) DepositRangeCore(
+	goCtx context.Context,
+	pairID *types.PairID,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+	amount0 math.Int,
+	amount1 math.Int,
+	lowerTickIndex int64,
+	upperTickIndex int64,
+	fee uint64,
+	shape types.RangeShape,
+	options *types.DepositOptions,
+) (position *types.RangePosition, reserve0Deposited, reserve1Deposited math.Int, failedDeposits []*types.FailedDeposit, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tickIndexes, err := types.RangeTickIndexes(lowerTickIndex, upperTickIndex, fee)
+	if err != nil {
+		return nil, math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	// Token0 is only placed in pools below the reference tick and Token1 only in pools above it, so that the
+	// range never places liquidity behind the current price
+	weights0 := shape.Weights(len(tickIndexes))
+	weights1 := shape.Weights(len(tickIndexes))
+	if refTick, found := k.rangeReferenceTick(ctx, pairID, amount0, amount1, lowerTickIndex, upperTickIndex); found {
+		for i, tickIndex := range tickIndexes {
+			if tickIndex > refTick {
+				weights0[i] = math.ZeroInt()
+			}
+			if tickIndex < refTick {
+				weights1[i] = math.ZeroInt()
+			}
+		}
+	}
+	rangeAmounts0 := types.DistributeAmount(amount0, weights0)
+	rangeAmounts1 := types.DistributeAmount(amount1, weights1)
+
+	// Pools that receive nothing (ie. the tails of a BELL shaped range) are skipped.
+	// rangeIdxs maps each deposit back to its index in the range.
+	var amounts0, amounts1 []math.Int
+	var depositTicks []int64
+	var fees []uint64
+	var depositOptions []*types.DepositOptions
+	var rangeIdxs []uint64
+	for i, tickIndex := range tickIndexes {
+		if rangeAmounts0[i].IsZero() && rangeAmounts1[i].IsZero() {
+			continue
+		}
+		amounts0 = append(amounts0, rangeAmounts0[i])
+		amounts1 = append(amounts1, rangeAmounts1[i])
+		depositTicks = append(depositTicks, tickIndex)
+		fees = append(fees, fee)
+		depositOptions = append(depositOptions, options)
+		rangeIdxs = append(rangeIdxs, uint64(i)) //nolint:gosec
+	}
+
+	_,
+		_,
+		totalAmountReserve0,
+		totalAmountReserve1,
+		sharesIssued,
+		events,
+		failedDeposits,
+		err := k.ExecuteDeposit(ctx, pairID, callerAddr, receiverAddr, amounts0, amounts1, depositTicks, fees, depositOptions)
+	if err != nil {
+		return nil, math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	for _, failedDeposit := range failedDeposits {
+		failedDeposit.DepositIdx = rangeIdxs[failedDeposit.DepositIdx]
+	}
+
+	if sharesIssued.IsZero() {
+		return nil, math.ZeroInt(), math.ZeroInt(), failedDeposits, types.ErrZeroTrueDeposit
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	if totalAmountReserve0.IsPositive() {
+		coin0 := sdk.NewCoin(pairID.Token0, totalAmountReserve0)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, sdk.Coins{coin0}); err != nil {
+			return nil, math.ZeroInt(), math.ZeroInt(), nil, err
+		}
+	}
+
+	if totalAmountReserve1.IsPositive() {
+		coin1 := sdk.NewCoin(pairID.Token1, totalAmountReserve1)
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, sdk.Coins{coin1}); err != nil {
+			return nil, math.ZeroInt(), math.ZeroInt(), nil, err
+		}
+	}
+
+	// Shares are minted to the dex module where they are held on behalf of the position owner
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sharesIssued); err != nil {
+		return nil, math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	position = &types.RangePosition{
+		Id:             k.initRangePositionID(ctx),
+		Owner:          receiverAddr.String(),
+		PairId:         pairID,
+		LowerTickIndex: lowerTickIndex,
+		UpperTickIndex: upperTickIndex,
+		Fee:            fee,
+		Shape:          shape,
+		Shares:         sharesIssued,
+	}
+	k.SetRangePosition(ctx, position)
+
+	k.queueAfterDeposit(ctx, callerAddr, receiverAddr, pairID, totalAmountReserve0, totalAmountReserve1, sharesIssued)
+
+	return position, totalAmountReserve0, totalAmountReserve1, failedDeposits, nil
+}
+
+// rangeReferenceTick returns the normalized tick index that separates the Token0 and Token1 pools of a range. It is
+// the midpoint between the best Token0 and Token1 liquidity, or the tick of the only side with liquidity. When the
+// pair has no liquidity, a deposit of both tokens is split at the middle of the range and a single token deposit is
+// spread across the whole range.
+func (k Keeper) rangeReferenceTick(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	amount0, amount1 math.Int,
+	lowerTickIndex, upperTickIndex int64,
+) (int64, bool) {
+	tick0, found0 := k.GetCurrTickIndexTakerToMakerNormalized(ctx, types.NewTradePairIDFromMaker(pairID, pairID.Token0))
+	tick1, found1 := k.GetCurrTickIndexTakerToMakerNormalized(ctx, types.NewTradePairIDFromMaker(pairID, pairID.Token1))
+
+	switch {
+	case found0 && found1:
+		return (tick0 + tick1) / 2, true
+	case found0:
+		return tick0, true
+	case found1:
+		return tick1, true
+	case amount0.IsPositive() && amount1.IsPositive():
+		return (lowerTickIndex + upperTickIndex) / 2, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/dex/utils"
+)
+
+// SetDynamicFee set a specific dynamicFee in the store
+func (k Keeper) SetDynamicFee(ctx sdk.Context, dynamicFee *types.DynamicFee) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(dynamicFee)
+	store.Set(types.DynamicFeeKey(dynamicFee.PairId), b)
+}
+
+// GetDynamicFee returns the dynamicFee of a pair
+func (k Keeper) GetDynamicFee(ctx sdk.Context, pairID *types.PairID) (dynamicFee *types.DynamicFee, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.DynamicFeeKey(pairID))
+	if b == nil {
+		return nil, false
+	}
+
+	dynamicFee = &types.DynamicFee{}
+	k.cdc.MustUnmarshal(b, dynamicFee)
+
+	return dynamicFee, true
+}
+
+// GetAllDynamicFee returns all dynamicFees
+func (k Keeper) GetAllDynamicFee(ctx sdk.Context) (list []*types.DynamicFee) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.DynamicFeeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.DynamicFee{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// InitDynamicFee starts tracking the tick movement of a pair once its first pool of the dynamic fee tier is
+// initialized. The fee of the pair starts at DynamicFeeMinBps.
+func (k Keeper) InitDynamicFee(ctx sdk.Context, params types.Params, pairID *types.PairID) {
+	if _, found := k.GetDynamicFee(ctx, pairID); found {
+		return
+	}
+
+	k.SetDynamicFee(ctx, &types.DynamicFee{
+		PairId: pairID,
+		FeeBps: params.DynamicFeeMinBps,
+	})
+}
+
+// GetDynamicFeeBps returns the effective fee of the pair's pools of the dynamic fee tier
+func (k Keeper) GetDynamicFeeBps(ctx sdk.Context, params types.Params, pairID *types.PairID) uint64 {
+	dynamicFee, found := k.GetDynamicFee(ctx, pairID)
+	if !found {
+		return params.DynamicFeeMinBps
+	}
+
+	// Fees are only recomputed for pairs whose tick moved, so the fee of an idle pair is recomputed here once its
+	// tick moves start to fall out of the window. Tick moves of the current block only apply from the next block.
+	cutoffHeight := dynamicFeeCutoffHeight(params, ctx.BlockHeight()-1)
+	if len(dynamicFee.TickMoves) > 0 && dynamicFee.TickMoves[0].Height <= cutoffHeight {
+		var totalTickMove uint64
+		for _, tickMove := range dynamicFee.TickMoves {
+			if tickMove.Height > cutoffHeight && tickMove.Height < ctx.BlockHeight() {
+				totalTickMove += tickMove.TickMove
+			}
+		}
+
+		return params.BoundDynamicFee(totalTickMove)
+	}
+
+	// The bounds may have been changed by governance since the fee was last computed
+	return params.BoundDynamicFee(dynamicFee.FeeBps)
+}
+
+// dynamicFeeCutoffHeight returns the height of the last block whose tick moves are outside the DynamicFeeWindow of
+// the fee computed at the end of block height
+func dynamicFeeCutoffHeight(params types.Params, height int64) int64 {
+	return height - int64(params.DynamicFeeWindow) //nolint:gosec
+}
+
+// RecordDynamicFeeTickMove is called after every swap on a pair with a DynamicFee with the best tick of the
+// TradePairID prior to the swap. The tick movement caused by the swap is added to the tick move of the current block
+// and the pair's fee is recomputed at the end of the block.
+func (k Keeper) RecordDynamicFeeTickMove(
+	ctx sdk.Context,
+	dynamicFee *types.DynamicFee,
+	tradePairID *types.TradePairID,
+	tickIndexBefore int64,
+) {
+	tickIndexAfter, tickFound := k.GetCurrTickIndexTakerToMaker(ctx, tradePairID)
+	if !tickFound || tickIndexAfter == tickIndexBefore {
+		return
+	}
+
+	tickMove := utils.Abs(tickIndexAfter - tickIndexBefore)
+	height := ctx.BlockHeight()
+	n := len(dynamicFee.TickMoves)
+	if n > 0 && dynamicFee.TickMoves[n-1].Height == height {
+		dynamicFee.TickMoves[n-1].TickMove += tickMove
+	} else {
+		dynamicFee.TickMoves = append(dynamicFee.TickMoves, types.DynamicFeeTickMove{
+			Height:   height,
+			TickMove: tickMove,
+		})
+	}
+
+	k.SetDynamicFee(ctx, dynamicFee)
+	k.markDynamicFeeDirty(ctx, dynamicFee.PairId)
+}
+
+func (k Keeper) markDynamicFeeDirty(ctx sdk.Context, pairID *types.PairID) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.DirtyDynamicFeeKeyPrefix))
+	key := types.KeyPrefix(pairID.CanonicalString())
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(pairID))
+}
+
+// UpdateDynamicFees prunes the tick moves that have fallen out of the DynamicFeeWindow and recomputes the fee of
+// every pair whose tick moved during the block. The new fee applies to swaps from the next block onwards. The fees of
+// idle pairs are recomputed when they are read.
+func (k Keeper) UpdateDynamicFees(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if !params.DynamicFeeEnabled() {
+		return
+	}
+
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.DirtyDynamicFeeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var dirtyKeys [][]byte
+	var pairIDs []*types.PairID
+	for ; iterator.Valid(); iterator.Next() {
+		pairID := &types.PairID{}
+		k.cdc.MustUnmarshal(iterator.Value(), pairID)
+		pairIDs = append(pairIDs, pairID)
+		dirtyKeys = append(dirtyKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	cutoffHeight := dynamicFeeCutoffHeight(params, ctx.BlockHeight())
+	for _, pairID := range pairIDs {
+		if dynamicFee, found := k.GetDynamicFee(ctx, pairID); found {
+			k.updateDynamicFee(ctx, params, dynamicFee, cutoffHeight)
+		}
+	}
+
+	for _, key := range dirtyKeys {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) updateDynamicFee(ctx sdk.Context, params types.Params, dynamicFee *types.DynamicFee, cutoffHeight int64) {
+	numExpired := 0
+	for numExpired < len(dynamicFee.TickMoves) && dynamicFee.TickMoves[numExpired].Height <= cutoffHeight {
+		numExpired++
+	}
+	tickMoves := dynamicFee.TickMoves[numExpired:]
+
+	var totalTickMove uint64
+	for _, tickMove := range tickMoves {
+		totalTickMove += tickMove.TickMove
+	}
+	// A tick is one basis point of price so the fee is the realised price movement over the window
+	feeBps := params.BoundDynamicFee(totalTickMove)
+
+	feeChanged := feeBps != dynamicFee.FeeBps
+	if numExpired == 0 && !feeChanged {
+		// Nothing has changed
+		return
+	}
+
+	dynamicFee.TickMoves = tickMoves
+	dynamicFee.FeeBps = feeBps
+	k.SetDynamicFee(ctx, dynamicFee)
+
+	if feeChanged {
+		ctx.EventManager().EmitEvent(types.DynamicFeeUpdateEvent(dynamicFee))
+	}
+}
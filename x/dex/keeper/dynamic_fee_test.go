@@ -0,0 +1,246 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+	"github.com/neutron-org/neutron/v5/x/dex/utils"
+)
+
+func (s *DexTestSuite) enableDynamicFee(tier, minBps, maxBps, window uint64) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.DynamicFeeTier = tier
+	params.DynamicFeeMinBps = minBps
+	params.DynamicFeeMaxBps = maxBps
+	params.DynamicFeeWindow = window
+	_, err := s.msgServer.UpdateParams(s.Ctx, &types.MsgUpdateParams{Params: params, Authority: s.App.DexKeeper.GetAuthority()})
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) setDynamicFeeBps(feeBps uint64) {
+	dynamicFee, found := s.App.DexKeeper.GetDynamicFee(s.Ctx, defaultPairID)
+	s.True(found)
+	dynamicFee.FeeBps = feeBps
+	s.App.DexKeeper.SetDynamicFee(s.Ctx, dynamicFee)
+}
+
+func (s *DexTestSuite) TestDynamicFeeInitializedOnDeposit() {
+	s.fundAliceBalances(0, 20)
+	s.enableDynamicFee(5, 10, 100, 10)
+
+	// WHEN alice deposits into a pool of another fee tier
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+
+	// THEN the pair is not tracked
+	_, found := s.App.DexKeeper.GetDynamicFee(s.Ctx, defaultPairID)
+	s.False(found)
+
+	// WHEN alice deposits into a pool of the dynamic fee tier
+	s.aliceDeposits(NewDeposit(0, 10, 0, 5))
+
+	// THEN the pair is tracked starting at the min fee
+	dynamicFee, found := s.App.DexKeeper.GetDynamicFee(s.Ctx, defaultPairID)
+	s.True(found)
+	s.Equal(uint64(10), dynamicFee.FeeBps)
+	s.Empty(dynamicFee.TickMoves)
+}
+
+func (s *DexTestSuite) TestDynamicFeeDisabled() {
+	s.fundAliceBalances(0, 10)
+
+	// WHEN alice deposits while the dynamic fee is disabled
+	s.aliceDeposits(NewDeposit(0, 10, 0, 0))
+
+	// THEN the pair is not tracked
+	_, found := s.App.DexKeeper.GetDynamicFee(s.Ctx, defaultPairID)
+	s.False(found)
+}
+
+func (s *DexTestSuite) TestDynamicFeeChargedOnSwap() {
+	s.fundAliceBalances(0, 100)
+	s.fundBobBalances(50, 0)
+	s.enableDynamicFee(5, 5, 100, 10)
+
+	// GIVEN a dynamic fee pool whose fee is 20 bps above its fee tier
+	s.aliceDeposits(NewDeposit(0, 100, 0, 5))
+	s.setDynamicFeeBps(25)
+
+	// WHEN bob simulates a swap of TokenA for TokenB
+	route := [][]string{{"TokenA", "TokenB"}}
+	exitLimitPrice := math_utils.MustNewPrecDecFromStr("0.9")
+	resp, err := s.App.DexKeeper.SimulateMultiHopSwap(s.Ctx, &types.QuerySimulateMultiHopSwapRequest{
+		Msg: types.NewMsgMultiHopSwap(s.bob.String(), s.bob.String(), route, sdkmath.NewInt(50).Mul(denomMultiple), exitLimitPrice, false),
+	})
+	s.NoError(err)
+
+	// THEN the dynamic fee is reported
+	s.Len(resp.DynamicFees, 1)
+	dynamicFee := resp.DynamicFees[0]
+	s.Equal("TokenA", dynamicFee.Denom)
+	s.True(dynamicFee.Amount.IsPositive())
+
+	// WHEN bob swaps
+	s.bobMultiHopSwaps(route, 50, exitLimitPrice, false)
+
+	// THEN bob pays the simulated dynamic fee on top of the amount swapped and it is added to the pool's reserves
+	bobAmountIn := sdkmath.NewInt(50).Mul(denomMultiple).Sub(s.App.BankKeeper.GetBalance(s.Ctx, s.bob, "TokenA").Amount)
+	s.True(dynamicFee.Amount.Equal(types.CalcLimitOrderTakerFee(bobAmountIn.Sub(dynamicFee.Amount), 20)))
+	liquidityA, _ := s.getLiquidityAtTick(0, 5)
+	s.True(liquidityA.Equal(bobAmountIn))
+	s.assertDexBalancesInt(bobAmountIn, sdkmath.NewInt(100).Mul(denomMultiple).Sub(s.App.BankKeeper.GetBalance(s.Ctx, s.bob, "TokenB").Amount))
+}
+
+func (s *DexTestSuite) TestDynamicFeeNotChargedAtMinFee() {
+	s.fundAliceBalances(0, 100)
+	s.enableDynamicFee(5, 5, 100, 10)
+
+	// GIVEN a dynamic fee pool charging only its fee tier
+	s.aliceDeposits(NewDeposit(0, 100, 0, 5))
+
+	// WHEN bob simulates a limit order against it
+	resp, err := s.App.DexKeeper.SimulatePlaceLimitOrder(s.Ctx, &types.QuerySimulatePlaceLimitOrderRequest{
+		Msg: &types.MsgPlaceLimitOrder{
+			TokenIn:          "TokenA",
+			TokenOut:         "TokenB",
+			TickIndexInToOut: 10,
+			AmountIn:         sdkmath.NewInt(10).Mul(denomMultiple),
+			OrderType:        types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+		},
+	})
+	s.NoError(err)
+
+	// THEN no dynamic fee is charged
+	s.True(resp.Resp.TakerCoinOut.IsPositive())
+	s.True(resp.DynamicFee.IsZero())
+}
+
+func (s *DexTestSuite) TestDynamicFeeExcludedFromLimitPrice() {
+	s.fundAliceBalances(0, 100)
+	s.fundBobBalances(10, 0)
+	s.enableDynamicFee(5, 5, 100, 10)
+
+	// GIVEN a dynamic fee pool whose fee is 20 bps above its fee tier
+	s.aliceDeposits(NewDeposit(0, 100, 0, 5))
+	s.setDynamicFeeBps(25)
+
+	// WHEN bob places a taker order whose min average price is only met before the dynamic fee
+	minAvgSellPrice := math_utils.MustNewPrecDecFromStr("0.999")
+	resp, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:             s.bob.String(),
+		Receiver:            s.bob.String(),
+		TokenIn:             "TokenA",
+		TokenOut:            "TokenB",
+		TickIndexInToOut:    10,
+		AmountIn:            sdkmath.NewInt(10).Mul(denomMultiple),
+		OrderType:           types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+		MinAverageSellPrice: &minAvgSellPrice,
+	})
+
+	// THEN the order succeeds since the dynamic fee is reported separately like the taker fee
+	s.NoError(err)
+	allInPrice := math_utils.NewPrecDecFromInt(resp.TakerCoinOut.Amount).QuoInt(resp.TakerCoinIn.Amount)
+	s.True(allInPrice.LT(minAvgSellPrice), "all in price: actual %s", allInPrice)
+}
+
+func (s *DexTestSuite) TestDynamicFeeUpdatesFromTickMovement() {
+	s.fundAliceBalances(0, 30)
+	s.fundBobBalances(20, 0)
+	s.enableDynamicFee(5, 5, 1_000, 10)
+
+	// GIVEN TokenB liquidity in dynamic fee pools spread over 3 ticks
+	s.aliceDeposits(
+		NewDeposit(0, 10, 0, 5),
+		NewDeposit(0, 10, 20, 5),
+		NewDeposit(0, 10, 40, 5),
+	)
+	height := s.Ctx.BlockHeight()
+
+	// WHEN bob's swap moves the best tick
+	tickBefore, _ := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, defaultTradePairID0To1)
+	s.bobLimitSells("TokenA", 100, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	tickAfter, _ := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, defaultTradePairID0To1)
+	tickMove := utils.Abs(tickAfter - tickBefore)
+	s.Positive(tickMove)
+
+	// THEN the tick movement is recorded
+	dynamicFee, _ := s.App.DexKeeper.GetDynamicFee(s.Ctx, defaultPairID)
+	s.Equal([]types.DynamicFeeTickMove{{Height: height, TickMove: tickMove}}, dynamicFee.TickMoves)
+
+	// WHEN the block ends
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.App.DexKeeper.UpdateDynamicFees(s.Ctx)
+
+	// THEN the fee is the realised tick movement
+	dynamicFee, _ = s.App.DexKeeper.GetDynamicFee(s.Ctx, defaultPairID)
+	s.Equal(tickMove, dynamicFee.FeeBps)
+	s.AssertEventEmitted(s.Ctx, types.EventTypeDynamicFeeUpdate, 1)
+
+	// WHEN the movement falls out of the window without any further swaps
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	s.Ctx = s.Ctx.WithBlockHeight(height + 10)
+	s.Equal(tickMove, s.App.DexKeeper.GetDynamicFeeBps(s.Ctx, params, defaultPairID))
+	s.Ctx = s.Ctx.WithBlockHeight(height + 11).WithEventManager(sdk.NewEventManager())
+	s.App.DexKeeper.UpdateDynamicFees(s.Ctx)
+
+	// THEN the idle pair is not updated at the end of the block
+	s.AssertEventEmitted(s.Ctx, types.EventTypeDynamicFeeUpdate, 0)
+	dynamicFee, _ = s.App.DexKeeper.GetDynamicFee(s.Ctx, defaultPairID)
+	s.Equal(tickMove, dynamicFee.FeeBps)
+
+	// AND its fee returns to the min fee when it is read
+	s.Equal(uint64(5), s.App.DexKeeper.GetDynamicFeeBps(s.Ctx, params, defaultPairID))
+}
+
+func (s *DexTestSuite) TestDynamicFeePrunedOnTickMove() {
+	s.fundAliceBalances(0, 30)
+	s.fundBobBalances(20, 0)
+	s.enableDynamicFee(5, 5, 1_000, 10)
+
+	// GIVEN TokenB liquidity in dynamic fee pools spread over 3 ticks
+	s.aliceDeposits(
+		NewDeposit(0, 10, 0, 5),
+		NewDeposit(0, 10, 20, 5),
+		NewDeposit(0, 10, 40, 5),
+	)
+	height := s.Ctx.BlockHeight()
+
+	// AND a swap that moved the best tick
+	s.bobLimitSells("TokenA", 100, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.App.DexKeeper.UpdateDynamicFees(s.Ctx)
+
+	// WHEN the tick moves again after the first movement has fallen out of the window
+	s.Ctx = s.Ctx.WithBlockHeight(height + 20)
+	s.bobLimitSells("TokenA", 100, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	dynamicFee, _ := s.App.DexKeeper.GetDynamicFee(s.Ctx, defaultPairID)
+	s.Len(dynamicFee.TickMoves, 2)
+	s.App.DexKeeper.UpdateDynamicFees(s.Ctx)
+
+	// THEN only the latest movement is kept
+	dynamicFee, _ = s.App.DexKeeper.GetDynamicFee(s.Ctx, defaultPairID)
+	s.Len(dynamicFee.TickMoves, 1)
+	s.Equal(height+20, dynamicFee.TickMoves[0].Height)
+	s.Equal(dynamicFee.TickMoves[0].TickMove, dynamicFee.FeeBps)
+}
+
+func (s *DexTestSuite) TestDynamicFeeBoundedByMax() {
+	s.fundAliceBalances(0, 30)
+	s.fundBobBalances(20, 0)
+	s.enableDynamicFee(5, 5, 8, 10)
+
+	// GIVEN TokenB liquidity in dynamic fee pools spread over 3 ticks
+	s.aliceDeposits(
+		NewDeposit(0, 10, 0, 5),
+		NewDeposit(0, 10, 20, 5),
+		NewDeposit(0, 10, 40, 5),
+	)
+
+	// WHEN bob's swap moves the best tick further than the max fee
+	s.bobLimitSells("TokenA", 100, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.App.DexKeeper.UpdateDynamicFees(s.Ctx)
+
+	// THEN the fee is the max fee
+	dynamicFee, _ := s.App.DexKeeper.GetDynamicFee(s.Ctx, defaultPairID)
+	s.Equal(uint64(8), dynamicFee.FeeBps)
+}
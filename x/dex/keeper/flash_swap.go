@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// FlashSwapCore handles MsgFlashSwap including bank operations and event emissions.
+// The swap output is sent to the calling contract before it is called with a FlashSwapCallback sudo msg. Once the
+// callback returns the swap's amount in is collected from the contract. The swap, the callback and the repayment all
+// run in the cached context of SwapWithCallback so nothing is committed unless the amount in is repaid.
+func (k Keeper) FlashSwapCore(
+	goCtx context.Context,
+	tokenIn string,
+	tokenOut string,
+	amountIn math.Int,
+	limitSellPrice *math_utils.PrecDec,
+	callbackData []byte,
+	callerAddr sdk.AccAddress,
+) (coinIn, coinOut sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.contractKeeper == nil {
+		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrap(types.ErrFlashSwapCallbackFailed, "contracts are not supported")
+	}
+
+	tradePairID, err := types.NewTradePairID(tokenIn, tokenOut)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	var limitPrice *math_utils.PrecDec
+	if limitSellPrice != nil {
+		limitBuyPrice := math_utils.OnePrecDec().Quo(*limitSellPrice)
+		limitPrice = &limitBuyPrice
+	}
+
+	result, err := k.SwapWithCallback(
+		ctx,
+		tradePairID,
+		amountIn,
+		nil,
+		limitPrice,
+		func(cacheCtx sdk.Context, coinIn, coinOut sdk.Coin) error {
+			return k.executeFlashSwapCallback(cacheCtx, coinIn, coinOut, callbackData, callerAddr)
+		},
+	)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+	coinIn, coinOut = result.TotalIn, result.TotalOut
+
+	ctx.EventManager().EmitEvent(types.FlashSwapEvent(callerAddr, coinIn, coinOut))
+	k.queueAfterSwap(ctx, callerAddr, callerAddr, coinIn, coinOut)
+
+	return coinIn, coinOut, nil
+}
+
+func (k Keeper) executeFlashSwapCallback(
+	ctx sdk.Context,
+	coinIn, coinOut sdk.Coin,
+	callbackData []byte,
+	callerAddr sdk.AccAddress,
+) error {
+	if !coinOut.IsPositive() {
+		return types.ErrNoLiquidity
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, callerAddr, sdk.Coins{coinOut})
+	if err != nil {
+		return err
+	}
+
+	msgBz, err := json.Marshal(types.FlashSwapSudoMsg{
+		FlashSwapCallback: &types.FlashSwapCallbackMsg{
+			CoinIn:  coinIn,
+			CoinOut: coinOut,
+			Data:    callbackData,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// NB: The contract is called directly rather than through the sudo limit wrapper so that the callback is only
+	// bounded by the gas meter of the transaction paying for it.
+	if _, err := k.contractKeeper.Sudo(ctx, callerAddr, msgBz); err != nil {
+		return sdkerrors.Wrapf(types.ErrFlashSwapCallbackFailed, "%s", err)
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, sdk.Coins{coinIn})
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrFlashSwapNotRepaid, "%s", err)
+	}
+
+	return nil
+}
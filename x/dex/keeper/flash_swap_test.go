@@ -0,0 +1,148 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// flashSwapContract mocks a contract that handles FlashSwapCallback sudo msgs
+type flashSwapContract struct {
+	callbacks  []types.FlashSwapCallbackMsg
+	onCallback func(ctx sdk.Context, contractAddr sdk.AccAddress, msg types.FlashSwapCallbackMsg) error
+}
+
+func (*flashSwapContract) HasContractInfo(context.Context, sdk.AccAddress) bool {
+	return true
+}
+
+func (c *flashSwapContract) Sudo(ctx context.Context, contractAddr sdk.AccAddress, msg []byte) ([]byte, error) {
+	var sudoMsg types.FlashSwapSudoMsg
+	if err := json.Unmarshal(msg, &sudoMsg); err != nil {
+		return nil, err
+	}
+	if sudoMsg.FlashSwapCallback == nil {
+		return nil, nil
+	}
+
+	c.callbacks = append(c.callbacks, *sudoMsg.FlashSwapCallback)
+	if c.onCallback == nil {
+		return nil, nil
+	}
+
+	return nil, c.onCallback(sdk.UnwrapSDKContext(ctx), contractAddr, *sudoMsg.FlashSwapCallback)
+}
+
+func (s *DexTestSuite) useFlashSwapContract(contract *flashSwapContract) {
+	k := dexkeeper.NewKeeper(
+		s.App.AppCodec(),
+		s.App.GetKey(types.StoreKey),
+		s.App.GetMemKey(types.MemStoreKey),
+		s.App.GetTKey(types.TStoreKey),
+		s.App.BankKeeper,
+		contract,
+		contract,
+		nil,
+		nil,
+		s.App.DexKeeper.GetAuthority(),
+	)
+	s.msgServer = dexkeeper.NewMsgServerImpl(*k)
+}
+
+func (s *DexTestSuite) aliceFlashSwaps(amountIn int64, callbackData []byte) (*types.MsgFlashSwapResponse, error) {
+	return s.msgServer.FlashSwap(s.Ctx, &types.MsgFlashSwap{
+		Creator:      s.alice.String(),
+		TokenIn:      "TokenA",
+		TokenOut:     "TokenB",
+		AmountIn:     sdkmath.NewInt(amountIn).Mul(denomMultiple),
+		CallbackData: callbackData,
+	})
+}
+
+func (s *DexTestSuite) TestFlashSwapRepaid() {
+	s.fundBobBalances(0, 10)
+	s.fundCarolBalances(11, 0)
+
+	// GIVEN TokenB liquidity at price 1
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// AND a contract that sells the swap output to carol for 11 TokenA
+	contract := &flashSwapContract{
+		onCallback: func(ctx sdk.Context, contractAddr sdk.AccAddress, msg types.FlashSwapCallbackMsg) error {
+			if err := s.App.BankKeeper.SendCoins(ctx, contractAddr, s.carol, sdk.Coins{msg.CoinOut}); err != nil {
+				return err
+			}
+			return s.App.BankKeeper.SendCoins(ctx, s.carol, contractAddr, sdk.NewCoins(sdk.NewCoin("TokenA", sdkmath.NewInt(11).Mul(denomMultiple))))
+		},
+	}
+	s.useFlashSwapContract(contract)
+
+	// WHEN alice flash swaps 10 TokenA without holding any TokenA
+	resp, err := s.aliceFlashSwaps(10, []byte("arb"))
+
+	// THEN the swap succeeds and the contract keeps the profit
+	s.NoError(err)
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), resp.CoinIn.Amount)
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), resp.CoinOut.Amount)
+	s.Len(contract.callbacks, 1)
+	s.Equal([]byte("arb"), contract.callbacks[0].Data)
+	s.Equal(resp.CoinIn, contract.callbacks[0].CoinIn)
+
+	s.assertAliceBalances(1, 0)
+	s.assertCarolBalances(0, 10)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 0)
+	s.AssertNEventValuesEmitted(types.FlashSwapEventKey, 1)
+}
+
+func (s *DexTestSuite) TestFlashSwapNotRepaidReverts() {
+	s.fundBobBalances(0, 10)
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// GIVEN a contract that does not repay the swap
+	s.useFlashSwapContract(&flashSwapContract{})
+
+	// WHEN alice flash swaps
+	_, err := s.aliceFlashSwaps(10, nil)
+
+	// THEN the swap is reverted
+	s.ErrorIs(err, types.ErrFlashSwapNotRepaid)
+	s.assertAliceBalances(0, 0)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 10)
+}
+
+func (s *DexTestSuite) TestFlashSwapCallbackErrorReverts() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+	s.bobLimitSells("TokenB", 0, 10)
+
+	// GIVEN a contract whose callback fails
+	s.useFlashSwapContract(&flashSwapContract{
+		onCallback: func(sdk.Context, sdk.AccAddress, types.FlashSwapCallbackMsg) error {
+			return errors.New("no arbitrage")
+		},
+	})
+
+	// WHEN alice flash swaps
+	_, err := s.aliceFlashSwaps(10, nil)
+
+	// THEN the swap is reverted
+	s.ErrorIs(err, types.ErrFlashSwapCallbackFailed)
+	s.assertAliceBalances(10, 0)
+	s.assertLimitLiquidityAtTick("TokenB", 0, 10)
+}
+
+func (s *DexTestSuite) TestFlashSwapNoLiquidityFails() {
+	s.useFlashSwapContract(&flashSwapContract{})
+
+	// WHEN alice flash swaps against an empty pair
+	_, err := s.aliceFlashSwaps(10, nil)
+
+	// THEN the swap fails
+	s.ErrorIs(err, types.ErrNoLiquidity)
+}
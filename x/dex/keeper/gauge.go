@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetGauge set a specific gauge in the store
+func (k Keeper) SetGauge(ctx sdk.Context, gauge *types.Gauge) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(gauge)
+	store.Set(types.GaugeKey(gauge.Id), b)
+}
+
+// GetGauge returns a gauge by id
+func (k Keeper) GetGauge(ctx sdk.Context, id uint64) (gauge *types.Gauge, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.GaugeKey(id))
+	if b == nil {
+		return nil, false
+	}
+
+	gauge = &types.Gauge{}
+	k.cdc.MustUnmarshal(b, gauge)
+
+	return gauge, true
+}
+
+// RemoveGauge removes a gauge from the store
+func (k Keeper) RemoveGauge(ctx sdk.Context, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GaugeKey(id))
+}
+
+// GetAllGauge returns all gauges
+func (k Keeper) GetAllGauge(ctx sdk.Context) (list []*types.Gauge) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GaugeKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.Gauge{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetGaugeCount get the total number of gauges ever created
+func (k Keeper) GetGaugeCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.GaugeCountKeyPrefix))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetGaugeCount set the total number of gauges ever created
+func (k Keeper) SetGaugeCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.KeyPrefix(types.GaugeCountKeyPrefix), bz)
+}
+
+// initGaugeID returns the next gauge id and increments the count
+func (k Keeper) initGaugeID(ctx sdk.Context) uint64 {
+	id := k.GetGaugeCount(ctx)
+	k.SetGaugeCount(ctx, id+1)
+
+	return id
+}
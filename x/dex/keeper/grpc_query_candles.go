@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Returns the OHLCV candles of a pair from the node-local candle store
+func (k Keeper) Candles(goCtx context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if k.candleStore == nil {
+		return nil, status.Error(codes.Unavailable, "candles are not enabled on this node")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+
+	baseInterval := int64(k.candleStore.config.Interval.Seconds())
+	interval := req.Interval
+	if interval == 0 {
+		interval = baseInterval
+	}
+	if interval < 0 || interval%baseInterval != 0 {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCandleInterval, "interval %d, node candle interval %d", interval, baseInterval)
+	}
+
+	endTime := ctx.BlockTime().Unix()
+	if req.EndTime != 0 {
+		endTime = req.EndTime
+	}
+	if req.StartTime < 0 || req.StartTime >= endTime || (endTime-req.StartTime)/interval > types.MaxCandlesPerQuery {
+		return nil, types.ErrInvalidCandleWindow
+	}
+
+	candles, err := k.GetCandles(pairID, interval, req.StartTime, endTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryCandlesResponse{Candles: candles}, nil
+}
@@ -20,6 +20,7 @@ func (k Keeper) EstimateMultiHopSwap(
 		AmountIn:       req.AmountIn,
 		ExitLimitPrice: req.ExitLimitPrice,
 		PickBestRoute:  req.PickBestRoute,
+		SplitRoutes:    req.SplitRoutes,
 	}
 	if err := msg.Validate(); err != nil {
 		return nil, err
@@ -31,12 +32,13 @@ func (k Keeper) EstimateMultiHopSwap(
 	callerAddr := sdk.MustAccAddressFromBech32(req.Creator)
 	receiverAddr := sdk.MustAccAddressFromBech32(req.Receiver)
 
-	coinOut, _, _, err := k.MultiHopSwapCore(
+	result, err := k.MultiHopSwapCore(
 		cacheCtx,
 		req.AmountIn,
 		req.Routes,
 		req.ExitLimitPrice,
 		req.PickBestRoute,
+		req.SplitRoutes,
 		callerAddr,
 		receiverAddr,
 	)
@@ -46,5 +48,8 @@ func (k Keeper) EstimateMultiHopSwap(
 
 	// NB: Critically, we do not write the best route's buffered state context since this is only an estimate.
 
-	return &types.QueryEstimateMultiHopSwapResponse{CoinOut: coinOut}, nil
+	return &types.QueryEstimateMultiHopSwapResponse{
+		CoinOut:          result.coinOut,
+		RouteAllocations: result.RouteAllocations(),
+	}, nil
 }
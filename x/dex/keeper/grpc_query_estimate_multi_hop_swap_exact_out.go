@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) EstimateMultiHopSwapExactOut(
+	goCtx context.Context,
+	req *types.QueryEstimateMultiHopSwapExactOutRequest,
+) (*types.QueryEstimateMultiHopSwapExactOutResponse, error) {
+	msg := types.MsgMultiHopSwapExactOut{
+		Creator:       req.Creator,
+		Receiver:      req.Receiver,
+		Routes:        req.Routes,
+		AmountOut:     req.AmountOut,
+		MaxAmountIn:   req.MaxAmountIn,
+		PickBestRoute: req.PickBestRoute,
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	// NB: We never write the best route's buffered state context since this is only an estimate.
+	bestRoute, err := k.CalculateMultiHopSwapExactOut(
+		cacheCtx,
+		req.AmountOut,
+		req.MaxAmountIn,
+		req.Routes,
+		req.PickBestRoute,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEstimateMultiHopSwapExactOutResponse{
+		CoinIn: bestRoute.coinIn,
+	}, nil
+}
@@ -364,3 +364,62 @@ func (s *DexTestSuite) TestEstimateMultiHopSwapEventsEmitted() {
 	// 8 tickUpdateEvents are emitted 4x for pool setup 4x for two swaps
 	s.AssertEventValueNotEmitted(types.TickUpdateEventKey, "Expected no events")
 }
+
+func (s *DexTestSuite) TestEstimateMultiHopSwapSplitRoutes() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, A<>C and C<>B that can't absorb the trade individually
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 50, 0, 1),
+		NewPoolSetup("TokenA", "TokenC", 0, 50, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 50, 0, 0, 1),
+	)
+
+	// WHEN alice estimates a split multihopswap
+	req := &types.QueryEstimateMultiHopSwapRequest{
+		Creator:  s.alice.String(),
+		Receiver: s.alice.String(),
+		Routes: []*types.MultiHopRoute{
+			{Hops: []string{"TokenA", "TokenB"}},
+			{Hops: []string{"TokenA", "TokenC", "TokenB"}},
+		},
+		AmountIn:       math.NewInt(100).Mul(denomMultiple),
+		ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+		SplitRoutes:    true,
+	}
+	resp, err := s.App.DexKeeper.EstimateMultiHopSwap(s.Ctx, req)
+	s.NoError(err)
+
+	// THEN the allocation of each route is reported and nothing changes
+	s.Len(resp.RouteAllocations, 2)
+	s.True(resp.CoinOut.Amount.GT(math.NewInt(99).Mul(denomMultiple)))
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 100)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenB", 0)
+}
+
+func (s *DexTestSuite) TestEstimateMultiHopSwapExactOut() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D,
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, -1, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, -1, 1),
+	)
+
+	// WHEN alice estimates an exact-out multihopswap for 50 TokenD
+	resp, err := s.App.DexKeeper.EstimateMultiHopSwapExactOut(s.Ctx, &types.QueryEstimateMultiHopSwapExactOutRequest{
+		Creator:     s.alice.String(),
+		Receiver:    s.alice.String(),
+		Routes:      []*types.MultiHopRoute{{Hops: []string{"TokenA", "TokenB", "TokenC", "TokenD"}}},
+		AmountOut:   math.NewInt(50).Mul(denomMultiple),
+		MaxAmountIn: math.NewInt(60).Mul(denomMultiple),
+	})
+	s.NoError(err)
+
+	// THEN alice would pay 50 TokenA and nothing changes
+	s.Assert().Equal(sdk.NewCoin("TokenA", math.NewInt(50).Mul(denomMultiple)), resp.CoinIn)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 100)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenD", 0)
+	s.assertDexBalanceWithDenom("TokenD", 100)
+}
@@ -44,7 +44,7 @@ func (k Keeper) EstimatePlaceLimitOrder(
 		)
 	}
 
-	_, totalInCoin, swapInCoin, swapOutCoin, err := k.PlaceLimitOrderCore(
+	_, totalInCoin, swapInCoin, swapOutCoin, _, err := k.PlaceLimitOrderCore(
 		cacheCtx,
 		req.TokenIn,
 		req.TokenOut,
@@ -52,8 +52,10 @@ func (k Keeper) EstimatePlaceLimitOrder(
 		req.TickIndexInToOut,
 		req.OrderType,
 		req.ExpirationTime,
+		0,
 		req.MaxAmountOut,
 		nil,
+		false,
 		callerAddr,
 		receiverAddr,
 	)
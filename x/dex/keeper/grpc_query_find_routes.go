@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) FindRoutes(
+	c context.Context,
+	req *types.QueryFindRoutesRequest,
+) (*types.QueryFindRoutesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if err := sdk.ValidateDenom(req.TokenIn); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token_in: %s", err)
+	}
+	if err := sdk.ValidateDenom(req.TokenOut); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token_out: %s", err)
+	}
+	if req.TokenIn == req.TokenOut {
+		return nil, status.Error(codes.InvalidArgument, "token_in and token_out cannot be the same")
+	}
+	if req.AmountIn.IsNil() || !req.AmountIn.IsPositive() {
+		return nil, status.Error(codes.InvalidArgument, "amount_in must be positive")
+	}
+
+	maxHops := req.MaxHops
+	if maxHops == 0 {
+		maxHops = types.DefaultFindRoutesMaxHops
+	}
+	if maxHops > types.MaxFindRoutesMaxHops {
+		return nil, status.Errorf(codes.InvalidArgument, "max_hops cannot exceed %d", types.MaxFindRoutesMaxHops)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	routes := k.GetCandidateRoutes(ctx, req.TokenIn, req.TokenOut, int(maxHops))
+
+	candidates := make([]types.RouteCandidate, 0, len(routes))
+	for _, route := range routes {
+		// NB: Each route is simulated against a fresh cache so that routes sharing a pair do not affect each other.
+		cacheCtx, _ := ctx.CacheContext()
+		result, _, err := k.CalulateMultiHopSwap(
+			cacheCtx,
+			req.AmountIn,
+			[]*types.MultiHopRoute{route},
+			math_utils.ZeroPrecDec(),
+			false,
+			false,
+		)
+		if err != nil || !result.coinOut.IsPositive() {
+			continue
+		}
+
+		candidates = append(candidates, types.RouteCandidate{
+			Route:   route,
+			CoinOut: result.coinOut,
+			Dust:    result.dust,
+		})
+	}
+
+	// Best output first; ties are broken in favour of the shorter route
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if !candidates[i].CoinOut.Amount.Equal(candidates[j].CoinOut.Amount) {
+			return candidates[i].CoinOut.Amount.GT(candidates[j].CoinOut.Amount)
+		}
+		return len(candidates[i].Route.Hops) < len(candidates[j].Route.Hops)
+	})
+
+	return &types.QueryFindRoutesResponse{Routes: candidates}, nil
+}
+
+// GetCandidateRoutes returns the routes from tokenIn to tokenOut of at most maxHops swaps that only pass
+// through trade pairs with maker liquidity. Routes never revisit a denom. The graph is searched breadth first
+// so routes are returned ordered by hop count and, once MaxFindRoutesCandidates routes have been found, the
+// remaining routes are all at least as long as the ones returned. At most MaxFindRoutesExploredPaths partial
+// routes are explored.
+func (k Keeper) GetCandidateRoutes(
+	ctx sdk.Context,
+	tokenIn string,
+	tokenOut string,
+	maxHops int,
+) []*types.MultiHopRoute {
+	graph := k.GetLiquidTradePairs(ctx)
+	routes := make([]*types.MultiHopRoute, 0)
+
+	queue := [][]string{{tokenIn}}
+	explored := 0
+	for len(queue) > 0 {
+		path := queue[0]
+		queue = queue[1:]
+
+		for _, next := range graph[path[len(path)-1]] {
+			if len(routes) >= types.MaxFindRoutesCandidates {
+				return routes
+			}
+			if containsDenom(path, next) {
+				continue
+			}
+
+			hops := make([]string, len(path), len(path)+1)
+			copy(hops, path)
+			hops = append(hops, next)
+
+			if next == tokenOut {
+				routes = append(routes, &types.MultiHopRoute{Hops: hops})
+				continue
+			}
+
+			// A path of n denoms has n-1 swaps so it can only be extended while it has fewer than maxHops denoms
+			if len(hops) < maxHops+1 && explored < types.MaxFindRoutesExploredPaths {
+				explored++
+				queue = append(queue, hops)
+			}
+		}
+	}
+
+	return routes
+}
+
+func containsDenom(denoms []string, denom string) bool {
+	for _, d := range denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+// GetLiquidTradePairs returns the maker denoms that can be bought with each taker denom.
+// Only trade pairs that currently have maker liquidity are included.
+func (k Keeper) GetLiquidTradePairs(ctx sdk.Context) map[string][]string {
+	store := ctx.KVStore(k.storeKey)
+	storePrefix := types.KeyPrefix(types.TickLiquidityKeyPrefix)
+	end := storetypes.PrefixEndBytes(storePrefix)
+
+	graph := make(map[string][]string)
+	start := storePrefix
+	for {
+		iter := store.Iterator(start, end)
+		if !iter.Valid() {
+			iter.Close()
+			break
+		}
+
+		var tick types.TickLiquidity
+		k.cdc.MustUnmarshal(iter.Value(), &tick)
+		iter.Close()
+
+		tradePairID := tickTradePairID(tick)
+		if _, found := k.GetCurrTickIndexTakerToMaker(ctx, tradePairID); found {
+			graph[tradePairID.TakerDenom] = append(graph[tradePairID.TakerDenom], tradePairID.MakerDenom)
+		}
+
+		// Skip the remaining ticks of this trade pair
+		start = storetypes.PrefixEndBytes(types.TickLiquidityPrefix(tradePairID))
+	}
+
+	for _, makerDenoms := range graph {
+		sort.Strings(makerDenoms)
+	}
+
+	return graph
+}
+
+func tickTradePairID(tick types.TickLiquidity) *types.TradePairID {
+	switch liquidity := tick.Liquidity.(type) {
+	case *types.TickLiquidity_LimitOrderTranche:
+		return liquidity.LimitOrderTranche.Key.TradePairId
+	case *types.TickLiquidity_PoolReserves:
+		return liquidity.PoolReserves.Key.TradePairId
+	default:
+		panic("Tick does not contain valid liqudityType")
+	}
+}
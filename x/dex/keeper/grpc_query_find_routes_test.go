@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) setupFindRoutes() {
+	// GIVEN liquidity for the routes A->B->D, A->C->D and A->D with increasing fees
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenD", 0, 100, -1, 1),
+		NewPoolSetup("TokenA", "TokenC", 0, 100, -1, 100),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, -1, 100),
+		NewPoolSetup("TokenA", "TokenD", 0, 100, -1, 300),
+	)
+}
+
+func (s *DexTestSuite) findRoutes(tokenIn, tokenOut string, amountIn int, maxHops uint64) (*types.QueryFindRoutesResponse, error) {
+	return s.App.DexKeeper.FindRoutes(s.Ctx, &types.QueryFindRoutesRequest{
+		TokenIn:  tokenIn,
+		TokenOut: tokenOut,
+		AmountIn: sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		MaxHops:  maxHops,
+	})
+}
+
+func (s *DexTestSuite) TestFindRoutes() {
+	s.setupFindRoutes()
+
+	resp, err := s.findRoutes("TokenA", "TokenD", 10, 0)
+	s.NoError(err)
+
+	// THEN all three routes are returned ordered by amount out
+	s.Len(resp.Routes, 3)
+	s.Equal([]string{"TokenA", "TokenB", "TokenD"}, resp.Routes[0].Route.Hops)
+	s.Equal([]string{"TokenA", "TokenC", "TokenD"}, resp.Routes[1].Route.Hops)
+	s.Equal([]string{"TokenA", "TokenD"}, resp.Routes[2].Route.Hops)
+
+	for _, candidate := range resp.Routes {
+		s.Equal("TokenD", candidate.CoinOut.Denom)
+		s.True(candidate.CoinOut.Amount.IsPositive())
+	}
+	s.True(resp.Routes[0].CoinOut.Amount.GT(resp.Routes[1].CoinOut.Amount))
+	s.True(resp.Routes[1].CoinOut.Amount.GT(resp.Routes[2].CoinOut.Amount))
+}
+
+func (s *DexTestSuite) TestFindRoutesMaxHops() {
+	s.setupFindRoutes()
+
+	resp, err := s.findRoutes("TokenA", "TokenD", 10, 1)
+	s.NoError(err)
+
+	// THEN only the direct route is returned
+	s.Len(resp.Routes, 1)
+	s.Equal([]string{"TokenA", "TokenD"}, resp.Routes[0].Route.Hops)
+}
+
+func (s *DexTestSuite) TestFindRoutesPrefersShorterRoutesWhenCandidatesAreCapped() {
+	// GIVEN more two hop routes A->Bxx->Z than can be simulated, all through denoms sorting before TokenZ
+	pools := []PoolSetup{NewPoolSetup("TokenA", "TokenZ", 0, 100, -1, 1)}
+	for i := 0; i < types.MaxFindRoutesCandidates+5; i++ {
+		intermediate := fmt.Sprintf("TokenB%02d", i)
+		pools = append(pools,
+			NewPoolSetup("TokenA", intermediate, 0, 100, -1, 1),
+			NewPoolSetup(intermediate, "TokenZ", 0, 100, -1, 1),
+		)
+	}
+	s.SetupMultiplePools(pools...)
+
+	resp, err := s.findRoutes("TokenA", "TokenZ", 10, 0)
+	s.NoError(err)
+
+	// THEN the candidate cap is respected and the direct route is still found
+	s.Len(resp.Routes, types.MaxFindRoutesCandidates)
+	s.Equal([]string{"TokenA", "TokenZ"}, resp.Routes[0].Route.Hops)
+}
+
+func (s *DexTestSuite) TestFindRoutesIgnoresPairsWithoutLiquidity() {
+	// GIVEN A<>B only has TokenA liquidity so TokenA cannot be swapped for TokenB
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 100, 0, 0, 1),
+	)
+
+	resp, err := s.findRoutes("TokenA", "TokenB", 10, 0)
+	s.NoError(err)
+	s.Empty(resp.Routes)
+
+	// THEN the opposite direction can be found
+	resp, err = s.findRoutes("TokenB", "TokenA", 10, 0)
+	s.NoError(err)
+	s.Len(resp.Routes, 1)
+	s.Equal([]string{"TokenB", "TokenA"}, resp.Routes[0].Route.Hops)
+}
+
+func (s *DexTestSuite) TestFindRoutesResultCanBeSwapped() {
+	s.fundAliceBalances(10, 0)
+	s.setupFindRoutes()
+
+	resp, err := s.findRoutes("TokenA", "TokenD", 10, 0)
+	s.NoError(err)
+	best := resp.Routes[0]
+
+	// WHEN alice swaps through the best route
+	_, err = s.msgServer.MultiHopSwap(s.Ctx, &types.MsgMultiHopSwap{
+		Creator:        s.alice.String(),
+		Receiver:       s.alice.String(),
+		Routes:         []*types.MultiHopRoute{best.Route},
+		AmountIn:       sdkmath.NewInt(10).Mul(denomMultiple),
+		ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+	})
+	s.NoError(err)
+
+	// THEN she receives the simulated amount
+	s.assertAccountBalanceWithDenomInt(s.alice, "TokenD", best.CoinOut.Amount)
+}
+
+func (s *DexTestSuite) TestFindRoutesInvalidRequest() {
+	_, err := s.findRoutes("TokenA", "TokenA", 10, 0)
+	s.ErrorContains(err, "token_in and token_out cannot be the same")
+
+	_, err = s.findRoutes("TokenA", "TokenD", 0, 0)
+	s.ErrorContains(err, "amount_in must be positive")
+
+	_, err = s.findRoutes("TokenA", "TokenD", 10, types.MaxFindRoutesMaxHops+1)
+	s.ErrorContains(err, "max_hops cannot exceed")
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) GaugeAll(
+	goCtx context.Context,
+	req *types.QueryAllGaugeRequest,
+) (*types.QueryAllGaugeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var gauges []*types.Gauge
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.GaugeKeyPrefix))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		gauge := &types.Gauge{}
+		if err := k.cdc.Unmarshal(value, gauge); err != nil {
+			return err
+		}
+
+		gauges = append(gauges, gauge)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllGaugeResponse{
+		Gauges:     gauges,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) StakeAllByAddress(
+	goCtx context.Context,
+	req *types.QueryAllStakeByAddressRequest,
+) (*types.QueryAllStakeByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var stakes []*types.Stake
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakeOwnerPrefix(addr.String()))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		stake := &types.Stake{}
+		if err := k.cdc.Unmarshal(value, stake); err != nil {
+			return err
+		}
+
+		stakes = append(stakes, stake)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllStakeByAddressResponse{
+		Stakes:     stakes,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) PendingRewards(
+	goCtx context.Context,
+	req *types.QueryPendingRewardsRequest,
+) (*types.QueryPendingRewardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stakerRewards, _ := k.GetStakerRewards(ctx, addr.String())
+
+	return &types.QueryPendingRewardsResponse{Rewards: stakerRewards.Rewards}, nil
+}
@@ -0,0 +1,121 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) OrderBook(
+	c context.Context,
+	req *types.QueryOrderBookRequest,
+) (*types.QueryOrderBookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TokenIn != pairID.Token0 && req.TokenIn != pairID.Token1 {
+		return nil, status.Errorf(codes.InvalidArgument, "token_in %s is not part of pair %s", req.TokenIn, req.PairId)
+	}
+
+	depth := req.Depth
+	if depth == 0 {
+		depth = types.DefaultOrderBookDepth
+	}
+	if depth > types.MaxOrderBookDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "depth cannot exceed %d", types.MaxOrderBookDepth)
+	}
+
+	grouping := int64(1)
+	if req.PriceGrouping > 1 {
+		if req.PriceGrouping > types.MaxTickExp {
+			return nil, status.Errorf(codes.InvalidArgument, "price_grouping cannot exceed %d", types.MaxTickExp)
+		}
+		grouping = int64(req.PriceGrouping)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	tradePairID := types.NewTradePairIDFromMaker(pairID, req.TokenIn)
+
+	return &types.QueryOrderBookResponse{
+		Levels: k.GetOrderBookLevels(ctx, tradePairID, depth, grouping),
+	}, nil
+}
+
+// GetOrderBookLevels walks the maker side of tradePairID from the best price to the worst and aggregates
+// the available reserves into at most depth price levels of grouping ticks each.
+func (k Keeper) GetOrderBookLevels(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	depth uint64,
+	grouping int64,
+) []types.OrderBookLevel {
+	levels := make([]types.OrderBookLevel, 0)
+	cumulative := math.ZeroInt()
+	var levelTick int64
+
+	ti := k.NewTickIterator(ctx, tradePairID)
+	defer ti.Close()
+	for ; ti.Valid(); ti.Next() {
+		tick := ti.Value()
+		if !tick.HasToken() {
+			continue
+		}
+
+		var reserves math.Int
+		switch liquidity := tick.Liquidity.(type) {
+		case *types.TickLiquidity_PoolReserves:
+			reserves = liquidity.PoolReserves.ReservesMakerDenom
+		case *types.TickLiquidity_LimitOrderTranche:
+			if liquidity.LimitOrderTranche.IsExpired(ctx) {
+				continue
+			}
+			reserves = liquidity.LimitOrderTranche.ReservesMakerDenom
+		}
+
+		// Ticks are stored from the best price to the worst, so rounding up groups each tick
+		// into a level at the worst price it contains
+		tickIndex := min(ceilToMultiple(tick.TickIndex(), grouping), int64(types.MaxTickExp))
+		if len(levels) == 0 || tickIndex != levelTick {
+			if uint64(len(levels)) == depth {
+				break
+			}
+			levelTick = tickIndex
+			levels = append(levels, types.OrderBookLevel{
+				TickIndex:          tradePairID.TickIndexNormalized(tickIndex),
+				MakerPrice:         types.MustCalcPrice(tickIndex),
+				Reserves:           math.ZeroInt(),
+				CumulativeReserves: cumulative,
+			})
+		}
+
+		cumulative = cumulative.Add(reserves)
+		level := &levels[len(levels)-1]
+		level.Reserves = level.Reserves.Add(reserves)
+		level.CumulativeReserves = cumulative
+	}
+
+	return levels
+}
+
+func ceilToMultiple(x, multiple int64) int64 {
+	if multiple <= 1 {
+		return x
+	}
+	remainder := x % multiple
+	if remainder > 0 {
+		return x + multiple - remainder
+	}
+	// Go's remainder takes the sign of x so negative values are already rounded up
+	return x - remainder
+}
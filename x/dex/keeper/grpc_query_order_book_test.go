@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) setupOrderBook() {
+	s.fundAliceBalances(100, 0)
+
+	// TokenA limit orders at ticks 1, 0 and -2
+	s.aliceLimitSells("TokenA", 1, 5)
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.aliceLimitSells("TokenA", 0, 3)
+	s.aliceLimitSells("TokenA", -2, 20)
+	// TokenA pool reserves at tick -1
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+}
+
+func (s *DexTestSuite) assertOrderBookLevel(level types.OrderBookLevel, tickIndex, reserves, cumulative int64) {
+	s.Equal(tickIndex, level.TickIndex)
+	s.Equal(types.MustCalcPrice(tickIndex*-1), level.MakerPrice)
+	s.Equal(sdkmath.NewInt(reserves).Mul(denomMultiple), level.Reserves)
+	s.Equal(sdkmath.NewInt(cumulative).Mul(denomMultiple), level.CumulativeReserves)
+}
+
+func (s *DexTestSuite) TestOrderBook() {
+	s.setupOrderBook()
+
+	resp, err := s.App.DexKeeper.OrderBook(s.Ctx, &types.QueryOrderBookRequest{
+		PairId:  "TokenA<>TokenB",
+		TokenIn: "TokenA",
+	})
+	s.NoError(err)
+
+	// Levels are ordered from the best price to the worst
+	s.Len(resp.Levels, 4)
+	s.assertOrderBookLevel(resp.Levels[0], 1, 5, 5)
+	s.assertOrderBookLevel(resp.Levels[1], 0, 13, 18)
+	s.assertOrderBookLevel(resp.Levels[2], -1, 10, 28)
+	s.assertOrderBookLevel(resp.Levels[3], -2, 20, 48)
+}
+
+func (s *DexTestSuite) TestOrderBookDepth() {
+	s.setupOrderBook()
+
+	resp, err := s.App.DexKeeper.OrderBook(s.Ctx, &types.QueryOrderBookRequest{
+		PairId:  "TokenA<>TokenB",
+		TokenIn: "TokenA",
+		Depth:   2,
+	})
+	s.NoError(err)
+
+	s.Len(resp.Levels, 2)
+	s.assertOrderBookLevel(resp.Levels[0], 1, 5, 5)
+	s.assertOrderBookLevel(resp.Levels[1], 0, 13, 18)
+}
+
+func (s *DexTestSuite) TestOrderBookPriceGrouping() {
+	s.setupOrderBook()
+
+	resp, err := s.App.DexKeeper.OrderBook(s.Ctx, &types.QueryOrderBookRequest{
+		PairId:        "TokenA<>TokenB",
+		TokenIn:       "TokenA",
+		PriceGrouping: 2,
+	})
+	s.NoError(err)
+
+	// Ticks are grouped into levels at the worst price they contain
+	s.Len(resp.Levels, 2)
+	s.assertOrderBookLevel(resp.Levels[0], 0, 18, 18)
+	s.assertOrderBookLevel(resp.Levels[1], -2, 30, 48)
+}
+
+func (s *DexTestSuite) TestOrderBookEmptySide() {
+	s.setupOrderBook()
+
+	resp, err := s.App.DexKeeper.OrderBook(s.Ctx, &types.QueryOrderBookRequest{
+		PairId:  "TokenA<>TokenB",
+		TokenIn: "TokenB",
+	})
+	s.NoError(err)
+	s.Empty(resp.Levels)
+}
+
+func (s *DexTestSuite) TestOrderBookInvalidRequest() {
+	_, err := s.App.DexKeeper.OrderBook(s.Ctx, &types.QueryOrderBookRequest{
+		PairId:  "TokenA<>TokenB",
+		TokenIn: "TokenC",
+	})
+	s.Error(err)
+
+	_, err = s.App.DexKeeper.OrderBook(s.Ctx, &types.QueryOrderBookRequest{
+		PairId:  "TokenA<>TokenB",
+		TokenIn: "TokenA",
+		Depth:   types.MaxOrderBookDepth + 1,
+	})
+	s.Error(err)
+}
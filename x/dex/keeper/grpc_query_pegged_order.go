@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) PeggedOrderAllByAddress(
+	goCtx context.Context,
+	req *types.QueryAllPeggedOrderByAddressRequest,
+) (*types.QueryAllPeggedOrderByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var peggedOrderList []*types.PeggedOrder
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PeggedOrderAddressPrefix(addr.String()))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		peggedOrder := &types.PeggedOrder{}
+		if err := k.cdc.Unmarshal(value, peggedOrder); err != nil {
+			return err
+		}
+
+		peggedOrderList = append(peggedOrderList, peggedOrder)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPeggedOrderByAddressResponse{
+		PeggedOrders: peggedOrderList,
+		Pagination:   pageRes,
+	}, nil
+}
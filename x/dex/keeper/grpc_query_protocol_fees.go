@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) ProtocolFeesAll(goCtx context.Context, req *types.QueryAllProtocolFeesRequest) (*types.QueryAllProtocolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var protocolFees []types.PairProtocolFees
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	protocolFeesStore := prefix.NewStore(store, types.KeyPrefix(types.ProtocolFeesKeyPrefix))
+
+	pageRes, err := query.Paginate(protocolFeesStore, req.Pagination, func(_, value []byte) error {
+		var pairProtocolFees types.PairProtocolFees
+		if err := k.cdc.Unmarshal(value, &pairProtocolFees); err != nil {
+			return err
+		}
+
+		protocolFees = append(protocolFees, pairProtocolFees)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllProtocolFeesResponse{ProtocolFees: protocolFees, Pagination: pageRes}, nil
+}
+
+// Returns the protocol fees accumulated by a pair. Pairs that have not accumulated any fees return an empty list of fees.
+func (k Keeper) ProtocolFees(goCtx context.Context, req *types.QueryGetProtocolFeesRequest) (*types.QueryGetProtocolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+
+	protocolFees, found := k.GetProtocolFees(ctx, pairID)
+	if !found {
+		protocolFees = &types.PairProtocolFees{PairId: pairID, Fees: sdk.Coins{}}
+	}
+
+	return &types.QueryGetProtocolFeesResponse{ProtocolFees: *protocolFees}, nil
+}
@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
 	"github.com/neutron-org/neutron/v5/x/dex/types"
 )
 
@@ -29,16 +30,34 @@ func (k Keeper) SimulateMultiHopSwap(
 		msg.Routes,
 		msg.ExitLimitPrice,
 		msg.PickBestRoute,
+		msg.SplitRoutes,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	var route *types.MultiHopRoute
+	if !msg.SplitRoutes {
+		route = &types.MultiHopRoute{Hops: bestRoute.route}
+	}
+
+	// All routes share the same token in and out so the first route with oracle prices for every pair is used
+	var oracleReferencePrice *math_utils.PrecDec
+	for _, r := range msg.Routes {
+		if price, found := k.GetOracleReferencePrice(ctx, r.Hops); found {
+			oracleReferencePrice = &price
+			break
+		}
+	}
+
 	return &types.QuerySimulateMultiHopSwapResponse{
 		Resp: &types.MsgMultiHopSwapResponse{
-			CoinOut: bestRoute.coinOut,
-			Dust:    bestRoute.dust,
-			Route:   &types.MultiHopRoute{Hops: bestRoute.route},
+			CoinOut:          bestRoute.coinOut,
+			Dust:             bestRoute.dust,
+			Route:            route,
+			RouteAllocations: bestRoute.RouteAllocations(),
 		},
+		OracleReferencePrice: oracleReferencePrice,
+		DynamicFees:          bestRoute.dynamicFees,
 	}, nil
 }
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) SimulateMultiHopSwapExactOut(
+	goCtx context.Context,
+	req *types.QuerySimulateMultiHopSwapExactOutRequest,
+) (*types.QuerySimulateMultiHopSwapExactOutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	msg := req.Msg
+	msg.Creator = types.DummyAddress
+	msg.Receiver = types.DummyAddress
+
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+
+	bestRoute, err := k.CalculateMultiHopSwapExactOut(
+		cacheCtx,
+		msg.AmountOut,
+		msg.MaxAmountIn,
+		msg.Routes,
+		msg.PickBestRoute,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySimulateMultiHopSwapExactOutResponse{
+		Resp: &types.MsgMultiHopSwapExactOutResponse{
+			CoinIn:  bestRoute.coinIn,
+			CoinOut: bestRoute.coinOut,
+			Route:   &types.MultiHopRoute{Hops: bestRoute.route},
+		},
+		DynamicFees: bestRoute.dynamicFees,
+	}, nil
+}
@@ -105,3 +105,92 @@ func (s *DexTestSuite) TestSimulateMultiHopSwapFails() {
 	s.Error(err, types.ErrLimitPriceNotSatisfied)
 	s.Nil(resp)
 }
+
+func (s *DexTestSuite) TestSimulateMultiHopSwapSplitRoutes() {
+	// GIVEN liquidity in pools A<>B, A<>C and C<>B that can't absorb the trade individually
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 50, 0, 1),
+		NewPoolSetup("TokenA", "TokenC", 0, 50, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 50, 0, 0, 1),
+	)
+
+	// WHEN alice simulates a split multihopswap
+	route1 := &types.MultiHopRoute{Hops: []string{"TokenA", "TokenB"}}
+	route2 := &types.MultiHopRoute{Hops: []string{"TokenA", "TokenC", "TokenB"}}
+	req := &types.QuerySimulateMultiHopSwapRequest{
+		Msg: &types.MsgMultiHopSwap{
+			Routes:         []*types.MultiHopRoute{route1, route2},
+			AmountIn:       math.NewInt(100_000_000),
+			ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.9"),
+			SplitRoutes:    true,
+		},
+	}
+	resp, err := s.App.DexKeeper.SimulateMultiHopSwap(s.Ctx, req)
+	s.NoError(err)
+
+	// THEN the allocation of each route is reported
+	s.Nil(resp.Resp.Route)
+	s.Len(resp.Resp.RouteAllocations, 2)
+	s.Equal(route1, resp.Resp.RouteAllocations[0].Route)
+	s.Equal(math.NewInt(50_000_000), resp.Resp.RouteAllocations[0].AmountIn)
+	s.Equal(route2, resp.Resp.RouteAllocations[1].Route)
+	s.Equal(math.NewInt(50_000_000), resp.Resp.RouteAllocations[1].AmountIn)
+	totalOut := resp.Resp.RouteAllocations[0].CoinOut.Add(resp.Resp.RouteAllocations[1].CoinOut)
+	s.True(resp.Resp.CoinOut.Equal(totalOut))
+
+	// Nothing changes on the dex
+	s.assertDexBalanceWithDenom("TokenA", 0)
+	s.assertDexBalanceWithDenom("TokenB", 100)
+	s.assertDexBalanceWithDenom("TokenC", 50)
+}
+
+func (s *DexTestSuite) TestSimulateMultiHopSwapExactOut() {
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D,
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, -1, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, -1, 1),
+	)
+
+	// WHEN alice simulates an exact-out multihopswap for 50 TokenD
+	route := &types.MultiHopRoute{Hops: []string{"TokenA", "TokenB", "TokenC", "TokenD"}}
+	req := &types.QuerySimulateMultiHopSwapExactOutRequest{
+		Msg: &types.MsgMultiHopSwapExactOut{
+			Routes:      []*types.MultiHopRoute{route},
+			AmountOut:   math.NewInt(50_000_000),
+			MaxAmountIn: math.NewInt(60_000_000),
+		},
+	}
+	resp, err := s.App.DexKeeper.SimulateMultiHopSwapExactOut(s.Ctx, req)
+	s.NoError(err)
+
+	// THEN alice would pay 50 TokenA
+	s.Assert().True(resp.Resp.CoinIn.Equal(sdk.NewCoin("TokenA", math.NewInt(50_000_000))))
+	s.Assert().True(resp.Resp.CoinOut.Equal(sdk.NewCoin("TokenD", math.NewInt(50_000_000))))
+	s.Assert().Equal(route, resp.Resp.Route)
+
+	// Nothing changes on the dex
+	s.assertDexBalanceWithDenom("TokenA", 0)
+	s.assertDexBalanceWithDenom("TokenD", 100)
+}
+
+func (s *DexTestSuite) TestSimulateMultiHopSwapExactOutMaxAmountInExceeded() {
+	// GIVEN liquidity in pools A<>B, B<>C
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, -1, 1),
+	)
+
+	// WHEN alice simulates an exact-out multihopswap with too low max_amount_in
+	req := &types.QuerySimulateMultiHopSwapExactOutRequest{
+		Msg: &types.MsgMultiHopSwapExactOut{
+			Routes:      []*types.MultiHopRoute{{Hops: []string{"TokenA", "TokenB", "TokenC"}}},
+			AmountOut:   math.NewInt(50_000_000),
+			MaxAmountIn: math.NewInt(40_000_000),
+		},
+	}
+	_, err := s.App.DexKeeper.SimulateMultiHopSwapExactOut(s.Ctx, req)
+
+	// THEN the simulation fails
+	s.ErrorIs(err, types.ErrMaxAmountInExceeded)
+}
@@ -25,7 +25,7 @@ func (k Keeper) SimulatePlaceLimitOrder(
 		return nil, err
 	}
 
-	err := msg.ValidateGoodTilExpiration(ctx.BlockTime())
+	err := msg.ValidateGoodTilExpiration(ctx.BlockTime(), ctx.BlockHeight())
 	if err != nil {
 		return nil, err
 	}
@@ -43,13 +43,14 @@ func (k Keeper) SimulatePlaceLimitOrder(
 			return nil, errors.Wrapf(err, "invalid LimitSellPrice %s", msg.LimitSellPrice.String())
 		}
 	}
-	trancheKey, totalIn, takerCoinIn, takerCoinOut, _, _, err := k.ExecutePlaceLimitOrder(
+	trancheKey, _, totalIn, takerCoinIn, takerCoinOut, takerFee, dynamicFee, _, _, err := k.ExecutePlaceLimitOrder(
 		cacheCtx,
 		takerTradePairID,
 		msg.AmountIn,
 		tickIndex,
 		msg.OrderType,
 		msg.ExpirationTime,
+		msg.ExpirationHeight,
 		msg.MaxAmountOut,
 		msg.MinAverageSellPrice,
 		receiverAddr,
@@ -65,6 +66,8 @@ func (k Keeper) SimulatePlaceLimitOrder(
 			CoinIn:       coinIn,
 			TakerCoinIn:  takerCoinIn,
 			TakerCoinOut: takerCoinOut,
+			TakerFee:     takerFee,
 		},
+		DynamicFee: dynamicFee,
 	}, nil
 }
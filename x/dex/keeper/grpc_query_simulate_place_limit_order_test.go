@@ -84,3 +84,48 @@ func (s *DexTestSuite) TestSimulatePlaceLimitOrderFails() {
 
 	s.assertDexBalances(0, 100)
 }
+
+func (s *DexTestSuite) TestSimulatePlaceLimitOrderPostOnlyFails() {
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+	)
+
+	req := &types.QuerySimulatePlaceLimitOrderRequest{
+		Msg: &types.MsgPlaceLimitOrder{
+			TokenIn:          "TokenA",
+			TokenOut:         "TokenB",
+			TickIndexInToOut: 5,
+			AmountIn:         math.NewInt(20_000_000),
+			OrderType:        types.LimitOrderType_POST_ONLY,
+		},
+	}
+
+	resp, err := s.App.DexKeeper.SimulatePlaceLimitOrder(s.Ctx, req)
+	s.ErrorIs(err, types.ErrPostOnlyOrderCrossesBook)
+	s.Nil(resp)
+}
+
+func (s *DexTestSuite) TestSimulatePlaceLimitOrderPostOnlyReprice() {
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 0, 1),
+	)
+
+	req := &types.QuerySimulatePlaceLimitOrderRequest{
+		Msg: &types.MsgPlaceLimitOrder{
+			TokenIn:          "TokenA",
+			TokenOut:         "TokenB",
+			TickIndexInToOut: 5,
+			AmountIn:         math.NewInt(20_000_000),
+			OrderType:        types.LimitOrderType_POST_ONLY_REPRICE,
+		},
+	}
+
+	resp, err := s.App.DexKeeper.SimulatePlaceLimitOrder(s.Ctx, req)
+	s.NoError(err)
+
+	s.Equal(sdk.NewCoin("TokenA", math.NewInt(20_000_000)), resp.Resp.CoinIn)
+	s.True(resp.Resp.TakerCoinIn.IsZero())
+	s.True(resp.Resp.TakerCoinOut.IsZero())
+
+	s.assertDexBalances(0, 100)
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Returns the time weighted average price of swapping TokenIn through the pair over the requested window
+func (k Keeper) TimeWeightedAveragePrice(
+	goCtx context.Context,
+	req *types.QueryTimeWeightedAveragePriceRequest,
+) (*types.QueryTimeWeightedAveragePriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pairID, err := types.NewPairIDFromCanonicalString(req.PairId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := pairID.OppositeToken(req.TokenIn); !ok {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidTradingPair, "%s is not part of %s", req.TokenIn, req.PairId)
+	}
+	tradePairID := types.NewTradePairIDFromTaker(pairID, req.TokenIn)
+
+	endTime := ctx.BlockTime()
+	if req.EndTime != 0 {
+		endTime = time.Unix(req.EndTime, 0)
+	}
+	startTime := time.Unix(req.StartTime, 0)
+
+	tick, err := k.GetTimeWeightedAverageTick(ctx, tradePairID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := types.CalcPrice(tick)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryTimeWeightedAveragePriceResponse{
+		TickIndexTakerToMaker: tick,
+		Price:                 price,
+	}, nil
+}
@@ -0,0 +1,122 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) TestTimeWeightedAveragePrice() {
+	s.fundAliceBalances(50, 0)
+	tradePairID := types.MustNewTradePairID("TokenB", "TokenA")
+	t0 := time.Unix(1_000_000, 0).UTC()
+
+	// GIVEN the best tick changes 100 seconds after the first order is placed
+	s.Ctx = s.Ctx.WithBlockTime(t0)
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.endBlockWithTime(t0)
+	tick0, _ := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, tradePairID)
+
+	t1 := t0.Add(100 * time.Second)
+	s.Ctx = s.Ctx.WithBlockTime(t1)
+	s.aliceLimitSells("TokenA", 10, 10)
+	s.endBlockWithTime(t1)
+	tick1, _ := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, tradePairID)
+
+	s.Ctx = s.Ctx.WithBlockTime(t0.Add(300 * time.Second))
+
+	// WHEN we query the TWAP over the full window
+	resp, err := s.App.DexKeeper.TimeWeightedAveragePrice(s.Ctx, &types.QueryTimeWeightedAveragePriceRequest{
+		PairId:    "TokenA<>TokenB",
+		TokenIn:   "TokenB",
+		StartTime: t0.Unix(),
+	})
+	s.NoError(err)
+
+	// THEN the tick is weighted by the time spent at each tick
+	expectedTick := (tick0*100 + tick1*200) / 300
+	if (tick0*100+tick1*200)%300 < 0 {
+		expectedTick--
+	}
+	s.Equal(expectedTick, resp.TickIndexTakerToMaker)
+	s.Equal(types.MustCalcPrice(expectedTick), resp.Price)
+
+	// AND a window entirely after the last update returns the current tick
+	resp, err = s.App.DexKeeper.TimeWeightedAveragePrice(s.Ctx, &types.QueryTimeWeightedAveragePriceRequest{
+		PairId:    "TokenA<>TokenB",
+		TokenIn:   "TokenB",
+		StartTime: t1.Add(10 * time.Second).Unix(),
+		EndTime:   t1.Add(20 * time.Second).Unix(),
+	})
+	s.NoError(err)
+	s.Equal(tick1, resp.TickIndexTakerToMaker)
+}
+
+func (s *DexTestSuite) TestTimeWeightedAveragePriceFails() {
+	s.fundAliceBalances(50, 0)
+	t0 := time.Unix(1_000_000, 0).UTC()
+
+	s.Ctx = s.Ctx.WithBlockTime(t0)
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.endBlockWithTime(t0)
+	s.Ctx = s.Ctx.WithBlockTime(t0.Add(100 * time.Second))
+
+	for _, tc := range []struct {
+		desc    string
+		request *types.QueryTimeWeightedAveragePriceRequest
+		err     error
+	}{
+		{
+			desc: "StartBeforeHistory",
+			request: &types.QueryTimeWeightedAveragePriceRequest{
+				PairId:    "TokenA<>TokenB",
+				TokenIn:   "TokenB",
+				StartTime: t0.Add(-time.Second).Unix(),
+			},
+			err: types.ErrTWAPWindowUnavailable,
+		},
+		{
+			desc: "NoHistoryForDirection",
+			request: &types.QueryTimeWeightedAveragePriceRequest{
+				PairId:    "TokenA<>TokenB",
+				TokenIn:   "TokenA",
+				StartTime: t0.Unix(),
+			},
+			err: types.ErrTWAPWindowUnavailable,
+		},
+		{
+			desc: "EndInFuture",
+			request: &types.QueryTimeWeightedAveragePriceRequest{
+				PairId:    "TokenA<>TokenB",
+				TokenIn:   "TokenB",
+				StartTime: t0.Unix(),
+				EndTime:   t0.Add(time.Hour).Unix(),
+			},
+			err: types.ErrInvalidTWAPWindow,
+		},
+		{
+			desc: "StartAfterEnd",
+			request: &types.QueryTimeWeightedAveragePriceRequest{
+				PairId:    "TokenA<>TokenB",
+				TokenIn:   "TokenB",
+				StartTime: t0.Add(50 * time.Second).Unix(),
+				EndTime:   t0.Add(10 * time.Second).Unix(),
+			},
+			err: types.ErrInvalidTWAPWindow,
+		},
+		{
+			desc: "TokenNotInPair",
+			request: &types.QueryTimeWeightedAveragePriceRequest{
+				PairId:    "TokenA<>TokenB",
+				TokenIn:   "TokenC",
+				StartTime: t0.Unix(),
+			},
+			err: types.ErrInvalidTradingPair,
+		},
+	} {
+		s.Run(tc.desc, func() {
+			_, err := s.App.DexKeeper.TimeWeightedAveragePrice(s.Ctx, tc.request)
+			s.ErrorIs(err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) TriggerOrderAllByAddress(
+	goCtx context.Context,
+	req *types.QueryAllTriggerOrderByAddressRequest,
+) (*types.QueryAllTriggerOrderByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	var triggerOrderList []*types.TriggerOrder
+	store := ctx.KVStore(k.storeKey)
+	refStore := prefix.NewStore(store, types.TriggerOrderRefAddressPrefix(addr.String()))
+
+	pageRes, err := query.Paginate(refStore, req.Pagination, func(_, orderKey []byte) error {
+		triggerOrder := &types.TriggerOrder{}
+		if err := k.cdc.Unmarshal(store.Get(orderKey), triggerOrder); err != nil {
+			return err
+		}
+
+		triggerOrderList = append(triggerOrderList, triggerOrder)
+
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTriggerOrderByAddressResponse{
+		TriggerOrders: triggerOrderList,
+		Pagination:    pageRes,
+	}, nil
+}
@@ -1,9 +1,13 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -26,55 +30,146 @@ func (k Keeper) UserDepositsAll(
 	}
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	pageReq := req.Pagination
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	limit := pageReq.Limit
+	countTotal := pageReq.CountTotal
+	if limit == 0 {
+		limit = query.DefaultLimit
+		countTotal = true
+	}
+
+	// Range positions are paginated after the deposits. Once the deposits are exhausted the page key points into
+	// the range positions and is prefixed with rangePositionPageKey.
+	rangeKey, rangeKeyOnly := bytes.CutPrefix(pageReq.Key, rangePositionPageKey)
+
 	var depositArr []*types.DepositRecord
+	pageRes := &query.PageResponse{}
+	var rangeOffset uint64
+	if !rangeKeyOnly {
+		depositsReq := *pageReq
+		// The number of deposits is needed to work out how many range positions the offset skips
+		depositsReq.CountTotal = countTotal || pageReq.Offset > 0
+
+		pageRes, err = utils.FilteredPaginateAccountBalances(
+			ctx,
+			k.bankKeeper,
+			addr,
+			&depositsReq,
+			func(poolCoinMaybe sdk.Coin, accumulate bool) bool {
+				err := types.ValidatePoolDenom(poolCoinMaybe.Denom)
+				if err != nil {
+					return false
+				}
 
-	pageRes, err := utils.FilteredPaginateAccountBalances(
-		ctx,
-		k.bankKeeper,
-		addr,
-		req.Pagination,
-		func(poolCoinMaybe sdk.Coin, accumulate bool) bool {
-			err := types.ValidatePoolDenom(poolCoinMaybe.Denom)
-			if err != nil {
-				return false
-			}
+				poolMetadata, err := k.GetPoolMetadataByDenom(ctx, poolCoinMaybe.Denom)
+				if err != nil {
+					panic("Can't get info for PoolDenom")
+				}
 
-			poolMetadata, err := k.GetPoolMetadataByDenom(ctx, poolCoinMaybe.Denom)
-			if err != nil {
-				panic("Can't get info for PoolDenom")
-			}
+				fee := dexutils.MustSafeUint64ToInt64(poolMetadata.Fee)
 
-			fee := dexutils.MustSafeUint64ToInt64(poolMetadata.Fee)
+				if accumulate {
+					depositRecord := &types.DepositRecord{
+						PairId:          poolMetadata.PairId,
+						SharesOwned:     poolCoinMaybe.Amount,
+						CenterTickIndex: poolMetadata.Tick,
+						LowerTickIndex:  poolMetadata.Tick - fee,
+						UpperTickIndex:  poolMetadata.Tick + fee,
+						Fee:             poolMetadata.Fee,
+					}
 
-			if accumulate {
-				depositRecord := &types.DepositRecord{
-					PairId:          poolMetadata.PairId,
-					SharesOwned:     poolCoinMaybe.Amount,
-					CenterTickIndex: poolMetadata.Tick,
-					LowerTickIndex:  poolMetadata.Tick - fee,
-					UpperTickIndex:  poolMetadata.Tick + fee,
-					Fee:             poolMetadata.Fee,
-				}
+					if req.IncludePoolData {
+						k.addPoolData(ctx, depositRecord)
+					}
 
-				if req.IncludePoolData {
-					k.addPoolData(ctx, depositRecord)
+					depositArr = append(depositArr, depositRecord)
 				}
 
-				depositArr = append(depositArr, depositRecord)
+				return true
+			})
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if pageReq.Offset > pageRes.Total {
+			rangeOffset = pageReq.Offset - pageRes.Total
+		}
+		if !countTotal {
+			pageRes.Total = 0
+		}
+
+		if pageRes.NextKey != nil {
+			return &types.QueryAllUserDepositsResponse{
+				Deposits:   depositArr,
+				Pagination: pageRes,
+			}, nil
+		}
+	}
+
+	rangePositionStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.RangePositionOwnerPrefix(req.Address))
+	remaining := limit - uint64(len(depositArr))
+	if remaining == 0 {
+		// The page is full, only check whether a page of range positions follows
+		if hasAny(rangePositionStore) {
+			pageRes.NextKey = rangePositionPageKey
+		}
+		return &types.QueryAllUserDepositsResponse{
+			Deposits:   depositArr,
+			Pagination: pageRes,
+		}, nil
+	}
+
+	if len(rangeKey) == 0 {
+		rangeKey = nil
+	}
+
+	var rangePositions []*types.RangePosition
+	rangePageRes, err := query.Paginate(
+		rangePositionStore,
+		&query.PageRequest{Key: rangeKey, Offset: rangeOffset, Limit: remaining, CountTotal: countTotal},
+		func(_, value []byte) error {
+			position := &types.RangePosition{}
+			if err := k.cdc.Unmarshal(value, position); err != nil {
+				return err
 			}
 
-			return true
-		})
+			rangePositions = append(rangePositions, position)
+			return nil
+		},
+	)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	pageRes.NextKey = nil
+	if rangePageRes.NextKey != nil {
+		pageRes.NextKey = append(bytes.Clone(rangePositionPageKey), rangePageRes.NextKey...)
+	}
+	if countTotal && !rangeKeyOnly {
+		pageRes.Total += rangePageRes.Total
+	}
+
 	return &types.QueryAllUserDepositsResponse{
-		Deposits:   depositArr,
-		Pagination: pageRes,
+		Deposits:       depositArr,
+		Pagination:     pageRes,
+		RangePositions: rangePositions,
 	}, nil
 }
 
+// rangePositionPageKey prefixes page keys that point into the range positions. Denoms never start with a zero
+// byte so it cannot be confused with the denom keys used to paginate deposits.
+var rangePositionPageKey = []byte{0}
+
+func hasAny(store storetypes.KVStore) bool {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
+
 func (k Keeper) addPoolData(ctx sdk.Context, record *types.DepositRecord) *types.DepositRecord {
 	pool, found := k.GetPool(ctx, record.PairId, record.CenterTickIndex, record.Fee)
 	if !found {
@@ -0,0 +1,320 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// Hooks returns the hooks passed to NewKeeper followed by the governance-registered hook contract (if any).
+func (k Keeper) Hooks() types.DexHooks {
+	return k.hooks
+}
+
+// queueFilledTranche records a tranche filled by a swap so that its AfterTrancheFilled hooks are called at the end
+// of the block. Swaps run inside cache contexts for route selection and simulations are never committed, so only
+// fills from the committing path are notified, and hook contracts are never called in the middle of a swap.
+func (k Keeper) queueFilledTranche(ctx sdk.Context, tranche *types.LimitOrderTranche) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.FilledTrancheKeyPrefix))
+	store.Set(tranche.Key.KeyMarshal(), k.cdc.MustMarshal(tranche))
+}
+
+// queueAfterSwap queues an AfterSwap hook call until the end of the block
+func (k Keeper) queueAfterSwap(ctx sdk.Context, creator, receiver sdk.AccAddress, coinIn, coinOut sdk.Coin) {
+	k.queueHook(ctx, types.DexHookSudoMsg{
+		AfterSwap: &types.AfterSwapHookMsg{
+			Creator:  creator.String(),
+			Receiver: receiver.String(),
+			CoinIn:   coinIn,
+			CoinOut:  coinOut,
+		},
+	})
+}
+
+// queueAfterDeposit queues an AfterDeposit hook call until the end of the block
+func (k Keeper) queueAfterDeposit(
+	ctx sdk.Context,
+	creator, receiver sdk.AccAddress,
+	pairID *types.PairID,
+	amount0, amount1 math.Int,
+	sharesIssued sdk.Coins,
+) {
+	k.queueHook(ctx, types.DexHookSudoMsg{
+		AfterDeposit: liquidityHookMsg(creator, receiver, pairID, amount0, amount1, sharesIssued),
+	})
+}
+
+// queueAfterWithdraw queues an AfterWithdraw hook call until the end of the block
+func (k Keeper) queueAfterWithdraw(
+	ctx sdk.Context,
+	creator, receiver sdk.AccAddress,
+	pairID *types.PairID,
+	amount0, amount1 math.Int,
+	sharesBurned sdk.Coins,
+) {
+	k.queueHook(ctx, types.DexHookSudoMsg{
+		AfterWithdraw: liquidityHookMsg(creator, receiver, pairID, amount0, amount1, sharesBurned),
+	})
+}
+
+// queueHook records a hook call in the transient store so that it is made at the end of the block, like
+// AfterTrancheFilled. Core functions also run inside the cache contexts of simulate and estimate queries, which are
+// never committed, so hook contracts are never called from a query.
+func (k Keeper) queueHook(ctx sdk.Context, msg types.DexHookSudoMsg) {
+	msgBz, err := json.Marshal(msg)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal dex hook", "error", err)
+		return
+	}
+
+	tStore := ctx.TransientStore(k.tKey)
+	countKey := types.KeyPrefix(types.QueuedHookCountKey)
+	var count uint64
+	if bz := tStore.Get(countKey); bz != nil {
+		count = binary.BigEndian.Uint64(bz)
+	}
+	tStore.Set(countKey, binary.BigEndian.AppendUint64(nil, count+1))
+
+	store := prefix.NewStore(tStore, types.KeyPrefix(types.QueuedHookKeyPrefix))
+	store.Set(binary.BigEndian.AppendUint64(nil, count), msgBz)
+}
+
+// NotifyHooks makes the hook calls queued in the current block in the order they were queued and then calls the
+// AfterTrancheFilled hooks for every tranche filled in the current block.
+func (k Keeper) NotifyHooks(ctx sdk.Context) {
+	tStore := ctx.TransientStore(k.tKey)
+	store := prefix.NewStore(tStore, types.KeyPrefix(types.QueuedHookKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var queuedKeys [][]byte
+	var queuedMsgs []types.DexHookSudoMsg
+	for ; iterator.Valid(); iterator.Next() {
+		var msg types.DexHookSudoMsg
+		if err := json.Unmarshal(iterator.Value(), &msg); err != nil {
+			k.Logger(ctx).Error("failed to unmarshal dex hook", "error", err)
+		} else {
+			queuedMsgs = append(queuedMsgs, msg)
+		}
+		queuedKeys = append(queuedKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range queuedKeys {
+		store.Delete(key)
+	}
+	tStore.Delete(types.KeyPrefix(types.QueuedHookCountKey))
+
+	for _, msg := range queuedMsgs {
+		k.callQueuedHook(ctx, msg)
+	}
+
+	k.notifyFilledTranches(ctx)
+}
+
+func (k Keeper) callQueuedHook(ctx sdk.Context, msg types.DexHookSudoMsg) {
+	switch {
+	case msg.AfterSwap != nil:
+		k.hooks.AfterSwap(
+			ctx,
+			sdk.MustAccAddressFromBech32(msg.AfterSwap.Creator),
+			sdk.MustAccAddressFromBech32(msg.AfterSwap.Receiver),
+			msg.AfterSwap.CoinIn,
+			msg.AfterSwap.CoinOut,
+		)
+	case msg.AfterDeposit != nil:
+		deposit := msg.AfterDeposit
+		creator, receiver, pairID := parseLiquidityHookMsg(deposit)
+		k.hooks.AfterDeposit(ctx, creator, receiver, pairID, deposit.Amount0, deposit.Amount1, deposit.Shares)
+	case msg.AfterWithdraw != nil:
+		withdrawal := msg.AfterWithdraw
+		creator, receiver, pairID := parseLiquidityHookMsg(withdrawal)
+		k.hooks.AfterWithdraw(ctx, creator, receiver, pairID, withdrawal.Amount0, withdrawal.Amount1, withdrawal.Shares)
+	}
+}
+
+// notifyFilledTranches calls the AfterTrancheFilled hooks for every tranche filled in the current block.
+func (k Keeper) notifyFilledTranches(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.FilledTrancheKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var filledKeys [][]byte
+	var filledTranches []*types.LimitOrderTranche
+	for ; iterator.Valid(); iterator.Next() {
+		var tranche types.LimitOrderTranche
+		k.cdc.MustUnmarshal(iterator.Value(), &tranche)
+		filledKeys = append(filledKeys, iterator.Key())
+		filledTranches = append(filledTranches, &tranche)
+	}
+	iterator.Close()
+
+	for _, key := range filledKeys {
+		store.Delete(key)
+	}
+
+	for _, tranche := range filledTranches {
+		k.hooks.AfterTrancheFilled(ctx, tranche)
+	}
+}
+
+// wasmHooks forwards every dex hook to the contract set in Params.HookContract. It holds a copy of the Keeper taken
+// before the hooks are set, so it must only use the keeper's stores and wasm keeper.
+type wasmHooks struct {
+	k Keeper
+}
+
+var _ types.DexHooks = wasmHooks{}
+
+func (h wasmHooks) AfterSwap(ctx sdk.Context, creator, receiver sdk.AccAddress, coinIn, coinOut sdk.Coin) {
+	h.sudo(ctx, types.DexHookSudoMsg{
+		AfterSwap: &types.AfterSwapHookMsg{
+			Creator:  creator.String(),
+			Receiver: receiver.String(),
+			CoinIn:   coinIn,
+			CoinOut:  coinOut,
+		},
+	})
+}
+
+func (h wasmHooks) AfterDeposit(
+	ctx sdk.Context,
+	creator, receiver sdk.AccAddress,
+	pairID *types.PairID,
+	amount0, amount1 math.Int,
+	sharesIssued sdk.Coins,
+) {
+	h.sudo(ctx, types.DexHookSudoMsg{
+		AfterDeposit: liquidityHookMsg(creator, receiver, pairID, amount0, amount1, sharesIssued),
+	})
+}
+
+func (h wasmHooks) AfterWithdraw(
+	ctx sdk.Context,
+	creator, receiver sdk.AccAddress,
+	pairID *types.PairID,
+	amount0, amount1 math.Int,
+	sharesBurned sdk.Coins,
+) {
+	h.sudo(ctx, types.DexHookSudoMsg{
+		AfterWithdraw: liquidityHookMsg(creator, receiver, pairID, amount0, amount1, sharesBurned),
+	})
+}
+
+func (h wasmHooks) AfterTrancheFilled(ctx sdk.Context, tranche *types.LimitOrderTranche) {
+	h.sudo(ctx, types.DexHookSudoMsg{
+		AfterTrancheFilled: trancheHookMsg(tranche),
+	})
+}
+
+func (h wasmHooks) AfterTrancheExpired(ctx sdk.Context, tranche *types.LimitOrderTranche) {
+	h.sudo(ctx, types.DexHookSudoMsg{
+		AfterTrancheExpired: trancheHookMsg(tranche),
+	})
+}
+
+func liquidityHookMsg(
+	creator, receiver sdk.AccAddress,
+	pairID *types.PairID,
+	amount0, amount1 math.Int,
+	shares sdk.Coins,
+) *types.AfterLiquidityHookMsg {
+	return &types.AfterLiquidityHookMsg{
+		Creator:  creator.String(),
+		Receiver: receiver.String(),
+		Token0:   pairID.Token0,
+		Token1:   pairID.Token1,
+		Amount0:  amount0,
+		Amount1:  amount1,
+		Shares:   shares,
+	}
+}
+
+func parseLiquidityHookMsg(msg *types.AfterLiquidityHookMsg) (creator, receiver sdk.AccAddress, pairID *types.PairID) {
+	// Addresses were valid when the hook was queued
+	creator = sdk.MustAccAddressFromBech32(msg.Creator)
+	receiver = sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	return creator, receiver, &types.PairID{Token0: msg.Token0, Token1: msg.Token1}
+}
+
+func trancheHookMsg(tranche *types.LimitOrderTranche) *types.AfterTrancheHookMsg {
+	return &types.AfterTrancheHookMsg{
+		TradePairID: tranche.Key.TradePairId,
+		TickIndex:   tranche.Key.TickIndexTakerToMaker,
+		TrancheKey:  tranche.Key.TrancheKey,
+	}
+}
+
+// sudo calls the hook contract. The wasm keeper is expected to be wrapped in contractmanager's SudoLimitWrapper
+// which limits the gas available to the contract and records failures, so errors are only logged here.
+func (h wasmHooks) sudo(ctx sdk.Context, msg types.DexHookSudoMsg) {
+	if h.k.wasmKeeper == nil {
+		return
+	}
+
+	hookContract := h.k.GetParams(ctx).HookContract
+	if hookContract == "" {
+		return
+	}
+
+	// This will never panic because the address is validated when params are set
+	contractAddr := sdk.MustAccAddressFromBech32(hookContract)
+
+	msgBz, err := json.Marshal(msg)
+	if err != nil {
+		h.k.Logger(ctx).Error("failed to marshal dex hook sudo msg", "error", err)
+		return
+	}
+
+	failures := h.k.getHookFailures(ctx, hookContract)
+	if failures >= types.MaxHookContractFailures {
+		return
+	}
+
+	if _, err := h.k.wasmKeeper.Sudo(ctx, contractAddr, msgBz); err != nil {
+		failures++
+		h.k.setHookFailures(ctx, hookContract, failures)
+		h.k.Logger(ctx).Error(
+			"dex hook contract returned an error",
+			"contract", hookContract,
+			"consecutive_failures", failures,
+			"error", err,
+		)
+		if failures == types.MaxHookContractFailures {
+			h.k.Logger(ctx).Error(
+				"dex hook contract disabled after repeated failures; set Params.HookContract to re-enable it",
+				"contract", hookContract,
+			)
+		}
+
+		return
+	}
+
+	if failures > 0 {
+		h.k.ResetHookFailures(ctx, hookContract)
+	}
+}
+
+func (k Keeper) getHookFailures(ctx sdk.Context, hookContract string) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.HookFailureKey(hookContract))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setHookFailures(ctx sdk.Context, hookContract string, failures uint64) {
+	ctx.KVStore(k.storeKey).Set(types.HookFailureKey(hookContract), sdk.Uint64ToBigEndian(failures))
+}
+
+// ResetHookFailures clears the consecutive failure count of a hook contract, re-enabling it if it was disabled
+// after MaxHookContractFailures failed calls.
+func (k Keeper) ResetHookFailures(ctx sdk.Context, hookContract string) {
+	ctx.KVStore(k.storeKey).Delete(types.HookFailureKey(hookContract))
+}
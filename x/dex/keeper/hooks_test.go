@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	adminmoduletypes "github.com/cosmos/admin-module/v2/x/adminmodule/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil/common/sample"
+	testkeeper "github.com/neutron-org/neutron/v5/testutil/dex/keeper"
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+type mockSudoKeeper struct {
+	calls []sdk.AccAddress
+	msgs  [][]byte
+	err   error
+}
+
+func (*mockSudoKeeper) HasContractInfo(context.Context, sdk.AccAddress) bool {
+	return true
+}
+
+func (m *mockSudoKeeper) Sudo(_ context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.calls = append(m.calls, contractAddress)
+	m.msgs = append(m.msgs, msg)
+	return nil, m.err
+}
+
+type countingHooks struct {
+	swaps    int
+	deposits int
+	fills    int
+	expiries int
+}
+
+func (h *countingHooks) AfterSwap(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coin, sdk.Coin) {
+	h.swaps++
+}
+
+func (h *countingHooks) AfterDeposit(sdk.Context, sdk.AccAddress, sdk.AccAddress, *types.PairID, math.Int, math.Int, sdk.Coins) {
+	h.deposits++
+}
+
+func (h *countingHooks) AfterWithdraw(sdk.Context, sdk.AccAddress, sdk.AccAddress, *types.PairID, math.Int, math.Int, sdk.Coins) {
+}
+
+func (h *countingHooks) AfterTrancheFilled(sdk.Context, *types.LimitOrderTranche) {
+	h.fills++
+}
+
+func (h *countingHooks) AfterTrancheExpired(sdk.Context, *types.LimitOrderTranche) {
+	h.expiries++
+}
+
+func TestHooksWithoutHookContract(t *testing.T) {
+	wasmKeeper := &mockSudoKeeper{}
+	hooks := &countingHooks{}
+	k, ctx := testkeeper.DexKeeperWithHooks(t, wasmKeeper, hooks)
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.Hooks().AfterSwap(ctx, addr, addr, sdk.NewInt64Coin("TokenA", 10), sdk.NewInt64Coin("TokenB", 10))
+
+	// Module hooks are always called but the contract is not called until it is set
+	require.Equal(t, 1, hooks.swaps)
+	require.Empty(t, wasmKeeper.calls)
+}
+
+func TestHooksCallHookContract(t *testing.T) {
+	wasmKeeper := &mockSudoKeeper{}
+	hooks := &countingHooks{}
+	k, ctx := testkeeper.DexKeeperWithHooks(t, wasmKeeper, hooks)
+
+	hookContract := sample.AccAddress()
+	params := types.DefaultParams()
+	params.HookContract = hookContract
+	require.NoError(t, k.SetParams(ctx, params))
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	k.Hooks().AfterSwap(ctx, addr, addr, sdk.NewInt64Coin("TokenA", 10), sdk.NewInt64Coin("TokenB", 9))
+	tranche := &types.LimitOrderTranche{
+		Key: &types.LimitOrderTrancheKey{
+			TradePairId:           types.MustNewTradePairID("TokenA", "TokenB"),
+			TickIndexTakerToMaker: 5,
+			TrancheKey:            "tranche",
+		},
+	}
+	k.Hooks().AfterTrancheExpired(ctx, tranche)
+
+	require.Equal(t, 1, hooks.swaps)
+	require.Equal(t, 1, hooks.expiries)
+	require.Len(t, wasmKeeper.calls, 2)
+	require.Equal(t, hookContract, wasmKeeper.calls[0].String())
+
+	var swapMsg types.DexHookSudoMsg
+	require.NoError(t, json.Unmarshal(wasmKeeper.msgs[0], &swapMsg))
+	require.NotNil(t, swapMsg.AfterSwap)
+	require.Equal(t, addr.String(), swapMsg.AfterSwap.Creator)
+	require.Equal(t, sdk.NewInt64Coin("TokenB", 9), swapMsg.AfterSwap.CoinOut)
+
+	var expiredMsg types.DexHookSudoMsg
+	require.NoError(t, json.Unmarshal(wasmKeeper.msgs[1], &expiredMsg))
+	require.Nil(t, expiredMsg.AfterSwap)
+	require.NotNil(t, expiredMsg.AfterTrancheExpired)
+	require.Equal(t, "tranche", expiredMsg.AfterTrancheExpired.TrancheKey)
+	require.Equal(t, int64(5), expiredMsg.AfterTrancheExpired.TickIndex)
+}
+
+func TestHooksDisableFailingHookContract(t *testing.T) {
+	wasmKeeper := &mockSudoKeeper{err: errors.New("hook failed")}
+	k, ctx := testkeeper.DexKeeperWithHooks(t, wasmKeeper, nil)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	params := types.DefaultParams()
+	params.HookContract = sample.AccAddress()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	swap := func() {
+		k.Hooks().AfterSwap(ctx, addr, addr, sdk.NewInt64Coin("TokenA", 10), sdk.NewInt64Coin("TokenB", 9))
+	}
+
+	// The contract is called until it has failed MaxHookContractFailures times in a row
+	for i := 0; i < types.MaxHookContractFailures+5; i++ {
+		swap()
+	}
+	require.Len(t, wasmKeeper.calls, types.MaxHookContractFailures)
+
+	// Setting the hook contract again re-enables it
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	swap()
+	require.Len(t, wasmKeeper.calls, types.MaxHookContractFailures+1)
+
+	// A successful call resets the count
+	wasmKeeper.err = nil
+	swap()
+	wasmKeeper.err = errors.New("hook failed")
+	for i := 0; i < types.MaxHookContractFailures-1; i++ {
+		swap()
+	}
+	swap()
+	require.Len(t, wasmKeeper.calls, 2*types.MaxHookContractFailures+2)
+}
+
+func TestHooksTrancheFilledAfterCommit(t *testing.T) {
+	wasmKeeper := &mockSudoKeeper{}
+	hooks := &countingHooks{}
+	k, ctx := testkeeper.DexKeeperWithHooks(t, wasmKeeper, hooks)
+
+	tranche := &types.LimitOrderTranche{
+		Key: &types.LimitOrderTrancheKey{
+			TradePairId:           types.MustNewTradePairID("TokenA", "TokenB"),
+			TickIndexTakerToMaker: 5,
+			TrancheKey:            "tranche",
+		},
+		ReservesMakerDenom: math.ZeroInt(),
+		ReservesTakerDenom: math.NewInt(10),
+		TotalMakerDenom:    math.NewInt(10),
+		TotalTakerDenom:    math.NewInt(10),
+	}
+	swapMetadata := types.SwapMetadata{AmountIn: math.NewInt(10), AmountOut: math.NewInt(10), TokenIn: "TokenA"}
+
+	// A fill inside a discarded cache context (eg. a simulation) is never notified
+	cacheCtx, _ := ctx.CacheContext()
+	k.UpdateTranche(cacheCtx, tranche, swapMetadata)
+	k.NotifyHooks(ctx)
+	require.Equal(t, 0, hooks.fills)
+
+	// A committed fill is only notified at the end of the block
+	k.UpdateTranche(ctx, tranche, swapMetadata)
+	require.Equal(t, 0, hooks.fills)
+
+	k.NotifyHooks(ctx)
+	require.Equal(t, 1, hooks.fills)
+
+	k.NotifyHooks(ctx)
+	require.Equal(t, 1, hooks.fills)
+}
+
+func (s *DexTestSuite) TestHooksNotCalledFromQueries() {
+	hooks := &countingHooks{}
+	k := dexkeeper.NewKeeper(
+		s.App.AppCodec(),
+		s.App.GetKey(types.StoreKey),
+		s.App.GetMemKey(types.MemStoreKey),
+		s.App.GetTKey(types.TStoreKey),
+		s.App.BankKeeper,
+		nil,
+		nil,
+		nil,
+		hooks,
+		authtypes.NewModuleAddress(adminmoduletypes.ModuleName).String(),
+	)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(50, 0)
+
+	// GIVEN alice deposits through the hooked keeper
+	_, err := msgServer.Deposit(s.Ctx, &types.MsgDeposit{
+		Creator:         s.alice.String(),
+		Receiver:        s.alice.String(),
+		TokenA:          "TokenA",
+		TokenB:          "TokenB",
+		AmountsA:        []math.Int{math.ZeroInt()},
+		AmountsB:        []math.Int{math.NewInt(10_000_000)},
+		TickIndexesAToB: []int64{0},
+		Fees:            []uint64{1},
+		Options:         []*types.DepositOptions{{}},
+	})
+	s.NoError(err)
+
+	// THEN AfterDeposit is only called at the end of the block
+	s.Equal(0, hooks.deposits)
+	k.NotifyHooks(s.Ctx)
+	s.Equal(1, hooks.deposits)
+
+	// WHEN bob estimates a swap
+	_, err = k.EstimatePlaceLimitOrder(s.Ctx, &types.QueryEstimatePlaceLimitOrderRequest{
+		Creator:          s.bob.String(),
+		Receiver:         s.bob.String(),
+		TokenIn:          "TokenA",
+		TokenOut:         "TokenB",
+		TickIndexInToOut: 10,
+		AmountIn:         math.NewInt(5_000_000),
+		OrderType:        types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+	})
+	s.NoError(err)
+
+	// THEN AfterSwap is never called
+	k.NotifyHooks(s.Ctx)
+	s.Equal(0, hooks.swaps)
+
+	// WHEN bob places the same swap
+	_, err = msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.bob.String(),
+		Receiver:         s.bob.String(),
+		TokenIn:          "TokenA",
+		TokenOut:         "TokenB",
+		TickIndexInToOut: 10,
+		AmountIn:         math.NewInt(5_000_000),
+		OrderType:        types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+	})
+	s.NoError(err)
+
+	// THEN AfterSwap is called once at the end of the block
+	s.Equal(0, hooks.swaps)
+	k.NotifyHooks(s.Ctx)
+	s.Equal(1, hooks.swaps)
+}
@@ -0,0 +1,365 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// CreateGaugeCore charges the GaugeCreationFee, escrows coins from the caller in the incentives module account and
+// creates a Gauge that distributes them to in-range stakers over numEpochs.
+func (k Keeper) CreateGaugeCore(
+	goCtx context.Context,
+	callerAddr sdk.AccAddress,
+	pairID *types.PairID,
+	startTick, endTick int64,
+	coins sdk.Coins,
+	numEpochs uint64,
+) (gaugeID uint64, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The creation fee makes every Gauge, which is iterated each epoch, pay for its share of the distribution cost
+	if creationFee := k.GetParams(ctx).GaugeCreationFee; !creationFee.IsZero() {
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ProtocolFeeCollectorName, creationFee)
+		if err != nil {
+			return 0, sdkerrors.Wrap(err, "failed to pay the gauge creation fee")
+		}
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.IncentivesModuleName, coins)
+	if err != nil {
+		return 0, err
+	}
+
+	gauge := &types.Gauge{
+		Id:               k.initGaugeID(ctx),
+		Owner:            callerAddr.String(),
+		PairId:           pairID,
+		StartTick:        startTick,
+		EndTick:          endTick,
+		NumEpochs:        numEpochs,
+		Coins:            coins,
+		DistributedCoins: sdk.NewCoins(),
+	}
+	k.SetGauge(ctx, gauge)
+
+	ctx.EventManager().EmitEvent(types.CreateGaugeEvent(gauge))
+
+	return gauge.Id, nil
+}
+
+// StakeCore escrows pool shares from the caller in the incentives module account so that they earn Gauge rewards
+func (k Keeper) StakeCore(
+	goCtx context.Context,
+	callerAddr sdk.AccAddress,
+	shares sdk.Coins,
+) (stakeID uint64, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minStakeShares := math.NewIntFromUint64(k.GetParams(ctx).MinStakeShares)
+	for _, share := range shares {
+		if _, err := k.GetPoolMetadataByDenom(ctx, share.Denom); err != nil {
+			return 0, sdkerrors.Wrapf(types.ErrInvalidStake, "%s: %s", share.Denom, err)
+		}
+
+		// Every Stake is paged through by the Gauges of its pairs each epoch, so dust Stakes are not allowed
+		if share.Amount.LT(minStakeShares) {
+			return 0, sdkerrors.Wrapf(types.ErrStakeTooSmall, "%s is less than %s", share, minStakeShares)
+		}
+	}
+
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.IncentivesModuleName, shares)
+	if err != nil {
+		return 0, err
+	}
+
+	stake := &types.Stake{
+		Id:     k.initStakeID(ctx),
+		Owner:  callerAddr.String(),
+		Shares: shares,
+	}
+	k.SetStake(ctx, stake)
+
+	ctx.EventManager().EmitEvent(types.StakeEvent(stake))
+
+	return stake.Id, nil
+}
+
+// UnstakeCore returns the pool shares of a Stake to its owner. Rewards accrued by the Stake remain claimable.
+func (k Keeper) UnstakeCore(
+	goCtx context.Context,
+	callerAddr sdk.AccAddress,
+	stakeID uint64,
+) (shares sdk.Coins, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stake, found := k.GetStake(ctx, callerAddr.String(), stakeID)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrStakeNotFound, "%s does not own stake %d", callerAddr, stakeID)
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.IncentivesModuleName, callerAddr, stake.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	k.RemoveStake(ctx, stake.Owner, stake.Id)
+
+	ctx.EventManager().EmitEvent(types.UnstakeEvent(stake))
+
+	return stake.Shares, nil
+}
+
+// ClaimCore sends all Gauge rewards accrued to the caller
+func (k Keeper) ClaimCore(goCtx context.Context, callerAddr sdk.AccAddress) (rewards sdk.Coins, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	stakerRewards, found := k.GetStakerRewards(ctx, callerAddr.String())
+	if !found || stakerRewards.Rewards.IsZero() {
+		return nil, sdkerrors.Wrapf(types.ErrNoRewardsToClaim, "%s", callerAddr)
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.IncentivesModuleName, callerAddr, stakerRewards.Rewards)
+	if err != nil {
+		return nil, err
+	}
+
+	k.RemoveStakerRewards(ctx, stakerRewards.Address)
+
+	ctx.EventManager().EmitEvent(types.ClaimEvent(callerAddr, stakerRewards.Rewards))
+
+	return stakerRewards.Rewards, nil
+}
+
+// gaugeDistributionPageSize is the number of Stakes read from the pair index at a time while distributing a Gauge
+const gaugeDistributionPageSize = 100
+
+// DistributeGaugeRewards distributes one epoch of rewards from every Gauge once every GaugeEpochBlocks blocks. Each
+// Gauge's epoch rewards are split pro-rata between Stakes by the amount of staked shares of pools within its tick
+// range. Gauges that have completed all of their epochs refund any undistributed coins to their owner. Execution
+// stops once GaugeDistributionAllowance gas has been consumed, even in the middle of the Stakes of a Gauge; the
+// distribution resumes from the same Stake in the following block.
+func (k Keeper) DistributeGaugeRewards(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.Paused || params.GaugeEpochBlocks == 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	cursorKey := types.KeyPrefix(types.GaugeDistributionCursorKey)
+	var distribution *types.GaugeDistribution
+	if cursor := store.Get(cursorKey); cursor != nil {
+		// The distribution of the current epoch has not finished yet
+		distribution = &types.GaugeDistribution{}
+		k.cdc.MustUnmarshal(cursor, distribution)
+	} else if uint64(ctx.BlockHeight())%params.GaugeEpochBlocks != 0 {
+		return
+	}
+
+	gasCutoff := ctx.GasMeter().GasConsumed() + params.GaugeDistributionAllowance
+	poolMetadataCache := make(map[string]types.PoolMetadata)
+	var nextGaugeID uint64
+	for {
+		if distribution == nil {
+			gauge, found := k.nextGauge(ctx, nextGaugeID)
+			if !found {
+				store.Delete(cursorKey)
+				return
+			}
+			distribution = &types.GaugeDistribution{
+				GaugeId:          gauge.Id,
+				StakeCount:       k.GetStakeCount(ctx),
+				TotalWeight:      math.ZeroInt(),
+				EpochCoins:       gauge.EpochCoins(),
+				DistributedCoins: sdk.NewCoins(),
+			}
+
+			if gasConsumed := ctx.GasMeter().GasConsumed(); gasConsumed >= gasCutoff {
+				store.Set(cursorKey, k.cdc.MustMarshal(distribution))
+				ctx.EventManager().EmitEvent(types.GaugeDistributionHitLimitEvent(gasConsumed))
+				return
+			}
+		}
+
+		if gauge, found := k.GetGauge(ctx, distribution.GaugeId); found {
+			if !k.distributeGaugeEpoch(ctx, gauge, distribution, gasCutoff, poolMetadataCache) {
+				store.Set(cursorKey, k.cdc.MustMarshal(distribution))
+				ctx.EventManager().EmitEvent(types.GaugeDistributionHitLimitEvent(ctx.GasMeter().GasConsumed()))
+				return
+			}
+		}
+
+		nextGaugeID = distribution.GaugeId + 1
+		distribution = nil
+	}
+}
+
+// nextGauge returns the Gauge with the lowest id that is at least id
+func (k Keeper) nextGauge(ctx sdk.Context, id uint64) (gauge *types.Gauge, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GaugeKey(id), storetypes.PrefixEndBytes(types.KeyPrefix(types.GaugeKeyPrefix)))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil, false
+	}
+
+	gauge = &types.Gauge{}
+	k.cdc.MustUnmarshal(iterator.Value(), gauge)
+
+	return gauge, true
+}
+
+// distributeGaugeEpoch pages through the Stakes of the Gauge's pair, first summing their weights and then splitting
+// the epoch coins between them. It returns false if gasCutoff is reached before the epoch has been distributed, in
+// which case distribution records where to resume. At least one Stake is processed per call so that the
+// distribution always makes progress. Rewards are credited as they are computed and the Gauge's
+// DistributedCoins are kept up to date, so the Gauge's accounting stays correct even if the distribution is never
+// resumed.
+func (k Keeper) distributeGaugeEpoch(
+	ctx sdk.Context,
+	gauge *types.Gauge,
+	distribution *types.GaugeDistribution,
+	gasCutoff uint64,
+	poolMetadataCache map[string]types.PoolMetadata,
+) (done bool) {
+	// The refund of a finished Gauge failed in an earlier epoch
+	if gauge.IsFinished() {
+		k.finishGauge(ctx, gauge)
+		return true
+	}
+
+	processed := 0
+	for {
+		page := k.getStakeIndexPage(
+			ctx,
+			gauge.PairId,
+			distribution.NextStakeId,
+			distribution.StakeCount,
+			gaugeDistributionPageSize,
+		)
+		for _, entry := range page {
+			if processed > 0 && ctx.GasMeter().GasConsumed() >= gasCutoff {
+				if distribution.Distributing {
+					k.SetGauge(ctx, gauge)
+				}
+				return false
+			}
+
+			// The index is removed together with the Stake so this will always be found
+			stake, _ := k.GetStake(ctx, entry.owner, entry.id)
+			weight := k.stakeWeight(ctx, gauge, stake, poolMetadataCache)
+			if !distribution.Distributing {
+				distribution.TotalWeight = distribution.TotalWeight.Add(weight)
+			} else if weight.IsPositive() {
+				rewards := k.rewardStake(ctx, stake, weight, distribution)
+				gauge.DistributedCoins = gauge.DistributedCoins.Add(rewards...)
+				distribution.DistributedCoins = distribution.DistributedCoins.Add(rewards...)
+			}
+			distribution.NextStakeId = entry.id + 1
+			processed++
+		}
+
+		if len(page) == gaugeDistributionPageSize {
+			continue
+		}
+
+		// The pass is complete; Epochs without any in-range stake still elapse and their coins are carried forward
+		if !distribution.Distributing && distribution.TotalWeight.IsPositive() {
+			distribution.Distributing = true
+			distribution.NextStakeId = 0
+			continue
+		}
+
+		break
+	}
+
+	gauge.FilledEpochs++
+
+	ctx.EventManager().EmitEvent(types.GaugeDistributionEvent(gauge, distribution.DistributedCoins))
+
+	if !gauge.IsFinished() {
+		k.SetGauge(ctx, gauge)
+		return true
+	}
+
+	k.finishGauge(ctx, gauge)
+
+	return true
+}
+
+// stakeWeight returns the amount of shares in a Stake of pools rewarded by the Gauge
+func (k Keeper) stakeWeight(
+	ctx sdk.Context,
+	gauge *types.Gauge,
+	stake *types.Stake,
+	poolMetadataCache map[string]types.PoolMetadata,
+) math.Int {
+	weight := math.ZeroInt()
+	for _, share := range stake.Shares {
+		poolMetadata, ok := poolMetadataCache[share.Denom]
+		if !ok {
+			// Shares are only staked for existing pools so this will never fail
+			var err error
+			poolMetadata, err = k.GetPoolMetadataByDenom(ctx, share.Denom)
+			if err != nil {
+				panic(err)
+			}
+			poolMetadataCache[share.Denom] = poolMetadata
+		}
+
+		if gauge.RewardsPool(poolMetadata) {
+			weight = weight.Add(share.Amount)
+		}
+	}
+
+	return weight
+}
+
+// rewardStake credits the owner of a Stake with its pro-rata share of the epoch coins
+func (k Keeper) rewardStake(
+	ctx sdk.Context,
+	stake *types.Stake,
+	weight math.Int,
+	distribution *types.GaugeDistribution,
+) sdk.Coins {
+	rewards := sdk.NewCoins()
+	for _, coin := range distribution.EpochCoins {
+		rewards = rewards.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(weight).Quo(distribution.TotalWeight)))
+	}
+	if rewards.IsZero() {
+		return rewards
+	}
+
+	stakerRewards, found := k.GetStakerRewards(ctx, stake.Owner)
+	if !found {
+		stakerRewards = types.StakerRewards{Address: stake.Owner}
+	}
+	stakerRewards.Rewards = stakerRewards.Rewards.Add(rewards...)
+	k.SetStakerRewards(ctx, stakerRewards)
+
+	return rewards
+}
+
+// finishGauge refunds the undistributed coins of a finished Gauge to its owner and removes the Gauge. If the refund
+// fails the Gauge is kept so that the refund is retried in the next epoch.
+func (k Keeper) finishGauge(ctx sdk.Context, gauge *types.Gauge) {
+	if refund := gauge.RemainingCoins(); !refund.IsZero() {
+		ownerAddr := sdk.MustAccAddressFromBech32(gauge.Owner)
+		cacheCtx, writeCache := ctx.CacheContext()
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.IncentivesModuleName, ownerAddr, refund)
+		if err != nil {
+			k.Logger(ctx).Error("failed to refund gauge", "gauge_id", gauge.Id, "coins", refund, "error", err)
+			k.SetGauge(ctx, gauge)
+			return
+		}
+		writeCache()
+	}
+
+	k.RemoveGauge(ctx, gauge.Id)
+}
@@ -0,0 +1,413 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+const gaugeEpochBlocks = 10
+
+func (s *DexTestSuite) poolShares(account sdk.AccAddress, tick int64, fee uint64) sdk.Coins {
+	poolID, found := s.App.DexKeeper.GetPoolIDByParams(s.Ctx, defaultPairID, tick, fee)
+	s.True(found, "pool not found")
+	denom := types.NewPoolDenom(poolID)
+
+	return sdk.NewCoins(s.App.BankKeeper.GetBalance(s.Ctx, account, denom))
+}
+
+func (s *DexTestSuite) stakes(account sdk.AccAddress, shares sdk.Coins) uint64 {
+	resp, err := s.msgServer.Stake(s.Ctx, &types.MsgStake{
+		Creator: account.String(),
+		Shares:  shares,
+	})
+	s.NoError(err)
+
+	return resp.StakeId
+}
+
+func (s *DexTestSuite) carolCreatesGauge(startTick, endTick, amount int64, numEpochs uint64) uint64 {
+	coins := sdk.NewCoins(sdk.NewCoin("TokenC", sdkmath.NewInt(amount).Mul(denomMultiple)))
+	s.fundAccountBalancesWithDenom(s.carol, coins)
+
+	resp, err := s.msgServer.CreateGauge(s.Ctx, &types.MsgCreateGauge{
+		Creator:   s.carol.String(),
+		PairId:    defaultPairID,
+		StartTick: startTick,
+		EndTick:   endTick,
+		Coins:     coins,
+		NumEpochs: numEpochs,
+	})
+	s.NoError(err)
+
+	return resp.GaugeId
+}
+
+func (s *DexTestSuite) nextGaugeEpoch() {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	if params.GaugeEpochBlocks != gaugeEpochBlocks {
+		params.GaugeEpochBlocks = gaugeEpochBlocks
+		s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+	}
+
+	height := (s.Ctx.BlockHeight()/gaugeEpochBlocks + 1) * gaugeEpochBlocks
+	s.Ctx = s.Ctx.WithBlockHeight(height).WithEventManager(sdk.NewEventManager())
+	s.App.DexKeeper.DistributeGaugeRewards(s.Ctx)
+}
+
+func (s *DexTestSuite) assertPendingRewards(account sdk.AccAddress, expected int64) {
+	resp, err := s.App.DexKeeper.PendingRewards(s.Ctx, &types.QueryPendingRewardsRequest{Address: account.String()})
+	s.NoError(err)
+	s.Equal(sdkmath.NewInt(expected).Mul(denomMultiple), resp.Rewards.AmountOf("TokenC"))
+}
+
+func (s *DexTestSuite) TestGaugeDistributesProRataToStakers() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(30, 0)
+
+	// GIVEN alice and bob stake their shares of the same pool
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	s.bobDeposits(NewDeposit(30, 0, 0, 1))
+	s.stakes(s.alice, s.poolShares(s.alice, 0, 1))
+	s.stakes(s.bob, s.poolShares(s.bob, 0, 1))
+
+	// AND a gauge of 400 TokenC over 2 epochs
+	gaugeID := s.carolCreatesGauge(-10, 10, 400, 2)
+	s.assertAccountBalanceWithDenom(s.carol, "TokenC", 0)
+
+	// WHEN an epoch passes
+	s.nextGaugeEpoch()
+
+	// THEN half of the gauge is split by stake
+	s.assertPendingRewards(s.alice, 50)
+	s.assertPendingRewards(s.bob, 150)
+	gauge, found := s.App.DexKeeper.GetGauge(s.Ctx, gaugeID)
+	s.True(found)
+	s.Equal(uint64(1), gauge.FilledEpochs)
+	s.AssertNEventValuesEmitted(types.GaugeDistributionEventKey, 1)
+
+	// WHEN the final epoch passes
+	s.nextGaugeEpoch()
+
+	// THEN the rest of the gauge is distributed and the gauge is removed
+	s.assertPendingRewards(s.alice, 100)
+	s.assertPendingRewards(s.bob, 300)
+	_, found = s.App.DexKeeper.GetGauge(s.Ctx, gaugeID)
+	s.False(found)
+	s.assertAccountBalanceWithDenom(s.carol, "TokenC", 0)
+
+	// WHEN alice claims
+	resp, err := s.msgServer.Claim(s.Ctx, &types.MsgClaim{Creator: s.alice.String()})
+
+	// THEN she receives her rewards
+	s.NoError(err)
+	s.Equal(sdkmath.NewInt(100).Mul(denomMultiple), resp.Rewards.AmountOf("TokenC"))
+	s.assertAccountBalanceWithDenom(s.alice, "TokenC", 100)
+	s.assertPendingRewards(s.alice, 0)
+}
+
+func (s *DexTestSuite) TestGaugeIgnoresOutOfRangePools() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN alice stakes shares of a pool within the gauge range and bob stakes shares of a pool outside of it
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	s.bobDeposits(NewDeposit(10, 0, 20, 1))
+	s.stakes(s.alice, s.poolShares(s.alice, 0, 1))
+	s.stakes(s.bob, s.poolShares(s.bob, 20, 1))
+
+	s.carolCreatesGauge(-5, 5, 100, 1)
+
+	// WHEN an epoch passes
+	s.nextGaugeEpoch()
+
+	// THEN only alice is rewarded
+	s.assertPendingRewards(s.alice, 100)
+	s.assertPendingRewards(s.bob, 0)
+}
+
+func (s *DexTestSuite) TestGaugeWithoutStakersRefundsOwner() {
+	// GIVEN a gauge without any stakers
+	gaugeID := s.carolCreatesGauge(-5, 5, 100, 2)
+
+	// WHEN all of its epochs pass
+	s.nextGaugeEpoch()
+	s.nextGaugeEpoch()
+
+	// THEN the gauge is refunded to its owner
+	_, found := s.App.DexKeeper.GetGauge(s.Ctx, gaugeID)
+	s.False(found)
+	s.assertAccountBalanceWithDenom(s.carol, "TokenC", 100)
+}
+
+func (s *DexTestSuite) TestUnstakeReturnsShares() {
+	s.fundAliceBalances(10, 0)
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	shares := s.poolShares(s.alice, 0, 1)
+	stakeID := s.stakes(s.alice, shares)
+	s.carolCreatesGauge(-5, 5, 100, 2)
+	s.nextGaugeEpoch()
+
+	// WHEN bob tries to unstake alice's shares
+	_, err := s.msgServer.Unstake(s.Ctx, &types.MsgUnstake{Creator: s.bob.String(), StakeId: stakeID})
+
+	// THEN he fails
+	s.ErrorIs(err, types.ErrStakeNotFound)
+
+	// WHEN alice unstakes
+	resp, err := s.msgServer.Unstake(s.Ctx, &types.MsgUnstake{Creator: s.alice.String(), StakeId: stakeID})
+
+	// THEN her shares are returned and she keeps her accrued rewards
+	s.NoError(err)
+	s.Equal(shares, resp.Shares)
+	s.Equal(shares, s.poolShares(s.alice, 0, 1))
+	s.Empty(s.App.DexKeeper.GetAllStakeByOwner(s.Ctx, s.alice.String()))
+
+	s.nextGaugeEpoch()
+	s.assertPendingRewards(s.alice, 50)
+	s.assertAccountBalanceWithDenom(s.carol, "TokenC", 50)
+}
+
+func (s *DexTestSuite) TestStakeInvalidSharesFails() {
+	s.fundAliceBalances(10, 0)
+
+	// WHEN alice stakes a denom that is not a pool share
+	_, err := s.msgServer.Stake(s.Ctx, &types.MsgStake{
+		Creator: s.alice.String(),
+		Shares:  sdk.NewCoins(sdk.NewCoin("TokenA", sdkmath.NewInt(10))),
+	})
+	s.ErrorIs(err, types.ErrInvalidStake)
+
+	// WHEN alice stakes shares of a pool that does not exist
+	_, err = s.msgServer.Stake(s.Ctx, &types.MsgStake{
+		Creator: s.alice.String(),
+		Shares:  sdk.NewCoins(sdk.NewCoin(types.NewPoolDenom(99), sdkmath.NewInt(10))),
+	})
+	s.ErrorIs(err, types.ErrInvalidStake)
+}
+
+func (s *DexTestSuite) TestClaimWithoutRewardsFails() {
+	_, err := s.msgServer.Claim(s.Ctx, &types.MsgClaim{Creator: s.alice.String()})
+	s.ErrorIs(err, types.ErrNoRewardsToClaim)
+}
+
+func (s *DexTestSuite) TestCreateGaugeValidation() {
+	msg := &types.MsgCreateGauge{
+		Creator:   s.carol.String(),
+		PairId:    defaultPairID,
+		StartTick: 10,
+		EndTick:   -10,
+		Coins:     sdk.NewCoins(sdk.NewCoin("TokenC", sdkmath.NewInt(10))),
+		NumEpochs: 1,
+	}
+	_, err := s.msgServer.CreateGauge(s.Ctx, msg)
+	s.ErrorIs(err, types.ErrInvalidGauge)
+
+	msg.StartTick, msg.EndTick = -10, 10
+	msg.NumEpochs = 0
+	_, err = s.msgServer.CreateGauge(s.Ctx, msg)
+	s.ErrorIs(err, types.ErrInvalidGauge)
+
+	msg.NumEpochs = 1
+	msg.Coins = sdk.NewCoins()
+	_, err = s.msgServer.CreateGauge(s.Ctx, msg)
+	s.ErrorIs(err, types.ErrInvalidGauge)
+}
+
+func (s *DexTestSuite) TestGaugeCreationFee() {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.GaugeCreationFee = sdk.NewCoins(sdk.NewCoin("TokenC", sdkmath.NewInt(5).Mul(denomMultiple)))
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+	coins := sdk.NewCoins(sdk.NewCoin("TokenC", sdkmath.NewInt(100).Mul(denomMultiple)))
+	s.fundAccountBalancesWithDenom(s.carol, coins)
+
+	// WHEN carol creates a gauge with only enough coins to fund it
+	_, err := s.msgServer.CreateGauge(s.Ctx, &types.MsgCreateGauge{
+		Creator:   s.carol.String(),
+		PairId:    defaultPairID,
+		StartTick: -10,
+		EndTick:   10,
+		Coins:     coins.Sub(params.GaugeCreationFee...),
+		NumEpochs: 1,
+	})
+
+	// THEN the creation fee is sent to the protocol fee collector
+	s.NoError(err)
+	s.assertAccountBalanceWithDenom(s.carol, "TokenC", 0)
+	collectorAddr := s.App.AccountKeeper.GetModuleAddress(types.ProtocolFeeCollectorName)
+	s.True(s.App.BankKeeper.GetBalance(s.Ctx, collectorAddr, "TokenC").Amount.Equal(params.GaugeCreationFee.AmountOf("TokenC")))
+
+	// WHEN carol cannot pay the creation fee
+	s.fundAccountBalancesWithDenom(s.carol, coins)
+	_, err = s.msgServer.CreateGauge(s.Ctx, &types.MsgCreateGauge{
+		Creator:   s.carol.String(),
+		PairId:    defaultPairID,
+		StartTick: -10,
+		EndTick:   10,
+		Coins:     coins,
+		NumEpochs: 1,
+	})
+
+	// THEN creating the gauge fails
+	s.ErrorContains(err, "failed to pay the gauge creation fee")
+}
+
+func (s *DexTestSuite) TestGaugeDistributionHitsGasLimit() {
+	s.fundAliceBalances(10, 0)
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	s.stakes(s.alice, s.poolShares(s.alice, 0, 1))
+	gaugeID0 := s.carolCreatesGauge(-10, 10, 100, 2)
+	gaugeID1 := s.carolCreatesGauge(-10, 10, 100, 2)
+
+	// GIVEN the gauge distribution allowance is used up
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	allowance := params.GaugeDistributionAllowance
+	params.GaugeDistributionAllowance = 0
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// WHEN an epoch passes
+	s.nextGaugeEpoch()
+
+	// THEN no gauge distributes
+	s.AssertEventEmitted(s.Ctx, types.EventTypeGaugeDistributionHitGasLimit, 1)
+	s.assertPendingRewards(s.alice, 0)
+
+	// WHEN the allowance is restored and the next block begins
+	params.GaugeDistributionAllowance = allowance
+	params.GaugeEpochBlocks = gaugeEpochBlocks
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	s.App.DexKeeper.DistributeGaugeRewards(s.Ctx)
+
+	// THEN both gauges distribute the epoch
+	s.assertPendingRewards(s.alice, 100)
+	for _, gaugeID := range []uint64{gaugeID0, gaugeID1} {
+		gauge, found := s.App.DexKeeper.GetGauge(s.Ctx, gaugeID)
+		s.True(found)
+		s.Equal(uint64(1), gauge.FilledEpochs)
+	}
+
+	// WHEN the next block begins
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	s.App.DexKeeper.DistributeGaugeRewards(s.Ctx)
+
+	// THEN nothing is distributed until the next epoch
+	s.assertPendingRewards(s.alice, 100)
+}
+
+func (s *DexTestSuite) TestGaugeKeptWhenRefundFails() {
+	// GIVEN a gauge without stakers whose owner cannot receive the refund
+	gaugeID := s.carolCreatesGauge(-10, 10, 100, 1)
+	gauge, _ := s.App.DexKeeper.GetGauge(s.Ctx, gaugeID)
+	gauge.Owner = authtypes.NewModuleAddress(types.ModuleName).String()
+	s.App.DexKeeper.SetGauge(s.Ctx, gauge)
+
+	// WHEN the final epoch passes
+	s.nextGaugeEpoch()
+
+	// THEN the gauge is kept with its coins
+	gauge, found := s.App.DexKeeper.GetGauge(s.Ctx, gaugeID)
+	s.True(found)
+	s.True(gauge.IsFinished())
+
+	// WHEN the owner can receive the refund in a later epoch
+	gauge.Owner = s.carol.String()
+	s.App.DexKeeper.SetGauge(s.Ctx, gauge)
+	s.nextGaugeEpoch()
+
+	// THEN the gauge is refunded and removed
+	_, found = s.App.DexKeeper.GetGauge(s.Ctx, gaugeID)
+	s.False(found)
+	s.assertAccountBalanceWithDenom(s.carol, "TokenC", 100)
+}
+
+func (s *DexTestSuite) TestStakeBelowMinimumFails() {
+	s.fundAliceBalances(10, 0)
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	shares := s.poolShares(s.alice, 0, 1)
+	minStakeShares := sdkmath.NewIntFromUint64(s.App.DexKeeper.GetParams(s.Ctx).MinStakeShares)
+
+	// WHEN alice stakes less than the minimum amount of shares
+	_, err := s.msgServer.Stake(s.Ctx, &types.MsgStake{
+		Creator: s.alice.String(),
+		Shares:  sdk.NewCoins(sdk.NewCoin(shares[0].Denom, minStakeShares.SubRaw(1))),
+	})
+
+	// THEN staking fails
+	s.ErrorIs(err, types.ErrStakeTooSmall)
+
+	// WHEN alice stakes the minimum amount of shares
+	_, err = s.msgServer.Stake(s.Ctx, &types.MsgStake{
+		Creator: s.alice.String(),
+		Shares:  sdk.NewCoins(sdk.NewCoin(shares[0].Denom, minStakeShares)),
+	})
+
+	// THEN staking succeeds
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) TestGaugeDistributionPagesThroughStakes() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(30, 0)
+	s.fundCarolBalances(10, 0)
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	s.bobDeposits(NewDeposit(30, 0, 0, 1))
+	s.carolDeposits(NewDeposit(10, 0, 0, 1))
+	s.stakes(s.alice, s.poolShares(s.alice, 0, 1))
+	s.stakes(s.bob, s.poolShares(s.bob, 0, 1))
+	gaugeID := s.carolCreatesGauge(-10, 10, 400, 2)
+
+	// GIVEN an allowance that only covers a single stake per block
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	allowance := params.GaugeDistributionAllowance
+	params.GaugeDistributionAllowance = 1
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+	nextBlock := func() {
+		s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+		s.App.DexKeeper.DistributeGaugeRewards(s.Ctx)
+	}
+
+	// WHEN an epoch begins
+	s.nextGaugeEpoch()
+	s.AssertEventEmitted(s.Ctx, types.EventTypeGaugeDistributionHitGasLimit, 1)
+
+	// AND the stake weights are summed over the following blocks
+	nextBlock()
+	nextBlock()
+
+	// THEN nothing has been distributed yet
+	s.assertPendingRewards(s.alice, 0)
+	s.assertPendingRewards(s.bob, 0)
+
+	// WHEN carol stakes in the middle of the distribution
+	s.stakes(s.carol, s.poolShares(s.carol, 0, 1))
+
+	// AND the stakes are rewarded one block at a time
+	nextBlock()
+	s.assertPendingRewards(s.alice, 50)
+	s.assertPendingRewards(s.bob, 0)
+	nextBlock()
+
+	// THEN the epoch is distributed pro-rata to the stakes that existed when it started
+	s.assertPendingRewards(s.alice, 50)
+	s.assertPendingRewards(s.bob, 150)
+	s.assertPendingRewards(s.carol, 0)
+	s.AssertNEventValuesEmitted(types.GaugeDistributionEventKey, 1)
+	gauge, found := s.App.DexKeeper.GetGauge(s.Ctx, gaugeID)
+	s.True(found)
+	s.Equal(uint64(1), gauge.FilledEpochs)
+	s.Equal(sdkmath.NewInt(200).Mul(denomMultiple), gauge.DistributedCoins.AmountOf("TokenC"))
+
+	// WHEN the allowance is restored and the final epoch passes
+	params.GaugeDistributionAllowance = allowance
+	params.GaugeEpochBlocks = gaugeEpochBlocks
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+	s.nextGaugeEpoch()
+
+	// THEN carol takes part in the distribution
+	s.assertPendingRewards(s.alice, 90)
+	s.assertPendingRewards(s.bob, 270)
+	s.assertPendingRewards(s.carol, 40)
+}
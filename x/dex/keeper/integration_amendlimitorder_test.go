@@ -0,0 +1,223 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func amendAmount(amount int64) *sdkmath.Int {
+	amt := sdkmath.NewInt(amount).Mul(denomMultiple)
+	return &amt
+}
+
+func amendPrice(price string) *math_utils.PrecDec {
+	p := math_utils.MustNewPrecDecFromStr(price)
+	return &p
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderReduceSize() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN alice limit sells 50 TokenA
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+
+	// WHEN she reduces the order to 20 TokenA
+	resp := s.aliceAmendsLimitSell(trancheKey, amendAmount(20), nil)
+
+	// THEN she gets back 30 TokenA and 20 TokenA remain in the book at the same tick
+	s.Equal(sdkmath.NewInt(30).Mul(denomMultiple), resp.MakerCoinOut.Amount)
+	s.True(resp.TakerCoinOut.Amount.IsZero())
+	s.assertAliceBalances(30, 0)
+	s.assertDexBalances(20, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 20)
+	s.assertCurr1To0(0)
+
+	// AND the order is shrunk in place so it keeps its tranche
+	s.Equal(trancheKey, resp.TrancheKey)
+	trancheUser, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), resp.TrancheKey)
+	s.True(found)
+	s.Equal(sdkmath.NewInt(20).Mul(denomMultiple), trancheUser.SharesOwned)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderReduceSizeKeepsTranchePriority() {
+	s.fundAliceBalances(50, 0)
+	s.fundCarolBalances(50, 0)
+
+	// GIVEN alice and carol both limit sell 10 TokenA in the same tranche
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+	s.carolLimitSells("TokenA", 0, 10)
+
+	// WHEN alice reduces her order to 5 TokenA
+	resp := s.aliceAmendsLimitSell(trancheKey, amendAmount(5), nil)
+
+	// THEN the tranche only holds the remaining 15 TokenA
+	s.Equal(trancheKey, resp.TrancheKey)
+	tranche, _, found := s.App.DexKeeper.FindLimitOrderTranche(s.Ctx, &types.LimitOrderTrancheKey{
+		TradePairId:           defaultTradePairID1To0,
+		TickIndexTakerToMaker: 0,
+		TrancheKey:            trancheKey,
+	})
+	s.True(found)
+	s.Equal(sdkmath.NewInt(15).Mul(denomMultiple), tranche.ReservesMakerDenom)
+	s.Equal(sdkmath.NewInt(15).Mul(denomMultiple), tranche.TotalMakerDenom)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 15)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderPartiallyFilled() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 50)
+
+	// GIVEN alice limit sells 50 TokenA and half of it is filled
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+	s.bobLimitSells("TokenB", -10, 25, types.LimitOrderType_FILL_OR_KILL)
+	s.assertDexBalances(25, 25)
+
+	// WHEN alice reduces the order to 10 TokenA
+	resp := s.aliceAmendsLimitSell(trancheKey, amendAmount(10), nil)
+
+	// THEN she receives the 25 TokenB proceeds and the 15 TokenA she no longer sells
+	s.Equal(sdkmath.NewInt(15).Mul(denomMultiple), resp.MakerCoinOut.Amount)
+	s.Equal(sdkmath.NewInt(25).Mul(denomMultiple), resp.TakerCoinOut.Amount)
+	s.assertAliceBalances(15, 25)
+	s.assertDexBalances(10, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+
+	// AND the amended order only owns the re-placed amount
+	trancheUser, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), resp.TrancheKey)
+	s.True(found)
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), trancheUser.SharesOwned)
+	s.True(trancheUser.SharesWithdrawn.IsZero())
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderNewPrice() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN alice limit sells 10 TokenA at tick 0
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN she moves the order to a sell price of 2
+	price := amendPrice("2")
+	resp := s.aliceAmendsLimitSell(trancheKey, nil, price)
+
+	// THEN the full amount is re-placed at the new tick
+	s.True(resp.MakerCoinOut.Amount.IsZero())
+	s.True(resp.TakerCoinOut.Amount.IsZero())
+	s.assertAliceBalances(40, 0)
+	s.assertDexBalances(10, 0)
+
+	expectedTickInToOut, err := types.CalcTickIndexFromPrice(math_utils.OnePrecDec().Quo(*price))
+	s.NoError(err)
+	trancheUser, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), resp.TrancheKey)
+	s.True(found)
+	s.Equal(expectedTickInToOut*-1, trancheUser.TickIndexTakerToMaker)
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), trancheUser.SharesOwned)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderNewPriceCrossesBook() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 5)
+
+	// GIVEN alice limit sells 10 TokenA at a price of 2
+	trancheKey := s.aliceLimitSells("TokenA", -6931, 10)
+	// AND bob limit sells 5 TokenB at a price of 1
+	s.bobLimitSells("TokenB", 0, 5)
+
+	// WHEN alice moves her order to a sell price of 0.5
+	resp := s.aliceAmendsLimitSell(trancheKey, nil, amendPrice("0.5"))
+
+	// THEN half of her order is filled against bob and the rest is placed
+	s.True(resp.MakerCoinOut.Amount.IsZero())
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), resp.TakerCoinOut.Amount)
+	s.assertAliceBalances(0, 5)
+	s.assertDexBalances(10, 0)
+
+	trancheUser, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), resp.TrancheKey)
+	s.True(found)
+	s.Equal(sdkmath.NewInt(5).Mul(denomMultiple), trancheUser.SharesOwned)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderNewPriceCrossesBookChargesTakerFee() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 5)
+	s.setLimitOrderFees(100, 0)
+
+	// GIVEN alice limit sells 10 TokenA at a price of 2
+	trancheKey := s.aliceLimitSells("TokenA", -6931, 10)
+	// AND bob limit sells 5 TokenB at a price of 1
+	s.bobLimitSells("TokenB", 0, 5)
+
+	// WHEN alice moves her order across bob's
+	resp := s.aliceAmendsLimitSell(trancheKey, nil, amendPrice("0.5"))
+
+	// THEN the taker fee paid for the fill is reported
+	s.Equal("TokenA", resp.TakerFee.Denom)
+	s.True(resp.TakerFee.Amount.IsPositive())
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderGoodTilKeepsExpiration() {
+	s.fundAliceBalances(50, 0)
+	expTime := time.Now().AddDate(0, 0, 1)
+
+	// GIVEN alice places a GOOD_TIL_TIME limit order
+	trancheKey := s.aliceLimitSellsGoodTil("TokenA", 0, 50, expTime)
+
+	// WHEN she reduces the order size
+	resp := s.aliceAmendsLimitSell(trancheKey, amendAmount(20), nil)
+
+	// THEN the order keeps its order type and expiration
+	s.assertAliceBalances(30, 0)
+	trancheUser, found := s.App.DexKeeper.GetLimitOrderTrancheUser(s.Ctx, s.alice.String(), resp.TrancheKey)
+	s.True(found)
+	s.Equal(types.LimitOrderType_GOOD_TIL_TIME, trancheUser.OrderType)
+
+	expirations := s.App.DexKeeper.GetAllLimitOrderExpiration(s.Ctx)
+	s.Len(expirations, 1)
+	s.True(expTime.Equal(expirations[0].ExpirationTime))
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderAmountTooLargeFails() {
+	s.fundAliceBalances(50, 0)
+	s.fundBobBalances(0, 50)
+
+	// GIVEN alice limit sells 50 TokenA and half of it is filled
+	trancheKey := s.aliceLimitSells("TokenA", 0, 50)
+	s.bobLimitSells("TokenB", -10, 25, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN alice cannot amend the order to more than the unfilled amount
+	s.aliceAmendsLimitSellFails(trancheKey, amendAmount(30), nil, types.ErrAmendAmountTooLarge)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderFilledFails() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN alice limit sells 10 TokenA and the order is completely filled
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+	s.bobLimitSells("TokenB", -10, 20, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN alice cannot amend the order
+	s.aliceAmendsLimitSellFails(trancheKey, nil, amendPrice("2"), types.ErrAmendFilledLimitOrder)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderJITFails() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice places a JIT limit order
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10, types.LimitOrderType_JUST_IN_TIME)
+
+	// THEN she cannot amend it
+	s.aliceAmendsLimitSellFails(trancheKey, amendAmount(5), nil, types.ErrInvalidAmendOrderType)
+}
+
+func (s *DexTestSuite) TestAmendLimitOrderEmptyFails() {
+	s.fundAliceBalances(10, 0)
+
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+
+	s.aliceAmendsLimitSellFails(trancheKey, nil, nil, types.ErrEmptyAmendLimitOrder)
+}
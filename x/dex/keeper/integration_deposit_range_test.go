@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) aliceDepositsRange(
+	amountA, amountB int64,
+	lowerTickIndex, upperTickIndex int64,
+	fee uint64,
+	shape types.RangeShape,
+) (*types.MsgDepositRangeResponse, error) {
+	return s.msgServer.DepositRange(s.Ctx, &types.MsgDepositRange{
+		Creator:            s.alice.String(),
+		Receiver:           s.alice.String(),
+		TokenA:             "TokenA",
+		TokenB:             "TokenB",
+		AmountA:            sdkmath.NewInt(amountA).Mul(denomMultiple),
+		AmountB:            sdkmath.NewInt(amountB).Mul(denomMultiple),
+		LowerTickIndexAToB: lowerTickIndex,
+		UpperTickIndexAToB: upperTickIndex,
+		Fee:                fee,
+		Shape:              shape,
+		Options:            &types.DepositOptions{},
+	})
+}
+
+func (s *DexTestSuite) withdrawsRange(account string, positionID uint64) (*types.MsgWithdrawRangeResponse, error) {
+	return s.msgServer.WithdrawRange(s.Ctx, &types.MsgWithdrawRange{
+		Creator:    account,
+		Receiver:   account,
+		PositionId: positionID,
+	})
+}
+
+func (s *DexTestSuite) TestDepositRangeFlat() {
+	s.fundAliceBalances(50, 0)
+
+	// WHEN
+	// alice deposits 30 TokenA across ticks 0 to 4 with fee 1
+	resp, err := s.aliceDepositsRange(30, 0, 0, 4, 1, types.RangeShape_FLAT)
+	s.NoError(err)
+
+	// THEN
+	// 10 TokenA is deposited into each of the 3 pools and tracked by a single position
+	s.Equal(uint64(0), resp.PositionId)
+	s.Len(resp.SharesIssued, 3)
+	s.assertLiquidityAtTick(10, 0, 0, 1)
+	s.assertLiquidityAtTick(10, 0, 2, 1)
+	s.assertLiquidityAtTick(10, 0, 4, 1)
+	s.assertAliceBalances(20, 0)
+	s.assertDexBalances(30, 0)
+
+	// Shares are held by the dex module and not by alice
+	s.assertAliceShares(0, 1, 0)
+
+	position, found := s.App.DexKeeper.GetRangePosition(s.Ctx, s.alice.String(), resp.PositionId)
+	s.True(found)
+	s.Equal(resp.SharesIssued, position.Shares)
+}
+
+func (s *DexTestSuite) TestDepositRangeBell() {
+	s.fundAliceBalances(0, 50)
+
+	// WHEN
+	// alice deposits 40 TokenB across ticks 0 to 4 with fee 1 with a BELL shape
+	_, err := s.aliceDepositsRange(0, 40, 0, 4, 1, types.RangeShape_BELL)
+	s.NoError(err)
+
+	// THEN
+	// TokenB is distributed 1:2:1 across the 3 pools
+	s.assertLiquidityAtTick(0, 10, 0, 1)
+	s.assertLiquidityAtTick(0, 20, 2, 1)
+	s.assertLiquidityAtTick(0, 10, 4, 1)
+	s.assertAliceBalances(0, 10)
+	s.assertDexBalances(0, 40)
+}
+
+func (s *DexTestSuite) TestDepositRangeSplitsTokensAroundRangeMiddle() {
+	s.fundAliceBalances(50, 50)
+
+	// WHEN
+	// alice deposits 30 TokenA and 30 TokenB across ticks 0 to 4 with fee 1 into an empty pair
+	_, err := s.aliceDepositsRange(30, 30, 0, 4, 1, types.RangeShape_FLAT)
+	s.NoError(err)
+
+	// THEN
+	// TokenA is only placed up to the middle of the range and TokenB only from the middle upwards
+	s.assertLiquidityAtTick(15, 0, 0, 1)
+	s.assertLiquidityAtTick(15, 15, 2, 1)
+	s.assertLiquidityAtTick(0, 15, 4, 1)
+	s.assertAliceBalances(20, 20)
+}
+
+func (s *DexTestSuite) TestDepositRangeNeverDepositsBehindCurrentPrice() {
+	s.fundAliceBalances(50, 50)
+
+	// GIVEN
+	// TokenB liquidity at tick 11
+	s.aliceDeposits(NewDeposit(0, 10, 10, 1))
+
+	// WHEN
+	// alice deposits 30 TokenA and 30 TokenB across ticks 0 to 4 with fee 1, which is entirely below the TokenB liquidity
+	resp, err := s.aliceDepositsRange(30, 30, 0, 4, 1, types.RangeShape_FLAT)
+	s.NoError(err)
+
+	// THEN
+	// only TokenA is deposited
+	s.True(resp.Reserve1Deposited.IsZero())
+	s.assertLiquidityAtTick(10, 0, 0, 1)
+	s.assertLiquidityAtTick(10, 0, 2, 1)
+	s.assertLiquidityAtTick(10, 0, 4, 1)
+	s.assertAliceBalances(20, 40)
+}
+
+func (s *DexTestSuite) TestDepositRangeTooWideFails() {
+	s.fundAliceBalances(50, 0)
+
+	// WHEN
+	// alice deposits into more than MaxRangePositionPools pools
+	// THEN
+	// deposit fails with ErrRangeTooWide
+	_, err := s.aliceDepositsRange(30, 0, 0, 2*types.MaxRangePositionPools, 1, types.RangeShape_FLAT)
+	s.ErrorIs(err, types.ErrRangeTooWide)
+}
+
+func (s *DexTestSuite) TestWithdrawRange() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN
+	// alice has a FLAT range position across ticks 0 to 4
+	resp, err := s.aliceDepositsRange(30, 0, 0, 4, 1, types.RangeShape_FLAT)
+	s.NoError(err)
+
+	// WHEN
+	// alice withdraws the position
+	withdrawResp, err := s.withdrawsRange(s.alice.String(), resp.PositionId)
+	s.NoError(err)
+
+	// THEN
+	// all liquidity is returned and the position is removed
+	s.Equal(resp.SharesIssued, withdrawResp.SharesBurned)
+	s.assertLiquidityAtTick(0, 0, 0, 1)
+	s.assertLiquidityAtTick(0, 0, 2, 1)
+	s.assertLiquidityAtTick(0, 0, 4, 1)
+	s.assertAliceBalances(50, 0)
+	s.assertDexBalances(0, 0)
+
+	_, found := s.App.DexKeeper.GetRangePosition(s.Ctx, s.alice.String(), resp.PositionId)
+	s.False(found)
+}
+
+func (s *DexTestSuite) TestWithdrawRangeNotOwnerFails() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN
+	// alice has a range position
+	resp, err := s.aliceDepositsRange(30, 0, 0, 4, 1, types.RangeShape_FLAT)
+	s.NoError(err)
+
+	// WHEN
+	// bob tries to withdraw alice's position
+	// THEN
+	// withdraw fails with ErrRangePositionNotFound
+	_, err = s.withdrawsRange(s.bob.String(), resp.PositionId)
+	s.ErrorIs(err, types.ErrRangePositionNotFound)
+	s.assertDexBalances(30, 0)
+}
+
+func (s *DexTestSuite) TestUserDepositsAllReturnsRangePositions() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN
+	// alice has a range position
+	resp, err := s.aliceDepositsRange(30, 0, 0, 4, 1, types.RangeShape_FLAT)
+	s.NoError(err)
+
+	// WHEN
+	// UserDepositsAll is queried for alice
+	queryResp, err := s.App.DexKeeper.UserDepositsAll(s.Ctx, &types.QueryAllUserDepositsRequest{Address: s.alice.String()})
+	s.NoError(err)
+
+	// THEN
+	// the range position is returned
+	s.Len(queryResp.RangePositions, 1)
+	s.Equal(resp.PositionId, queryResp.RangePositions[0].Id)
+	s.Equal(resp.SharesIssued, queryResp.RangePositions[0].Shares)
+}
+
+func (s *DexTestSuite) TestUserDepositsAllPaginatesRangePositions() {
+	s.fundAliceBalances(50, 0)
+
+	// GIVEN
+	// alice has a regular deposit and two range positions
+	s.aliceDeposits(NewDeposit(10, 0, 0, 1))
+	_, err := s.aliceDepositsRange(10, 0, 0, 4, 1, types.RangeShape_FLAT)
+	s.NoError(err)
+	_, err = s.aliceDepositsRange(10, 0, 10, 14, 1, types.RangeShape_FLAT)
+	s.NoError(err)
+
+	// WHEN
+	// the first page of 2 is queried
+	queryResp, err := s.App.DexKeeper.UserDepositsAll(s.Ctx, &types.QueryAllUserDepositsRequest{
+		Address:    s.alice.String(),
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	s.NoError(err)
+
+	// THEN
+	// it contains the deposit and the first range position
+	s.Len(queryResp.Deposits, 1)
+	s.Len(queryResp.RangePositions, 1)
+	s.Equal(uint64(0), queryResp.RangePositions[0].Id)
+	s.NotNil(queryResp.Pagination.NextKey)
+
+	// WHEN
+	// the next page is queried
+	queryResp, err = s.App.DexKeeper.UserDepositsAll(s.Ctx, &types.QueryAllUserDepositsRequest{
+		Address:    s.alice.String(),
+		Pagination: &query.PageRequest{Key: queryResp.Pagination.NextKey, Limit: 2},
+	})
+	s.NoError(err)
+
+	// THEN
+	// it only contains the second range position
+	s.Empty(queryResp.Deposits)
+	s.Len(queryResp.RangePositions, 1)
+	s.Equal(uint64(1), queryResp.RangePositions[0].Id)
+	s.Nil(queryResp.Pagination.NextKey)
+
+	// WHEN
+	// the second page is queried by offset
+	queryResp, err = s.App.DexKeeper.UserDepositsAll(s.Ctx, &types.QueryAllUserDepositsRequest{
+		Address:    s.alice.String(),
+		Pagination: &query.PageRequest{Offset: 2, Limit: 2},
+	})
+	s.NoError(err)
+
+	// THEN
+	// it also only contains the second range position
+	s.Empty(queryResp.Deposits)
+	s.Len(queryResp.RangePositions, 1)
+	s.Equal(uint64(1), queryResp.RangePositions[0].Id)
+}
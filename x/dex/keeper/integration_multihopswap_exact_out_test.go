@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) aliceMultiHopSwapsExactOut(
+	routes [][]string,
+	amountOut int,
+	maxAmountIn int,
+	pickBest bool,
+) (*types.MsgMultiHopSwapExactOutResponse, error) {
+	msg := types.NewMsgMultiHopSwapExactOut(
+		s.alice.String(),
+		s.alice.String(),
+		routes,
+		math.NewInt(int64(amountOut)).Mul(denomMultiple),
+		math.NewInt(int64(maxAmountIn)).Mul(denomMultiple),
+		pickBest,
+	)
+	return s.msgServer.MultiHopSwapExactOut(s.Ctx, msg)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapExactOutSingleRoute() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D,
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, -1, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, -1, 1),
+	)
+
+	// WHEN alice multihopswaps A<>B => B<>C => C<>D for exactly 50 TokenD
+	route := [][]string{{"TokenA", "TokenB", "TokenC", "TokenD"}}
+	resp, err := s.aliceMultiHopSwapsExactOut(route, 50, 60, false)
+	s.NoError(err)
+
+	// THEN alice gets out exactly 50 TokenD for 50 TokenA and there is no dust
+	s.Equal(math.NewInt(50).Mul(denomMultiple), resp.CoinIn.Amount)
+	s.Equal("TokenA", resp.CoinIn.Denom)
+	s.Equal(math.NewInt(50).Mul(denomMultiple), resp.CoinOut.Amount)
+	s.Equal(route[0], resp.Route.Hops)
+
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 50)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenB", 0)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenC", 0)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenD", 50)
+
+	s.assertDexBalanceWithDenom("TokenA", 50)
+	s.assertDexBalanceWithDenom("TokenB", 100)
+	s.assertDexBalanceWithDenom("TokenC", 100)
+	s.assertDexBalanceWithDenom("TokenD", 50)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapExactOutMultiRoutePickBest() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D and a cheaper B<>D pool
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, -1, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenD", 0, 100, -1001, 1),
+	)
+
+	// WHEN alice multihopswaps with pickBestRoute for exactly 50 TokenD
+	routes := [][]string{
+		{"TokenA", "TokenB", "TokenC", "TokenD"},
+		{"TokenA", "TokenB", "TokenD"},
+	}
+	resp, err := s.aliceMultiHopSwapsExactOut(routes, 50, 60, true)
+	s.NoError(err)
+
+	// THEN the route requiring the smallest amount in is used
+	s.Equal(routes[1], resp.Route.Hops)
+	s.True(resp.CoinIn.Amount.LT(math.NewInt(50).Mul(denomMultiple)))
+
+	aliceAmountA := math.NewInt(100).Mul(denomMultiple).Sub(resp.CoinIn.Amount)
+	s.assertAccountBalanceWithDenomInt(s.alice, "TokenA", aliceAmountA)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenB", 0)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenD", 50)
+	s.assertDexBalanceWithDenom("TokenC", 100)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapExactOutMultiRouteFirstSuccessful() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D and a cheaper B<>D pool
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, -1, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenD", 0, 100, -1001, 1),
+	)
+
+	// WHEN alice multihopswaps without pickBestRoute
+	routes := [][]string{
+		{"TokenA", "TokenB", "TokenC", "TokenD"},
+		{"TokenA", "TokenB", "TokenD"},
+	}
+	resp, err := s.aliceMultiHopSwapsExactOut(routes, 50, 60, false)
+	s.NoError(err)
+
+	// THEN the first route is used
+	s.Equal(routes[0], resp.Route.Hops)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 50)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenD", 50)
+	s.assertDexBalanceWithDenom("TokenC", 100)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapExactOutMaxAmountInExceededFails() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D,
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, -1, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, -1, 1),
+	)
+
+	// WHEN alice multihopswaps for 50 TokenD with a max amount in of 49 TokenA
+	route := [][]string{{"TokenA", "TokenB", "TokenC", "TokenD"}}
+	_, err := s.aliceMultiHopSwapsExactOut(route, 50, 49, false)
+
+	// THEN the swap fails
+	s.ErrorIs(err, types.ErrMaxAmountInExceeded)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 100)
+	s.assertDexBalanceWithDenom("TokenD", 100)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapExactOutMaxAmountInExceededAfterPriceMoveFails() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in A<>B spread across two ticks
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 10, -1, 1),
+		NewPoolSetup("TokenA", "TokenB", 0, 100, 999, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 100, -1, 1),
+	)
+
+	// WHEN alice swaps for 50 TokenC with a max amount in of 51 TokenA
+	route := [][]string{{"TokenA", "TokenB", "TokenC"}}
+	_, err := s.aliceMultiHopSwapsExactOut(route, 50, 51, false)
+
+	// THEN the swap fails since the best price cannot be had for the full amount
+	s.ErrorIs(err, types.ErrMaxAmountInExceeded)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 100)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapExactOutInsufficientLiquidityFails() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, B<>C, C<>D,
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 100, -1, 1),
+		NewPoolSetup("TokenB", "TokenC", 0, 20, -1, 1),
+		NewPoolSetup("TokenC", "TokenD", 0, 100, -1, 1),
+	)
+
+	// WHEN alice multihopswaps for more TokenD than B<>C can provide
+	route := [][]string{{"TokenA", "TokenB", "TokenC", "TokenD"}}
+	_, err := s.aliceMultiHopSwapsExactOut(route, 50, 100, false)
+
+	// THEN the swap fails
+	s.ErrorIs(err, types.ErrNoLiquidity)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 100)
+	s.assertDexBalanceWithDenom("TokenD", 100)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapExactOutPausedFails() {
+	s.fundAliceBalances(100, 0)
+
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.Paused = true
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	route := [][]string{{"TokenA", "TokenB"}}
+	_, err := s.aliceMultiHopSwapsExactOut(route, 50, 100, false)
+	s.ErrorIs(err, types.ErrDexPaused)
+}
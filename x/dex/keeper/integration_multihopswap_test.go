@@ -502,3 +502,99 @@ func (s *DexTestSuite) TestMultiHopSwapEventsEmitted() {
 	// 8 tickUpdateEvents are emitted 4x for pool setup 4x for two swaps
 	s.AssertNEventValuesEmitted(types.TickUpdateEventKey, 8)
 }
+
+func (s *DexTestSuite) TestMultiHopSwapSplitRoutes() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN liquidity in pools A<>B, A<>C and C<>B that can't absorb the trade individually
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 50, 0, 1),
+		NewPoolSetup("TokenA", "TokenC", 0, 50, 0, 1),
+		NewPoolSetup("TokenB", "TokenC", 50, 0, 0, 1),
+	)
+	routes := [][]string{{"TokenA", "TokenB"}, {"TokenA", "TokenC", "TokenB"}}
+
+	// THEN no single route can fill the trade
+	s.aliceMultiHopSwapFails(
+		types.ErrAllMultiHopRoutesFailed,
+		routes,
+		100,
+		math_utils.MustNewPrecDecFromStr("0.9"),
+		true,
+	)
+
+	// WHEN alice multihopswaps with split routes
+	msg := types.NewMsgMultiHopSwap(
+		s.alice.String(),
+		s.alice.String(),
+		routes,
+		math.NewInt(100).Mul(denomMultiple),
+		math_utils.MustNewPrecDecFromStr("0.9"),
+		false,
+	)
+	msg.SplitRoutes = true
+	resp, err := s.msgServer.MultiHopSwap(s.Ctx, msg)
+	s.NoError(err)
+
+	// THEN the trade is split evenly across both routes
+	s.Nil(resp.Route)
+	s.Len(resp.RouteAllocations, 2)
+	s.Equal(routes[0], resp.RouteAllocations[0].Route.Hops)
+	s.Equal(math.NewInt(50).Mul(denomMultiple), resp.RouteAllocations[0].AmountIn)
+	s.Equal(routes[1], resp.RouteAllocations[1].Route.Hops)
+	s.Equal(math.NewInt(50).Mul(denomMultiple), resp.RouteAllocations[1].AmountIn)
+
+	totalOut := resp.RouteAllocations[0].CoinOut.Add(resp.RouteAllocations[1].CoinOut)
+	s.True(resp.CoinOut.Equal(totalOut))
+	s.True(resp.CoinOut.Amount.GT(math.NewInt(99).Mul(denomMultiple)))
+
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 0)
+	s.assertAccountBalanceWithDenomInt(s.alice, "TokenB", resp.CoinOut.Amount)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapSplitRoutesPrefersBetterRoute() {
+	s.fundAliceBalances(100, 0)
+
+	// GIVEN a deep A<>B pool and a shallow A<>C<>B route at a better price
+	s.SetupMultiplePools(
+		NewPoolSetup("TokenA", "TokenB", 0, 200, 0, 1),
+		NewPoolSetup("TokenA", "TokenC", 0, 20, -1000, 1),
+		NewPoolSetup("TokenB", "TokenC", 20, 0, 0, 1),
+	)
+	routes := [][]string{{"TokenA", "TokenB"}, {"TokenA", "TokenC", "TokenB"}}
+
+	// WHEN alice multihopswaps with split routes
+	msg := types.NewMsgMultiHopSwap(
+		s.alice.String(),
+		s.alice.String(),
+		routes,
+		math.NewInt(100).Mul(denomMultiple),
+		math_utils.MustNewPrecDecFromStr("0.9"),
+		false,
+	)
+	msg.SplitRoutes = true
+	resp, err := s.msgServer.MultiHopSwap(s.Ctx, msg)
+	s.NoError(err)
+
+	// THEN the better route is used until its liquidity runs out and the rest goes through A<>B
+	s.Len(resp.RouteAllocations, 2)
+	s.Equal(math.NewInt(90).Mul(denomMultiple), resp.RouteAllocations[0].AmountIn)
+	s.Equal(math.NewInt(10).Mul(denomMultiple), resp.RouteAllocations[1].AmountIn)
+	s.assertAccountBalanceWithDenom(s.alice, "TokenA", 0)
+}
+
+func (s *DexTestSuite) TestMultiHopSwapSplitRoutesWithPickBestRouteFails() {
+	s.fundAliceBalances(100, 0)
+
+	msg := types.NewMsgMultiHopSwap(
+		s.alice.String(),
+		s.alice.String(),
+		[][]string{{"TokenA", "TokenB"}},
+		math.NewInt(100).Mul(denomMultiple),
+		math_utils.MustNewPrecDecFromStr("0.9"),
+		true,
+	)
+	msg.SplitRoutes = true
+	_, err := s.msgServer.MultiHopSwap(s.Ctx, msg)
+	s.ErrorIs(err, types.ErrSplitRoutesWithPickBestRoute)
+}
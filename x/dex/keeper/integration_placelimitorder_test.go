@@ -884,3 +884,169 @@ func (s *DexTestSuite) TestPlaceLimitOrderMixedTypes() {
 	s.NotEqual(trancheKey2, trancheKey3, "GTC and JIT in same tranche")
 	s.Equal(trancheKey4, trancheKey3, "GTCs not combined")
 }
+
+// GoodTilBlockLimitOrders //////////////////////////////////////////////////
+
+func (s *DexTestSuite) TestPlaceLimitOrderGoodTilBlockFills() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+	// GIVEN Alice submits a limitOrder for 10 tokenA expiring in 10 blocks
+	trancheKey := s.aliceLimitSellsGoodTilBlock("TokenA", 0, 10, uint64(s.Ctx.BlockHeight()+10))
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertAliceBalances(0, 0)
+
+	// WHEN bob swaps through all the liquidity
+	s.bobLimitSells("TokenB", -10, 10, types.LimitOrderType_FILL_OR_KILL)
+
+	// THEN all liquidity is depleted
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	// Alice can withdraw 10 TokenB
+	s.aliceWithdrawsLimitSell(trancheKey)
+	s.assertAliceBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderGoodTilBlockExpires() {
+	s.fundAliceBalances(10, 0)
+	expirationHeight := uint64(s.Ctx.BlockHeight() + 2)
+	// GIVEN Alice submits a limitOrder for 10 tokenA expiring in 2 blocks
+	trancheKey := s.aliceLimitSellsGoodTilBlock("TokenA", 0, 10, expirationHeight)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.Len(s.App.DexKeeper.GetAllLimitOrderHeightExpiration(s.Ctx), 1)
+
+	// WHEN the next block begins the order is still live
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1)
+	s.beginBlockWithTime(s.Ctx.BlockTime())
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+
+	// WHEN the expiration height is reached (ie. purge is run)
+	s.Ctx = s.Ctx.WithBlockHeight(int64(expirationHeight))
+	s.beginBlockWithTime(s.Ctx.BlockTime())
+
+	// THEN there is no liquidity available
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.Empty(s.App.DexKeeper.GetAllLimitOrderHeightExpiration(s.Ctx))
+	// Alice can withdraw the entirety of the unfilled limitOrder
+	s.aliceWithdrawsLimitSell(trancheKey)
+	s.assertAliceBalances(10, 0)
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderGoodTilBlockExpiresNotPurged() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 20)
+	// GIVEN Alice submits a limitOrder for 10 tokenA expiring in 2 blocks
+	expirationHeight := uint64(s.Ctx.BlockHeight() + 2)
+	trancheKey := s.aliceLimitSellsGoodTilBlock("TokenA", 0, 10, expirationHeight)
+
+	// WHEN the expiration height is reached but purge has not been run
+	s.Ctx = s.Ctx.WithBlockHeight(int64(expirationHeight))
+
+	// THEN there is no liquidity available
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertBobLimitSellFails(types.ErrNoLiquidity, "TokenB", -10, 10, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// Alice can cancel the entirety of the unfilled limitOrder
+	s.aliceCancelsLimitSell(trancheKey)
+	s.assertAliceBalances(10, 0)
+	s.Empty(s.App.DexKeeper.GetAllLimitOrderHeightExpiration(s.Ctx))
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderGoodTilBlockAlreadyExpiredFails() {
+	s.fundAliceBalances(10, 0)
+
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.alice.String(),
+		Receiver:         s.alice.String(),
+		TokenIn:          "TokenA",
+		TokenOut:         "TokenB",
+		TickIndexInToOut: 0,
+		AmountIn:         sdkmath.NewInt(50),
+		OrderType:        types.LimitOrderType_GOOD_TIL_BLOCK,
+		ExpirationHeight: uint64(s.Ctx.BlockHeight()),
+	})
+	s.Assert().ErrorIs(err, types.ErrExpirationHeightInPast)
+}
+
+// Post-only limitOrders //////////////////////////////////////////////////////
+
+func (s *DexTestSuite) TestPlaceLimitOrderPostOnlyEmptyBook() {
+	s.fundAliceBalances(10, 0)
+
+	// WHEN alice places a POST_ONLY order into an empty book
+	s.aliceLimitSells("TokenA", 0, 10, types.LimitOrderType_POST_ONLY)
+
+	// THEN it is placed as a maker order
+	s.assertAliceBalances(0, 0)
+	s.assertDexBalances(10, 0)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertCurr1To0(0)
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderPostOnlyBehindSpread() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice sells TokenA at tick 0
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN bob places a POST_ONLY order for TokenB that does not cross
+	s.bobLimitSells("TokenB", 1, 10, types.LimitOrderType_POST_ONLY)
+
+	// THEN both orders rest on the book
+	s.assertBobBalances(0, 0)
+	s.assertDexBalances(10, 10)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertLimitLiquidityAtTick("TokenB", 1, 10)
+	s.assertCurr1To0(0)
+	s.assertCurr0To1(1)
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderPostOnlyCrossesFails() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice sells TokenA at tick 0
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// THEN bob cannot place a POST_ONLY order that would fill against alice
+	s.assertBobLimitSellFails(types.ErrPostOnlyOrderCrossesBook, "TokenB", -10, 10, types.LimitOrderType_POST_ONLY)
+	// AND an order at the same price would also be filled so it is rejected
+	s.assertBobLimitSellFails(types.ErrPostOnlyOrderCrossesBook, "TokenB", 0, 10, types.LimitOrderType_POST_ONLY)
+
+	s.assertBobBalances(0, 10)
+	s.assertDexBalances(10, 0)
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderPostOnlyReprice() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice sells TokenA at tick 0
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN bob places a POST_ONLY_REPRICE order for TokenB that would cross
+	s.bobLimitSells("TokenB", -10, 10, types.LimitOrderType_POST_ONLY_REPRICE)
+
+	// THEN nothing is swapped and bob's order is moved one tick behind alice's
+	s.assertBobBalances(0, 0)
+	s.assertDexBalances(10, 10)
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertLimitLiquidityAtTick("TokenB", 1, 10)
+	s.assertLimitLiquidityAtTick("TokenB", -10, 0)
+	s.assertCurr1To0(0)
+	s.assertCurr0To1(1)
+}
+
+func (s *DexTestSuite) TestPlaceLimitOrderPostOnlyRepriceNotCrossing() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 10)
+
+	// GIVEN alice sells TokenA at tick 0
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN bob places a POST_ONLY_REPRICE order for TokenB that does not cross
+	s.bobLimitSells("TokenB", 5, 10, types.LimitOrderType_POST_ONLY_REPRICE)
+
+	// THEN it is placed at the requested tick
+	s.assertLimitLiquidityAtTick("TokenB", 5, 10)
+	s.assertCurr0To1(5)
+}
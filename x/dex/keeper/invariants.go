@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// RegisterInvariants registers all dex invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "dex-balance", DexBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "pool-shares", PoolSharesInvariant(k))
+}
+
+// AllInvariants runs all dex invariants
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := DexBalanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return PoolSharesInvariant(k)(ctx)
+	}
+}
+
+// DexBalanceInvariant checks that the dex module account holds enough of every denom to cover the reserves of all
+// PoolReserves and LimitOrderTranches, unwithdrawn maker rebates, TriggerOrder and BatchAuctionOrder escrows and
+// pending protocol fees
+func DexBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		liabilities := types.DexLiabilities(
+			k.GetAllTickLiquidity(ctx),
+			k.GetAllInactiveLimitOrderTranche(ctx),
+			k.GetAllTriggerOrder(ctx),
+			k.GetAllBatchAuctionOrder(ctx),
+			k.GetPendingProtocolFees(ctx),
+		)
+
+		balance := sdk.NewCoins()
+		k.bankKeeper.IterateAccountBalances(ctx, authtypes.NewModuleAddress(types.ModuleName), func(coin sdk.Coin) bool {
+			balance = balance.Add(coin)
+			return false
+		})
+
+		var deficits []types.BalanceDifference
+		for _, diff := range types.CompareDexBalance(balance, liabilities) {
+			if diff.IsDeficit() {
+				deficits = append(deficits, diff)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"dex-balance",
+			formatBalanceDifferences("dex module balance does not cover liabilities", deficits),
+		), len(deficits) > 0
+	}
+}
+
+// PoolSharesInvariant checks that the bank supply of every PoolShare denom equals the sum of its shareholders' balances
+func PoolSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		shareholderTotals := sdk.NewCoins()
+		for poolID, shareholders := range k.GetAllPoolShareholders(ctx) {
+			denom := types.NewPoolDenom(poolID)
+			for _, shareholder := range shareholders {
+				shareholderTotals = shareholderTotals.Add(sdk.NewCoin(denom, shareholder.Shares))
+			}
+		}
+
+		supply := sdk.NewCoins()
+		for _, poolMetadata := range k.GetAllPoolMetadata(ctx) {
+			supply = supply.Add(k.bankKeeper.GetSupply(ctx, types.NewPoolDenom(poolMetadata.Id)))
+		}
+
+		diffs := types.ComparePoolShares(supply, shareholderTotals)
+
+		return sdk.FormatInvariant(
+			types.ModuleName,
+			"pool-shares",
+			formatBalanceDifferences("pool share supply does not match shareholder totals", diffs),
+		), len(diffs) > 0
+	}
+}
+
+func formatBalanceDifferences(header string, diffs []types.BalanceDifference) string {
+	if len(diffs) == 0 {
+		return ""
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%s:\n", header))
+	for _, diff := range diffs {
+		sb.WriteString(fmt.Sprintf("\t%s\n", diff))
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,54 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dexkeeper "github.com/neutron-org/neutron/v5/x/dex/keeper"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) assertInvariantsHold() {
+	msg, broken := dexkeeper.AllInvariants(s.App.DexKeeper)(s.Ctx)
+	s.False(broken, msg)
+}
+
+func (s *DexTestSuite) TestInvariantsHoldAfterTrading() {
+	s.fundAliceBalances(50, 50)
+	s.fundBobBalances(50, 50)
+	s.setLimitOrderFees(100, 40)
+
+	// GIVEN pool and limit order liquidity
+	s.aliceDeposits(NewDeposit(10, 10, 0, 1))
+	trancheKey := s.aliceLimitSells("TokenB", 0, 10)
+	s.assertInvariantsHold()
+
+	// WHEN bob trades against it
+	s.bobLimitSells("TokenA", 10, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.assertInvariantsHold()
+
+	// AND alice withdraws her filled order
+	s.aliceWithdrawsLimitSell(trancheKey)
+
+	// THEN the invariants still hold
+	s.assertInvariantsHold()
+}
+
+func (s *DexTestSuite) TestDexBalanceInvariantBroken() {
+	s.fundAliceBalances(0, 10)
+	s.aliceLimitSells("TokenB", 0, 10)
+
+	// WHEN funds leave the dex without updating its state
+	err := s.App.BankKeeper.SendCoinsFromModuleToAccount(
+		s.Ctx,
+		types.ModuleName,
+		s.carol,
+		sdk.NewCoins(sdk.NewCoin("TokenB", sdkmath.OneInt())),
+	)
+	s.NoError(err)
+
+	// THEN the invariant is broken
+	msg, broken := dexkeeper.DexBalanceInvariant(s.App.DexKeeper)(s.Ctx)
+	s.True(broken)
+	s.Contains(msg, "TokenB")
+}
@@ -18,7 +18,15 @@ type (
 		memKey     storetypes.StoreKey
 		tKey       storetypes.StoreKey
 		bankKeeper types.BankKeeper
-		authority  string
+		wasmKeeper types.WasmKeeper
+		// contractKeeper may be nil in which case flash swaps are disabled
+		contractKeeper types.ContractKeeper
+		// oracleKeeper may be nil in which case OraclePriceGuards are never applied
+		oracleKeeper types.OracleKeeper
+		hooks        types.DexHooks
+		authority    string
+		// candleStore is nil unless candles are enabled in app.toml
+		candleStore *CandleStore
 	}
 )
 
@@ -28,16 +36,33 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	tKey storetypes.StoreKey,
 	bankKeeper types.BankKeeper,
+	wasmKeeper types.WasmKeeper,
+	contractKeeper types.ContractKeeper,
+	oracleKeeper types.OracleKeeper,
+	hooks types.DexHooks,
 	authority string,
 ) *Keeper {
-	return &Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		memKey:     memKey,
-		tKey:       tKey,
-		bankKeeper: bankKeeper,
-		authority:  authority,
+	k := &Keeper{
+		cdc:            cdc,
+		storeKey:       storeKey,
+		memKey:         memKey,
+		tKey:           tKey,
+		bankKeeper:     bankKeeper,
+		wasmKeeper:     wasmKeeper,
+		contractKeeper: contractKeeper,
+		oracleKeeper:   oracleKeeper,
+		authority:      authority,
 	}
+
+	// The hook contract is always called after the hooks passed in
+	contractHooks := wasmHooks{*k}
+	if hooks == nil {
+		k.hooks = contractHooks
+	} else {
+		k.hooks = types.NewMultiDexHooks(hooks, contractHooks)
+	}
+
+	return k
 }
 
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
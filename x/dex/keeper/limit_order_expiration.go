@@ -23,6 +23,18 @@ func NewLimitOrderExpiration(tranche *types.LimitOrderTranche) *types.LimitOrder
 	}
 }
 
+// Creates a new LimitOrderHeightExpiration struct based on a LimitOrderTranche
+func NewLimitOrderHeightExpiration(tranche *types.LimitOrderTranche) *types.LimitOrderHeightExpiration {
+	if tranche.ExpirationHeight == 0 {
+		panic("Cannot create LimitOrderHeightExpiration from tranche without ExpirationHeight")
+	}
+
+	return &types.LimitOrderHeightExpiration{
+		TrancheRef:       tranche.Key.KeyMarshal(),
+		ExpirationHeight: tranche.ExpirationHeight,
+	}
+}
+
 // SetLimitOrderExpiration set a specific goodTilRecord in the store from its index
 func (k Keeper) SetLimitOrderExpiration(
 	ctx sdk.Context,
@@ -107,7 +119,125 @@ func (k Keeper) GetAllLimitOrderExpiration(ctx sdk.Context) (list []*types.Limit
 	return
 }
 
+// SetLimitOrderHeightExpiration set a specific goodTilHeightRecord in the store from its index
+func (k Keeper) SetLimitOrderHeightExpiration(
+	ctx sdk.Context,
+	goodTilHeightRecord *types.LimitOrderHeightExpiration,
+) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.LimitOrderHeightExpirationKeyPrefix),
+	)
+	b := k.cdc.MustMarshal(goodTilHeightRecord)
+	store.Set(types.LimitOrderHeightExpirationKey(
+		goodTilHeightRecord.ExpirationHeight,
+		goodTilHeightRecord.TrancheRef,
+	), b)
+}
+
+// GetLimitOrderHeightExpiration returns a goodTilHeightRecord from its index
+func (k Keeper) GetLimitOrderHeightExpiration(
+	ctx sdk.Context,
+	goodTilHeight uint64,
+	trancheRef []byte,
+) (val *types.LimitOrderHeightExpiration, found bool) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.LimitOrderHeightExpirationKeyPrefix),
+	)
+
+	b := store.Get(types.LimitOrderHeightExpirationKey(
+		goodTilHeight,
+		trancheRef,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	val = &types.LimitOrderHeightExpiration{}
+	k.cdc.MustUnmarshal(b, val)
+
+	return val, true
+}
+
+// RemoveLimitOrderHeightExpiration removes a goodTilHeightRecord from the store
+func (k Keeper) RemoveLimitOrderHeightExpiration(
+	ctx sdk.Context,
+	goodTilHeight uint64,
+	trancheRef []byte,
+) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.LimitOrderHeightExpirationKeyPrefix),
+	)
+	store.Delete(types.LimitOrderHeightExpirationKey(
+		goodTilHeight,
+		trancheRef,
+	))
+}
+
+func (k Keeper) RemoveLimitOrderHeightExpirationByKey(ctx sdk.Context, key []byte) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.LimitOrderHeightExpirationKeyPrefix),
+	)
+	store.Delete(key)
+}
+
+// GetAllLimitOrderHeightExpiration returns all goodTilHeightRecord
+func (k Keeper) GetAllLimitOrderHeightExpiration(ctx sdk.Context) (list []*types.LimitOrderHeightExpiration) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.LimitOrderHeightExpirationKeyPrefix),
+	)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.LimitOrderHeightExpiration{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetTrancheExpiration indexes an expiring tranche by either its ExpirationHeight or its ExpirationTime
+func (k Keeper) SetTrancheExpiration(ctx sdk.Context, tranche *types.LimitOrderTranche) {
+	if tranche.ExpirationHeight != 0 {
+		k.SetLimitOrderHeightExpiration(ctx, NewLimitOrderHeightExpiration(tranche))
+	} else {
+		k.SetLimitOrderExpiration(ctx, NewLimitOrderExpiration(tranche))
+	}
+}
+
+// RemoveTrancheExpiration removes the expiration index entry created by SetTrancheExpiration
+func (k Keeper) RemoveTrancheExpiration(ctx sdk.Context, tranche *types.LimitOrderTranche) {
+	if tranche.ExpirationHeight != 0 {
+		k.RemoveLimitOrderHeightExpiration(ctx, tranche.ExpirationHeight, tranche.Key.KeyMarshal())
+	} else {
+		k.RemoveLimitOrderExpiration(ctx, *tranche.ExpirationTime, tranche.Key.KeyMarshal())
+	}
+}
+
 func (k Keeper) PurgeExpiredLimitOrders(ctx sdk.Context, curTime time.Time) {
+	archivedTranches := make(map[string]bool)
+	gasCutoff := ctx.GasMeter().GasConsumed() + k.GetGoodTilPurgeAllowance(ctx)
+
+	if hitGasLimit := k.purgeTimeExpiredLimitOrders(ctx, curTime, gasCutoff, archivedTranches); hitGasLimit {
+		return
+	}
+
+	k.purgeHeightExpiredLimitOrders(ctx, gasCutoff, archivedTranches)
+}
+
+func (k Keeper) purgeTimeExpiredLimitOrders(
+	ctx sdk.Context,
+	curTime time.Time,
+	gasCutoff uint64,
+	archivedTranches map[string]bool,
+) (hitGasLimit bool) {
 	store := prefix.NewStore(
 		ctx.KVStore(k.storeKey),
 		types.KeyPrefix(types.LimitOrderExpirationKeyPrefix),
@@ -115,14 +245,12 @@ func (k Keeper) PurgeExpiredLimitOrders(ctx sdk.Context, curTime time.Time) {
 	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
 	inGoodTilSegment := false
 
-	archivedTranches := make(map[string]bool)
 	defer iterator.Close()
-	gasCutoff := ctx.GasMeter().GasConsumed() + k.GetGoodTilPurgeAllowance(ctx)
 	for ; iterator.Valid(); iterator.Next() {
 		var val types.LimitOrderExpiration
 		k.cdc.MustUnmarshal(iterator.Value(), &val)
 		if val.ExpirationTime.After(curTime) {
-			return
+			return false
 		}
 
 		inGoodTilSegment = inGoodTilSegment || val.ExpirationTime != types.JITGoodTilTime()
@@ -138,23 +266,67 @@ func (k Keeper) PurgeExpiredLimitOrders(ctx sdk.Context, curTime time.Time) {
 			// canceled in a single block.
 			ctx.EventManager().EmitEvent(types.GoodTilPurgeHitLimitEvent(gasConsumed))
 
+			return true
+		}
+
+		k.purgeExpiredTranche(ctx, val.TrancheRef, archivedTranches)
+		k.RemoveLimitOrderExpirationByKey(ctx, iterator.Key())
+	}
+
+	return false
+}
+
+func (k Keeper) purgeHeightExpiredLimitOrders(
+	ctx sdk.Context,
+	gasCutoff uint64,
+	archivedTranches map[string]bool,
+) {
+	store := prefix.NewStore(
+		ctx.KVStore(k.storeKey),
+		types.KeyPrefix(types.LimitOrderHeightExpirationKeyPrefix),
+	)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	curHeight := uint64(ctx.BlockHeight()) //nolint:gosec
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LimitOrderHeightExpiration
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if val.ExpirationHeight > curHeight {
 			return
 		}
-		var pairID types.TradePairID
-		if _, ok := archivedTranches[string(val.TrancheRef)]; !ok {
-			tranche, found := k.GetLimitOrderTrancheByKey(ctx, val.TrancheRef)
-			if found {
-				// Convert the tranche to an inactiveTranche
-				k.SetInactiveLimitOrderTranche(ctx, tranche)
-				k.RemoveLimitOrderTranche(ctx, tranche.Key)
-				archivedTranches[string(val.TrancheRef)] = true
-
-				pairID = *tranche.Key.TradePairId
-				ctx.EventManager().EmitEvent(types.CreateTickUpdateLimitOrderTranchePurge(tranche))
-			}
+
+		// GOOD_TIL_BLOCK orders are treated as expired by the liquidity iterator as soon as their height is reached,
+		// so they can safely be left for a later block once the gas cutoff is hit.
+		gasConsumed := ctx.GasMeter().GasConsumed()
+		if gasConsumed >= gasCutoff {
+			ctx.EventManager().EmitEvent(types.GoodTilPurgeHitLimitEvent(gasConsumed))
+
+			return
 		}
 
-		k.RemoveLimitOrderExpirationByKey(ctx, iterator.Key())
-		ctx.EventManager().EmitEvents(types.GetEventsDecExpiringOrders(&pairID))
+		k.purgeExpiredTranche(ctx, val.TrancheRef, archivedTranches)
+		k.RemoveLimitOrderHeightExpirationByKey(ctx, iterator.Key())
 	}
 }
+
+func (k Keeper) purgeExpiredTranche(ctx sdk.Context, trancheRef []byte, archivedTranches map[string]bool) {
+	var pairID types.TradePairID
+	if _, ok := archivedTranches[string(trancheRef)]; !ok {
+		tranche, found := k.GetLimitOrderTrancheByKey(ctx, trancheRef)
+		if found {
+			// Convert the tranche to an inactiveTranche
+			k.SetInactiveLimitOrderTranche(ctx, tranche)
+			k.RemoveLimitOrderTranche(ctx, tranche.Key)
+			archivedTranches[string(trancheRef)] = true
+
+			pairID = *tranche.Key.TradePairId
+			k.MarkPriceAccumulatorDirty(ctx, tranche.Key.TradePairId)
+			ctx.EventManager().EmitEvent(types.CreateTickUpdateLimitOrderTranchePurge(tranche))
+			k.QueueAutoWithdrawTranche(ctx, tranche.Key.TrancheKey)
+			k.Hooks().AfterTrancheExpired(ctx, tranche)
+		}
+	}
+
+	ctx.EventManager().EmitEvents(types.GetEventsDecExpiringOrders(&pairID))
+}
@@ -61,6 +61,33 @@ func createLimitOrderExpirationAndTranches(
 	}
 }
 
+func createLimitOrderHeightExpirationAndTranches(
+	keeper *keeper.Keeper,
+	ctx sdk.Context,
+	expHeights []uint64,
+) {
+	for i, expHeight := range expHeights {
+		tranche := &types.LimitOrderTranche{
+			Key: &types.LimitOrderTrancheKey{
+				TradePairId: &types.TradePairID{
+					MakerDenom: "TokenA",
+					TakerDenom: "TokenB",
+				},
+				TickIndexTakerToMaker: 0,
+				TrancheKey:            "height" + strconv.Itoa(i),
+			},
+			ReservesMakerDenom: math.NewInt(10),
+			ReservesTakerDenom: math.NewInt(10),
+			TotalMakerDenom:    math.NewInt(10),
+			TotalTakerDenom:    math.NewInt(10),
+			ExpirationHeight:   expHeight,
+		}
+
+		keeper.SetTrancheExpiration(ctx, tranche)
+		keeper.SetLimitOrderTranche(ctx, tranche)
+	}
+}
+
 // Sets a new purge allowance
 func SetPurgeAllowance(
 	keeper *keeper.Keeper,
@@ -232,3 +259,54 @@ func (s *DexTestSuite) TestPurgeExpiredLimitOrdersAtBlockGasLimitOnlyJIT() {
 	// AND GoodTilPurgeHitGasLimit event is not been emitted
 	s.AssertEventValueNotEmitted(types.EventTypeGoodTilPurgeHitGasLimit, "Hit gas limit purging JIT expirations")
 }
+
+func (s *DexTestSuite) TestPurgeHeightExpiredLimitOrders() {
+	keeper := s.App.DexKeeper
+	now := time.Now().UTC()
+	ctx := s.Ctx.WithBlockTime(now).WithBlockHeight(10)
+
+	createLimitOrderHeightExpirationAndTranches(&keeper, ctx, []uint64{9, 10, 11})
+	createLimitOrderExpirationAndTranches(&keeper, ctx, []time.Time{now.AddDate(0, 0, -1)})
+
+	keeper.PurgeExpiredLimitOrders(ctx, now)
+
+	// Only future LimitOrderHeightExpiration items still exist
+	expList := keeper.GetAllLimitOrderHeightExpiration(ctx)
+	s.Equal(1, len(expList))
+	s.Equal(uint64(11), expList[0].ExpirationHeight)
+	s.Empty(keeper.GetAllLimitOrderExpiration(ctx))
+
+	// Only the future LimitOrderTranche exists
+	trancheList := keeper.GetAllLimitOrderTrancheAtIndex(ctx, defaultTradePairID1To0, 0)
+	s.Equal(1, len(trancheList))
+	s.Equal(uint64(11), trancheList[0].ExpirationHeight)
+
+	// InactiveLimitOrderTranches have been created for the expired tranches
+	s.Equal(3, len(keeper.GetAllInactiveLimitOrderTranche(ctx)))
+}
+
+func (s *DexTestSuite) TestPurgeHeightExpiredLimitOrdersAtBlockGasLimit() {
+	keeper := s.App.DexKeeper
+	now := time.Now().UTC()
+	ctx := s.Ctx.WithBlockTime(now).WithBlockHeight(10)
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	yesterday := now.AddDate(0, 0, -1)
+	createLimitOrderExpirationAndTranches(&keeper, ctx, []time.Time{yesterday, yesterday})
+	createLimitOrderHeightExpirationAndTranches(&keeper, ctx, []uint64{9, 9})
+
+	// WHEN PurgeExpiredLimitOrders is run with a gas limit only big enough to purge 3 LOs
+	err := SetPurgeAllowance(&keeper, ctx, 3*gasRequiredToPurgeOneLO)
+	s.NoError(err)
+
+	keeper.PurgeExpiredLimitOrders(ctx, now)
+
+	// THEN GoodTilPurgeHitGasLimit event is emitted
+	s.AssertEventEmitted(ctx, types.EventTypeGoodTilPurgeHitGasLimit, 1)
+
+	// AND the time based expirations are purged before the height based expirations
+	s.Empty(keeper.GetAllLimitOrderExpiration(ctx))
+	expList := keeper.GetAllLimitOrderHeightExpiration(ctx)
+	// NOTE: like TestPurgeExpiredLimitOrdersAtBlockGasLimit this relies on an estimated cost for deleting expirations
+	s.Equal(1, len(expList))
+}
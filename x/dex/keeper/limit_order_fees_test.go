@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) setLimitOrderFees(takerFeeBps, makerRebateBps uint64) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.LimitOrderTakerFeeBps = takerFeeBps
+	params.LimitOrderMakerRebateBps = makerRebateBps
+	_, err := s.msgServer.UpdateParams(s.Ctx, &types.MsgUpdateParams{Params: params, Authority: s.App.DexKeeper.GetAuthority()})
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) bobTakesTokenB(amountIn int64) *types.MsgPlaceLimitOrderResponse {
+	resp, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.bob.String(),
+		Receiver:         s.bob.String(),
+		TokenIn:          "TokenA",
+		TokenOut:         "TokenB",
+		TickIndexInToOut: 0,
+		AmountIn:         sdkmath.NewInt(amountIn),
+		OrderType:        types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+	})
+	s.NoError(err)
+
+	return resp
+}
+
+func (s *DexTestSuite) TestLimitOrderTakerFeeChargedOnFill() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(20, 0)
+	s.setLimitOrderFees(100, 40)
+
+	// GIVEN alice sells 10 TokenB at price 1
+	trancheKey := s.aliceLimitSells("TokenB", 0, 10)
+
+	// WHEN bob buys all of it paying a 1% taker fee
+	resp := s.bobTakesTokenB(10_100_000)
+
+	// THEN the fee is charged on top of the fill
+	s.True(resp.TakerCoinIn.Amount.Equal(sdkmath.NewInt(10_100_000)), "taker coin in: actual %s", resp.TakerCoinIn)
+	s.True(resp.TakerCoinOut.Amount.Equal(sdkmath.NewInt(10_000_000)), "taker coin out: actual %s", resp.TakerCoinOut)
+	s.Equal("TokenA", resp.TakerFee.Denom)
+	s.True(resp.TakerFee.Amount.Equal(sdkmath.NewInt(100_000)), "taker fee: actual %s", resp.TakerFee)
+	s.assertBobBalancesInt(sdkmath.NewInt(9_900_000), sdkmath.NewInt(10_000_000))
+
+	// AND the protocol keeps the fee net of the maker rebate
+	s.True(s.getProtocolFees(defaultPairID).AmountOf("TokenA").Equal(sdkmath.NewInt(60_000)))
+
+	// WHEN alice withdraws her filled order
+	s.aliceWithdrawsLimitSell(trancheKey)
+
+	// THEN she receives the maker rebate with her proceeds
+	s.assertAliceBalancesInt(sdkmath.NewInt(10_040_000), sdkmath.ZeroInt())
+	s.assertDexBalancesInt(sdkmath.NewInt(60_000), sdkmath.ZeroInt())
+}
+
+func (s *DexTestSuite) TestLimitOrderMakerRebatePaidOnCancel() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(20, 0)
+	s.setLimitOrderFees(100, 40)
+
+	// GIVEN alice sells 10 TokenB at price 1
+	trancheKey := s.aliceLimitSells("TokenB", 0, 10)
+
+	// AND bob buys half of it
+	resp := s.bobTakesTokenB(5_050_000)
+	s.True(resp.TakerFee.Amount.Equal(sdkmath.NewInt(50_000)), "taker fee: actual %s", resp.TakerFee)
+
+	// WHEN alice cancels her order
+	s.aliceCancelsLimitSell(trancheKey)
+
+	// THEN she receives her unfilled TokenB and the filled TokenA plus the maker rebate
+	s.assertAliceBalancesInt(sdkmath.NewInt(5_020_000), sdkmath.NewInt(5_000_000))
+	s.assertDexBalancesInt(sdkmath.NewInt(30_000), sdkmath.ZeroInt())
+}
+
+func (s *DexTestSuite) TestLimitOrderTakerFeeNotChargedOnPools() {
+	s.fundAliceBalances(0, 10)
+	s.fundBobBalances(20, 0)
+	s.setLimitOrderFees(100, 40)
+
+	// GIVEN TokenB liquidity in a pool
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+
+	// WHEN bob swaps against it
+	s.bobMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 5, math_utils.MustNewPrecDecFromStr("0.9"), false)
+
+	// THEN no limit order taker fee is taken
+	s.True(s.getProtocolFees(defaultPairID).IsZero())
+}
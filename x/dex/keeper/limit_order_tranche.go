@@ -77,7 +77,14 @@ func (k Keeper) UpdateTranche(ctx sdk.Context, tranche *types.LimitOrderTranche,
 		ctx.EventManager().EmitEvents(types.GetEventsDecTotalOrders(tranche.Key.TradePairId))
 	}
 
+	k.MarkPriceAccumulatorDirty(ctx, tranche.Key.TradePairId)
 	ctx.EventManager().EmitEvent(types.CreateTickUpdateLimitOrderTranche(tranche, swapMetadata...))
+
+	// Only swaps pass swapMetadata, so a tranche without TokenIn has just been filled
+	if len(swapMetadata) > 0 && !tranche.HasTokenIn() {
+		k.QueueAutoWithdrawTranche(ctx, tranche.Key.TrancheKey)
+		k.queueFilledTranche(ctx, tranche)
+	}
 }
 
 func (k Keeper) SetLimitOrderTranche(ctx sdk.Context, tranche *types.LimitOrderTranche) {
@@ -222,6 +229,7 @@ func (k Keeper) GetOrInitPlaceTranche(ctx sdk.Context,
 	tradePairID *types.TradePairID,
 	tickIndexTakerToMaker int64,
 	goodTil *time.Time,
+	goodTilHeight uint64,
 	orderType types.LimitOrderType,
 ) (placeTranche *types.LimitOrderTranche, err error) {
 	// NOTE: Right now we are not indexing by goodTil date so we can't easily check if there's already a tranche
@@ -248,6 +256,17 @@ func (k Keeper) GetOrInitPlaceTranche(ctx sdk.Context,
 		}
 		placeTranche, err = NewLimitOrderTranche(limitOrderTrancheKey, goodTil)
 		ctx.EventManager().EmitEvents(types.GetEventsIncExpiringOrders(tradePairID))
+	case types.LimitOrderType_GOOD_TIL_BLOCK:
+		limitOrderTrancheKey := &types.LimitOrderTrancheKey{
+			TradePairId:           tradePairID,
+			TickIndexTakerToMaker: tickIndexTakerToMaker,
+			TrancheKey:            NewTrancheKey(ctx),
+		}
+		placeTranche, err = NewLimitOrderTranche(limitOrderTrancheKey, nil)
+		if err == nil {
+			placeTranche.ExpirationHeight = goodTilHeight
+		}
+		ctx.EventManager().EmitEvents(types.GetEventsIncExpiringOrders(tradePairID))
 	default:
 		placeTranche = k.GetGTCPlaceTranche(ctx, tradePairID, tickIndexTakerToMaker)
 		if placeTranche == nil {
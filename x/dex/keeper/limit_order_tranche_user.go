@@ -42,6 +42,10 @@ func (k Keeper) SetLimitOrderTrancheUser(ctx sdk.Context, limitOrderTrancheUser
 		limitOrderTrancheUser.Address,
 		limitOrderTrancheUser.TrancheKey,
 	), b)
+
+	if limitOrderTrancheUser.AutoWithdraw {
+		k.SetAutoWithdrawOrder(ctx, limitOrderTrancheUser.TrancheKey, limitOrderTrancheUser.Address)
+	}
 }
 
 // GetLimitOrderTrancheUser returns a LimitOrderTrancheUser from its index
@@ -85,6 +89,10 @@ func (k Keeper) RemoveLimitOrderTrancheUser(ctx sdk.Context, trancheUser *types.
 		trancheUser.TrancheKey,
 		trancheUser.Address,
 	)
+
+	if trancheUser.AutoWithdraw {
+		k.RemoveAutoWithdrawOrder(ctx, trancheUser.TrancheKey, trancheUser.Address)
+	}
 }
 
 // UpdateTrancheUser handles the logic for all updates to LimitOrderTrancheUsers in the KV Store.
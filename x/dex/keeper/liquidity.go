@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -8,13 +9,136 @@ import (
 	"github.com/neutron-org/neutron/v5/x/dex/types"
 )
 
+// Swap consumes liquidity from the tradePairID orderbook. The guards of the pair are applied around the swap:
+// assertCanSwap and beforeSwap run before any liquidity is consumed and afterSwap checks the result.
+// Swaps that would consume liquidity of a pair in batch auction mode fail. Only taker limit orders are queued on
+// such pairs; multi-hop, flash and maker limit order swaps are not queued since they complete within their tx.
+// Fees charged on top of the amount swapped are reported separately in the result and, like the price of each tick,
+// limitPrice excludes them.
 func (k Keeper) Swap(
 	ctx sdk.Context,
 	tradePairID *types.TradePairID,
 	maxAmountTakerDenom math.Int,
 	maxAmountMakerDenom *math.Int,
 	limitPrice *math_utils.PrecDec,
-) (totalTakerCoin, totalMakerCoin sdk.Coin, orderFilled bool, err error) {
+) (result types.SwapResult, err error) {
+	if err := k.assertCanSwap(ctx, tradePairID, limitPrice); err != nil {
+		return types.SwapResult{}, err
+	}
+
+	guard := k.beforeSwap(ctx, tradePairID, limitPrice)
+	result = k.swapLiquidity(ctx, tradePairID, maxAmountTakerDenom, maxAmountMakerDenom, guard.limitPrice)
+	if err := k.afterSwap(ctx, guard, result); err != nil {
+		return types.SwapResult{}, err
+	}
+
+	return result, nil
+}
+
+// assertCanSwap returns an error if the pair is paused or if a swap would take liquidity of a pair in batch auction
+// mode. Swaps that cannot reach any liquidity are still allowed so that maker orders can rest behind the orderbook.
+func (k Keeper) assertCanSwap(ctx sdk.Context, tradePairID *types.TradePairID, limitPrice *math_utils.PrecDec) error {
+	pairID := tradePairID.MustPairID()
+	if err := k.AssertPairNotPaused(ctx, pairID); err != nil {
+		return err
+	}
+
+	if k.IsPairBatchAuction(ctx, pairID) {
+		tickIndex, found := k.GetCurrTickIndexTakerToMaker(ctx, tradePairID)
+		if found && (limitPrice == nil || !types.MustCalcPrice(tickIndex).GT(*limitPrice)) {
+			return sdkerrors.Wrapf(types.ErrPairInBatchAuction, "pair %s", pairID.CanonicalString())
+		}
+	}
+
+	return nil
+}
+
+// swapGuard is the state of a TradePairID captured before a swap that is needed to apply its guards afterwards
+type swapGuard struct {
+	tradePairID *types.TradePairID
+	// limitPrice is the caller's limit price bounded by the oracle price guard of the pair
+	limitPrice     *math_utils.PrecDec
+	userLimitPrice *math_utils.PrecDec
+	oracleGuard    types.OraclePriceGuard
+	oracleMaxPrice math_utils.PrecDec
+	oracleGuarded  bool
+	// tickIndexBefore is only captured if the circuit breaker or the dynamic fee of the pair needs it
+	tickIndexBefore       int64
+	hasLiquidity          bool
+	circuitBreakerEnabled bool
+	dynamicFee            *types.DynamicFee
+}
+
+// beforeSwap captures the best tick of tradePairID and bounds limitPrice by the oracle price guard of the pair so that
+// no liquidity priced beyond the oracle bound is consumed
+func (k Keeper) beforeSwap(ctx sdk.Context, tradePairID *types.TradePairID, limitPrice *math_utils.PrecDec) swapGuard {
+	params := k.GetParams(ctx)
+	guard := swapGuard{
+		tradePairID:           tradePairID,
+		limitPrice:            limitPrice,
+		userLimitPrice:        limitPrice,
+		circuitBreakerEnabled: params.CircuitBreakerMaxTickMove > 0,
+	}
+
+	if params.DynamicFeeEnabled() {
+		guard.dynamicFee, _ = k.GetDynamicFee(ctx, tradePairID.MustPairID())
+	}
+	if guard.circuitBreakerEnabled || guard.dynamicFee != nil {
+		guard.tickIndexBefore, guard.hasLiquidity = k.GetCurrTickIndexTakerToMaker(ctx, tradePairID)
+	}
+
+	guard.oracleGuard, guard.oracleMaxPrice, guard.oracleGuarded = k.GetOraclePriceBound(ctx, params, tradePairID)
+	if guard.oracleGuarded && (limitPrice == nil || guard.oracleMaxPrice.LT(*limitPrice)) {
+		guard.limitPrice = &guard.oracleMaxPrice
+	}
+
+	return guard
+}
+
+// afterSwap rejects swaps that were stopped by an oracle price guard with the REJECT action or that moved the price
+// further than the circuit breaker allows, and records the tick move of the pair for its dynamic fee
+func (k Keeper) afterSwap(ctx sdk.Context, guard swapGuard, result types.SwapResult) error {
+	if guard.oracleGuarded && guard.oracleGuard.Action == types.OraclePriceGuardAction_REJECT && !result.OrderFilled {
+		// The swap stopped at the oracle bound if the next liquidity is beyond it but within the caller's limit price
+		if liq := k.GetCurrLiq(ctx, guard.tradePairID); liq != nil {
+			price := liq.Price()
+			if price.GT(guard.oracleMaxPrice) && (guard.userLimitPrice == nil || !price.GT(*guard.userLimitPrice)) {
+				return sdkerrors.Wrapf(
+					types.ErrOraclePriceDeviation,
+					"price %s exceeds oracle bound %s",
+					price,
+					guard.oracleMaxPrice,
+				)
+			}
+		}
+	}
+
+	if guard.hasLiquidity && guard.circuitBreakerEnabled {
+		if err := k.UpdateCircuitBreaker(ctx, guard.tradePairID, guard.tickIndexBefore); err != nil {
+			return err
+		}
+	}
+	k.recordSwapTickMove(ctx, guard)
+
+	return nil
+}
+
+// recordSwapTickMove records the tick move of a swap for the dynamic fee of the pair
+func (k Keeper) recordSwapTickMove(ctx sdk.Context, guard swapGuard) {
+	if guard.hasLiquidity && guard.dynamicFee != nil {
+		k.RecordDynamicFeeTickMove(ctx, guard.dynamicFee, guard.tradePairID, guard.tickIndexBefore)
+	}
+}
+
+// swapLiquidity consumes liquidity from the tradePairID orderbook up to limitPrice without applying any of the guards
+// of the pair
+func (k Keeper) swapLiquidity(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	maxAmountTakerDenom math.Int,
+	maxAmountMakerDenom *math.Int,
+	limitPrice *math_utils.PrecDec,
+) types.SwapResult {
 	gasBefore := ctx.GasMeter().GasConsumed()
 	useMaxOut := maxAmountMakerDenom != nil
 	var remainingMakerDenom *math.Int
@@ -25,7 +149,9 @@ func (k Keeper) Swap(
 
 	remainingTakerDenom := maxAmountTakerDenom
 	totalMakerDenom := math.ZeroInt()
-	orderFilled = false
+	totalTakerFee := math.ZeroInt()
+	totalDynamicFee := math.ZeroInt()
+	orderFilled := false
 
 	// verify that amount left is not zero and that there are additional valid ticks to check
 	liqIter := k.NewLiquidityIterator(ctx, tradePairID)
@@ -48,8 +174,30 @@ func (k Keeper) Swap(
 			AmountOut: outAmount,
 			TokenIn:   tradePairID.TakerDenom,
 		}
+		trancheLiq, isTranche := liq.(*types.LimitOrderTrancheLiquidity)
+		if isTranche {
+			swapMetadata.TakerFee = trancheLiq.TakerFee
+			swapMetadata.MakerRebate = trancheLiq.MakerRebate
+			totalTakerFee = totalTakerFee.Add(trancheLiq.TakerFee)
+		}
+		poolLiq, isPool := liq.(*types.PoolLiquidity)
+		if isPool && poolLiq.DynamicFeeBps > 0 {
+			swapMetadata.DynamicFee = poolLiq.DynamicFee
+			totalDynamicFee = totalDynamicFee.Add(poolLiq.DynamicFee)
+		}
 		k.SaveLiquidity(ctx, liq, swapMetadata)
 
+		switch liq := liq.(type) {
+		case *types.PoolLiquidity:
+			if liq.ProtocolFee.IsPositive() {
+				k.AccrueProtocolFee(ctx, tradePairID, sdk.NewCoin(tradePairID.TakerDenom, liq.ProtocolFee))
+			}
+		case *types.LimitOrderTrancheLiquidity:
+			if protocolFee := liq.ProtocolFee(); protocolFee.IsPositive() {
+				k.AccrueProtocolFee(ctx, tradePairID, sdk.NewCoin(tradePairID.TakerDenom, protocolFee))
+			}
+		}
+
 		remainingTakerDenom = remainingTakerDenom.Sub(inAmount)
 		totalMakerDenom = totalMakerDenom.Add(outAmount)
 
@@ -82,13 +230,13 @@ func (k Keeper) Swap(
 	gasAfter := ctx.GasMeter().GasConsumed()
 	ctx.EventManager().EmitEvents(types.GetEventsGasConsumed(gasBefore, gasAfter))
 
-	return sdk.NewCoin(
-			tradePairID.TakerDenom,
-			totalTakerDenom,
-		), sdk.NewCoin(
-			tradePairID.MakerDenom,
-			totalMakerDenom,
-		), orderFilled, nil
+	return types.SwapResult{
+		TotalIn:     sdk.NewCoin(tradePairID.TakerDenom, totalTakerDenom),
+		TotalOut:    sdk.NewCoin(tradePairID.MakerDenom, totalMakerDenom),
+		TakerFee:    sdk.NewCoin(tradePairID.TakerDenom, totalTakerFee),
+		DynamicFee:  sdk.NewCoin(tradePairID.TakerDenom, totalDynamicFee),
+		OrderFilled: orderFilled,
+	}
 }
 
 func (k Keeper) SwapWithCache(
@@ -97,32 +245,64 @@ func (k Keeper) SwapWithCache(
 	maxAmountIn math.Int,
 	maxAmountOut *math.Int,
 	limitPrice *math_utils.PrecDec,
-) (totalIn, totalOut sdk.Coin, orderFilled bool, err error) {
+) (result types.SwapResult, err error) {
+	return k.SwapWithCallback(ctx, tradePairID, maxAmountIn, maxAmountOut, limitPrice, nil)
+}
+
+// SwapWithCallback performs a Swap in a cached context and calls callback with the result of the swap before the
+// cache is written. If callback returns an error nothing is written and the error is returned.
+func (k Keeper) SwapWithCallback(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	maxAmountIn math.Int,
+	maxAmountOut *math.Int,
+	limitPrice *math_utils.PrecDec,
+	callback func(cacheCtx sdk.Context, totalIn, totalOut sdk.Coin) error,
+) (result types.SwapResult, err error) {
 	cacheCtx, writeCache := ctx.CacheContext()
-	totalIn, totalOut, orderFilled, err = k.Swap(
+	result, err = k.Swap(
 		cacheCtx,
 		tradePairID,
 		maxAmountIn,
 		maxAmountOut,
 		limitPrice,
 	)
+	if err != nil {
+		return types.SwapResult{}, err
+	}
+
+	if callback != nil {
+		if err := callback(cacheCtx, result.TotalIn, result.TotalOut); err != nil {
+			return types.SwapResult{}, err
+		}
+	}
 
 	writeCache()
 
-	return totalIn, totalOut, orderFilled, err
+	return result, nil
 }
 
 func (k Keeper) SaveLiquidity(sdkCtx sdk.Context, liquidityI types.Liquidity, swapMetadata ...types.SwapMetadata) {
+	var tradePairID *types.TradePairID
 	switch liquidity := liquidityI.(type) {
 	case *types.LimitOrderTranche:
 		// If there is still makerReserves we will save the tranche as active, if not, we will move it to inactive
 		k.UpdateTranche(sdkCtx, liquidity, swapMetadata...)
+		tradePairID = liquidity.Key.TradePairId
+	case *types.LimitOrderTrancheLiquidity:
+		k.UpdateTranche(sdkCtx, liquidity.Tranche, swapMetadata...)
+		tradePairID = liquidity.Tranche.Key.TradePairId
 	case *types.PoolLiquidity:
 		// Save updated to both sides of the pool. If one of the sides is empty it will be deleted
 		k.UpdatePool(sdkCtx, liquidity.Pool, swapMetadata...)
+		tradePairID = liquidity.TradePairID
 	default:
 		panic("Invalid liquidity type")
 	}
+
+	if len(swapMetadata) > 0 {
+		k.RecordSwapCandle(sdkCtx, tradePairID, swapMetadata[0])
+	}
 }
 
 // Wrapper for taker LimitOrders
@@ -135,8 +315,8 @@ func (k Keeper) TakerLimitOrderSwap(
 	limitPrice math_utils.PrecDec,
 	minAvgSellPrice math_utils.PrecDec,
 	orderType types.LimitOrderType,
-) (totalInCoin, totalOutCoin sdk.Coin, err error) {
-	totalInCoin, totalOutCoin, orderFilled, err := k.SwapWithCache(
+) (result types.SwapResult, err error) {
+	result, err = k.SwapWithCache(
 		ctx,
 		&tradePairID,
 		amountIn,
@@ -144,24 +324,25 @@ func (k Keeper) TakerLimitOrderSwap(
 		&limitPrice,
 	)
 	if err != nil {
-		return sdk.Coin{}, sdk.Coin{}, err
+		return types.SwapResult{}, err
 	}
 
-	if orderType.IsFoK() && !orderFilled {
-		return sdk.Coin{}, sdk.Coin{}, types.ErrFoKLimitOrderNotFilled
+	if orderType.IsFoK() && !result.OrderFilled {
+		return types.SwapResult{}, types.ErrFoKLimitOrderNotFilled
 	}
 
-	if totalInCoin.Amount.IsZero() {
-		return sdk.Coin{}, sdk.Coin{}, types.ErrNoLiquidity
+	if result.TotalIn.Amount.IsZero() {
+		return types.SwapResult{}, types.ErrNoLiquidity
 	}
 
-	truePrice := math_utils.NewPrecDecFromInt(totalOutCoin.Amount).QuoInt(totalInCoin.Amount)
+	// Fees are reported separately so they are excluded from the price check
+	truePrice := math_utils.NewPrecDecFromInt(result.TotalOut.Amount).QuoInt(result.AmountSwapped())
 
 	if truePrice.LT(minAvgSellPrice) {
-		return sdk.Coin{}, sdk.Coin{}, types.ErrLimitPriceNotSatisfied
+		return types.SwapResult{}, types.ErrLimitPriceNotSatisfied
 	}
 
-	return totalInCoin, totalOutCoin, nil
+	return result, nil
 }
 
 // Wrapper for maker LimitOrders
@@ -172,8 +353,8 @@ func (k Keeper) MakerLimitOrderSwap(
 	amountIn math.Int,
 	limitPrice math_utils.PrecDec,
 	minAvgSellPrice math_utils.PrecDec,
-) (totalInCoin, totalOutCoin sdk.Coin, filled bool, err error) {
-	totalInCoin, totalOutCoin, filled, err = k.SwapWithCache(
+) (result types.SwapResult, err error) {
+	result, err = k.SwapWithCache(
 		ctx,
 		&tradePairID,
 		amountIn,
@@ -181,19 +362,20 @@ func (k Keeper) MakerLimitOrderSwap(
 		&limitPrice,
 	)
 	if err != nil {
-		return sdk.Coin{}, sdk.Coin{}, filled, err
+		return types.SwapResult{}, err
 	}
 
-	if totalInCoin.Amount.IsPositive() {
-		remainingIn := amountIn.Sub(totalInCoin.Amount)
+	if result.TotalIn.Amount.IsPositive() {
+		remainingIn := amountIn.Sub(result.TotalIn.Amount)
 		expectedOutMakerPortion := math_utils.NewPrecDecFromInt(remainingIn).Quo(limitPrice)
-		totalExpectedOut := expectedOutMakerPortion.Add(math_utils.NewPrecDecFromInt(totalOutCoin.Amount))
-		truePrice := totalExpectedOut.QuoInt(amountIn)
+		totalExpectedOut := expectedOutMakerPortion.Add(math_utils.NewPrecDecFromInt(result.TotalOut.Amount))
+		// Fees are reported separately so they are excluded from the price check
+		truePrice := totalExpectedOut.QuoInt(remainingIn.Add(result.AmountSwapped()))
 
 		if truePrice.LT(minAvgSellPrice) {
-			return sdk.Coin{}, sdk.Coin{}, false, types.ErrLimitPriceNotSatisfied
+			return types.SwapResult{}, types.ErrLimitPriceNotSatisfied
 		}
 	}
 
-	return totalInCoin, totalOutCoin, filled, nil
+	return result, nil
 }
@@ -11,17 +11,28 @@ type LiquidityIterator struct {
 	tradePairID *types.TradePairID
 	ctx         sdk.Context
 	iter        TickIterator
+	params      types.Params
+	// dynamicFeeBps is charged on top of the fee tier by pools of the dynamic fee tier
+	dynamicFeeBps uint64
 }
 
 func (k Keeper) NewLiquidityIterator(
 	ctx sdk.Context,
 	tradePairID *types.TradePairID,
 ) *LiquidityIterator {
+	params := k.GetParams(ctx)
+	var dynamicFeeBps uint64
+	if params.DynamicFeeEnabled() {
+		dynamicFeeBps = k.GetDynamicFeeBps(ctx, params, tradePairID.MustPairID()) - params.DynamicFeeTier
+	}
+
 	return &LiquidityIterator{
-		iter:        k.NewTickIterator(ctx, tradePairID),
-		keeper:      &k,
-		ctx:         ctx,
-		tradePairID: tradePairID,
+		iter:          k.NewTickIterator(ctx, tradePairID),
+		keeper:        &k,
+		ctx:           ctx,
+		tradePairID:   tradePairID,
+		params:        params,
+		dynamicFeeBps: dynamicFeeBps,
 	}
 }
 
@@ -72,12 +83,18 @@ func (s *LiquidityIterator) WrapTickLiquidity(tick types.TickLiquidity) types.Li
 			lowerTick0 = poolReserves
 			upperTick1 = counterpartReserves
 		}
+		var dynamicFeeBps uint64
+		if s.params.IsDynamicFeeTier(poolReserves.Key.Fee) {
+			dynamicFeeBps = s.dynamicFeeBps
+		}
 		return &types.PoolLiquidity{
 			TradePairID: s.tradePairID,
 			Pool: &types.Pool{
 				LowerTick0: lowerTick0,
 				UpperTick1: upperTick1,
 			},
+			ProtocolFeeFraction: s.params.ProtocolFeeFraction(poolReserves.Key.Fee),
+			DynamicFeeBps:       dynamicFeeBps,
 		}
 
 	case *types.TickLiquidity_LimitOrderTranche:
@@ -87,7 +104,11 @@ func (s *LiquidityIterator) WrapTickLiquidity(tick types.TickLiquidity) types.Li
 			return nil
 		}
 
-		return tranche
+		return &types.LimitOrderTrancheLiquidity{
+			Tranche:        tranche,
+			TakerFeeBps:    s.params.LimitOrderTakerFeeBps,
+			MakerRebateBps: s.params.LimitOrderMakerRebateBps,
+		}
 
 	default:
 		panic("Tick does not have liquidity")
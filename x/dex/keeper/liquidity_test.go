@@ -600,6 +600,7 @@ func (s *DexTestSuite) placeGTCLimitOrder(
 		tradePairID,
 		tickIndexTakerToMaker,
 		nil,
+		0,
 		types.LimitOrderType_GOOD_TIL_CANCELLED,
 	)
 	s.Assert().NoError(err)
@@ -614,13 +615,15 @@ func (s *DexTestSuite) swapInt(
 ) (coinIn, coinOut sdk.Coin, filled bool, err error) {
 	tradePairID, err := types.NewTradePairID(tokenIn, tokenOut)
 	s.Assert().NoError(err)
-	return s.App.DexKeeper.Swap(
+	result, err := s.App.DexKeeper.Swap(
 		s.Ctx,
 		tradePairID,
 		maxAmountIn,
 		nil,
 		nil,
 	)
+
+	return result.TotalIn, result.TotalOut, result.OrderFilled, err
 }
 
 func (s *DexTestSuite) swapSuccess(
@@ -641,7 +644,7 @@ func (s *DexTestSuite) swapWithMaxOut(
 ) (coinIn, coinOut sdk.Coin) {
 	tradePairID := types.MustNewTradePairID(tokenIn, tokenOut)
 	maxAmountOutInt := sdkmath.NewInt(maxAmountOut).Mul(denomMultiple)
-	coinIn, coinOut, _, err := s.App.DexKeeper.Swap(
+	result, err := s.App.DexKeeper.Swap(
 		s.Ctx,
 		tradePairID,
 		sdkmath.NewInt(maxAmountIn).Mul(denomMultiple),
@@ -650,7 +653,7 @@ func (s *DexTestSuite) swapWithMaxOut(
 	)
 	s.Assert().NoError(err)
 
-	return coinIn, coinOut
+	return result.TotalIn, result.TotalOut
 }
 
 func (s *DexTestSuite) assertSwapOutputInt(
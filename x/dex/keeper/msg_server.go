@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -36,6 +37,13 @@ func (k MsgServer) Deposit(
 		return nil, err
 	}
 
+	return k.deposit(goCtx, msg)
+}
+
+func (k MsgServer) deposit(
+	goCtx context.Context,
+	msg *types.MsgDeposit,
+) (*types.MsgDepositResponse, error) {
 	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
 	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
 
@@ -84,6 +92,13 @@ func (k MsgServer) Withdrawal(
 		return nil, err
 	}
 
+	return k.withdrawal(goCtx, msg)
+}
+
+func (k MsgServer) withdrawal(
+	goCtx context.Context,
+	msg *types.MsgWithdrawal,
+) (*types.MsgWithdrawalResponse, error) {
 	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
 	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
 
@@ -126,12 +141,19 @@ func (k MsgServer) PlaceLimitOrder(
 		return nil, err
 	}
 
+	return k.placeLimitOrder(goCtx, msg)
+}
+
+func (k MsgServer) placeLimitOrder(
+	goCtx context.Context,
+	msg *types.MsgPlaceLimitOrder,
+) (*types.MsgPlaceLimitOrderResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
 	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
 
-	err := msg.ValidateGoodTilExpiration(ctx.BlockTime())
+	err := msg.ValidateGoodTilExpiration(ctx.BlockTime(), ctx.BlockHeight())
 	if err != nil {
 		return &types.MsgPlaceLimitOrderResponse{}, err
 	}
@@ -143,7 +165,65 @@ func (k MsgServer) PlaceLimitOrder(
 			return &types.MsgPlaceLimitOrderResponse{}, errors.Wrapf(err, "invalid LimitSellPrice %s", msg.LimitSellPrice.String())
 		}
 	}
-	trancheKey, coinIn, swapInCoin, coinOutSwap, err := k.PlaceLimitOrderCore(
+
+	if msg.OraclePeg != nil {
+		trancheKey, coinIn, swapInCoin, coinOutSwap, takerFee, err := k.PlacePeggedLimitOrderCore(
+			goCtx,
+			msg.TokenIn,
+			msg.TokenOut,
+			msg.AmountIn,
+			*msg.OraclePeg,
+			msg.AutoWithdraw,
+			callerAddr,
+			receiverAddr,
+		)
+		if err != nil {
+			return &types.MsgPlaceLimitOrderResponse{}, err
+		}
+
+		return &types.MsgPlaceLimitOrderResponse{
+			TrancheKey:   trancheKey,
+			CoinIn:       coinIn,
+			TakerCoinOut: coinOutSwap,
+			TakerCoinIn:  swapInCoin,
+			TakerFee:     takerFee,
+		}, nil
+	}
+
+	if msg.OrderType.IsTrigger() {
+		triggerBuyPrice := math_utils.OnePrecDec().Quo(*msg.TriggerSellPrice)
+		triggerTickIndex, err := types.CalcTickIndexFromPrice(triggerBuyPrice)
+		if err != nil {
+			return &types.MsgPlaceLimitOrderResponse{}, errors.Wrapf(err, "invalid TriggerSellPrice %s", msg.TriggerSellPrice.String())
+		}
+
+		orderKey, coinIn, err := k.PlaceTriggerOrderCore(
+			goCtx,
+			msg.TokenIn,
+			msg.TokenOut,
+			msg.AmountIn,
+			tickIndex,
+			triggerTickIndex,
+			msg.OrderType,
+			msg.MaxAmountOut,
+			msg.MinAverageSellPrice,
+			callerAddr,
+			receiverAddr,
+		)
+		if err != nil {
+			return &types.MsgPlaceLimitOrderResponse{}, err
+		}
+
+		return &types.MsgPlaceLimitOrderResponse{
+			TrancheKey:   orderKey,
+			CoinIn:       coinIn,
+			TakerCoinOut: sdk.NewCoin(msg.TokenOut, math.ZeroInt()),
+			TakerCoinIn:  sdk.NewCoin(msg.TokenIn, math.ZeroInt()),
+			TakerFee:     sdk.NewCoin(msg.TokenIn, math.ZeroInt()),
+		}, nil
+	}
+
+	trancheKey, coinIn, swapInCoin, coinOutSwap, takerFee, err := k.PlaceLimitOrderCore(
 		goCtx,
 		msg.TokenIn,
 		msg.TokenOut,
@@ -151,8 +231,10 @@ func (k MsgServer) PlaceLimitOrder(
 		tickIndex,
 		msg.OrderType,
 		msg.ExpirationTime,
+		msg.ExpirationHeight,
 		msg.MaxAmountOut,
 		msg.MinAverageSellPrice,
+		msg.AutoWithdraw,
 		callerAddr,
 		receiverAddr,
 	)
@@ -165,6 +247,7 @@ func (k MsgServer) PlaceLimitOrder(
 		CoinIn:       coinIn,
 		TakerCoinOut: coinOutSwap,
 		TakerCoinIn:  swapInCoin,
+		TakerFee:     takerFee,
 	}, nil
 }
 
@@ -180,6 +263,13 @@ func (k MsgServer) WithdrawFilledLimitOrder(
 		return nil, err
 	}
 
+	return k.withdrawFilledLimitOrder(goCtx, msg)
+}
+
+func (k MsgServer) withdrawFilledLimitOrder(
+	goCtx context.Context,
+	msg *types.MsgWithdrawFilledLimitOrder,
+) (*types.MsgWithdrawFilledLimitOrderResponse, error) {
 	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
 
 	takerCoinOut, makerCoinOut, err := k.WithdrawFilledLimitOrderCore(
@@ -209,6 +299,13 @@ func (k MsgServer) CancelLimitOrder(
 		return nil, err
 	}
 
+	return k.cancelLimitOrder(goCtx, msg)
+}
+
+func (k MsgServer) cancelLimitOrder(
+	goCtx context.Context,
+	msg *types.MsgCancelLimitOrder,
+) (*types.MsgCancelLimitOrderResponse, error) {
 	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
 
 	makerCoinOut, takerCoinOut, err := k.CancelLimitOrderCore(
@@ -226,6 +323,189 @@ func (k MsgServer) CancelLimitOrder(
 	}, nil
 }
 
+func (k MsgServer) AmendLimitOrder(
+	goCtx context.Context,
+	msg *types.MsgAmendLimitOrder,
+) (*types.MsgAmendLimitOrderResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgAmendLimitOrder")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	var tickIndex *int64
+	if msg.LimitSellPrice != nil {
+		limitBuyPrice := math_utils.OnePrecDec().Quo(*msg.LimitSellPrice)
+		tick, err := types.CalcTickIndexFromPrice(limitBuyPrice)
+		if err != nil {
+			return &types.MsgAmendLimitOrderResponse{}, errors.Wrapf(err, "invalid LimitSellPrice %s", msg.LimitSellPrice.String())
+		}
+		tickIndex = &tick
+	}
+
+	trancheKey, makerCoinOut, takerCoinOut, takerFee, err := k.AmendLimitOrderCore(
+		goCtx,
+		msg.TrancheKey,
+		msg.AmountIn,
+		tickIndex,
+		callerAddr,
+	)
+	if err != nil {
+		return &types.MsgAmendLimitOrderResponse{}, err
+	}
+
+	// Repricing a pegged order unpegs it; resized pegged orders keep following the oracle price
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if peggedOrder, found := k.GetPeggedOrder(ctx, callerAddr.String(), msg.TrancheKey); found {
+		k.RemovePeggedOrder(ctx, peggedOrder)
+		_, stillResting := k.GetLimitOrderTrancheUser(ctx, callerAddr.String(), trancheKey)
+		if tickIndex == nil && stillResting {
+			peggedOrder.TrancheKey = trancheKey
+			k.SetPeggedOrder(ctx, peggedOrder)
+		}
+	}
+
+	return &types.MsgAmendLimitOrderResponse{
+		TrancheKey:   trancheKey,
+		TakerCoinOut: takerCoinOut,
+		MakerCoinOut: makerCoinOut,
+		TakerFee:     takerFee,
+	}, nil
+}
+
+func (k MsgServer) FlashSwap(
+	goCtx context.Context,
+	msg *types.MsgFlashSwap,
+) (*types.MsgFlashSwapResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgFlashSwap")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	coinIn, coinOut, err := k.FlashSwapCore(
+		goCtx,
+		msg.TokenIn,
+		msg.TokenOut,
+		msg.AmountIn,
+		msg.LimitSellPrice,
+		msg.CallbackData,
+		callerAddr,
+	)
+	if err != nil {
+		return &types.MsgFlashSwapResponse{}, err
+	}
+
+	return &types.MsgFlashSwapResponse{
+		CoinIn:  coinIn,
+		CoinOut: coinOut,
+	}, nil
+}
+
+func (k MsgServer) CreateGauge(
+	goCtx context.Context,
+	msg *types.MsgCreateGauge,
+) (*types.MsgCreateGaugeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgCreateGauge")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	gaugeID, err := k.CreateGaugeCore(
+		goCtx,
+		callerAddr,
+		msg.PairId,
+		msg.StartTick,
+		msg.EndTick,
+		msg.Coins,
+		msg.NumEpochs,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateGaugeResponse{GaugeId: gaugeID}, nil
+}
+
+func (k MsgServer) Stake(
+	goCtx context.Context,
+	msg *types.MsgStake,
+) (*types.MsgStakeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgStake")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	stakeID, err := k.StakeCore(goCtx, callerAddr, msg.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgStakeResponse{StakeId: stakeID}, nil
+}
+
+func (k MsgServer) Unstake(
+	goCtx context.Context,
+	msg *types.MsgUnstake,
+) (*types.MsgUnstakeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUnstake")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	shares, err := k.UnstakeCore(goCtx, callerAddr, msg.StakeId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnstakeResponse{Shares: shares}, nil
+}
+
+func (k MsgServer) Claim(
+	goCtx context.Context,
+	msg *types.MsgClaim,
+) (*types.MsgClaimResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgClaim")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+
+	rewards, err := k.ClaimCore(goCtx, callerAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimResponse{Rewards: rewards}, nil
+}
+
 func (k MsgServer) MultiHopSwap(
 	goCtx context.Context,
 	msg *types.MsgMultiHopSwap,
@@ -238,28 +518,235 @@ func (k MsgServer) MultiHopSwap(
 		return nil, err
 	}
 
+	return k.multiHopSwap(goCtx, msg)
+}
+
+func (k MsgServer) multiHopSwap(
+	goCtx context.Context,
+	msg *types.MsgMultiHopSwap,
+) (*types.MsgMultiHopSwapResponse, error) {
 	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
 	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
 
-	coinOut, route, dust, err := k.MultiHopSwapCore(
+	result, err := k.MultiHopSwapCore(
 		goCtx,
 		msg.AmountIn,
 		msg.Routes,
 		msg.ExitLimitPrice,
 		msg.PickBestRoute,
+		msg.SplitRoutes,
 		callerAddr,
 		receiverAddr,
 	)
 	if err != nil {
 		return &types.MsgMultiHopSwapResponse{}, err
 	}
+
+	var route *types.MultiHopRoute
+	if !msg.SplitRoutes {
+		route = &types.MultiHopRoute{Hops: result.route}
+	}
+
 	return &types.MsgMultiHopSwapResponse{
-		CoinOut: coinOut,
-		Route:   &types.MultiHopRoute{Hops: route},
-		Dust:    dust,
+		CoinOut:          result.coinOut,
+		Route:            route,
+		Dust:             result.dust,
+		RouteAllocations: result.RouteAllocations(),
+	}, nil
+}
+
+func (k MsgServer) MultiHopSwapExactOut(
+	goCtx context.Context,
+	msg *types.MsgMultiHopSwapExactOut,
+) (*types.MsgMultiHopSwapExactOutResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgMultiHopSwapExactOut")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	result, err := k.MultiHopSwapExactOutCore(
+		goCtx,
+		msg.AmountOut,
+		msg.MaxAmountIn,
+		msg.Routes,
+		msg.PickBestRoute,
+		callerAddr,
+		receiverAddr,
+	)
+	if err != nil {
+		return &types.MsgMultiHopSwapExactOutResponse{}, err
+	}
+
+	return &types.MsgMultiHopSwapExactOutResponse{
+		CoinIn:  result.coinIn,
+		CoinOut: result.coinOut,
+		Route:   &types.MultiHopRoute{Hops: result.route},
 	}, nil
 }
 
+func (k MsgServer) DepositRange(
+	goCtx context.Context,
+	msg *types.MsgDepositRange,
+) (*types.MsgDepositRangeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgDepositRange")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	pairID, err := types.NewPairID(msg.TokenA, msg.TokenB)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1 := msg.AmountA, msg.AmountB
+	if msg.TokenA != pairID.Token0 {
+		amount0, amount1 = msg.AmountB, msg.AmountA
+	}
+
+	// Normalizing the ticks flips their order when tokenA is token1
+	tickIndexes := NormalizeAllTickIndexes(msg.TokenA, pairID.Token0, []int64{msg.LowerTickIndexAToB, msg.UpperTickIndexAToB})
+	lowerTickIndex, upperTickIndex := min(tickIndexes[0], tickIndexes[1]), max(tickIndexes[0], tickIndexes[1])
+
+	position, reserve0Deposited, reserve1Deposited, failedDeposits, err := k.DepositRangeCore(
+		goCtx,
+		pairID,
+		callerAddr,
+		receiverAddr,
+		amount0,
+		amount1,
+		lowerTickIndex,
+		upperTickIndex,
+		msg.Fee,
+		msg.Shape,
+		msg.Options,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositRangeResponse{
+		PositionId:        position.Id,
+		Reserve0Deposited: reserve0Deposited,
+		Reserve1Deposited: reserve1Deposited,
+		FailedDeposits:    failedDeposits,
+		SharesIssued:      position.Shares,
+	}, nil
+}
+
+func (k MsgServer) WithdrawRange(
+	goCtx context.Context,
+	msg *types.MsgWithdrawRange,
+) (*types.MsgWithdrawRangeResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgWithdrawRange")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	callerAddr := sdk.MustAccAddressFromBech32(msg.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(msg.Receiver)
+
+	reserve0Withdrawn, reserve1Withdrawn, sharesBurned, err := k.WithdrawRangeCore(
+		goCtx,
+		callerAddr,
+		receiverAddr,
+		msg.PositionId,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawRangeResponse{
+		Reserve0Withdrawn: reserve0Withdrawn,
+		Reserve1Withdrawn: reserve1Withdrawn,
+		SharesBurned:      sharesBurned,
+	}, nil
+}
+
+func (k MsgServer) BatchOps(
+	goCtx context.Context,
+	msg *types.MsgBatchOps,
+) (*types.MsgBatchOpsResponse, error) {
+	if err := msg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgBatchOps")
+	}
+
+	if err := k.AssertNotPaused(goCtx); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	results := make([]*types.BatchOpResponse, len(msg.Ops))
+	failedOps := make([]*types.FailedBatchOp, 0)
+	for i, op := range msg.Ops {
+		if !op.AllowFailure {
+			result, err := k.batchOp(ctx, op)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to execute BatchOp %d", i)
+			}
+			results[i] = result
+			continue
+		}
+
+		// Run operations that are allowed to fail in a cached context so that partial
+		// state changes of a failed operation can be discarded
+		cacheCtx, writeCache := ctx.CacheContext()
+		result, err := k.batchOp(cacheCtx, op)
+		if err != nil {
+			failedOps = append(failedOps, &types.FailedBatchOp{OpIdx: uint64(i), Error: err.Error()})
+			results[i] = &types.BatchOpResponse{}
+			continue
+		}
+		writeCache()
+		results[i] = result
+	}
+
+	return &types.MsgBatchOpsResponse{
+		Results:   results,
+		FailedOps: failedOps,
+	}, nil
+}
+
+func (k MsgServer) batchOp(ctx sdk.Context, op *types.BatchOp) (result *types.BatchOpResponse, err error) {
+	result = &types.BatchOpResponse{}
+	switch {
+	case op.Deposit != nil:
+		result.Deposit, err = k.deposit(ctx, op.Deposit)
+	case op.Withdrawal != nil:
+		result.Withdrawal, err = k.withdrawal(ctx, op.Withdrawal)
+	case op.PlaceLimitOrder != nil:
+		result.PlaceLimitOrder, err = k.placeLimitOrder(ctx, op.PlaceLimitOrder)
+	case op.WithdrawFilledLimitOrder != nil:
+		result.WithdrawFilledLimitOrder, err = k.withdrawFilledLimitOrder(ctx, op.WithdrawFilledLimitOrder)
+	case op.CancelLimitOrder != nil:
+		result.CancelLimitOrder, err = k.cancelLimitOrder(ctx, op.CancelLimitOrder)
+	case op.MultiHopSwap != nil:
+		result.MultiHopSwap, err = k.multiHopSwap(ctx, op.MultiHopSwap)
+	default:
+		return nil, types.ErrInvalidBatchOp
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
 func (k MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, errors.Wrap(err, "failed to validate MsgUpdateParams")
@@ -275,9 +762,89 @@ func (k MsgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 		return nil, err
 	}
 
+	// Setting the hook contract re-enables it if it was disabled after repeated failures
+	if req.Params.HookContract != "" {
+		k.ResetHookFailures(ctx, req.Params.HookContract)
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+func (k MsgServer) SetPairPaused(goCtx context.Context, req *types.MsgSetPairPaused) (*types.MsgSetPairPausedResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetPairPaused")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Sorting the tokens ensures that the pair is stored under its canonical key
+	pairID := types.MustNewPairID(req.PairId.Token0, req.PairId.Token1)
+	k.Keeper.SetPairPaused(ctx, pairID, req.Paused)
+
+	return &types.MsgSetPairPausedResponse{}, nil
+}
+
+func (k MsgServer) SetPairBatchAuction(
+	goCtx context.Context,
+	req *types.MsgSetPairBatchAuction,
+) (*types.MsgSetPairBatchAuctionResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetPairBatchAuction")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// Sorting the tokens ensures that the pair is stored under its canonical key
+	pairID := types.MustNewPairID(req.PairId.Token0, req.PairId.Token1)
+	k.Keeper.SetPairBatchAuction(ctx, pairID, req.Enabled)
+
+	return &types.MsgSetPairBatchAuctionResponse{}, nil
+}
+
+func (k MsgServer) SetDenomPaused(goCtx context.Context, req *types.MsgSetDenomPaused) (*types.MsgSetDenomPausedResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgSetDenomPaused")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetDenomPaused(ctx, req.Denom, req.Paused)
+
+	return &types.MsgSetDenomPausedResponse{}, nil
+}
+
+func (k MsgServer) ResetCircuitBreaker(
+	goCtx context.Context,
+	req *types.MsgResetCircuitBreaker,
+) (*types.MsgResetCircuitBreakerResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgResetCircuitBreaker")
+	}
+
+	authority := k.GetAuthority()
+	if authority != req.Authority {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid authority; expected %s, got %s", authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pairID := types.MustNewPairID(req.PairId.Token0, req.PairId.Token1)
+	k.Keeper.ResetCircuitBreaker(ctx, pairID)
+
+	return &types.MsgResetCircuitBreakerResponse{}, nil
+}
+
 func (k MsgServer) AssertNotPaused(goCtx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	paused := k.GetParams(ctx).Paused
@@ -519,6 +519,39 @@ func (s *DexTestSuite) limitSellsGoodTil(
 	return msg.TrancheKey
 }
 
+func (s *DexTestSuite) aliceLimitSellsGoodTilBlock(
+	selling string,
+	tick, amountIn int,
+	goodTilHeight uint64,
+) string {
+	return s.limitSellsGoodTilBlock(s.alice, selling, tick, amountIn, goodTilHeight)
+}
+
+func (s *DexTestSuite) limitSellsGoodTilBlock(
+	account sdk.AccAddress,
+	tokenIn string,
+	tick, amountIn int,
+	goodTilHeight uint64,
+) string {
+	tradePairID := types.NewTradePairIDFromTaker(defaultPairID, tokenIn)
+	tickIndexTakerToMaker := tradePairID.TickIndexTakerToMaker(int64(tick))
+
+	msg, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          account.String(),
+		Receiver:         account.String(),
+		TokenIn:          tradePairID.TakerDenom,
+		TokenOut:         tradePairID.MakerDenom,
+		TickIndexInToOut: tickIndexTakerToMaker,
+		AmountIn:         sdkmath.NewInt(int64(amountIn)).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_GOOD_TIL_BLOCK,
+		ExpirationHeight: goodTilHeight,
+	})
+
+	s.Assert().NoError(err)
+
+	return msg.TrancheKey
+}
+
 // / Deposit
 type Deposit struct {
 	AmountA   sdkmath.Int
@@ -859,6 +892,47 @@ func (s *DexTestSuite) cancelsLimitSellFails(
 	s.Assert().ErrorIs(err, expectedErr)
 }
 
+/// Amend limit order
+
+func (s *DexTestSuite) aliceAmendsLimitSell(
+	trancheKey string,
+	amountIn *sdkmath.Int,
+	limitSellPrice *math_utils.PrecDec,
+) *types.MsgAmendLimitOrderResponse {
+	return s.amendsLimitSell(s.alice, trancheKey, amountIn, limitSellPrice)
+}
+
+func (s *DexTestSuite) amendsLimitSell(
+	account sdk.AccAddress,
+	trancheKey string,
+	amountIn *sdkmath.Int,
+	limitSellPrice *math_utils.PrecDec,
+) *types.MsgAmendLimitOrderResponse {
+	resp, err := s.msgServer.AmendLimitOrder(s.Ctx, &types.MsgAmendLimitOrder{
+		Creator:        account.String(),
+		TrancheKey:     trancheKey,
+		AmountIn:       amountIn,
+		LimitSellPrice: limitSellPrice,
+	})
+	s.Assert().Nil(err)
+	return resp
+}
+
+func (s *DexTestSuite) aliceAmendsLimitSellFails(
+	trancheKey string,
+	amountIn *sdkmath.Int,
+	limitSellPrice *math_utils.PrecDec,
+	expectedErr error,
+) {
+	_, err := s.msgServer.AmendLimitOrder(s.Ctx, &types.MsgAmendLimitOrder{
+		Creator:        s.alice.String(),
+		TrancheKey:     trancheKey,
+		AmountIn:       amountIn,
+		LimitSellPrice: limitSellPrice,
+	})
+	s.Assert().ErrorIs(err, expectedErr)
+}
+
 /// MultiHopSwap
 
 func (s *DexTestSuite) aliceMultiHopSwaps(
@@ -2151,6 +2225,74 @@ func TestMsgPlaceLimitOrderValidate(t *testing.T) {
 			},
 			types.ErrZeroMinAverageSellPrice,
 		},
+		{
+			"stop loss without trigger price",
+			types.MsgPlaceLimitOrder{
+				Creator:          sample.AccAddress(),
+				Receiver:         sample.AccAddress(),
+				TokenIn:          "TokenA",
+				TokenOut:         "TokenB",
+				TickIndexInToOut: 0,
+				AmountIn:         sdkmath.OneInt(),
+				OrderType:        types.LimitOrderType_STOP_LOSS,
+			},
+			types.ErrTriggerOrderWithoutTriggerPrice,
+		},
+		{
+			"trigger price on GTC",
+			types.MsgPlaceLimitOrder{
+				Creator:          sample.AccAddress(),
+				Receiver:         sample.AccAddress(),
+				TokenIn:          "TokenA",
+				TokenOut:         "TokenB",
+				TickIndexInToOut: 0,
+				AmountIn:         sdkmath.OneInt(),
+				OrderType:        types.LimitOrderType_GOOD_TIL_CANCELLED,
+				TriggerSellPrice: &FIVEDEC,
+			},
+			types.ErrTriggerPriceOnWrongOrderType,
+		},
+		{
+			"trigger price > maxPrice",
+			types.MsgPlaceLimitOrder{
+				Creator:          sample.AccAddress(),
+				Receiver:         sample.AccAddress(),
+				TokenIn:          "TokenA",
+				TokenOut:         "TokenB",
+				TickIndexInToOut: 0,
+				AmountIn:         sdkmath.OneInt(),
+				OrderType:        types.LimitOrderType_TAKE_PROFIT,
+				TriggerSellPrice: &HUGEDEC,
+			},
+			types.ErrPriceOutsideRange,
+		},
+		{
+			"GOOD_TIL_BLOCK without expiration height",
+			types.MsgPlaceLimitOrder{
+				Creator:          sample.AccAddress(),
+				Receiver:         sample.AccAddress(),
+				TokenIn:          "TokenA",
+				TokenOut:         "TokenB",
+				TickIndexInToOut: 0,
+				AmountIn:         sdkmath.OneInt(),
+				OrderType:        types.LimitOrderType_GOOD_TIL_BLOCK,
+			},
+			types.ErrGoodTilBlockOrderWithoutExpirationHeight,
+		},
+		{
+			"expiration height on GTC",
+			types.MsgPlaceLimitOrder{
+				Creator:          sample.AccAddress(),
+				Receiver:         sample.AccAddress(),
+				TokenIn:          "TokenA",
+				TokenOut:         "TokenB",
+				TickIndexInToOut: 0,
+				AmountIn:         sdkmath.OneInt(),
+				OrderType:        types.LimitOrderType_GOOD_TIL_CANCELLED,
+				ExpirationHeight: 10,
+			},
+			types.ErrExpirationHeightOnWrongOrderType,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2360,6 +2502,92 @@ func TestMsgMultiHopSwapValidate(t *testing.T) {
 	}
 }
 
+func TestMsgMultiHopSwapExactOutValidate(t *testing.T) {
+	k, ctx := testkeeper.DexKeeper(t)
+	msgServer := dexkeeper.NewMsgServerImpl(*k)
+
+	tests := []struct {
+		name        string
+		msg         types.MsgMultiHopSwapExactOut
+		expectedErr error
+	}{
+		{
+			"invalid creator address",
+			types.MsgMultiHopSwapExactOut{
+				Creator:     "invalid_address",
+				Receiver:    sample.AccAddress(),
+				Routes:      []*types.MultiHopRoute{{Hops: []string{"TokenA", "TokenB"}}},
+				AmountOut:   sdkmath.OneInt(),
+				MaxAmountIn: sdkmath.OneInt(),
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"invalid receiver address",
+			types.MsgMultiHopSwapExactOut{
+				Creator:     sample.AccAddress(),
+				Receiver:    "invalid_address",
+				Routes:      []*types.MultiHopRoute{{Hops: []string{"TokenA", "TokenB"}}},
+				AmountOut:   sdkmath.OneInt(),
+				MaxAmountIn: sdkmath.OneInt(),
+			},
+			types.ErrInvalidAddress,
+		},
+		{
+			"missing route",
+			types.MsgMultiHopSwapExactOut{
+				Creator:     sample.AccAddress(),
+				Receiver:    sample.AccAddress(),
+				Routes:      []*types.MultiHopRoute{},
+				AmountOut:   sdkmath.OneInt(),
+				MaxAmountIn: sdkmath.OneInt(),
+			},
+			types.ErrMissingMultihopRoute,
+		},
+		{
+			"cycles in hops",
+			types.MsgMultiHopSwapExactOut{
+				Creator:     sample.AccAddress(),
+				Receiver:    sample.AccAddress(),
+				Routes:      []*types.MultiHopRoute{{Hops: []string{"TokenA", "TokenB", "TokenA", "TokenC"}}},
+				AmountOut:   sdkmath.OneInt(),
+				MaxAmountIn: sdkmath.OneInt(),
+			},
+			types.ErrCycleInHops,
+		},
+		{
+			"zero amountOut",
+			types.MsgMultiHopSwapExactOut{
+				Creator:     sample.AccAddress(),
+				Receiver:    sample.AccAddress(),
+				Routes:      []*types.MultiHopRoute{{Hops: []string{"TokenA", "TokenB"}}},
+				AmountOut:   sdkmath.ZeroInt(),
+				MaxAmountIn: sdkmath.OneInt(),
+			},
+			types.ErrZeroSwap,
+		},
+		{
+			"zero maxAmountIn",
+			types.MsgMultiHopSwapExactOut{
+				Creator:     sample.AccAddress(),
+				Receiver:    sample.AccAddress(),
+				Routes:      []*types.MultiHopRoute{{Hops: []string{"TokenA", "TokenB"}}},
+				AmountOut:   sdkmath.OneInt(),
+				MaxAmountIn: sdkmath.ZeroInt(),
+			},
+			types.ErrZeroSwap,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := msgServer.MultiHopSwapExactOut(ctx, &tt.msg)
+			require.ErrorIs(t, err, tt.expectedErr)
+			require.Nil(t, resp)
+		})
+	}
+}
+
 func TestMsgUpdateParamsValidate(t *testing.T) {
 	k, ctx := testkeeper.DexKeeper(t)
 	msgServer := dexkeeper.NewMsgServerImpl(*k)
@@ -24,6 +24,37 @@ type MultiHopRouteOutput struct {
 	coinOut sdk.Coin
 	route   []string
 	dust    sdk.Coins
+	// dynamicFees are the dynamic fees charged by pools of the dynamic fee tier at each hop
+	dynamicFees sdk.Coins
+	// splits is only set when amountIn has been split across multiple routes
+	splits []MultiHopRouteSplit
+}
+
+// MultiHopRouteSplit is the portion of a split MultiHopSwap that is executed through a single route
+type MultiHopRouteSplit struct {
+	amountIn    math.Int
+	coinOut     sdk.Coin
+	route       []string
+	dust        sdk.Coins
+	dynamicFees sdk.Coins
+}
+
+// RouteAllocations returns the amount in and out of every route used by a split MultiHopSwap
+func (o MultiHopRouteOutput) RouteAllocations() []*types.MultiHopRouteAllocation {
+	if len(o.splits) == 0 {
+		return nil
+	}
+
+	allocations := make([]*types.MultiHopRouteAllocation, len(o.splits))
+	for i, split := range o.splits {
+		allocations[i] = &types.MultiHopRouteAllocation{
+			Route:    &types.MultiHopRoute{Hops: split.route},
+			AmountIn: split.amountIn,
+			CoinOut:  split.coinOut,
+		}
+	}
+
+	return allocations
 }
 
 // MultiHopSwapCore handles logic for MsgMultihopSwap including bank operations and event emissions.
@@ -33,14 +64,15 @@ func (k Keeper) MultiHopSwapCore(
 	routes []*types.MultiHopRoute,
 	exitLimitPrice math_utils.PrecDec,
 	pickBestRoute bool,
+	splitRoutes bool,
 	callerAddr sdk.AccAddress,
 	receiverAddr sdk.AccAddress,
-) (coinOut sdk.Coin, route []string, dust sdk.Coins, err error) {
+) (result MultiHopRouteOutput, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	bestRoute, initialInCoin, err := k.CalulateMultiHopSwap(ctx, amountIn, routes, exitLimitPrice, pickBestRoute)
+	bestRoute, initialInCoin, err := k.CalulateMultiHopSwap(ctx, amountIn, routes, exitLimitPrice, pickBestRoute, splitRoutes)
 	if err != nil {
-		return sdk.Coin{}, []string{}, sdk.Coins{}, err
+		return MultiHopRouteOutput{}, err
 	}
 
 	bestRoute.write()
@@ -51,7 +83,7 @@ func (k Keeper) MultiHopSwapCore(
 		sdk.Coins{initialInCoin},
 	)
 	if err != nil {
-		return sdk.Coin{}, []string{}, sdk.Coins{}, err
+		return MultiHopRouteOutput{}, err
 	}
 
 	// send both dust and coinOut to receiver
@@ -63,21 +95,39 @@ func (k Keeper) MultiHopSwapCore(
 		bestRoute.dust.Add(bestRoute.coinOut),
 	)
 	if err != nil {
-		return sdk.Coin{}, []string{}, sdk.Coins{}, fmt.Errorf("failed to send out coin and dust to the receiver: %w", err)
+		return MultiHopRouteOutput{}, fmt.Errorf("failed to send out coin and dust to the receiver: %w", err)
 	}
 
-	ctx.EventManager().EmitEvent(types.CreateMultihopSwapEvent(
-		callerAddr,
-		receiverAddr,
-		initialInCoin.Denom,
-		bestRoute.coinOut.Denom,
-		initialInCoin.Amount,
-		bestRoute.coinOut.Amount,
-		bestRoute.route,
-		bestRoute.dust,
-	))
-
-	return bestRoute.coinOut, bestRoute.route, bestRoute.dust, nil
+	if len(bestRoute.splits) == 0 {
+		ctx.EventManager().EmitEvent(types.CreateMultihopSwapEvent(
+			callerAddr,
+			receiverAddr,
+			initialInCoin.Denom,
+			bestRoute.coinOut.Denom,
+			initialInCoin.Amount,
+			bestRoute.coinOut.Amount,
+			bestRoute.route,
+			bestRoute.dust,
+		))
+	}
+
+	// For split swaps we emit a separate event for each route
+	for _, split := range bestRoute.splits {
+		ctx.EventManager().EmitEvent(types.CreateMultihopSwapEvent(
+			callerAddr,
+			receiverAddr,
+			initialInCoin.Denom,
+			split.coinOut.Denom,
+			split.amountIn,
+			split.coinOut.Amount,
+			split.route,
+			split.dust,
+		))
+	}
+
+	k.queueAfterSwap(ctx, callerAddr, receiverAddr, initialInCoin, bestRoute.coinOut)
+
+	return bestRoute, nil
 }
 
 // CalulateMultiHopSwap handles the core logic for MultiHopSwap -- simulating swap operations across all routes (when applicable)
@@ -88,15 +138,20 @@ func (k Keeper) CalulateMultiHopSwap(
 	routes []*types.MultiHopRoute,
 	exitLimitPrice math_utils.PrecDec,
 	pickBestRoute bool,
+	splitRoutes bool,
 ) (bestRoute MultiHopRouteOutput, initialInCoin sdk.Coin, err error) {
+	if splitRoutes {
+		return k.CalculateSplitMultiHopSwap(ctx, amountIn, routes, exitLimitPrice)
+	}
+
 	var routeErrors []error
 	initialInCoin = sdk.NewCoin(routes[0].Hops[0], amountIn)
-	stepCache := make(map[multihopCacheKey]StepResult)
+	stepCache := make(multihopStepCache)
 
 	bestRoute.coinOut = sdk.Coin{Amount: math.ZeroInt()}
 
 	for _, route := range routes {
-		routeDust, routeCoinOut, writeRoute, err := k.RunMultihopRoute(
+		routeDust, routeCoinOut, routeDynamicFees, writeRoute, err := k.RunMultihopRoute(
 			ctx,
 			*route,
 			initialInCoin,
@@ -113,6 +168,7 @@ func (k Keeper) CalulateMultiHopSwap(
 			bestRoute.write = writeRoute
 			bestRoute.route = route.Hops
 			bestRoute.dust = routeDust
+			bestRoute.dynamicFees = routeDynamicFees
 		}
 		if !pickBestRoute {
 			break
@@ -130,6 +186,111 @@ func (k Keeper) CalulateMultiHopSwap(
 	return bestRoute, initialInCoin, nil
 }
 
+// CalculateSplitMultiHopSwap splits amountIn into MultiHopSplitChunks portions and greedily allocates each portion
+// to the route with the highest output given the portions that have already been allocated. Like CalulateMultiHopSwap
+// it uses a cache and does not modify state until the returned write function is called.
+func (k Keeper) CalculateSplitMultiHopSwap(
+	ctx sdk.Context,
+	amountIn math.Int,
+	routes []*types.MultiHopRoute,
+	exitLimitPrice math_utils.PrecDec,
+) (result MultiHopRouteOutput, initialInCoin sdk.Coin, err error) {
+	initialInCoin = sdk.NewCoin(routes[0].Hops[0], amountIn)
+	tokenOut := routes[0].Hops[len(routes[0].Hops)-1]
+	splitCtx, writeSplits := ctx.CacheContext()
+
+	splits := make([]MultiHopRouteSplit, len(routes))
+	for i, route := range routes {
+		splits[i] = MultiHopRouteSplit{
+			amountIn:    math.ZeroInt(),
+			coinOut:     sdk.NewCoin(tokenOut, math.ZeroInt()),
+			route:       route.Hops,
+			dust:        sdk.Coins{},
+			dynamicFees: sdk.Coins{},
+		}
+	}
+
+	// Step results are reused across chunks until one of the pairs they depend on is swapped on by a committed chunk
+	stepCache := make(multihopStepCache)
+	for _, chunkAmount := range splitAmount(amountIn, types.MultiHopSplitChunks) {
+		chunkCoin := sdk.NewCoin(initialInCoin.Denom, chunkAmount)
+
+		var routeErrors []error
+		bestIdx := -1
+		var bestCoinOut sdk.Coin
+		var bestDust sdk.Coins
+		var bestDynamicFees sdk.Coins
+		var bestWrite func()
+		for i, route := range routes {
+			routeDust, routeCoinOut, routeDynamicFees, writeRoute, err := k.RunMultihopRoute(
+				splitCtx,
+				*route,
+				chunkCoin,
+				exitLimitPrice,
+				stepCache,
+			)
+			if err != nil {
+				routeErrors = append(routeErrors, err)
+				continue
+			}
+
+			if bestIdx == -1 || bestCoinOut.Amount.LT(routeCoinOut.Amount) {
+				bestIdx = i
+				bestCoinOut = routeCoinOut
+				bestDust = routeDust
+				bestDynamicFees = routeDynamicFees
+				bestWrite = writeRoute
+			}
+		}
+
+		if bestIdx == -1 {
+			// No route can absorb the remaining amount
+			allErr := errors.Join(append([]error{types.ErrAllMultiHopRoutesFailed}, routeErrors...)...)
+
+			return MultiHopRouteOutput{}, sdk.Coin{}, allErr
+		}
+
+		bestWrite()
+		stepCache.invalidate(routes[bestIdx].Hops)
+		splits[bestIdx].amountIn = splits[bestIdx].amountIn.Add(chunkAmount)
+		splits[bestIdx].coinOut = splits[bestIdx].coinOut.Add(bestCoinOut)
+		splits[bestIdx].dust = splits[bestIdx].dust.Add(bestDust...)
+		splits[bestIdx].dynamicFees = splits[bestIdx].dynamicFees.Add(bestDynamicFees...)
+	}
+
+	result.write = writeSplits
+	result.coinOut = sdk.NewCoin(tokenOut, math.ZeroInt())
+	result.dust = sdk.Coins{}
+	result.dynamicFees = sdk.Coins{}
+	for _, split := range splits {
+		if split.amountIn.IsZero() {
+			continue
+		}
+		result.coinOut = result.coinOut.Add(split.coinOut)
+		result.dust = result.dust.Add(split.dust...)
+		result.dynamicFees = result.dynamicFees.Add(split.dynamicFees...)
+		result.splits = append(result.splits, split)
+	}
+
+	return result, initialInCoin, nil
+}
+
+// splitAmount divides amount into at most n portions of equal size, the last portion receives any remainder
+func splitAmount(amount math.Int, n int64) []math.Int {
+	if amount.LT(math.NewInt(n)) {
+		n = amount.Int64()
+	}
+
+	chunkSize := amount.QuoRaw(n)
+	chunks := make([]math.Int, n)
+	for i := range chunks {
+		chunks[i] = chunkSize
+	}
+	chunks[n-1] = amount.Sub(chunkSize.MulRaw(n - 1))
+
+	return chunks
+}
+
 func (k Keeper) HopsToRouteData(
 	ctx sdk.Context,
 	hops []string,
@@ -160,10 +321,41 @@ func (k Keeper) HopsToRouteData(
 }
 
 type StepResult struct {
-	Ctx     *types.BranchableCache
-	CoinOut sdk.Coin
-	Dust    sdk.Coin
-	Err     error
+	Ctx        *types.BranchableCache
+	CoinOut    sdk.Coin
+	Dust       sdk.Coin
+	DynamicFee sdk.Coin
+	Err        error
+	// Hops of the route up to and including the step. Ctx includes the swaps on all of their pairs.
+	Hops []string
+}
+
+// multihopStepCache caches StepResults by the pair and amount swapped
+type multihopStepCache map[multihopCacheKey]StepResult
+
+// invalidate removes every StepResult whose state includes a swap on any of the pairs of hops
+func (c multihopStepCache) invalidate(hops []string) {
+	changedPairs := make(map[string]bool, len(hops))
+	for i := 0; i < len(hops)-1; i++ {
+		changedPairs[hopsPairKey(hops[i], hops[i+1])] = true
+	}
+
+	for key, result := range c {
+		for i := 0; i < len(result.Hops)-1; i++ {
+			if changedPairs[hopsPairKey(result.Hops[i], result.Hops[i+1])] {
+				delete(c, key)
+				break
+			}
+		}
+	}
+}
+
+func hopsPairKey(tokenA, tokenB string) string {
+	if tokenA > tokenB {
+		tokenA, tokenB = tokenB, tokenA
+	}
+
+	return tokenA + "<>" + tokenB
 }
 
 type multihopCacheKey struct {
@@ -184,13 +376,14 @@ func (k Keeper) MultihopStep(
 	bCtx *types.BranchableCache,
 	step MultihopStep,
 	inCoin sdk.Coin,
-	stepCache map[multihopCacheKey]StepResult,
-) (sdk.Coin, sdk.Coin, *types.BranchableCache, error) {
+	hops []string,
+	stepCache multihopStepCache,
+) (sdk.Coin, sdk.Coin, sdk.Coin, *types.BranchableCache, error) {
 	cacheKey := newCacheKey(step.tradePairID.TakerDenom, step.tradePairID.MakerDenom, inCoin.Amount)
 	val, ok := stepCache[cacheKey]
 	if ok {
 		ctxBranchCopy := val.Ctx.Branch()
-		return val.Dust, val.CoinOut, ctxBranchCopy, val.Err
+		return val.Dust, val.CoinOut, val.DynamicFee, ctxBranchCopy, val.Err
 	}
 
 	// Due to rounding on swap it is possible to leak tokens at each hop.
@@ -198,14 +391,14 @@ func (k Keeper) MultihopStep(
 	// To solve this without sending user dust we would have to pre-calculate the route such that
 	// the amount in will be used completely at each step.
 
-	dust, coinOut, err := k.SwapFullAmountIn(bCtx.Ctx, step.tradePairID, inCoin.Amount)
+	dust, coinOut, dynamicFee, err := k.SwapFullAmountIn(bCtx.Ctx, step.tradePairID, inCoin.Amount)
 	ctxBranch := bCtx.Branch()
-	stepCache[cacheKey] = StepResult{Ctx: bCtx, CoinOut: coinOut, Dust: dust, Err: err}
+	stepCache[cacheKey] = StepResult{Ctx: bCtx, CoinOut: coinOut, Dust: dust, DynamicFee: dynamicFee, Err: err, Hops: hops}
 	if err != nil {
-		return sdk.Coin{}, sdk.Coin{}, bCtx, err
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, bCtx, err
 	}
 
-	return dust, coinOut, ctxBranch, nil
+	return dust, coinOut, dynamicFee, ctxBranch, nil
 }
 
 func (k Keeper) RunMultihopRoute(
@@ -213,37 +406,40 @@ func (k Keeper) RunMultihopRoute(
 	route types.MultiHopRoute,
 	initialInCoin sdk.Coin,
 	exitLimitPrice math_utils.PrecDec,
-	stepCache map[multihopCacheKey]StepResult,
-) (sdk.Coins, sdk.Coin, func(), error) {
+	stepCache multihopStepCache,
+) (dust sdk.Coins, coinOut sdk.Coin, dynamicFees sdk.Coins, write func(), err error) {
 	routeData, err := k.HopsToRouteData(ctx, route.Hops)
 	if err != nil {
-		return sdk.Coins{}, sdk.Coin{}, nil, err
+		return sdk.Coins{}, sdk.Coin{}, sdk.Coins{}, nil, err
 	}
 	currentPrice := math_utils.OnePrecDec()
 
 	var stepOutCoin sdk.Coin
 	var stepDust sdk.Coin
+	var stepDynamicFee sdk.Coin
 	inCoin := initialInCoin
 	bCacheCtx := types.NewBranchableCache(ctx)
 
 	var dustAcc sdk.Coins
+	var dynamicFeeAcc sdk.Coins
 
-	for _, step := range routeData {
+	for i, step := range routeData {
 		// If we can't hit the best possible price we can greedily abort
 		priceUpperbound := currentPrice.Mul(step.RemainingBestPrice)
 		if exitLimitPrice.GT(priceUpperbound) {
-			return sdk.Coins{}, sdk.Coin{}, bCacheCtx.WriteCache, types.ErrLimitPriceNotSatisfied
+			return sdk.Coins{}, sdk.Coin{}, sdk.Coins{}, bCacheCtx.WriteCache, types.ErrLimitPriceNotSatisfied
 		}
 
-		stepDust, stepOutCoin, bCacheCtx, err = k.MultihopStep(
+		stepDust, stepOutCoin, stepDynamicFee, bCacheCtx, err = k.MultihopStep(
 			bCacheCtx,
 			step,
 			inCoin,
+			route.Hops[:i+2],
 			stepCache,
 		)
 		inCoin = stepOutCoin
 		if err != nil {
-			return sdk.Coins{}, sdk.Coin{}, nil, sdkerrors.Wrapf(
+			return sdk.Coins{}, sdk.Coin{}, sdk.Coins{}, nil, sdkerrors.Wrapf(
 				err,
 				"Failed at pair: %s",
 				step.tradePairID.MustPairID().CanonicalString(),
@@ -252,16 +448,17 @@ func (k Keeper) RunMultihopRoute(
 
 		// Add what hasn't been swapped to dustAcc
 		dustAcc = dustAcc.Add(stepDust)
+		dynamicFeeAcc = dynamicFeeAcc.Add(stepDynamicFee)
 
 		currentPrice = math_utils.NewPrecDecFromInt(stepOutCoin.Amount).
 			Quo(math_utils.NewPrecDecFromInt(initialInCoin.Amount))
 	}
 
 	if exitLimitPrice.GT(currentPrice) {
-		return sdk.Coins{}, sdk.Coin{}, nil, types.ErrLimitPriceNotSatisfied
+		return sdk.Coins{}, sdk.Coin{}, sdk.Coins{}, nil, types.ErrLimitPriceNotSatisfied
 	}
 
-	return dustAcc, stepOutCoin, bCacheCtx.WriteCache, nil
+	return dustAcc, stepOutCoin, dynamicFeeAcc, bCacheCtx.WriteCache, nil
 }
 
 // SwapFullAmountIn swaps full amount of given `amountIn` to the `tradePairID` taker denom.
@@ -272,8 +469,8 @@ func (k Keeper) SwapFullAmountIn(
 	ctx sdk.Context,
 	tradePairID *types.TradePairID,
 	amountIn math.Int,
-) (dust, totalOut sdk.Coin, err error) {
-	swapAmountTakerDenom, swapAmountMakerDenom, orderFilled, err := k.Swap(
+) (dust, totalOut, dynamicFee sdk.Coin, err error) {
+	result, err := k.Swap(
 		ctx,
 		tradePairID,
 		amountIn,
@@ -281,16 +478,16 @@ func (k Keeper) SwapFullAmountIn(
 		nil,
 	)
 	if err != nil {
-		return sdk.Coin{}, sdk.Coin{}, err
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
 	}
-	if !orderFilled {
-		return sdk.Coin{}, sdk.Coin{}, types.ErrNoLiquidity
+	if !result.OrderFilled {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, types.ErrNoLiquidity
 	}
 
-	dust = sdk.Coin.Sub(sdk.NewCoin(swapAmountTakerDenom.Denom, amountIn), swapAmountTakerDenom)
+	dust = sdk.Coin.Sub(sdk.NewCoin(result.TotalIn.Denom, amountIn), result.TotalIn)
 	if dust.IsNegative() {
-		return sdk.Coin{}, sdk.Coin{}, fmt.Errorf("dust coins are negative")
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, fmt.Errorf("dust coins are negative")
 	}
 
-	return dust, swapAmountMakerDenom, err
+	return dust, result.TotalOut, result.DynamicFee, nil
 }
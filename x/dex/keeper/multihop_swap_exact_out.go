@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// unboundedAmountIn is used as the max amount in for all but the first hop of an exact-out route.
+// The amount in of those hops is bounded by the amount out of the previous hop rather than by the user.
+var unboundedAmountIn = math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 128))
+
+type MultiHopExactOutRouteOutput struct {
+	write   func()
+	coinIn  sdk.Coin
+	coinOut sdk.Coin
+	route   []string
+	// dynamicFees are the dynamic fees charged by pools of the dynamic fee tier at each hop
+	dynamicFees sdk.Coins
+}
+
+// MultiHopSwapExactOutCore handles logic for MsgMultiHopSwapExactOut including bank operations and event emissions.
+func (k Keeper) MultiHopSwapExactOutCore(
+	goCtx context.Context,
+	amountOut math.Int,
+	maxAmountIn math.Int,
+	routes []*types.MultiHopRoute,
+	pickBestRoute bool,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+) (result MultiHopExactOutRouteOutput, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	bestRoute, err := k.CalculateMultiHopSwapExactOut(ctx, amountOut, maxAmountIn, routes, pickBestRoute)
+	if err != nil {
+		return MultiHopExactOutRouteOutput{}, err
+	}
+
+	bestRoute.write()
+	err = k.bankKeeper.SendCoinsFromAccountToModule(
+		ctx,
+		callerAddr,
+		types.ModuleName,
+		sdk.Coins{bestRoute.coinIn},
+	)
+	if err != nil {
+		return MultiHopExactOutRouteOutput{}, err
+	}
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(
+		ctx,
+		types.ModuleName,
+		receiverAddr,
+		sdk.Coins{bestRoute.coinOut},
+	)
+	if err != nil {
+		return MultiHopExactOutRouteOutput{}, sdkerrors.Wrap(err, "failed to send out coin to the receiver")
+	}
+
+	// Since every hop swaps for an exact amount out, exact-out swaps never produce dust
+	ctx.EventManager().EmitEvent(types.CreateMultihopSwapEvent(
+		callerAddr,
+		receiverAddr,
+		bestRoute.coinIn.Denom,
+		bestRoute.coinOut.Denom,
+		bestRoute.coinIn.Amount,
+		bestRoute.coinOut.Amount,
+		bestRoute.route,
+		sdk.Coins{},
+	))
+
+	k.queueAfterSwap(ctx, callerAddr, receiverAddr, bestRoute.coinIn, bestRoute.coinOut)
+
+	return bestRoute, nil
+}
+
+// CalculateMultiHopSwapExactOut simulates exact-out swaps across all routes (when applicable) and picks the
+// route requiring the smallest amount in. It uses a cache and does not modify state.
+func (k Keeper) CalculateMultiHopSwapExactOut(
+	ctx sdk.Context,
+	amountOut math.Int,
+	maxAmountIn math.Int,
+	routes []*types.MultiHopRoute,
+	pickBestRoute bool,
+) (bestRoute MultiHopExactOutRouteOutput, err error) {
+	var routeErrors []error
+	found := false
+
+	for _, route := range routes {
+		routeCoinIn, routeCoinOut, routeDynamicFees, writeRoute, err := k.RunMultihopRouteExactOut(
+			ctx,
+			*route,
+			amountOut,
+			maxAmountIn,
+		)
+		if err != nil {
+			routeErrors = append(routeErrors, err)
+			continue
+		}
+
+		if !found || routeCoinIn.Amount.LT(bestRoute.coinIn.Amount) {
+			bestRoute.coinIn = routeCoinIn
+			bestRoute.coinOut = routeCoinOut
+			bestRoute.write = writeRoute
+			bestRoute.route = route.Hops
+			bestRoute.dynamicFees = routeDynamicFees
+			found = true
+		}
+		if !pickBestRoute {
+			break
+		}
+	}
+
+	if !found {
+		// All routes have failed
+		allErr := errors.Join(append([]error{types.ErrAllMultiHopRoutesFailed}, routeErrors...)...)
+
+		return MultiHopExactOutRouteOutput{}, allErr
+	}
+
+	return bestRoute, nil
+}
+
+// RunMultihopRouteExactOut walks the route in reverse, starting from the last hop, and swaps for the exact
+// amount out required by the next hop. Only the first hop is bounded by maxAmountIn.
+func (k Keeper) RunMultihopRouteExactOut(
+	ctx sdk.Context,
+	route types.MultiHopRoute,
+	amountOut math.Int,
+	maxAmountIn math.Int,
+) (coinIn, coinOut sdk.Coin, dynamicFees sdk.Coins, write func(), err error) {
+	routeData, err := k.HopsToRouteData(ctx, route.Hops)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coins{}, nil, err
+	}
+
+	// If we can't get amountOut at the best possible price we can greedily abort
+	minAmountIn := math_utils.NewPrecDecFromInt(amountOut).Quo(routeData[0].RemainingBestPrice)
+	if minAmountIn.GT(math_utils.NewPrecDecFromInt(maxAmountIn)) {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coins{}, nil, types.ErrMaxAmountInExceeded
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	stepAmountOut := amountOut
+	for i := len(routeData) - 1; i >= 0; i-- {
+		step := routeData[i]
+		stepMaxAmountIn := unboundedAmountIn
+		if i == 0 {
+			stepMaxAmountIn = maxAmountIn
+		}
+
+		stepCoinIn, stepDynamicFee, err := k.SwapExactAmountOut(cacheCtx, step.tradePairID, stepAmountOut, stepMaxAmountIn)
+		if err != nil {
+			return sdk.Coin{}, sdk.Coin{}, sdk.Coins{}, nil, sdkerrors.Wrapf(
+				err,
+				"Failed at pair: %s",
+				step.tradePairID.MustPairID().CanonicalString(),
+			)
+		}
+
+		stepAmountOut = stepCoinIn.Amount
+		dynamicFees = dynamicFees.Add(stepDynamicFee)
+	}
+
+	coinIn = sdk.NewCoin(route.Hops[0], stepAmountOut)
+	coinOut = sdk.NewCoin(route.Hops[len(route.Hops)-1], amountOut)
+
+	return coinIn, coinOut, dynamicFees, writeCache, nil
+}
+
+// SwapExactAmountOut swaps the `tradePairID` taker denom for exactly `amountOut` of the maker denom
+// using at most `maxAmountIn` of the taker denom. It returns the amount of taker denom used and the part of it
+// that was charged as a dynamic fee.
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	amountOut math.Int,
+	maxAmountIn math.Int,
+) (coinIn, dynamicFee sdk.Coin, err error) {
+	result, err := k.Swap(
+		ctx,
+		tradePairID,
+		maxAmountIn,
+		&amountOut,
+		nil,
+	)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	if result.TotalOut.Amount.LT(amountOut) {
+		// If the order has been filled without reaching amountOut then maxAmountIn has been used up
+		if result.OrderFilled {
+			return sdk.Coin{}, sdk.Coin{}, types.ErrMaxAmountInExceeded
+		}
+		return sdk.Coin{}, sdk.Coin{}, types.ErrNoLiquidity
+	}
+
+	return result.TotalIn, result.DynamicFee, nil
+}
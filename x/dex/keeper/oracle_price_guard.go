@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// GetOracleMakerPrice returns the x/oracle price of one maker token of tradePairID denominated in the taker token.
+// found is false if the oracle price is missing, stale or cannot be read.
+func (k Keeper) GetOracleMakerPrice(
+	ctx sdk.Context,
+	guard types.OraclePriceGuard,
+	tradePairID *types.TradePairID,
+) (price math_utils.PrecDec, found bool) {
+	oraclePrice, decimals, priceHeight, found := k.GetOraclePrice(ctx, guard.MustCurrencyPair())
+	if !found {
+		return math_utils.ZeroPrecDec(), false
+	}
+
+	height := uint64(ctx.BlockHeight()) //nolint:gosec
+	if guard.MaxPriceAge != 0 && height > priceHeight+guard.MaxPriceAge {
+		return math_utils.ZeroPrecDec(), false
+	}
+
+	return guard.MakerPrice(tradePairID, oraclePrice, decimals), true
+}
+
+// GetOraclePrice returns the raw x/oracle price of cp along with its number of decimals and the block height at which
+// it was last updated. found is false if there is no oracle keeper or the price is missing or cannot be read.
+func (k Keeper) GetOraclePrice(
+	ctx sdk.Context,
+	cp slinkytypes.CurrencyPair,
+) (price math_utils.PrecDec, decimals, height uint64, found bool) {
+	if k.oracleKeeper == nil {
+		return math_utils.ZeroPrecDec(), 0, 0, false
+	}
+
+	quotePrice, err := k.oracleKeeper.GetPriceForCurrencyPair(ctx, cp)
+	if err != nil || quotePrice.Price.IsNil() || !quotePrice.Price.IsPositive() {
+		return math_utils.ZeroPrecDec(), 0, 0, false
+	}
+
+	decimals, err = k.oracleKeeper.GetDecimalsForCurrencyPair(ctx, cp)
+	if err != nil {
+		return math_utils.ZeroPrecDec(), 0, 0, false
+	}
+
+	return math_utils.NewPrecDecFromInt(quotePrice.Price), decimals, quotePrice.BlockHeight, true
+}
+
+// GetOraclePriceBound returns the OraclePriceGuard of tradePairID and the worst MakerPrice at which swaps on
+// tradePairID may execute. found is false if the pair is not guarded or the guard is not currently applied.
+func (k Keeper) GetOraclePriceBound(
+	ctx sdk.Context,
+	params types.Params,
+	tradePairID *types.TradePairID,
+) (guard types.OraclePriceGuard, maxMakerPrice math_utils.PrecDec, found bool) {
+	guard, found = params.OraclePriceGuard(tradePairID.MustPairID())
+	if !found {
+		return types.OraclePriceGuard{}, math_utils.ZeroPrecDec(), false
+	}
+
+	oracleMakerPrice, found := k.GetOracleMakerPrice(ctx, guard, tradePairID)
+	if !found {
+		return types.OraclePriceGuard{}, math_utils.ZeroPrecDec(), false
+	}
+
+	return guard, guard.MaxMakerPrice(oracleMakerPrice), true
+}
+
+// GetOracleReferencePrice returns the price of one hops[0] token denominated in the last token of hops implied
+// by the x/oracle prices of every pair along the route. found is false if any pair of the route is not guarded
+// or does not have a current oracle price.
+func (k Keeper) GetOracleReferencePrice(ctx sdk.Context, hops []string) (price math_utils.PrecDec, found bool) {
+	params := k.GetParams(ctx)
+	price = math_utils.OnePrecDec()
+	for i := 0; i < len(hops)-1; i++ {
+		tradePairID, err := types.NewTradePairID(hops[i], hops[i+1])
+		if err != nil {
+			return math_utils.ZeroPrecDec(), false
+		}
+
+		guard, guarded := params.OraclePriceGuard(tradePairID.MustPairID())
+		if !guarded {
+			return math_utils.ZeroPrecDec(), false
+		}
+
+		oracleMakerPrice, found := k.GetOracleMakerPrice(ctx, guard, tradePairID)
+		if !found {
+			return math_utils.ZeroPrecDec(), false
+		}
+
+		// MakerPrice is denominated in the taker token so the amount out per token in is its inverse
+		price = price.Quo(oracleMakerPrice)
+	}
+
+	return price, true
+}
@@ -0,0 +1,138 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+const oracleGuardCurrencyPair = "TOKENA/TOKENB"
+
+func (s *DexTestSuite) setOraclePriceGuard(action types.OraclePriceGuardAction, maxPriceAge uint64) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.OraclePriceGuards = []types.OraclePriceGuard{{
+		PairId:          defaultPairID.CanonicalString(),
+		CurrencyPair:    oracleGuardCurrencyPair,
+		BaseDenom:       "TokenA",
+		BaseDecimals:    6,
+		QuoteDecimals:   6,
+		MaxDeviationBps: 100,
+		Action:          action,
+		MaxPriceAge:     maxPriceAge,
+	}}
+	_, err := s.msgServer.UpdateParams(s.Ctx, &types.MsgUpdateParams{Params: params, Authority: s.App.DexKeeper.GetAuthority()})
+	s.NoError(err)
+}
+
+// setOraclePrice sets the price of one TokenA in TokenB. Legacy currency pairs use 8 decimals.
+func (s *DexTestSuite) setOraclePrice(price int64) {
+	cp, err := slinkytypes.CurrencyPairFromString(oracleGuardCurrencyPair)
+	s.NoError(err)
+	err = s.App.OracleKeeper.SetPriceForCurrencyPair(s.Ctx, cp, oracletypes.QuotePrice{
+		Price:          sdkmath.NewInt(price),
+		BlockTimestamp: s.Ctx.BlockTime(),
+		BlockHeight:    uint64(s.Ctx.BlockHeight()),
+	})
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) setupOraclePriceGuard(action types.OraclePriceGuardAction) {
+	s.fundAliceBalances(20, 0)
+	s.fundBobBalances(0, 20)
+
+	// GIVEN TokenB liquidity at price 1 and ~1.02
+	s.bobLimitSells("TokenB", 0, 10)
+	s.bobLimitSells("TokenB", 200, 10)
+
+	// AND an oracle price of 1 with a max deviation of 1%
+	s.setOraclePrice(100_000_000)
+	s.setOraclePriceGuard(action, 0)
+}
+
+func (s *DexTestSuite) TestOraclePriceGuardRejectsSwap() {
+	s.setupOraclePriceGuard(types.OraclePriceGuardAction_REJECT)
+
+	// WHEN alice swaps more than the liquidity within 1% of the oracle price
+	// THEN the swap fails
+	s.aliceMultiHopSwapFails(types.ErrOraclePriceDeviation, [][]string{{"TokenA", "TokenB"}}, 15, math_utils.MustNewPrecDecFromStr("0.5"), false)
+
+	// WHEN alice only swaps against liquidity within 1% of the oracle price
+	// THEN the swap succeeds
+	s.aliceMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 5, math_utils.MustNewPrecDecFromStr("0.5"), false)
+	s.assertAliceBalances(15, 5)
+}
+
+func (s *DexTestSuite) TestOraclePriceGuardCapsSwap() {
+	s.setupOraclePriceGuard(types.OraclePriceGuardAction_CAP)
+
+	// WHEN 15 TokenA is swapped
+	amountIn := sdkmath.NewInt(15).Mul(denomMultiple)
+	result, err := s.App.DexKeeper.Swap(s.Ctx, defaultTradePairID0To1, amountIn, nil, nil)
+	s.NoError(err)
+
+	// THEN the swap stops once it reaches liquidity more than 1% from the oracle price
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), result.TotalIn.Amount)
+	s.Equal(sdkmath.NewInt(10).Mul(denomMultiple), result.TotalOut.Amount)
+	s.assertLimitLiquidityAtTick("TokenB", 200, 10)
+}
+
+func (s *DexTestSuite) TestOraclePriceGuardIgnoresStalePrice() {
+	s.setupOraclePriceGuard(types.OraclePriceGuardAction_REJECT)
+	s.setOraclePriceGuard(types.OraclePriceGuardAction_REJECT, 5)
+
+	// WHEN the oracle price is older than max_price_age
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 10)
+
+	// THEN the guard is not applied
+	amountIn := sdkmath.NewInt(15).Mul(denomMultiple)
+	result, err := s.App.DexKeeper.Swap(s.Ctx, defaultTradePairID0To1, amountIn, nil, nil)
+	s.NoError(err)
+	s.True(result.TotalOut.Amount.GT(sdkmath.NewInt(10).Mul(denomMultiple)))
+}
+
+func (s *DexTestSuite) TestOraclePriceGuardWithoutOraclePrice() {
+	s.fundBobBalances(0, 20)
+	s.bobLimitSells("TokenB", 0, 10)
+	s.bobLimitSells("TokenB", 200, 10)
+
+	// GIVEN a guard for a currency pair without an oracle price
+	s.setOraclePriceGuard(types.OraclePriceGuardAction_REJECT, 0)
+
+	// THEN the guard is not applied
+	amountIn := sdkmath.NewInt(15).Mul(denomMultiple)
+	result, err := s.App.DexKeeper.Swap(s.Ctx, defaultTradePairID0To1, amountIn, nil, nil)
+	s.NoError(err)
+	s.True(result.TotalOut.Amount.GT(sdkmath.NewInt(10).Mul(denomMultiple)))
+}
+
+func (s *DexTestSuite) TestSimulateMultiHopSwapOracleReferencePrice() {
+	s.fundBobBalances(0, 20)
+	s.bobLimitSells("TokenB", 0, 10)
+
+	msg := &types.MsgMultiHopSwap{
+		Routes:         []*types.MultiHopRoute{{Hops: []string{"TokenA", "TokenB"}}},
+		AmountIn:       sdkmath.NewInt(5).Mul(denomMultiple),
+		ExitLimitPrice: math_utils.MustNewPrecDecFromStr("0.5"),
+	}
+
+	// WHEN the pair is not guarded
+	resp, err := s.App.DexKeeper.SimulateMultiHopSwap(s.Ctx, &types.QuerySimulateMultiHopSwapRequest{Msg: msg})
+	s.NoError(err)
+
+	// THEN there is no oracle reference price
+	s.Nil(resp.OracleReferencePrice)
+
+	// WHEN the pair is guarded with an oracle price of 1.005
+	s.setOraclePrice(100_500_000)
+	s.setOraclePriceGuard(types.OraclePriceGuardAction_REJECT, 0)
+	resp, err = s.App.DexKeeper.SimulateMultiHopSwap(s.Ctx, &types.QuerySimulateMultiHopSwapRequest{Msg: msg})
+	s.NoError(err)
+
+	// THEN the oracle reference price is returned
+	s.NotNil(resp.OracleReferencePrice)
+	diff := resp.OracleReferencePrice.Sub(math_utils.MustNewPrecDecFromStr("1.005")).Abs()
+	s.True(diff.LT(math_utils.MustNewPrecDecFromStr("0.000000001")), "unexpected oracle reference price %s", resp.OracleReferencePrice)
+}
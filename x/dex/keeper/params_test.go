@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/neutron-org/neutron/v5/testutil/common/sample"
 	testkeeper "github.com/neutron-org/neutron/v5/testutil/dex/keeper"
 	math_utils "github.com/neutron-org/neutron/v5/utils/math"
 	"github.com/neutron-org/neutron/v5/x/dex/types"
@@ -37,6 +38,52 @@ func TestValidateParams(t *testing.T) {
 
 	badFees := []uint64{1, 2, 3, 3}
 	require.Error(t, types.Params{FeeTiers: badFees}.Validate())
+
+	require.NoError(t, types.Params{FeeTiers: goodFees, HookContract: sample.AccAddress()}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, HookContract: "invalid_address"}.Validate())
+
+	require.NoError(t, types.Params{FeeTiers: goodFees, CircuitBreakerMaxTickMove: 10, CircuitBreakerWindow: 5}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, CircuitBreakerMaxTickMove: 10}.Validate())
+
+	halfFee := []types.ProtocolFee{{FeeTier: 5, Fraction: math_utils.MustNewPrecDecFromStr("0.5")}}
+	require.NoError(t, types.Params{FeeTiers: goodFees, ProtocolFees: halfFee}.Validate())
+	unknownTier := []types.ProtocolFee{{FeeTier: 7, Fraction: math_utils.MustNewPrecDecFromStr("0.5")}}
+	require.Error(t, types.Params{FeeTiers: goodFees, ProtocolFees: unknownTier}.Validate())
+	tooLarge := []types.ProtocolFee{{FeeTier: 5, Fraction: math_utils.MustNewPrecDecFromStr("1.5")}}
+	require.Error(t, types.Params{FeeTiers: goodFees, ProtocolFees: tooLarge}.Validate())
+	duplicateTier := append(halfFee, halfFee...)
+	require.Error(t, types.Params{FeeTiers: goodFees, ProtocolFees: duplicateTier}.Validate())
+
+	guard := types.OraclePriceGuard{
+		PairId:          "TokenA<>TokenB",
+		CurrencyPair:    "ATOM/USD",
+		BaseDenom:       "TokenA",
+		BaseDecimals:    6,
+		QuoteDecimals:   6,
+		MaxDeviationBps: 100,
+	}
+	require.NoError(t, types.Params{FeeTiers: goodFees, OraclePriceGuards: []types.OraclePriceGuard{guard}}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, OraclePriceGuards: []types.OraclePriceGuard{guard, guard}}.Validate())
+	badBaseDenom := guard
+	badBaseDenom.BaseDenom = "TokenC"
+	require.Error(t, types.Params{FeeTiers: goodFees, OraclePriceGuards: []types.OraclePriceGuard{badBaseDenom}}.Validate())
+	badCurrencyPair := guard
+	badCurrencyPair.CurrencyPair = "ATOMUSD"
+	require.Error(t, types.Params{FeeTiers: goodFees, OraclePriceGuards: []types.OraclePriceGuard{badCurrencyPair}}.Validate())
+	zeroDeviation := guard
+	zeroDeviation.MaxDeviationBps = 0
+	require.Error(t, types.Params{FeeTiers: goodFees, OraclePriceGuards: []types.OraclePriceGuard{zeroDeviation}}.Validate())
+
+	require.NoError(t, types.Params{FeeTiers: goodFees, LimitOrderTakerFeeBps: 10, LimitOrderMakerRebateBps: 5}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, LimitOrderTakerFeeBps: 5, LimitOrderMakerRebateBps: 10}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, LimitOrderTakerFeeBps: types.MaxLimitOrderTakerFeeBps + 1}.Validate())
+
+	require.NoError(t, types.Params{FeeTiers: goodFees, DynamicFeeTier: 5, DynamicFeeMinBps: 5, DynamicFeeMaxBps: 100, DynamicFeeWindow: 10}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, DynamicFeeTier: 7, DynamicFeeMinBps: 7, DynamicFeeMaxBps: 100, DynamicFeeWindow: 10}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, DynamicFeeTier: 5, DynamicFeeMinBps: 4, DynamicFeeMaxBps: 100, DynamicFeeWindow: 10}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, DynamicFeeTier: 5, DynamicFeeMinBps: 50, DynamicFeeMaxBps: 10, DynamicFeeWindow: 10}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, DynamicFeeTier: 5, DynamicFeeMinBps: 5, DynamicFeeMaxBps: 100}.Validate())
+	require.Error(t, types.Params{FeeTiers: goodFees, DynamicFeeTier: 5, DynamicFeeMinBps: 5, DynamicFeeMaxBps: types.MaxDynamicFeeBps + 1, DynamicFeeWindow: 10}.Validate())
 }
 
 func (s *DexTestSuite) TestPauseDex() {
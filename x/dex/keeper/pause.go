@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetPairPaused adds or removes a PairID from the pause registry
+func (k Keeper) SetPairPaused(ctx sdk.Context, pairID *types.PairID, paused bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PausedPairKeyPrefix))
+	key := []byte(pairID.CanonicalString())
+	if paused {
+		store.Set(key, k.cdc.MustMarshal(pairID))
+	} else {
+		store.Delete(key)
+	}
+}
+
+func (k Keeper) IsPairPaused(ctx sdk.Context, pairID *types.PairID) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PausedPairKeyPrefix))
+	return store.Has([]byte(pairID.CanonicalString()))
+}
+
+// GetAllPausedPairs returns all PairIDs in the pause registry
+func (k Keeper) GetAllPausedPairs(ctx sdk.Context) (list []*types.PairID) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PausedPairKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.PairID{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// SetDenomPaused adds or removes a denom from the pause registry
+func (k Keeper) SetDenomPaused(ctx sdk.Context, denom string, paused bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PausedDenomKeyPrefix))
+	if paused {
+		store.Set([]byte(denom), []byte(denom))
+	} else {
+		store.Delete([]byte(denom))
+	}
+}
+
+func (k Keeper) IsDenomPaused(ctx sdk.Context, denom string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PausedDenomKeyPrefix))
+	return store.Has([]byte(denom))
+}
+
+// GetAllPausedDenoms returns all denoms in the pause registry
+func (k Keeper) GetAllPausedDenoms(ctx sdk.Context) (list []string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PausedDenomKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, string(iterator.Value()))
+	}
+
+	return
+}
+
+// AssertPairNotPaused returns an error if the pair or either of its denoms has been paused by governance
+func (k Keeper) AssertPairNotPaused(ctx sdk.Context, pairID *types.PairID) error {
+	if k.IsPairPaused(ctx, pairID) || k.IsDenomPaused(ctx, pairID.Token0) || k.IsDenomPaused(ctx, pairID.Token1) {
+		return sdkerrors.Wrapf(types.ErrPairPaused, "pair %s", pairID.CanonicalString())
+	}
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package keeper_test
+
+import (
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) setPairPaused(pairID *types.PairID, paused bool) {
+	_, err := s.msgServer.SetPairPaused(s.Ctx, &types.MsgSetPairPaused{
+		Authority: s.App.DexKeeper.GetAuthority(),
+		PairId:    pairID,
+		Paused:    paused,
+	})
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) setCircuitBreakerParams(maxTickMove, window uint64) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.CircuitBreakerMaxTickMove = maxTickMove
+	params.CircuitBreakerWindow = window
+	_, err := s.msgServer.UpdateParams(s.Ctx, &types.MsgUpdateParams{Params: params, Authority: s.App.DexKeeper.GetAuthority()})
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) TestPausePair() {
+	s.fundAliceBalances(100, 100)
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED)
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+
+	// WHEN TokenA<>TokenB is paused
+	s.setPairPaused(defaultPairID, true)
+
+	// THEN deposits, limit orders and swaps fail
+	s.assertAliceDepositFails(types.ErrPairPaused, NewDeposit(0, 10, 0, 1))
+	s.assertAliceLimitSellFails(types.ErrPairPaused, "TokenB", -2, 1, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.aliceMultiHopSwapFails(types.ErrPairPaused, [][]string{{"TokenA", "TokenB"}}, 5, math_utils.MustNewPrecDecFromStr("0.01"), false)
+
+	// Users can still exit their positions
+	s.aliceWithdraws(NewWithdrawal(5, 0, 1))
+	s.aliceCancelsLimitSell(trancheKey)
+
+	// WHEN the pair is unpaused
+	s.setPairPaused(defaultPairID, false)
+
+	// THEN deposits succeed
+	s.aliceDeposits(NewDeposit(0, 10, 0, 1))
+}
+
+func (s *DexTestSuite) TestPausePairUnauthorizedFails() {
+	_, err := s.msgServer.SetPairPaused(s.Ctx, &types.MsgSetPairPaused{
+		Authority: s.alice.String(),
+		PairId:    defaultPairID,
+		Paused:    true,
+	})
+	s.ErrorContains(err, "invalid authority")
+	s.False(s.App.DexKeeper.IsPairPaused(s.Ctx, defaultPairID))
+}
+
+func (s *DexTestSuite) TestPauseDenom() {
+	s.fundAliceBalances(100, 100)
+
+	// WHEN TokenB is paused
+	_, err := s.msgServer.SetDenomPaused(s.Ctx, &types.MsgSetDenomPaused{
+		Authority: s.App.DexKeeper.GetAuthority(),
+		Denom:     "TokenB",
+		Paused:    true,
+	})
+	s.NoError(err)
+
+	// THEN every pair containing TokenB is paused
+	s.assertAliceDepositFails(types.ErrPairPaused, NewDeposit(0, 10, 0, 1))
+	s.assertAliceLimitSellFails(types.ErrPairPaused, "TokenA", 0, 10, types.LimitOrderType_GOOD_TIL_CANCELLED)
+}
+
+func (s *DexTestSuite) TestCircuitBreakerRejectsSwap() {
+	s.fundAliceBalances(100, 100)
+	s.fundBobBalances(100, 100)
+	s.setCircuitBreakerParams(5, 10)
+
+	// GIVEN TokenB liquidity at tick 0 and tick 20
+	s.aliceLimitSells("TokenB", 0, 10)
+	s.aliceLimitSells("TokenB", 20, 10)
+
+	// WHEN bob swaps through all of the liquidity at tick 0
+	// THEN the swap is rejected because it would move the best price 20 ticks
+	s.assertBobLimitSellFails(types.ErrCircuitBreakerTripped, "TokenA", 10, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.assertBobBalances(100, 100)
+
+	// The pair is not frozen, swaps within the limit and deposits still succeed
+	s.bobLimitSells("TokenA", 10, 5, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.assertBobBalances(95, 105)
+	s.aliceDeposits(NewDeposit(10, 0, -10, 1))
+}
+
+func (s *DexTestSuite) TestCircuitBreakerWindowResets() {
+	s.fundAliceBalances(100, 100)
+	s.fundBobBalances(100, 100)
+	s.setCircuitBreakerParams(5, 10)
+
+	// GIVEN TokenB liquidity at tick 0, 3 and 6
+	s.aliceLimitSells("TokenB", 0, 10)
+	s.aliceLimitSells("TokenB", 3, 10)
+	s.aliceLimitSells("TokenB", 6, 10)
+
+	// GIVEN bob has moved the best price 3 ticks in the current window
+	s.bobLimitSells("TokenA", 1, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.assertBobBalances(90, 110)
+
+	// WHEN bob swaps through the liquidity at tick 3 in the same window
+	// THEN the swap is rejected because the best price would have moved 6 ticks
+	s.assertBobLimitSellFails(types.ErrCircuitBreakerTripped, "TokenA", 4, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.assertBobBalances(90, 110)
+
+	// WHEN the window has passed
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 10)
+
+	// THEN the new window starts at tick 3 and the swap succeeds
+	s.bobLimitSells("TokenA", 4, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+}
+
+func (s *DexTestSuite) TestCircuitBreakerReset() {
+	s.fundAliceBalances(100, 100)
+	s.fundBobBalances(100, 100)
+	s.setCircuitBreakerParams(5, 10)
+
+	// GIVEN TokenB liquidity at tick 0, 3 and 6 and bob has moved the best price 3 ticks
+	s.aliceLimitSells("TokenB", 0, 10)
+	s.aliceLimitSells("TokenB", 3, 10)
+	s.aliceLimitSells("TokenB", 6, 10)
+	s.bobLimitSells("TokenA", 1, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+	s.assertBobLimitSellFails(types.ErrCircuitBreakerTripped, "TokenA", 4, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// WHEN governance resets the circuit breaker
+	_, err := s.msgServer.ResetCircuitBreaker(s.Ctx, &types.MsgResetCircuitBreaker{
+		Authority: s.App.DexKeeper.GetAuthority(),
+		PairId:    defaultPairID,
+	})
+	s.NoError(err)
+
+	// THEN a new window starts from the current price and the swap succeeds
+	s.bobLimitSells("TokenA", 4, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+}
+
+func (s *DexTestSuite) TestCircuitBreakerDoesNotTripWithinLimit() {
+	s.fundAliceBalances(100, 100)
+	s.fundBobBalances(100, 100)
+	s.setCircuitBreakerParams(5, 10)
+
+	// GIVEN TokenB liquidity at tick 0 and tick 3
+	s.aliceLimitSells("TokenB", 0, 10)
+	s.aliceLimitSells("TokenB", 3, 10)
+
+	// WHEN bob swaps through all of the liquidity at tick 0
+	s.bobLimitSells("TokenA", 1, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN the best price has only moved 3 ticks and the swap succeeds
+	s.assertBobBalances(90, 110)
+}
+
+func (s *DexTestSuite) TestCircuitBreakerDisabledByDefault() {
+	s.fundAliceBalances(100, 100)
+	s.fundBobBalances(100, 100)
+
+	// GIVEN TokenB liquidity at tick 0 and tick 20
+	s.aliceLimitSells("TokenB", 0, 10)
+	s.aliceLimitSells("TokenB", 20, 10)
+
+	// WHEN bob swaps through all of the liquidity at tick 0
+	s.bobLimitSells("TokenA", 10, 15, types.LimitOrderType_IMMEDIATE_OR_CANCEL)
+
+	// THEN the swap succeeds and no circuit breaker is tracked
+	s.assertBobBalances(90, 110)
+	s.Empty(s.App.DexKeeper.GetAllCircuitBreaker(s.Ctx))
+}
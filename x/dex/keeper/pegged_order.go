@@ -0,0 +1,226 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) SetPeggedOrder(ctx sdk.Context, order *types.PeggedOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.PeggedOrderKey(order.Address, order.TrancheKey), k.cdc.MustMarshal(order))
+}
+
+func (k Keeper) GetPeggedOrder(ctx sdk.Context, address, trancheKey string) (order *types.PeggedOrder, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PeggedOrderKey(address, trancheKey))
+	if b == nil {
+		return nil, false
+	}
+
+	order = &types.PeggedOrder{}
+	k.cdc.MustUnmarshal(b, order)
+
+	return order, true
+}
+
+func (k Keeper) RemovePeggedOrder(ctx sdk.Context, order *types.PeggedOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.PeggedOrderKey(order.Address, order.TrancheKey))
+}
+
+// GetAllPeggedOrder returns all PeggedOrders
+func (k Keeper) GetAllPeggedOrder(ctx sdk.Context) (list []*types.PeggedOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PeggedOrderKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.PeggedOrder{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetPeggedTickIndex returns the tick at which an order selling tokenIn should be placed given the current oracle price
+// of its peg, along with the block height of that oracle price.
+func (k Keeper) GetPeggedTickIndex(
+	ctx sdk.Context,
+	tokenIn string,
+	peg types.OraclePeg,
+) (tickIndexInToOut int64, oraclePriceHeight uint64, err error) {
+	oraclePrice, decimals, oraclePriceHeight, found := k.GetOraclePrice(ctx, peg.MustCurrencyPair())
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrOraclePriceNotFound, "%s", peg.CurrencyPair)
+	}
+
+	tickIndexInToOut, err = peg.TickIndexInToOut(tokenIn, oraclePrice, decimals)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return tickIndexInToOut, oraclePriceHeight, nil
+}
+
+// PlacePeggedLimitOrderCore places a GOOD_TIL_CANCELLED limit order at the tick implied by the current oracle price
+// of peg and tracks it so that it follows subsequent oracle price changes.
+func (k Keeper) PlacePeggedLimitOrderCore(
+	goCtx context.Context,
+	tokenIn string,
+	tokenOut string,
+	amountIn math.Int,
+	peg types.OraclePeg,
+	autoWithdraw bool,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+) (trancheKey string, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tickIndexInToOut, oraclePriceHeight, err := k.GetPeggedTickIndex(ctx, tokenIn, peg)
+	if err != nil {
+		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin, err
+	}
+
+	trancheKey, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin, err = k.PlaceLimitOrderCore(
+		goCtx,
+		tokenIn,
+		tokenOut,
+		amountIn,
+		tickIndexInToOut,
+		types.LimitOrderType_GOOD_TIL_CANCELLED,
+		nil,
+		0,
+		nil,
+		nil,
+		autoWithdraw,
+		callerAddr,
+		receiverAddr,
+	)
+	if err != nil {
+		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin, err
+	}
+
+	// Orders that are entirely filled as a taker leave nothing to track
+	if _, found := k.GetLimitOrderTrancheUser(ctx, receiverAddr.String(), trancheKey); found {
+		// This will never panic because PlaceLimitOrderCore has already constructed the TradePairID
+		tradePairID := types.MustNewTradePairID(tokenIn, tokenOut)
+		k.SetPeggedOrder(ctx, &types.PeggedOrder{
+			Address:           receiverAddr.String(),
+			TrancheKey:        trancheKey,
+			TradePairId:       tradePairID,
+			Peg:               peg,
+			TickIndexInToOut:  tickIndexInToOut,
+			OraclePriceHeight: oraclePriceHeight,
+		})
+		ctx.GasMeter().ConsumeGas(types.PeggedOrderGas, "Pegged LimitOrder Fee")
+	}
+
+	return trancheKey, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin, nil
+}
+
+// MovePeggedOrders moves every PeggedOrder whose oracle price has changed to the tick implied by the new price.
+// Execution stops once PeggedOrderAllowance gas has been consumed; the following block resumes with the next order.
+func (k Keeper) MovePeggedOrders(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.Paused {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	orderStore := prefix.NewStore(store, types.KeyPrefix(types.PeggedOrderKeyPrefix))
+	cursorKey := types.KeyPrefix(types.PeggedOrderCursorKey)
+	cursor := store.Get(cursorKey)
+
+	gasCutoff := ctx.GasMeter().GasConsumed() + params.PeggedOrderAllowance
+	next, end := cursor, []byte(nil)
+	for {
+		key, order, found := k.firstPeggedOrder(orderStore, next, end)
+		if !found {
+			// Wrap around to the orders that precede the cursor
+			if cursor == nil || end != nil {
+				break
+			}
+			next, end = nil, cursor
+			continue
+		}
+
+		gasConsumed := ctx.GasMeter().GasConsumed()
+		if gasConsumed >= gasCutoff {
+			store.Set(cursorKey, key)
+			ctx.EventManager().EmitEvent(types.PeggedOrderHitLimitEvent(gasConsumed))
+			return
+		}
+
+		k.movePeggedOrder(ctx, order)
+		next = append(key, 0x00)
+	}
+
+	store.Delete(cursorKey)
+}
+
+func (k Keeper) firstPeggedOrder(store storetypes.KVStore, start, end []byte) (key []byte, order *types.PeggedOrder, found bool) {
+	iter := store.Iterator(start, end)
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, nil, false
+	}
+
+	order = &types.PeggedOrder{}
+	k.cdc.MustUnmarshal(iter.Value(), order)
+
+	return append([]byte{}, iter.Key()...), order, true
+}
+
+func (k Keeper) movePeggedOrder(ctx sdk.Context, order *types.PeggedOrder) {
+	oraclePrice, decimals, oraclePriceHeight, found := k.GetOraclePrice(ctx, order.Peg.MustCurrencyPair())
+	if !found || oraclePriceHeight == order.OraclePriceHeight {
+		return
+	}
+
+	newTickIndex, err := order.Peg.TickIndexInToOut(order.TradePairId.TakerDenom, oraclePrice, decimals)
+	if err == nil && newTickIndex == order.TickIndexInToOut {
+		order.OraclePriceHeight = oraclePriceHeight
+		k.SetPeggedOrder(ctx, order)
+		return
+	}
+
+	newTrancheKey := ""
+	if err == nil {
+		ownerAddr := sdk.MustAccAddressFromBech32(order.Address)
+		cacheCtx, writeCache := ctx.CacheContext()
+		newTrancheKey, _, _, _, err = k.AmendLimitOrderCore(cacheCtx, order.TrancheKey, nil, &newTickIndex, ownerAddr)
+		if err == nil {
+			writeCache()
+		}
+	}
+
+	ctx.EventManager().EmitEvent(types.PeggedOrderMovedEvent(order, newTrancheKey, newTickIndex, err))
+
+	switch {
+	case err == nil:
+		k.RemovePeggedOrder(ctx, order)
+		if _, found := k.GetLimitOrderTrancheUser(ctx, order.Address, newTrancheKey); found {
+			order.TrancheKey = newTrancheKey
+			order.TickIndexInToOut = newTickIndex
+			order.OraclePriceHeight = oraclePriceHeight
+			k.SetPeggedOrder(ctx, order)
+		}
+	case errors.Is(err, types.ErrAmendFilledLimitOrder) || errors.Is(err, types.ErrValidLimitOrderTrancheNotFound):
+		// The order no longer has any unfilled amount to move
+		k.RemovePeggedOrder(ctx, order)
+	default:
+		// Retry once the oracle price changes again
+		order.OraclePriceHeight = oraclePriceHeight
+		k.SetPeggedOrder(ctx, order)
+	}
+}
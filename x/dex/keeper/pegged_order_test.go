@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) alicePlacesPeggedOrder(amountIn int64) string {
+	return s.placesPeggedOrder(s.alice, amountIn)
+}
+
+func (s *DexTestSuite) bobPlacesPeggedOrder(amountIn int64) string {
+	return s.placesPeggedOrder(s.bob, amountIn)
+}
+
+func (s *DexTestSuite) placesPeggedOrder(account sdk.AccAddress, amountIn int64) string {
+	resp, err := s.placesPeggedOrderWithErr(account, amountIn)
+	s.NoError(err)
+
+	return resp.TrancheKey
+}
+
+func (s *DexTestSuite) alicePlacesPeggedOrderWithErr(amountIn int64) (*types.MsgPlaceLimitOrderResponse, error) {
+	return s.placesPeggedOrderWithErr(s.alice, amountIn)
+}
+
+func (s *DexTestSuite) placesPeggedOrderWithErr(account sdk.AccAddress, amountIn int64) (*types.MsgPlaceLimitOrderResponse, error) {
+	return s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:   account.String(),
+		Receiver:  account.String(),
+		TokenIn:   "TokenA",
+		TokenOut:  "TokenB",
+		AmountIn:  sdkmath.NewInt(amountIn).Mul(denomMultiple),
+		OrderType: types.LimitOrderType_GOOD_TIL_CANCELLED,
+		OraclePeg: &types.OraclePeg{
+			CurrencyPair:  oracleGuardCurrencyPair,
+			BaseDenom:     "TokenA",
+			BaseDecimals:  6,
+			QuoteDecimals: 6,
+		},
+	})
+}
+
+func (s *DexTestSuite) nextBlockWithOraclePrice(price int64) {
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	s.setOraclePrice(price)
+}
+
+func (s *DexTestSuite) assertAlicePeggedOrder(trancheKey string, tickIndexInToOut int64) {
+	order, found := s.App.DexKeeper.GetPeggedOrder(s.Ctx, s.alice.String(), trancheKey)
+	s.True(found, "PeggedOrder not found")
+	s.Equal(tickIndexInToOut, order.TickIndexInToOut)
+}
+
+func (s *DexTestSuite) TestPeggedOrderPlacedAtOraclePrice() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN an oracle price of 1 TokenB per TokenA
+	s.setOraclePrice(100_000_000)
+
+	// WHEN alice places a TokenA order pegged to the oracle price
+	trancheKey := s.alicePlacesPeggedOrder(10)
+
+	// THEN the order rests at the oracle price
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertAlicePeggedOrder(trancheKey, 0)
+	s.assertAliceBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestPeggedOrderWithoutOraclePriceFails() {
+	s.fundAliceBalances(10, 0)
+
+	// WHEN alice places a pegged order without an oracle price
+	_, err := s.alicePlacesPeggedOrderWithErr(10)
+
+	// THEN the order is rejected
+	s.ErrorIs(err, types.ErrOraclePriceNotFound)
+	s.assertAliceBalances(10, 0)
+}
+
+func (s *DexTestSuite) TestPeggedOrderMovesWithOraclePrice() {
+	s.fundAliceBalances(10, 0)
+	s.setOraclePrice(100_000_000)
+	trancheKey := s.alicePlacesPeggedOrder(10)
+
+	// WHEN the oracle price rises to ~1.0202 TokenB per TokenA
+	s.nextBlockWithOraclePrice(102_020_032)
+	s.App.DexKeeper.MovePeggedOrders(s.Ctx)
+
+	// THEN the order is moved to the new price
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertLimitLiquidityAtTick("TokenA", -200, 10)
+	s.AssertNEventValuesEmitted(types.PeggedOrderMovedEventKey, 1)
+	s.AssertEventEmitted(s.Ctx, types.EventTypeTickUpdate, 2)
+
+	_, found := s.App.DexKeeper.GetPeggedOrder(s.Ctx, s.alice.String(), trancheKey)
+	s.False(found)
+	orders := s.App.DexKeeper.GetAllPeggedOrder(s.Ctx)
+	s.Len(orders, 1)
+	s.Equal(int64(-200), orders[0].TickIndexInToOut)
+}
+
+func (s *DexTestSuite) TestPeggedOrderNotMovedWithoutNewOraclePrice() {
+	s.fundAliceBalances(10, 0)
+	s.setOraclePrice(100_000_000)
+	trancheKey := s.alicePlacesPeggedOrder(10)
+
+	// WHEN pegged orders are moved without an oracle price update
+	s.Ctx = s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() + 1).WithEventManager(sdk.NewEventManager())
+	s.App.DexKeeper.MovePeggedOrders(s.Ctx)
+
+	// THEN the order is left in place
+	s.assertLimitLiquidityAtTick("TokenA", 0, 10)
+	s.assertAlicePeggedOrder(trancheKey, 0)
+	s.AssertNEventValuesEmitted(types.PeggedOrderMovedEventKey, 0)
+}
+
+func (s *DexTestSuite) TestPeggedOrderCancelRemovesPeg() {
+	s.fundAliceBalances(10, 0)
+	s.setOraclePrice(100_000_000)
+	trancheKey := s.alicePlacesPeggedOrder(10)
+
+	// WHEN alice cancels the pegged order
+	s.aliceCancelsLimitSell(trancheKey)
+
+	// THEN it is no longer tracked
+	s.Empty(s.App.DexKeeper.GetAllPeggedOrder(s.Ctx))
+	s.assertAliceBalances(10, 0)
+}
+
+func (s *DexTestSuite) TestMovePeggedOrdersHitsGasLimit() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(10, 0)
+
+	// GIVEN pegged orders from alice and bob
+	s.setOraclePrice(100_000_000)
+	s.alicePlacesPeggedOrder(10)
+	s.bobPlacesPeggedOrder(10)
+
+	// AND no PeggedOrderAllowance
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.PeggedOrderAllowance = 0
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	// WHEN the oracle price changes
+	s.nextBlockWithOraclePrice(102_020_032)
+	s.App.DexKeeper.MovePeggedOrders(s.Ctx)
+
+	// THEN nothing is moved
+	s.assertLimitLiquidityAtTick("TokenA", 0, 20)
+	s.AssertEventEmitted(s.Ctx, types.EventTypePeggedOrderHitGasLimit, 1)
+
+	// WHEN the allowance is restored
+	params.PeggedOrderAllowance = types.DefaultPeggedOrderAllowance
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+	s.App.DexKeeper.MovePeggedOrders(s.Ctx)
+
+	// THEN both orders are moved
+	s.assertLimitLiquidityAtTick("TokenA", 0, 0)
+	s.assertLimitLiquidityAtTick("TokenA", -200, 20)
+}
+
+func (s *DexTestSuite) TestPeggedOrderValidation() {
+	s.fundAliceBalances(10, 0)
+	s.setOraclePrice(100_000_000)
+
+	msg := &types.MsgPlaceLimitOrder{
+		Creator:   s.alice.String(),
+		Receiver:  s.alice.String(),
+		TokenIn:   "TokenA",
+		TokenOut:  "TokenB",
+		AmountIn:  sdkmath.NewInt(10).Mul(denomMultiple),
+		OrderType: types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+		OraclePeg: &types.OraclePeg{CurrencyPair: oracleGuardCurrencyPair, BaseDenom: "TokenA"},
+	}
+	_, err := s.msgServer.PlaceLimitOrder(s.Ctx, msg)
+	s.ErrorIs(err, types.ErrInvalidPeggedOrderType)
+
+	msg.OrderType = types.LimitOrderType_GOOD_TIL_CANCELLED
+	msg.TickIndexInToOut = 10
+	_, err = s.msgServer.PlaceLimitOrder(s.Ctx, msg)
+	s.ErrorIs(err, types.ErrInvalidOraclePeg)
+
+	msg.TickIndexInToOut = 0
+	msg.OraclePeg.BaseDenom = "TokenC"
+	_, err = s.msgServer.PlaceLimitOrder(s.Ctx, msg)
+	s.ErrorIs(err, types.ErrInvalidOraclePeg)
+
+	msg.OraclePeg.BaseDenom = "TokenA"
+	msg.OraclePeg.OffsetBps = -10_000
+	_, err = s.msgServer.PlaceLimitOrder(s.Ctx, msg)
+	s.ErrorIs(err, types.ErrInvalidOraclePeg)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	sdkerrors "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -20,30 +21,59 @@ func (k Keeper) PlaceLimitOrderCore(
 	tickIndexInToOut int64,
 	orderType types.LimitOrderType,
 	goodTil *time.Time,
+	goodTilHeight uint64,
 	maxAmountOut *math.Int,
 	minAvgSellPriceP *math_utils.PrecDec,
+	autoWithdraw bool,
 	callerAddr sdk.AccAddress,
 	receiverAddr sdk.AccAddress,
-) (trancheKey string, totalInCoin, swapInCoin, swapOutCoin sdk.Coin, err error) {
+) (trancheKey string, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin sdk.Coin, err error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
 	takerTradePairID, err := types.NewTradePairID(tokenIn, tokenOut)
 	if err != nil {
-		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin, err
 	}
-	trancheKey, totalIn, swapInCoin, swapOutCoin, sharesIssued, minAvgSellPrice, err := k.ExecutePlaceLimitOrder(
+
+	// Taker orders on pairs in batch auction mode are queued until the auction is cleared in EndBlock
+	if orderType.IsTakerOnly() && k.IsPairBatchAuction(ctx, takerTradePairID.MustPairID()) {
+		trancheKey, totalInCoin, err = k.PlaceBatchAuctionOrderCore(
+			ctx,
+			takerTradePairID,
+			amountIn,
+			tickIndexInToOut,
+			orderType,
+			maxAmountOut,
+			minAvgSellPriceP,
+			callerAddr,
+			receiverAddr,
+		)
+		swapInCoin = sdk.NewCoin(tokenIn, math.ZeroInt())
+		swapOutCoin = sdk.NewCoin(tokenOut, math.ZeroInt())
+		takerFeeCoin = sdk.NewCoin(tokenIn, math.ZeroInt())
+
+		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin, err
+	}
+
+	// Repriced POST_ONLY orders are reported at the tick they are placed at
+	trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, _, sharesIssued, minAvgSellPrice, err := k.ExecutePlaceLimitOrder(
 		ctx,
 		takerTradePairID,
 		amountIn,
 		tickIndexInToOut,
 		orderType,
 		goodTil,
+		goodTilHeight,
 		maxAmountOut,
 		minAvgSellPriceP,
 		receiverAddr,
 	)
 	if err != nil {
-		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+		return trancheKey, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin, err
+	}
+
+	if autoWithdraw && sharesIssued.IsPositive() {
+		k.EnableAutoWithdraw(ctx, receiverAddr.String(), trancheKey)
 	}
 
 	if swapOutCoin.IsPositive() {
@@ -54,7 +84,7 @@ func (k Keeper) PlaceLimitOrderCore(
 			sdk.Coins{swapOutCoin},
 		)
 		if err != nil {
-			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin, err
 		}
 	}
 
@@ -68,7 +98,7 @@ func (k Keeper) PlaceLimitOrderCore(
 			sdk.Coins{totalInCoin},
 		)
 		if err != nil {
-			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, err
+			return trancheKey, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin, err
 		}
 	}
 
@@ -89,9 +119,14 @@ func (k Keeper) PlaceLimitOrderCore(
 		trancheKey,
 		swapInCoin.Amount,
 		swapOutCoin.Amount,
+		takerFeeCoin.Amount,
 	))
 
-	return trancheKey, totalInCoin, swapInCoin, swapOutCoin, nil
+	if swapOutCoin.IsPositive() {
+		k.queueAfterSwap(ctx, callerAddr, receiverAddr, swapInCoin, swapOutCoin)
+	}
+
+	return trancheKey, totalInCoin, swapInCoin, swapOutCoin, takerFeeCoin, nil
 }
 
 // ExecutePlaceLimitOrder handles the core logic for PlaceLimitOrder -- performing taker a swap
@@ -104,22 +139,37 @@ func (k Keeper) ExecutePlaceLimitOrder(
 	tickIndexInToOut int64,
 	orderType types.LimitOrderType,
 	goodTil *time.Time,
+	goodTilHeight uint64,
 	maxAmountOut *math.Int,
 	minAvgSellPriceP *math_utils.PrecDec,
 	receiverAddr sdk.AccAddress,
 ) (
 	trancheKey string,
+	placeTickIndexInToOut int64,
 	totalIn math.Int,
-	swapInCoin, swapOutCoin sdk.Coin,
+	swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin sdk.Coin,
 	sharesIssued math.Int,
 	minAvgSellPrice math_utils.PrecDec,
 	err error,
 ) {
+	if orderType.IsTrigger() {
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin, math.ZeroInt(), math_utils.ZeroPrecDec(), types.ErrTriggerOrderNotExecutable
+	}
+
+	if err := k.AssertPairNotPaused(ctx, takerTradePairID.MustPairID()); err != nil {
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin, math.ZeroInt(), math_utils.ZeroPrecDec(), err
+	}
+
+	tickIndexInToOut, err = k.PostOnlyTickIndex(ctx, takerTradePairID, tickIndexInToOut, orderType)
+	if err != nil {
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin, math.ZeroInt(), math_utils.ZeroPrecDec(), err
+	}
+
 	amountLeft := amountIn
 
 	limitBuyPrice, err := types.CalcPrice(tickIndexInToOut)
 	if err != nil {
-		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), math_utils.ZeroPrecDec(), err
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin, math.ZeroInt(), math_utils.ZeroPrecDec(), err
 	}
 
 	// Use limitPrice for minAvgSellPrice if it has not been specified
@@ -132,18 +182,30 @@ func (k Keeper) ExecutePlaceLimitOrder(
 	// Ensure that after rounding user will get at least 1 token out.
 	err = types.ValidateFairOutput(amountIn, limitBuyPrice)
 	if err != nil {
-		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin, math.ZeroInt(), minAvgSellPrice, err
 	}
 
-	var orderFilled bool
-	if orderType.IsTakerOnly() {
-		swapInCoin, swapOutCoin, err = k.TakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, maxAmountOut, limitBuyPrice, minAvgSellPrice, orderType)
-	} else {
-		swapInCoin, swapOutCoin, orderFilled, err = k.MakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, limitBuyPrice, minAvgSellPrice)
+	var swapResult types.SwapResult
+	switch {
+	case orderType.IsTakerOnly():
+		swapResult, err = k.TakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, maxAmountOut, limitBuyPrice, minAvgSellPrice, orderType)
+	case orderType.IsPostOnly():
+		// POST_ONLY orders never take liquidity
+		swapResult = types.SwapResult{
+			TotalIn:    sdk.NewCoin(takerTradePairID.TakerDenom, math.ZeroInt()),
+			TotalOut:   sdk.NewCoin(takerTradePairID.MakerDenom, math.ZeroInt()),
+			TakerFee:   sdk.NewCoin(takerTradePairID.TakerDenom, math.ZeroInt()),
+			DynamicFee: sdk.NewCoin(takerTradePairID.TakerDenom, math.ZeroInt()),
+		}
+	default:
+		swapResult, err = k.MakerLimitOrderSwap(ctx, *takerTradePairID, amountIn, limitBuyPrice, minAvgSellPrice)
 	}
 	if err != nil {
-		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin, math.ZeroInt(), minAvgSellPrice, err
 	}
+	swapInCoin, swapOutCoin = swapResult.TotalIn, swapResult.TotalOut
+	takerFeeCoin, dynamicFeeCoin = swapResult.TakerFee, swapResult.DynamicFee
+	orderFilled := swapResult.OrderFilled
 
 	totalIn = swapInCoin.Amount
 	amountLeft = amountLeft.Sub(swapInCoin.Amount)
@@ -156,10 +218,11 @@ func (k Keeper) ExecutePlaceLimitOrder(
 		makerTradePairID,
 		tickIndexTakerToMaker,
 		goodTil,
+		goodTilHeight,
 		orderType,
 	)
 	if err != nil {
-		return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
+		return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin, math.ZeroInt(), minAvgSellPrice, err
 	}
 
 	trancheKey = placeTranche.Key.TrancheKey
@@ -172,9 +235,9 @@ func (k Keeper) ExecutePlaceLimitOrder(
 		receiverAddr.String(),
 	)
 
-	// FOR GTC, JIT & GoodTil try to place a maker limitOrder with remaining Amount
+	// FOR GTC, JIT, GoodTil, GoodTilBlock & PostOnly try to place a maker limitOrder with remaining Amount
 	if amountLeft.IsPositive() && !orderFilled &&
-		(orderType.IsGTC() || orderType.IsJIT() || orderType.IsGoodTil()) {
+		(orderType.IsGTC() || orderType.IsJIT() || orderType.IsGoodTil() || orderType.IsGoodTilBlock() || orderType.IsPostOnly()) {
 
 		// Ensure that the maker portion will generate at least 1 token of output
 		// NOTE: This does mean that a successful taker leg of the trade will be thrown away since the entire tx will fail.
@@ -182,14 +245,13 @@ func (k Keeper) ExecutePlaceLimitOrder(
 		// order with the remaining liquidity.
 		err = types.ValidateFairOutput(amountLeft, limitBuyPrice)
 		if err != nil {
-			return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
+			return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin, math.ZeroInt(), minAvgSellPrice, err
 		}
 		placeTranche.PlaceMakerLimitOrder(amountLeft)
 		trancheUser.SharesOwned = trancheUser.SharesOwned.Add(amountLeft)
 
 		if orderType.HasExpiration() {
-			goodTilRecord := NewLimitOrderExpiration(placeTranche)
-			k.SetLimitOrderExpiration(ctx, goodTilRecord)
+			k.SetTrancheExpiration(ctx, placeTranche)
 			ctx.GasMeter().ConsumeGas(types.ExpiringLimitOrderGas, "Expiring LimitOrder Fee")
 		}
 
@@ -208,10 +270,50 @@ func (k Keeper) ExecutePlaceLimitOrder(
 	if orderType.IsJIT() {
 		err = k.AssertCanPlaceJIT(ctx)
 		if err != nil {
-			return trancheKey, totalIn, swapInCoin, swapOutCoin, math.ZeroInt(), minAvgSellPrice, err
+			return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin, math.ZeroInt(), minAvgSellPrice, err
 		}
 		k.IncrementJITsInBlock(ctx)
 	}
 
-	return trancheKey, totalIn, swapInCoin, swapOutCoin, sharesIssued, minAvgSellPrice, nil
+	return trancheKey, tickIndexInToOut, totalIn, swapInCoin, swapOutCoin, takerFeeCoin, dynamicFeeCoin, sharesIssued, minAvgSellPrice, nil
+}
+
+// PostOnlyTickIndex returns the tick that a limit order will be placed at. For POST_ONLY orders that would
+// fill against the opposing side of the book an error is returned; POST_ONLY_REPRICE orders are instead moved
+// one tick behind the best opposing price. All other order types are returned unchanged.
+func (k Keeper) PostOnlyTickIndex(
+	ctx sdk.Context,
+	takerTradePairID *types.TradePairID,
+	tickIndexInToOut int64,
+	orderType types.LimitOrderType,
+) (int64, error) {
+	if !orderType.IsPostOnly() {
+		return tickIndexInToOut, nil
+	}
+
+	// An order placed at the best opposing price would be filled as a taker, so it is checked one tick closer to
+	// the opposing side of the book
+	makerTradePairID := takerTradePairID.Reversed()
+	tickIndexTakerToMaker := tickIndexInToOut * -1
+	if !k.IsBehindEnemyLines(ctx, makerTradePairID, tickIndexTakerToMaker-1) {
+		return tickIndexInToOut, nil
+	}
+
+	oppositeTick, _ := k.GetCurrTickIndexTakerToMaker(ctx, takerTradePairID)
+
+	if !orderType.IsPostOnlyReprice() {
+		return 0, sdkerrors.Wrapf(
+			types.ErrPostOnlyOrderCrossesBook,
+			"tick index %d crosses best opposing tick index %d",
+			tickIndexInToOut,
+			oppositeTick,
+		)
+	}
+
+	repricedTickIndex := oppositeTick - 1
+	if types.IsTickOutOfRange(repricedTickIndex) {
+		return 0, types.ErrTickOutsideRange
+	}
+
+	return repricedTickIndex, nil
 }
@@ -34,6 +34,10 @@ func (k Keeper) InitPool(
 
 	k.StorePoolIDRef(ctx, poolID, pairID, centerTickIndexNormalized, fee)
 
+	if params := k.GetParams(ctx); params.IsDynamicFeeTier(fee) {
+		k.InitDynamicFee(ctx, params, pairID)
+	}
+
 	return types.NewPool(pairID, centerTickIndexNormalized, fee, poolID)
 }
 
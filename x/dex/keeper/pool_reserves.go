@@ -54,6 +54,8 @@ func (k Keeper) UpdatePoolReserves(ctx sdk.Context, reserves *types.PoolReserves
 		k.RemovePoolReserves(ctx, reserves.Key)
 	}
 
+	k.MarkPriceAccumulatorDirty(ctx, reserves.Key.TradePairId)
+
 	// TODO: This will create a bit of extra noise since UpdatePoolReserves is called for both sides of the pool,
 	// but not in some cases only one side has been updated
 	// This should be solved upstream by better tracking of dirty ticks
@@ -0,0 +1,298 @@
+package keeper
+
+import (
+	"bytes"
+	"time"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) SetPriceAccumulator(ctx sdk.Context, accumulator *types.PriceAccumulator) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(accumulator)
+	store.Set(types.PriceAccumulatorKey(accumulator.TradePairId, accumulator.Timestamp), b)
+
+	pairKey := types.PriceAccumulatorPairKey(accumulator.TradePairId)
+	if !store.Has(pairKey) {
+		store.Set(pairKey, k.cdc.MustMarshal(accumulator.TradePairId))
+	}
+}
+
+// GetPriceAccumulatorAtOrBefore returns the most recent PriceAccumulator of the TradePairID
+// with a timestamp less than or equal to t
+func (k Keeper) GetPriceAccumulatorAtOrBefore(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	t time.Time,
+) (val *types.PriceAccumulator, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceAccumulatorPrefix(tradePairID))
+	iterator := store.ReverseIterator(nil, storetypes.PrefixEndBytes(types.TimeBytes(t)))
+
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return nil, false
+	}
+
+	val = &types.PriceAccumulator{}
+	k.cdc.MustUnmarshal(iterator.Value(), val)
+
+	return val, true
+}
+
+// GetAllPriceAccumulator returns all PriceAccumulators
+func (k Keeper) GetAllPriceAccumulator(ctx sdk.Context) (list []*types.PriceAccumulator) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PriceAccumulatorKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.PriceAccumulator{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// MarkPriceAccumulatorDirty flags the TradePairID so that its PriceAccumulator is updated at the end of the block
+func (k Keeper) MarkPriceAccumulatorDirty(ctx sdk.Context, tradePairID *types.TradePairID) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.DirtyPriceAccumulatorKeyPrefix))
+	key := types.TradePairIDKey(tradePairID)
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, k.cdc.MustMarshal(tradePairID))
+}
+
+// UpdatePriceAccumulators advances the PriceAccumulator of every TradePairID whose liquidity was updated during
+// the block and prunes snapshots that have fallen out of the retention window. TradePairIDs without updates are
+// advanced and pruned by a sweep of at most PriceAccumulatorSweepLimit pairs per block, so that idle pairs are
+// also kept up to date.
+func (k Keeper) UpdatePriceAccumulators(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.TransientStore(k.tKey), types.KeyPrefix(types.DirtyPriceAccumulatorKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var dirtyKeys [][]byte
+	var tradePairIDs []*types.TradePairID
+	for ; iterator.Valid(); iterator.Next() {
+		tradePairID := &types.TradePairID{}
+		k.cdc.MustUnmarshal(iterator.Value(), tradePairID)
+		tradePairIDs = append(tradePairIDs, tradePairID)
+		dirtyKeys = append(dirtyKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	cutoff := ctx.BlockTime().Add(-types.PriceAccumulatorRetention)
+	updated := make(map[string]bool, len(tradePairIDs))
+	for _, tradePairID := range tradePairIDs {
+		k.updatePriceAccumulator(ctx, tradePairID)
+		k.prunePriceAccumulators(ctx, tradePairID, cutoff)
+		updated[string(types.TradePairIDKey(tradePairID))] = true
+	}
+
+	for _, tradePairID := range k.nextPriceAccumulatorSweep(ctx, types.PriceAccumulatorSweepLimit) {
+		if updated[string(types.TradePairIDKey(tradePairID))] {
+			continue
+		}
+		k.updatePriceAccumulator(ctx, tradePairID)
+		k.prunePriceAccumulators(ctx, tradePairID, cutoff)
+	}
+
+	for _, key := range dirtyKeys {
+		store.Delete(key)
+	}
+}
+
+// nextPriceAccumulatorSweep returns up to limit TradePairIDs with PriceAccumulators following the sweep cursor,
+// wrapping around to the first TradePairID, and moves the cursor to the last one returned.
+func (k Keeper) nextPriceAccumulatorSweep(ctx sdk.Context, limit int) (tradePairIDs []*types.TradePairID) {
+	kvStore := ctx.KVStore(k.storeKey)
+	store := prefix.NewStore(kvStore, types.KeyPrefix(types.PriceAccumulatorPairKeyPrefix))
+	cursorKey := types.KeyPrefix(types.PriceAccumulatorCursorKey)
+
+	var start []byte
+	if cursor := kvStore.Get(cursorKey); cursor != nil {
+		// The smallest key after the cursor
+		start = append(bytes.Clone(cursor), 0)
+	}
+
+	var lastKey []byte
+	collect := func(iterator storetypes.Iterator) {
+		defer iterator.Close()
+		for ; iterator.Valid() && len(tradePairIDs) < limit; iterator.Next() {
+			tradePairID := &types.TradePairID{}
+			k.cdc.MustUnmarshal(iterator.Value(), tradePairID)
+			tradePairIDs = append(tradePairIDs, tradePairID)
+			lastKey = bytes.Clone(iterator.Key())
+		}
+	}
+
+	collect(store.Iterator(start, nil))
+	if start != nil && len(tradePairIDs) < limit {
+		collect(store.Iterator(nil, start))
+	}
+
+	if lastKey != nil {
+		kvStore.Set(cursorKey, lastKey)
+	}
+
+	return tradePairIDs
+}
+
+func (k Keeper) updatePriceAccumulator(ctx sdk.Context, tradePairID *types.TradePairID) {
+	blockTime := ctx.BlockTime()
+	tick, tickFound := k.GetCurrTickIndexTakerToMaker(ctx, tradePairID)
+	latest, latestFound := k.GetPriceAccumulatorAtOrBefore(ctx, tradePairID, blockTime)
+
+	switch {
+	// No history and no liquidity ==> nothing to record
+	case !latestFound && !tickFound:
+		return
+
+	// First snapshot for the TradePairID
+	case !latestFound:
+		k.SetPriceAccumulator(ctx, &types.PriceAccumulator{
+			TradePairId:           tradePairID,
+			Timestamp:             blockTime,
+			TickCumulative:        0,
+			TickIndexTakerToMaker: tick,
+		})
+
+	// Multiple blocks can share a timestamp; just overwrite the tick of the existing snapshot
+	case latest.Timestamp.Equal(blockTime):
+		if tickFound {
+			latest.TickIndexTakerToMaker = tick
+		}
+		latest.NoLiquidity = !tickFound
+		k.SetPriceAccumulator(ctx, latest)
+
+	// The book has been drained; record the gap rather than extrapolating the last tick through it
+	case !tickFound:
+		if latest.NoLiquidity {
+			return
+		}
+		k.SetPriceAccumulator(ctx, &types.PriceAccumulator{
+			TradePairId:           tradePairID,
+			Timestamp:             blockTime,
+			TickCumulative:        latest.TickCumulativeAt(blockTime),
+			TickIndexTakerToMaker: latest.TickIndexTakerToMaker,
+			NoLiquidity:           true,
+		})
+
+	// The cumulative tick is extrapolated from the latest snapshot while the tick is unchanged
+	case !latest.NoLiquidity && tick == latest.TickIndexTakerToMaker:
+		return
+
+	default:
+		k.SetPriceAccumulator(ctx, &types.PriceAccumulator{
+			TradePairId:           tradePairID,
+			Timestamp:             blockTime,
+			TickCumulative:        latest.TickCumulativeAt(blockTime),
+			TickIndexTakerToMaker: tick,
+		})
+	}
+}
+
+// prunePriceAccumulators removes all snapshots older than cutoff except for the most recent one,
+// which is still required to compute the cumulative tick at cutoff.
+func (k Keeper) prunePriceAccumulators(ctx sdk.Context, tradePairID *types.TradePairID, cutoff time.Time) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceAccumulatorPrefix(tradePairID))
+	iterator := store.Iterator(nil, types.TimeBytes(cutoff))
+
+	var expiredKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		expiredKeys = append(expiredKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	if len(expiredKeys) <= 1 {
+		return
+	}
+
+	for _, key := range expiredKeys[:len(expiredKeys)-1] {
+		store.Delete(key)
+	}
+}
+
+// GetTickCumulative returns the cumulative tick of the TradePairID at time t
+func (k Keeper) GetTickCumulative(ctx sdk.Context, tradePairID *types.TradePairID, t time.Time) (int64, error) {
+	accumulator, found := k.GetPriceAccumulatorAtOrBefore(ctx, tradePairID, t)
+	if !found {
+		return 0, sdkerrors.Wrapf(types.ErrTWAPWindowUnavailable, "no history for %s at %d", tradePairID.MustPairID().CanonicalString(), t.Unix())
+	}
+
+	return accumulator.TickCumulativeAt(t), nil
+}
+
+// hasLiquidityGap returns true if the TradePairID had no liquidity at any time in [startTime, endTime)
+func (k Keeper) hasLiquidityGap(ctx sdk.Context, tradePairID *types.TradePairID, startTime, endTime time.Time) bool {
+	// The snapshot at or before startTime has already been found by GetTickCumulative
+	first, _ := k.GetPriceAccumulatorAtOrBefore(ctx, tradePairID, startTime)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PriceAccumulatorPrefix(tradePairID))
+	iterator := store.Iterator(types.TimeBytes(first.Timestamp), types.TimeBytes(endTime))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var accumulator types.PriceAccumulator
+		k.cdc.MustUnmarshal(iterator.Value(), &accumulator)
+		if accumulator.NoLiquidity {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetTimeWeightedAverageTick returns the time weighted arithmetic mean of the TradePairID's
+// tickIndexTakerToMaker over [startTime, endTime], rounded towards negative infinity. Windows during which the
+// TradePairID had no liquidity are unavailable.
+func (k Keeper) GetTimeWeightedAverageTick(
+	ctx sdk.Context,
+	tradePairID *types.TradePairID,
+	startTime, endTime time.Time,
+) (int64, error) {
+	if !startTime.Before(endTime) || endTime.After(ctx.BlockTime()) {
+		return 0, types.ErrInvalidTWAPWindow
+	}
+
+	startCumulative, err := k.GetTickCumulative(ctx, tradePairID, startTime)
+	if err != nil {
+		return 0, err
+	}
+
+	endCumulative, err := k.GetTickCumulative(ctx, tradePairID, endTime)
+	if err != nil {
+		return 0, err
+	}
+
+	if k.hasLiquidityGap(ctx, tradePairID, startTime, endTime) {
+		return 0, sdkerrors.Wrapf(
+			types.ErrTWAPWindowUnavailable,
+			"%s had no liquidity between %d and %d",
+			tradePairID.MustPairID().CanonicalString(),
+			startTime.Unix(),
+			endTime.Unix(),
+		)
+	}
+
+	elapsed := endTime.Unix() - startTime.Unix()
+	if elapsed <= 0 {
+		return 0, types.ErrInvalidTWAPWindow
+	}
+
+	delta := endCumulative - startCumulative
+	mean := delta / elapsed
+	// Go integer division truncates towards zero; adjust to round down
+	if delta%elapsed != 0 && delta < 0 {
+		mean--
+	}
+
+	return mean, nil
+}
@@ -0,0 +1,204 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) endBlockWithTime(blockTime time.Time) {
+	s.Ctx = s.Ctx.WithBlockTime(blockTime)
+	s.App.DexKeeper.UpdatePriceAccumulators(s.Ctx)
+}
+
+func (s *DexTestSuite) TestPriceAccumulatorCreatedOnLiquidityUpdate() {
+	s.fundAliceBalances(50, 0)
+	tradePairID := types.MustNewTradePairID("TokenB", "TokenA")
+	t0 := time.Unix(1_000_000, 0).UTC()
+
+	// GIVEN alice places a limit order
+	s.Ctx = s.Ctx.WithBlockTime(t0)
+	s.aliceLimitSells("TokenA", 0, 10)
+
+	// WHEN the block ends
+	s.endBlockWithTime(t0)
+
+	// THEN a snapshot is written with the current tick
+	tick, found := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, tradePairID)
+	s.True(found)
+	acc, found := s.App.DexKeeper.GetPriceAccumulatorAtOrBefore(s.Ctx, tradePairID, t0)
+	s.True(found)
+	s.Equal(int64(0), acc.TickCumulative)
+	s.Equal(tick, acc.TickIndexTakerToMaker)
+
+	// AND the opposite direction has no snapshot
+	_, found = s.App.DexKeeper.GetPriceAccumulatorAtOrBefore(s.Ctx, tradePairID.Reversed(), t0)
+	s.False(found)
+}
+
+func (s *DexTestSuite) TestPriceAccumulatorAccumulatesTick() {
+	s.fundAliceBalances(50, 0)
+	tradePairID := types.MustNewTradePairID("TokenB", "TokenA")
+	t0 := time.Unix(1_000_000, 0).UTC()
+
+	// GIVEN a limit order at tick 0
+	s.Ctx = s.Ctx.WithBlockTime(t0)
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.endBlockWithTime(t0)
+	tick0, _ := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, tradePairID)
+
+	// WHEN a better priced order is placed 100 seconds later
+	t1 := t0.Add(100 * time.Second)
+	s.Ctx = s.Ctx.WithBlockTime(t1)
+	s.aliceLimitSells("TokenA", 10, 10)
+	s.endBlockWithTime(t1)
+	tick1, _ := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, tradePairID)
+	s.NotEqual(tick0, tick1)
+
+	// THEN the new snapshot accumulates the previous tick over the elapsed time
+	acc, found := s.App.DexKeeper.GetPriceAccumulatorAtOrBefore(s.Ctx, tradePairID, t1)
+	s.True(found)
+	s.Equal(tick0*100, acc.TickCumulative)
+	s.Equal(tick1, acc.TickIndexTakerToMaker)
+
+	// AND the cumulative tick is extrapolated between snapshots
+	cumulative, err := s.App.DexKeeper.GetTickCumulative(s.Ctx, tradePairID, t1.Add(50*time.Second))
+	s.NoError(err)
+	s.Equal(tick0*100+tick1*50, cumulative)
+}
+
+func (s *DexTestSuite) TestPriceAccumulatorUnchangedWithoutUpdates() {
+	s.fundAliceBalances(50, 0)
+	tradePairID := types.MustNewTradePairID("TokenB", "TokenA")
+	t0 := time.Unix(1_000_000, 0).UTC()
+
+	s.Ctx = s.Ctx.WithBlockTime(t0)
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.endBlockWithTime(t0)
+
+	// WHEN a block ends without any liquidity updates
+	s.endBlockWithTime(t0.Add(10 * time.Second))
+
+	// THEN no new snapshot is written
+	s.Len(s.App.DexKeeper.GetAllPriceAccumulator(s.Ctx), 1)
+	acc, found := s.App.DexKeeper.GetPriceAccumulatorAtOrBefore(s.Ctx, tradePairID, s.Ctx.BlockTime())
+	s.True(found)
+	s.Equal(t0, acc.Timestamp.UTC())
+}
+
+func (s *DexTestSuite) TestPriceAccumulatorPruning() {
+	s.fundAliceBalances(50, 0)
+	tradePairID := types.MustNewTradePairID("TokenB", "TokenA")
+	t0 := time.Unix(1_000_000, 0).UTC()
+
+	// GIVEN snapshots at t0 and t0 + 1 hour
+	s.Ctx = s.Ctx.WithBlockTime(t0)
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.endBlockWithTime(t0)
+
+	t1 := t0.Add(time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(t1)
+	s.aliceLimitSells("TokenA", 10, 10)
+	s.endBlockWithTime(t1)
+
+	// WHEN liquidity is updated after both snapshots have fallen out of the retention window
+	t2 := t1.Add(types.PriceAccumulatorRetention + time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(t2)
+	s.aliceLimitSells("TokenA", 20, 10)
+	s.endBlockWithTime(t2)
+
+	// THEN only the most recent expired snapshot is kept
+	accumulators := s.App.DexKeeper.GetAllPriceAccumulator(s.Ctx)
+	s.Len(accumulators, 2)
+	s.Equal(t1, accumulators[0].Timestamp.UTC())
+	s.Equal(t2, accumulators[1].Timestamp.UTC())
+
+	// AND the cumulative tick at the start of the retention window can still be computed
+	_, err := s.App.DexKeeper.GetTickCumulative(s.Ctx, tradePairID, t2.Add(-types.PriceAccumulatorRetention))
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) TestPriceAccumulatorIdlePairPruning() {
+	s.fundAliceBalances(50, 0)
+	tradePairID := types.MustNewTradePairID("TokenB", "TokenA")
+	t0 := time.Unix(1_000_000, 0).UTC()
+
+	// GIVEN snapshots at t0 and t0 + 1 hour
+	s.Ctx = s.Ctx.WithBlockTime(t0)
+	s.aliceLimitSells("TokenA", 0, 10)
+	s.endBlockWithTime(t0)
+
+	t1 := t0.Add(time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(t1)
+	s.aliceLimitSells("TokenA", 10, 10)
+	s.endBlockWithTime(t1)
+
+	// WHEN a block ends without any liquidity updates after both snapshots have fallen out of the retention window
+	t2 := t1.Add(types.PriceAccumulatorRetention + time.Hour)
+	s.endBlockWithTime(t2)
+
+	// THEN the idle pair is still pruned down to the most recent snapshot
+	accumulators := s.App.DexKeeper.GetAllPriceAccumulator(s.Ctx)
+	s.Len(accumulators, 1)
+	s.Equal(t1, accumulators[0].Timestamp.UTC())
+
+	// AND the cumulative tick is extrapolated over the whole idle period
+	tick1, _ := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, tradePairID)
+	cumulative, err := s.App.DexKeeper.GetTickCumulative(s.Ctx, tradePairID, t2)
+	s.NoError(err)
+	s.Equal(accumulators[0].TickCumulative+tick1*int64(t2.Sub(t1).Seconds()), cumulative)
+}
+
+func (s *DexTestSuite) TestPriceAccumulatorDrainedBook() {
+	s.fundAliceBalances(50, 0)
+	tradePairID := types.MustNewTradePairID("TokenB", "TokenA")
+	t0 := time.Unix(1_000_000, 0).UTC()
+
+	// GIVEN a limit order at tick 0
+	s.Ctx = s.Ctx.WithBlockTime(t0)
+	trancheKey := s.aliceLimitSells("TokenA", 0, 10)
+	s.endBlockWithTime(t0)
+	tick0, _ := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, tradePairID)
+
+	// WHEN the book is drained 100 seconds later
+	t1 := t0.Add(100 * time.Second)
+	s.Ctx = s.Ctx.WithBlockTime(t1)
+	s.aliceCancelsLimitSell(trancheKey)
+	s.endBlockWithTime(t1)
+
+	// THEN a no liquidity snapshot is recorded
+	acc, found := s.App.DexKeeper.GetPriceAccumulatorAtOrBefore(s.Ctx, tradePairID, t1)
+	s.True(found)
+	s.True(acc.NoLiquidity)
+	s.Equal(tick0*100, acc.TickCumulative)
+
+	// AND the last tick is not extrapolated through the gap
+	t2 := t1.Add(1000 * time.Second)
+	s.endBlockWithTime(t2)
+	cumulative, err := s.App.DexKeeper.GetTickCumulative(s.Ctx, tradePairID, t2)
+	s.NoError(err)
+	s.Equal(tick0*100, cumulative)
+
+	// WHEN liquidity returns
+	t3 := t2.Add(100 * time.Second)
+	s.Ctx = s.Ctx.WithBlockTime(t3)
+	s.aliceLimitSells("TokenA", 10, 10)
+	s.endBlockWithTime(t3)
+	tick1, _ := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, tradePairID)
+	t4 := t3.Add(100 * time.Second)
+	s.endBlockWithTime(t4)
+
+	// THEN windows before and after the gap are available
+	twap, err := s.App.DexKeeper.GetTimeWeightedAverageTick(s.Ctx, tradePairID, t0, t1)
+	s.NoError(err)
+	s.Equal(tick0, twap)
+	twap, err = s.App.DexKeeper.GetTimeWeightedAverageTick(s.Ctx, tradePairID, t3, t4)
+	s.NoError(err)
+	s.Equal(tick1, twap)
+
+	// AND windows that overlap the gap are unavailable
+	_, err = s.App.DexKeeper.GetTimeWeightedAverageTick(s.Ctx, tradePairID, t0.Add(50*time.Second), t3)
+	s.ErrorIs(err, types.ErrTWAPWindowUnavailable)
+	_, err = s.App.DexKeeper.GetTimeWeightedAverageTick(s.Ctx, tradePairID, t2, t3.Add(50*time.Second))
+	s.ErrorIs(err, types.ErrTWAPWindowUnavailable)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetProtocolFees set a specific pairProtocolFees in the store
+func (k Keeper) SetProtocolFees(ctx sdk.Context, protocolFees *types.PairProtocolFees) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(protocolFees)
+	store.Set(types.ProtocolFeesKey(protocolFees.PairId), b)
+}
+
+// GetProtocolFees returns the protocol fees accumulated by a pair
+func (k Keeper) GetProtocolFees(ctx sdk.Context, pairID *types.PairID) (protocolFees *types.PairProtocolFees, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.ProtocolFeesKey(pairID))
+	if b == nil {
+		return nil, false
+	}
+
+	protocolFees = &types.PairProtocolFees{}
+	k.cdc.MustUnmarshal(b, protocolFees)
+
+	return protocolFees, true
+}
+
+// GetAllProtocolFees returns the protocol fees accumulated by all pairs
+func (k Keeper) GetAllProtocolFees(ctx sdk.Context) (list []types.PairProtocolFees) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ProtocolFeesKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PairProtocolFees
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AccrueProtocolFee records a protocol fee taken on a swap through tradePairID. The fee is added to the
+// pair's lifetime total and is sent to the protocol fee collector at the end of the block.
+func (k Keeper) AccrueProtocolFee(ctx sdk.Context, tradePairID *types.TradePairID, fee sdk.Coin) {
+	pairID := tradePairID.MustPairID()
+	protocolFees, found := k.GetProtocolFees(ctx, pairID)
+	if !found {
+		protocolFees = &types.PairProtocolFees{PairId: pairID}
+	}
+	protocolFees.Fees = protocolFees.Fees.Add(fee)
+	k.SetProtocolFees(ctx, protocolFees)
+
+	store := k.pendingProtocolFeeStore(ctx)
+	key := types.KeyPrefix(fee.Denom)
+	pending := fee
+	if b := store.Get(key); b != nil {
+		var existing sdk.Coin
+		k.cdc.MustUnmarshal(b, &existing)
+		pending = pending.Add(existing)
+	}
+	store.Set(key, k.cdc.MustMarshal(&pending))
+}
+
+// pendingProtocolFeeStore holds the accrued protocol fees that have not yet been sent to the protocol fee
+// collector. It is persistent so that fees which fail to send stay pending until a later block.
+func (k Keeper) pendingProtocolFeeStore(ctx sdk.Context) prefix.Store {
+	return prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.PendingProtocolFeeKeyPrefix))
+}
+
+// GetPendingProtocolFees returns the accrued protocol fees that have not yet been sent to the protocol fee collector
+func (k Keeper) GetPendingProtocolFees(ctx sdk.Context) sdk.Coins {
+	store := k.pendingProtocolFeeStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	pendingFees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &fee)
+		pendingFees = pendingFees.Add(fee)
+	}
+
+	return pendingFees
+}
+
+// SetPendingProtocolFees replaces the accrued protocol fees that have not yet been sent to the protocol fee collector
+func (k Keeper) SetPendingProtocolFees(ctx sdk.Context, pendingFees sdk.Coins) {
+	store := k.pendingProtocolFeeStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	var existingKeys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		existingKeys = append(existingKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range existingKeys {
+		store.Delete(key)
+	}
+	for _, fee := range pendingFees {
+		store.Set(types.KeyPrefix(fee.Denom), k.cdc.MustMarshal(&fee))
+	}
+}
+
+// SendPendingProtocolFees sends the pending protocol fees from the dex module to the protocol fee collector,
+// where they are picked up by x/feeburner. If the transfer fails the error is logged and the fees stay pending.
+func (k Keeper) SendPendingProtocolFees(ctx sdk.Context) {
+	store := k.pendingProtocolFeeStore(ctx)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var pendingKeys [][]byte
+	pendingFees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var fee sdk.Coin
+		k.cdc.MustUnmarshal(iterator.Value(), &fee)
+		pendingFees = pendingFees.Add(fee)
+		pendingKeys = append(pendingKeys, iterator.Key())
+	}
+	iterator.Close()
+
+	if !pendingFees.IsZero() {
+		err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.ProtocolFeeCollectorName, pendingFees)
+		if err != nil {
+			k.Logger(ctx).Error("failed to send protocol fees", "fees", pendingFees, "error", err)
+			return
+		}
+	}
+
+	for _, key := range pendingKeys {
+		store.Delete(key)
+	}
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (s *DexTestSuite) setProtocolFee(feeTier uint64, fraction string) {
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.ProtocolFees = []types.ProtocolFee{{FeeTier: feeTier, Fraction: math_utils.MustNewPrecDecFromStr(fraction)}}
+	_, err := s.msgServer.UpdateParams(s.Ctx, &types.MsgUpdateParams{Params: params, Authority: s.App.DexKeeper.GetAuthority()})
+	s.NoError(err)
+}
+
+func (s *DexTestSuite) getProtocolFees(pairID *types.PairID) sdk.Coins {
+	resp, err := s.App.DexKeeper.ProtocolFees(s.Ctx, &types.QueryGetProtocolFeesRequest{PairId: pairID.CanonicalString()})
+	s.NoError(err)
+	return resp.ProtocolFees.Fees
+}
+
+func (s *DexTestSuite) TestProtocolFeeTakenOnSwap() {
+	s.fundAliceBalances(0, 100)
+	s.fundBobBalances(50, 0)
+	s.setProtocolFee(10, "0.5")
+
+	// GIVEN TokenB liquidity in a pool with fee 10
+	s.aliceDeposits(NewDeposit(0, 100, 0, 10))
+
+	// WHEN bob swaps TokenA for TokenB
+	s.bobMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 50, math_utils.MustNewPrecDecFromStr("0.9"), false)
+
+	// THEN half of the swap fee is taken by the protocol
+	bobAmountIn := sdkmath.NewInt(50).Mul(denomMultiple).Sub(s.App.BankKeeper.GetBalance(s.Ctx, s.bob, "TokenA").Amount)
+	liquidityA, _ := s.getLiquidityAtTick(0, 10)
+	protocolFee := s.getProtocolFees(defaultPairID).AmountOf("TokenA")
+
+	// fee = (amountIn - amountOut * centerPrice) * 0.5 = (50_000_000 - 49_950_027) * 0.5
+	s.True(protocolFee.Equal(sdkmath.NewInt(24_986)), "protocol fee: actual %s", protocolFee)
+	s.True(liquidityA.Add(protocolFee).Equal(bobAmountIn))
+
+	// WHEN the block ends
+	s.App.DexKeeper.SendPendingProtocolFees(s.Ctx)
+
+	// THEN the protocol fee is moved to the protocol fee collector
+	collectorAddr := s.App.AccountKeeper.GetModuleAddress(types.ProtocolFeeCollectorName)
+	s.True(s.App.BankKeeper.GetBalance(s.Ctx, collectorAddr, "TokenA").Amount.Equal(protocolFee))
+	s.assertDexBalancesInt(liquidityA, sdkmath.NewInt(100).Mul(denomMultiple).Sub(s.App.BankKeeper.GetBalance(s.Ctx, s.bob, "TokenB").Amount))
+
+	// Lifetime protocol fees are not reset by the sweep
+	s.True(s.getProtocolFees(defaultPairID).AmountOf("TokenA").Equal(protocolFee))
+}
+
+func (s *DexTestSuite) TestNoProtocolFeeOnOtherFeeTiers() {
+	s.fundAliceBalances(0, 100)
+	s.fundBobBalances(50, 0)
+	s.setProtocolFee(10, "0.5")
+
+	// GIVEN TokenB liquidity in a pool with fee 1
+	s.aliceDeposits(NewDeposit(0, 100, 0, 1))
+
+	// WHEN bob swaps TokenA for TokenB
+	s.bobMultiHopSwaps([][]string{{"TokenA", "TokenB"}}, 50, math_utils.MustNewPrecDecFromStr("0.9"), false)
+
+	// THEN no protocol fee is taken
+	s.True(s.getProtocolFees(defaultPairID).IsZero())
+}
+
+func (s *DexTestSuite) TestProtocolFeesQueryUnknownPair() {
+	fees := s.getProtocolFees(&types.PairID{Token0: "TokenA", Token1: "TokenZ"})
+	s.True(fees.IsZero())
+}
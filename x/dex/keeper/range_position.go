@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetRangePosition set a specific rangePosition in the store
+func (k Keeper) SetRangePosition(ctx sdk.Context, position *types.RangePosition) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(position)
+	store.Set(types.RangePositionKey(position.Owner, position.Id), b)
+}
+
+// GetRangePosition returns a rangePosition by owner and id
+func (k Keeper) GetRangePosition(ctx sdk.Context, owner string, id uint64) (position *types.RangePosition, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.RangePositionKey(owner, id))
+	if b == nil {
+		return nil, false
+	}
+
+	position = &types.RangePosition{}
+	k.cdc.MustUnmarshal(b, position)
+
+	return position, true
+}
+
+// RemoveRangePosition removes a rangePosition from the store
+func (k Keeper) RemoveRangePosition(ctx sdk.Context, owner string, id uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RangePositionKey(owner, id))
+}
+
+// GetAllRangePosition returns all rangePositions
+func (k Keeper) GetAllRangePosition(ctx sdk.Context) (list []*types.RangePosition) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RangePositionKeyPrefix))
+	return k.iterateRangePositions(store)
+}
+
+func (k Keeper) iterateRangePositions(store storetypes.KVStore) (list []*types.RangePosition) {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.RangePosition{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetRangePositionCount get the total number of rangePositions ever created
+func (k Keeper) GetRangePositionCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.RangePositionCountKeyPrefix))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetRangePositionCount set the total number of rangePositions ever created
+func (k Keeper) SetRangePositionCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.KeyPrefix(types.RangePositionCountKeyPrefix), bz)
+}
+
+// initRangePositionID returns the next rangePosition id and increments the count
+func (k Keeper) initRangePositionID(ctx sdk.Context) uint64 {
+	id := k.GetRangePositionCount(ctx)
+	k.SetRangePositionCount(ctx, id+1)
+
+	return id
+}
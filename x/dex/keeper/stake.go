@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// SetStake set a specific stake in the store and indexes it under the pairs of its shares
+func (k Keeper) SetStake(ctx sdk.Context, stake *types.Stake) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(stake)
+	store.Set(types.StakeKey(stake.Owner, stake.Id), b)
+
+	for _, pairID := range k.stakePairIDs(ctx, stake) {
+		store.Set(types.StakePairKey(pairID, stake.Id), []byte(stake.Owner))
+	}
+}
+
+// GetStake returns a stake by owner and id
+func (k Keeper) GetStake(ctx sdk.Context, owner string, id uint64) (stake *types.Stake, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.StakeKey(owner, id))
+	if b == nil {
+		return nil, false
+	}
+
+	stake = &types.Stake{}
+	k.cdc.MustUnmarshal(b, stake)
+
+	return stake, true
+}
+
+// RemoveStake removes a stake and its pair index from the store
+func (k Keeper) RemoveStake(ctx sdk.Context, owner string, id uint64) {
+	stake, found := k.GetStake(ctx, owner, id)
+	if !found {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for _, pairID := range k.stakePairIDs(ctx, stake) {
+		store.Delete(types.StakePairKey(pairID, id))
+	}
+	store.Delete(types.StakeKey(owner, id))
+}
+
+// stakePairIDs returns the distinct pairs of the pools whose shares are staked
+func (k Keeper) stakePairIDs(ctx sdk.Context, stake *types.Stake) (pairIDs []*types.PairID) {
+	seen := make(map[types.PairID]bool)
+	for _, share := range stake.Shares {
+		// Shares are only staked for existing pools so this will never fail
+		poolMetadata, err := k.GetPoolMetadataByDenom(ctx, share.Denom)
+		if err != nil {
+			panic(err)
+		}
+
+		if !seen[*poolMetadata.PairId] {
+			seen[*poolMetadata.PairId] = true
+			pairIDs = append(pairIDs, poolMetadata.PairId)
+		}
+	}
+
+	return pairIDs
+}
+
+// stakeIndexEntry is a Stake in the index of its pair
+type stakeIndexEntry struct {
+	id    uint64
+	owner string
+}
+
+// getStakeIndexPage returns up to limit Stakes of a pair with ids in [startID, endID)
+func (k Keeper) getStakeIndexPage(
+	ctx sdk.Context,
+	pairID *types.PairID,
+	startID, endID uint64,
+	limit int,
+) (page []stakeIndexEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakePairPrefix(pairID))
+	iterator := store.Iterator(sdk.Uint64ToBigEndian(startID), sdk.Uint64ToBigEndian(endID))
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(page) < limit; iterator.Next() {
+		page = append(page, stakeIndexEntry{
+			id:    sdk.BigEndianToUint64(iterator.Key()),
+			owner: string(iterator.Value()),
+		})
+	}
+
+	return page
+}
+
+// GetAllStake returns all stakes
+func (k Keeper) GetAllStake(ctx sdk.Context) (list []*types.Stake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakeKeyPrefix))
+	return k.iterateStakes(store)
+}
+
+// GetAllStakeByOwner returns all stakes owned by an address
+func (k Keeper) GetAllStakeByOwner(ctx sdk.Context, owner string) (list []*types.Stake) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.StakeOwnerPrefix(owner))
+	return k.iterateStakes(store)
+}
+
+func (k Keeper) iterateStakes(store storetypes.KVStore) (list []*types.Stake) {
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.Stake{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetStakeCount get the total number of stakes ever created
+func (k Keeper) GetStakeCount(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefix(types.StakeCountKeyPrefix))
+
+	// Count doesn't exist: no element
+	if bz == nil {
+		return 0
+	}
+
+	// Parse bytes
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetStakeCount set the total number of stakes ever created
+func (k Keeper) SetStakeCount(ctx sdk.Context, count uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(types.KeyPrefix(types.StakeCountKeyPrefix), bz)
+}
+
+// initStakeID returns the next stake id and increments the count
+func (k Keeper) initStakeID(ctx sdk.Context) uint64 {
+	id := k.GetStakeCount(ctx)
+	k.SetStakeCount(ctx, id+1)
+
+	return id
+}
+
+// SetStakerRewards set the unclaimed rewards of an address in the store
+func (k Keeper) SetStakerRewards(ctx sdk.Context, rewards types.StakerRewards) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&rewards)
+	store.Set(types.StakerRewardsKey(rewards.Address), b)
+}
+
+// GetStakerRewards returns the unclaimed rewards of an address
+func (k Keeper) GetStakerRewards(ctx sdk.Context, address string) (rewards types.StakerRewards, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.StakerRewardsKey(address))
+	if b == nil {
+		return rewards, false
+	}
+
+	k.cdc.MustUnmarshal(b, &rewards)
+
+	return rewards, true
+}
+
+// RemoveStakerRewards removes the unclaimed rewards of an address from the store
+func (k Keeper) RemoveStakerRewards(ctx sdk.Context, address string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.StakerRewardsKey(address))
+}
+
+// GetAllStakerRewards returns the unclaimed rewards of all addresses
+func (k Keeper) GetAllStakerRewards(ctx sdk.Context) (list []types.StakerRewards) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.StakerRewardsKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.StakerRewards
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
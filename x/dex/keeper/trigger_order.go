@@ -0,0 +1,312 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func (k Keeper) SetTriggerOrder(ctx sdk.Context, order *types.TriggerOrder) {
+	store := ctx.KVStore(k.storeKey)
+	key := order.KeyMarshal()
+	store.Set(key, k.cdc.MustMarshal(order))
+	store.Set(types.TriggerOrderRefKey(order.Creator, order.OrderKey), key)
+
+	pairStore := prefix.NewStore(store, types.KeyPrefix(types.TriggerOrderTradePairKeyPrefix))
+	pairStore.Set(types.TradePairIDKey(order.TradePairId), k.cdc.MustMarshal(order.TradePairId))
+}
+
+func (k Keeper) GetTriggerOrder(ctx sdk.Context, address, orderKey string) (order *types.TriggerOrder, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := store.Get(types.TriggerOrderRefKey(address, orderKey))
+	if key == nil {
+		return nil, false
+	}
+
+	b := store.Get(key)
+	if b == nil {
+		return nil, false
+	}
+
+	order = &types.TriggerOrder{}
+	k.cdc.MustUnmarshal(b, order)
+
+	return order, true
+}
+
+// RemoveTriggerOrder removes a TriggerOrder and its references from the store
+func (k Keeper) RemoveTriggerOrder(ctx sdk.Context, order *types.TriggerOrder) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(order.KeyMarshal())
+	store.Delete(types.TriggerOrderRefKey(order.Creator, order.OrderKey))
+
+	// Stop tracking the TradePairID once it has no pending TriggerOrders
+	tradePairPrefix := append(types.KeyPrefix(types.TriggerOrderKeyPrefix), types.TradePairIDKey(order.TradePairId)...)
+	iter := storetypes.KVStorePrefixIterator(store, tradePairPrefix)
+	defer iter.Close()
+	if !iter.Valid() {
+		pairStore := prefix.NewStore(store, types.KeyPrefix(types.TriggerOrderTradePairKeyPrefix))
+		pairStore.Delete(types.TradePairIDKey(order.TradePairId))
+	}
+}
+
+// GetAllTriggerOrder returns all TriggerOrders
+func (k Keeper) GetAllTriggerOrder(ctx sdk.Context) (list []*types.TriggerOrder) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.TriggerOrder{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetAllTriggerOrderTradePairIDs returns all TradePairIDs that have pending TriggerOrders
+func (k Keeper) GetAllTriggerOrderTradePairIDs(ctx sdk.Context) (list []*types.TradePairID) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.TriggerOrderTradePairKeyPrefix))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		val := &types.TradePairID{}
+		k.cdc.MustUnmarshal(iterator.Value(), val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetNextTriggeredOrder returns a TriggerOrder of the TradePairID whose trigger condition is met by the current price.
+// STOP_LOSS orders are checked first, in order of ascending trigger tick, followed by TAKE_PROFIT orders in order of descending trigger tick.
+func (k Keeper) GetNextTriggeredOrder(ctx sdk.Context, tradePairID *types.TradePairID) (order *types.TriggerOrder, found bool) {
+	tickIndexTakerToMaker, found := k.GetCurrTickIndexTakerToMaker(ctx, tradePairID)
+	if !found {
+		return nil, false
+	}
+
+	store := ctx.KVStore(k.storeKey)
+
+	stopLossStore := prefix.NewStore(store, types.TriggerOrderPrefix(tradePairID, types.LimitOrderType_STOP_LOSS))
+	if order, found := k.firstTriggerOrder(stopLossStore.Iterator(nil, nil)); found && order.IsTriggered(tickIndexTakerToMaker) {
+		return order, true
+	}
+
+	takeProfitStore := prefix.NewStore(store, types.TriggerOrderPrefix(tradePairID, types.LimitOrderType_TAKE_PROFIT))
+	if order, found := k.firstTriggerOrder(takeProfitStore.ReverseIterator(nil, nil)); found && order.IsTriggered(tickIndexTakerToMaker) {
+		return order, true
+	}
+
+	return nil, false
+}
+
+func (k Keeper) firstTriggerOrder(iter storetypes.Iterator) (order *types.TriggerOrder, found bool) {
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, false
+	}
+
+	order = &types.TriggerOrder{}
+	k.cdc.MustUnmarshal(iter.Value(), order)
+
+	return order, true
+}
+
+// PlaceTriggerOrderCore escrows AmountIn and stores a STOP_LOSS or TAKE_PROFIT order until its trigger price is reached.
+func (k Keeper) PlaceTriggerOrderCore(
+	goCtx context.Context,
+	tokenIn string,
+	tokenOut string,
+	amountIn math.Int,
+	tickIndexInToOut int64,
+	triggerTickIndexInToOut int64,
+	orderType types.LimitOrderType,
+	maxAmountOut *math.Int,
+	minAvgSellPrice *math_utils.PrecDec,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+) (orderKey string, coinIn sdk.Coin, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	tradePairID, err := types.NewTradePairID(tokenIn, tokenOut)
+	if err != nil {
+		return orderKey, coinIn, err
+	}
+
+	if !orderType.IsTrigger() {
+		return orderKey, coinIn, sdkerrors.Wrapf(types.ErrInvalidOrderType, "%s", orderType)
+	}
+
+	if err := k.AssertPairNotPaused(ctx, tradePairID.MustPairID()); err != nil {
+		return orderKey, coinIn, err
+	}
+
+	limitBuyPrice, err := types.CalcPrice(tickIndexInToOut)
+	if err != nil {
+		return orderKey, coinIn, err
+	}
+
+	// Ensure that after rounding user will get at least 1 token out when the order is executed.
+	err = types.ValidateFairOutput(amountIn, limitBuyPrice)
+	if err != nil {
+		return orderKey, coinIn, err
+	}
+
+	orderKey = NewTrancheKey(ctx)
+	order := &types.TriggerOrder{
+		TradePairId:             tradePairID,
+		TriggerTickIndexInToOut: triggerTickIndexInToOut,
+		OrderType:               orderType,
+		OrderKey:                orderKey,
+		Creator:                 callerAddr.String(),
+		Receiver:                receiverAddr.String(),
+		AmountIn:                amountIn,
+		TickIndexInToOut:        tickIndexInToOut,
+		MaxAmountOut:            maxAmountOut,
+		MinAverageSellPrice:     minAvgSellPrice,
+	}
+
+	coinIn = order.CoinIn()
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, callerAddr, types.ModuleName, sdk.Coins{coinIn})
+	if err != nil {
+		return orderKey, coinIn, err
+	}
+
+	k.SetTriggerOrder(ctx, order)
+	// TriggerOrders are executed in EndBlock so we charge for the execution upfront
+	ctx.GasMeter().ConsumeGas(types.TriggerOrderGas, "Trigger LimitOrder Fee")
+
+	pairID := tradePairID.MustPairID()
+	var minAvgSellPriceEvent math_utils.PrecDec
+	if minAvgSellPrice != nil {
+		minAvgSellPriceEvent = *minAvgSellPrice
+	} else {
+		minAvgSellPriceEvent = math_utils.OnePrecDec().Quo(limitBuyPrice)
+	}
+	ctx.EventManager().EmitEvent(types.CreatePlaceLimitOrderEvent(
+		callerAddr,
+		receiverAddr,
+		pairID.Token0,
+		pairID.Token1,
+		tokenIn,
+		tokenOut,
+		amountIn,
+		tickIndexInToOut,
+		orderType.String(),
+		minAvgSellPriceEvent,
+		math.ZeroInt(),
+		orderKey,
+		math.ZeroInt(),
+		math.ZeroInt(),
+		math.ZeroInt(),
+	))
+
+	return orderKey, coinIn, nil
+}
+
+// CancelTriggerOrderCore removes a pending TriggerOrder and returns the escrowed AmountIn to its creator.
+func (k Keeper) CancelTriggerOrderCore(
+	ctx sdk.Context,
+	order *types.TriggerOrder,
+	callerAddr sdk.AccAddress,
+) (makerCoinOut, takerCoinOut sdk.Coin, err error) {
+	k.RemoveTriggerOrder(ctx, order)
+
+	makerCoinOut = order.CoinIn()
+	takerCoinOut = sdk.NewCoin(order.TradePairId.MakerDenom, math.ZeroInt())
+
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, callerAddr, sdk.Coins{makerCoinOut})
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	pairID := order.TradePairId.MustPairID()
+	ctx.EventManager().EmitEvent(types.CancelLimitOrderEvent(
+		callerAddr,
+		pairID.Token0,
+		pairID.Token1,
+		makerCoinOut.Denom,
+		takerCoinOut.Denom,
+		takerCoinOut.Amount,
+		makerCoinOut.Amount,
+		order.OrderKey,
+	))
+
+	return makerCoinOut, takerCoinOut, nil
+}
+
+// ExecuteTriggerOrders converts all TriggerOrders whose trigger price has been reached into IMMEDIATE_OR_CANCEL limit orders.
+// Execution stops once TriggerOrderAllowance gas has been consumed; remaining orders are picked up in the following block.
+func (k Keeper) ExecuteTriggerOrders(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.Paused {
+		return
+	}
+
+	gasCutoff := ctx.GasMeter().GasConsumed() + params.TriggerOrderAllowance
+	for _, tradePairID := range k.GetAllTriggerOrderTradePairIDs(ctx) {
+		for {
+			gasConsumed := ctx.GasMeter().GasConsumed()
+			if gasConsumed >= gasCutoff {
+				ctx.EventManager().EmitEvent(types.TriggerOrderHitLimitEvent(gasConsumed))
+				return
+			}
+
+			order, found := k.GetNextTriggeredOrder(ctx, tradePairID)
+			if !found {
+				break
+			}
+
+			k.executeTriggerOrder(ctx, order)
+		}
+	}
+}
+
+func (k Keeper) executeTriggerOrder(ctx sdk.Context, order *types.TriggerOrder) {
+	k.RemoveTriggerOrder(ctx, order)
+
+	creatorAddr := sdk.MustAccAddressFromBech32(order.Creator)
+	receiverAddr := sdk.MustAccAddressFromBech32(order.Receiver)
+
+	// Return the escrowed funds so that the order can be placed on behalf of the creator.
+	// If the order fails the creator keeps the funds.
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, creatorAddr, sdk.Coins{order.CoinIn()})
+	if err != nil {
+		ctx.Logger().Error("failed to release TriggerOrder escrow", "order_key", order.OrderKey, "error", err)
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	_, _, _, swapOutCoin, _, err := k.PlaceLimitOrderCore(
+		cacheCtx,
+		order.TradePairId.TakerDenom,
+		order.TradePairId.MakerDenom,
+		order.AmountIn,
+		order.TickIndexInToOut,
+		types.LimitOrderType_IMMEDIATE_OR_CANCEL,
+		nil,
+		0,
+		order.MaxAmountOut,
+		order.MinAverageSellPrice,
+		false,
+		creatorAddr,
+		receiverAddr,
+	)
+	if err == nil {
+		writeCache()
+	}
+
+	ctx.EventManager().EmitEvent(types.TriggerOrderExecutedEvent(order, swapOutCoin.Amount, err))
+}
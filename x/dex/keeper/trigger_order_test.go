@@ -0,0 +1,234 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// bobPlacesLiquidityAToB places a TokenB limit order that can be filled by TokenA->TokenB swaps at tickIndexAToB
+func (s *DexTestSuite) bobPlacesLiquidityAToB(tickIndexAToB int64, amount int64) string {
+	resp, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.bob.String(),
+		Receiver:         s.bob.String(),
+		TokenIn:          "TokenB",
+		TokenOut:         "TokenA",
+		TickIndexInToOut: -tickIndexAToB,
+		AmountIn:         math.NewInt(amount).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_GOOD_TIL_CANCELLED,
+	})
+	s.NoError(err)
+
+	return resp.TrancheKey
+}
+
+func (s *DexTestSuite) alicePlacesTriggerOrder(
+	orderType types.LimitOrderType,
+	amountIn int64,
+	tickIndexInToOut int64,
+	triggerTickIndexInToOut int64,
+) string {
+	orderKey, _, err := s.App.DexKeeper.PlaceTriggerOrderCore(
+		s.Ctx,
+		"TokenA",
+		"TokenB",
+		math.NewInt(amountIn).Mul(denomMultiple),
+		tickIndexInToOut,
+		triggerTickIndexInToOut,
+		orderType,
+		nil,
+		nil,
+		s.alice,
+		s.alice,
+	)
+	s.NoError(err)
+
+	return orderKey
+}
+
+func (s *DexTestSuite) assertAliceTriggerOrderExists(orderKey string) {
+	_, found := s.App.DexKeeper.GetTriggerOrder(s.Ctx, s.alice.String(), orderKey)
+	s.True(found, "TriggerOrder %s not found", orderKey)
+}
+
+func (s *DexTestSuite) assertAliceTriggerOrderNotExists(orderKey string) {
+	_, found := s.App.DexKeeper.GetTriggerOrder(s.Ctx, s.alice.String(), orderKey)
+	s.False(found, "TriggerOrder %s still exists", orderKey)
+}
+
+func (s *DexTestSuite) TestPlaceTriggerOrderEscrowsFunds() {
+	s.fundAliceBalances(10, 0)
+
+	// WHEN alice places a STOP_LOSS order
+	orderKey := s.alicePlacesTriggerOrder(types.LimitOrderType_STOP_LOSS, 10, 20, 5)
+
+	// THEN her funds are held by the dex and no liquidity is added
+	s.assertAliceBalances(0, 0)
+	s.assertDexBalances(10, 0)
+	s.assertAliceTriggerOrderExists(orderKey)
+	_, found := s.App.DexKeeper.GetCurrTickIndexTakerToMaker(s.Ctx, &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"})
+	s.False(found)
+
+	resp, err := s.App.DexKeeper.TriggerOrderAllByAddress(s.Ctx, &types.QueryAllTriggerOrderByAddressRequest{
+		Address: s.alice.String(),
+	})
+	s.NoError(err)
+	s.Len(resp.TriggerOrders, 1)
+	s.Equal(orderKey, resp.TriggerOrders[0].OrderKey)
+}
+
+func (s *DexTestSuite) TestPlaceTriggerOrderWithTriggerSellPrice() {
+	s.fundAliceBalances(10, 0)
+	triggerSellPrice := math_utils.MustNewPrecDecFromStr("0.5")
+
+	// WHEN alice places a TAKE_PROFIT order with a trigger price
+	resp, err := s.msgServer.PlaceLimitOrder(s.Ctx, &types.MsgPlaceLimitOrder{
+		Creator:          s.alice.String(),
+		Receiver:         s.alice.String(),
+		TokenIn:          "TokenA",
+		TokenOut:         "TokenB",
+		TickIndexInToOut: 0,
+		AmountIn:         math.NewInt(10).Mul(denomMultiple),
+		OrderType:        types.LimitOrderType_TAKE_PROFIT,
+		TriggerSellPrice: &triggerSellPrice,
+	})
+	s.NoError(err)
+
+	// THEN the order is stored with the trigger price converted to a tick
+	expectedTriggerTick, err := types.CalcTickIndexFromPrice(math_utils.OnePrecDec().Quo(triggerSellPrice))
+	s.NoError(err)
+
+	order, found := s.App.DexKeeper.GetTriggerOrder(s.Ctx, s.alice.String(), resp.TrancheKey)
+	s.True(found)
+	s.Equal(expectedTriggerTick, order.TriggerTickIndexInToOut)
+	s.Equal(types.LimitOrderType_TAKE_PROFIT, order.OrderType)
+	s.True(resp.TakerCoinOut.Amount.IsZero())
+	s.assertAliceBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestStopLossNotTriggered() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 50)
+
+	// GIVEN liquidity at tick 0
+	s.bobPlacesLiquidityAToB(0, 50)
+
+	// WHEN alice places a STOP_LOSS at tick 5
+	orderKey := s.alicePlacesTriggerOrder(types.LimitOrderType_STOP_LOSS, 10, 20, 5)
+	s.App.DexKeeper.ExecuteTriggerOrders(s.Ctx)
+
+	// THEN it is not executed
+	s.assertAliceTriggerOrderExists(orderKey)
+	s.assertAliceBalances(0, 0)
+}
+
+func (s *DexTestSuite) TestStopLossTriggered() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 100)
+
+	// GIVEN liquidity at tick 0 and alice's STOP_LOSS at tick 5
+	trancheKey := s.bobPlacesLiquidityAToB(0, 50)
+	orderKey := s.alicePlacesTriggerOrder(types.LimitOrderType_STOP_LOSS, 10, 20, 5)
+
+	// WHEN the price moves past the trigger
+	s.bobCancelsLimitSell(trancheKey)
+	s.bobPlacesLiquidityAToB(10, 50)
+	s.App.DexKeeper.ExecuteTriggerOrders(s.Ctx)
+
+	// THEN the order is swapped at the new price
+	s.assertAliceTriggerOrderNotExists(orderKey)
+	s.assertAliceBalancesInt(math.ZeroInt(), math.NewInt(9_990_005))
+	s.Empty(s.App.DexKeeper.GetAllTriggerOrderTradePairIDs(s.Ctx))
+}
+
+func (s *DexTestSuite) TestTakeProfitTriggered() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 100)
+
+	// GIVEN liquidity at tick 10 and alice's TAKE_PROFIT at tick 5
+	s.bobPlacesLiquidityAToB(10, 50)
+	orderKey := s.alicePlacesTriggerOrder(types.LimitOrderType_TAKE_PROFIT, 10, 20, 5)
+	s.App.DexKeeper.ExecuteTriggerOrders(s.Ctx)
+	s.assertAliceTriggerOrderExists(orderKey)
+
+	// WHEN the price improves past the trigger
+	s.bobPlacesLiquidityAToB(0, 50)
+	s.App.DexKeeper.ExecuteTriggerOrders(s.Ctx)
+
+	// THEN the order is swapped at the new price
+	s.assertAliceTriggerOrderNotExists(orderKey)
+	s.assertAliceBalances(0, 10)
+}
+
+func (s *DexTestSuite) TestTriggerOrderFailsReturnsFunds() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 100)
+
+	// GIVEN alice's STOP_LOSS at tick 5 with a limit price of tick 8
+	trancheKey := s.bobPlacesLiquidityAToB(0, 50)
+	orderKey := s.alicePlacesTriggerOrder(types.LimitOrderType_STOP_LOSS, 10, 8, 5)
+
+	// WHEN the price moves beyond both the trigger and the limit price
+	s.bobCancelsLimitSell(trancheKey)
+	s.bobPlacesLiquidityAToB(10, 50)
+	s.App.DexKeeper.ExecuteTriggerOrders(s.Ctx)
+
+	// THEN the order is removed and alice gets her funds back
+	s.assertAliceTriggerOrderNotExists(orderKey)
+	s.assertAliceBalances(10, 0)
+	s.assertDexBalances(0, 50)
+}
+
+func (s *DexTestSuite) TestCancelTriggerOrder() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN a pending STOP_LOSS
+	orderKey := s.alicePlacesTriggerOrder(types.LimitOrderType_STOP_LOSS, 10, 20, 5)
+
+	// WHEN alice cancels it
+	s.aliceCancelsLimitSell(orderKey)
+
+	// THEN her funds are returned
+	s.assertAliceTriggerOrderNotExists(orderKey)
+	s.assertAliceBalances(10, 0)
+	s.assertDexBalances(0, 0)
+	s.Empty(s.App.DexKeeper.GetAllTriggerOrderTradePairIDs(s.Ctx))
+}
+
+func (s *DexTestSuite) TestCancelTriggerOrderOtherUserFails() {
+	s.fundAliceBalances(10, 0)
+
+	// GIVEN alice's pending STOP_LOSS
+	orderKey := s.alicePlacesTriggerOrder(types.LimitOrderType_STOP_LOSS, 10, 20, 5)
+
+	// THEN bob cannot cancel it
+	_, err := s.msgServer.CancelLimitOrder(s.Ctx, &types.MsgCancelLimitOrder{
+		Creator:    s.bob.String(),
+		TrancheKey: orderKey,
+	})
+	s.ErrorIs(err, types.ErrValidLimitOrderTrancheNotFound)
+	s.assertAliceTriggerOrderExists(orderKey)
+}
+
+func (s *DexTestSuite) TestExecuteTriggerOrdersHitsGasLimit() {
+	s.fundAliceBalances(10, 0)
+	s.fundBobBalances(0, 50)
+
+	// GIVEN a triggered STOP_LOSS and no TriggerOrderAllowance
+	params := s.App.DexKeeper.GetParams(s.Ctx)
+	params.TriggerOrderAllowance = 0
+	s.NoError(s.App.DexKeeper.SetParams(s.Ctx, params))
+
+	s.bobPlacesLiquidityAToB(10, 50)
+	orderKey := s.alicePlacesTriggerOrder(types.LimitOrderType_STOP_LOSS, 10, 20, 5)
+
+	// WHEN trigger orders are executed
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.App.DexKeeper.ExecuteTriggerOrders(s.Ctx)
+
+	// THEN nothing is executed
+	s.assertAliceTriggerOrderExists(orderKey)
+	s.AssertEventEmitted(s.Ctx, types.EventTypeTriggerOrderHitGasLimit, 1)
+}
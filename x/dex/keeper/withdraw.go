@@ -70,6 +70,8 @@ func (k Keeper) WithdrawCore(
 		}
 	}
 
+	k.queueAfterWithdraw(ctx, callerAddr, receiverAddr, pairID, totalReserve0ToRemove, totalReserve1ToRemove, coinsToBurn)
+
 	return totalReserve0ToRemove, totalReserve1ToRemove, coinsToBurn, nil
 }
 
@@ -83,6 +83,7 @@ func (k Keeper) ExecuteWithdrawFilledLimitOrder(
 	if found {
 		var amountOutTokenIn math.Int
 		amountOutTokenIn, amountOutTokenOut = tranche.Withdraw(trancheUser)
+		amountOutTokenOut = amountOutTokenOut.Add(tranche.WithdrawMakerRebate(amountOutTokenOut))
 
 		if wasFilled {
 			// This is only relevant for inactive JIT and GoodTil limit orders
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// WithdrawRangeCore handles core logic for MsgWithdrawRange. All shares of the RangePosition are withdrawn
+// and the position is removed.
+func (k Keeper) WithdrawRangeCore(
+	goCtx context.Context,
+	callerAddr sdk.AccAddress,
+	receiverAddr sdk.AccAddress,
+	positionID uint64,
+) (reserve0Withdrawn, reserve1Withdrawn math.Int, sharesBurned sdk.Coins, err error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	position, found := k.GetRangePosition(ctx, callerAddr.String(), positionID)
+	if !found {
+		return math.ZeroInt(), math.ZeroInt(), nil, sdkerrors.Wrapf(
+			types.ErrRangePositionNotFound,
+			"%s does not own range position %d",
+			callerAddr,
+			positionID,
+		)
+	}
+
+	sharesToRemove := make([]math.Int, len(position.Shares))
+	tickIndexes := make([]int64, len(position.Shares))
+	fees := make([]uint64, len(position.Shares))
+	for i, share := range position.Shares {
+		poolMetadata, err := k.GetPoolMetadataByDenom(ctx, share.Denom)
+		if err != nil {
+			return math.ZeroInt(), math.ZeroInt(), nil, err
+		}
+		sharesToRemove[i] = share.Amount
+		tickIndexes[i] = poolMetadata.Tick
+		fees[i] = poolMetadata.Fee
+	}
+
+	// The position's shares are held by the dex module
+	totalReserve0ToRemove, totalReserve1ToRemove, coinsToBurn, events, err := k.ExecuteWithdraw(
+		ctx,
+		position.PairId,
+		authtypes.NewModuleAddress(types.ModuleName),
+		receiverAddr,
+		sharesToRemove,
+		tickIndexes,
+		fees,
+	)
+	if err != nil {
+		return math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	ctx.EventManager().EmitEvents(events)
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coinsToBurn); err != nil {
+		return math.ZeroInt(), math.ZeroInt(), nil, err
+	}
+
+	coinsOut := sdk.NewCoins(
+		sdk.NewCoin(position.PairId.Token0, totalReserve0ToRemove),
+		sdk.NewCoin(position.PairId.Token1, totalReserve1ToRemove),
+	)
+	if !coinsOut.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiverAddr, coinsOut); err != nil {
+			return math.ZeroInt(), math.ZeroInt(), nil, err
+		}
+		ctx.EventManager().EmitEvents(types.GetEventsWithdrawnAmount(coinsOut))
+	}
+
+	k.RemoveRangePosition(ctx, position.Owner, position.Id)
+
+	k.queueAfterWithdraw(ctx, callerAddr, receiverAddr, position.PairId, totalReserve0ToRemove, totalReserve1ToRemove, coinsToBurn)
+
+	return totalReserve0ToRemove, totalReserve1ToRemove, coinsToBurn, nil
+}
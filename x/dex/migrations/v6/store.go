@@ -0,0 +1,61 @@
+package v6
+
+import (
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration adds new dex params -- TriggerOrderAllowance for executing STOP_LOSS and TAKE_PROFIT orders
+// PeggedOrderAllowance for moving oracle-pegged limit orders, the LimitOrderTakerFeeBps and LimitOrderMakerRebateBps
+// limit order fees, GaugeEpochBlocks for LP incentive Gauges, AutoWithdrawAllowance for auto withdrawing limit orders,
+// the DynamicFee params of the dynamic fee tier, which is disabled by default, BatchAuctionAllowance for clearing
+// batch auctions, and GaugeDistributionAllowance, GaugeCreationFee and MinStakeShares for Gauges.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	return migrateParams(ctx, cdc, storeKey)
+}
+
+func migrateParams(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating dex params...")
+
+	// fetch old params
+	store := ctx.KVStore(storeKey)
+	bz := store.Get(types.KeyPrefix(types.ParamsKey))
+	if bz == nil {
+		return errors.New("cannot fetch dex params from KV store")
+	}
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+
+	// add new param values
+	params.TriggerOrderAllowance = types.DefaultTriggerOrderAllowance
+	params.PeggedOrderAllowance = types.DefaultPeggedOrderAllowance
+	params.LimitOrderTakerFeeBps = types.DefaultLimitOrderTakerFeeBps
+	params.LimitOrderMakerRebateBps = types.DefaultLimitOrderMakerRebateBps
+	params.GaugeEpochBlocks = types.DefaultGaugeEpochBlocks
+	params.AutoWithdrawAllowance = types.DefaultAutoWithdrawAllowance
+	params.DynamicFeeTier = types.DefaultDynamicFeeTier
+	params.DynamicFeeMinBps = types.DefaultDynamicFeeMinBps
+	params.DynamicFeeMaxBps = types.DefaultDynamicFeeMaxBps
+	params.DynamicFeeWindow = types.DefaultDynamicFeeWindow
+	params.BatchAuctionAllowance = types.DefaultBatchAuctionAllowance
+	params.GaugeDistributionAllowance = types.DefaultGaugeDistributionAllowance
+	params.GaugeCreationFee = types.DefaultGaugeCreationFee
+	params.MinStakeShares = types.DefaultMinStakeShares
+
+	// set params
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	ctx.Logger().Info("Finished migrating dex params")
+
+	return nil
+}
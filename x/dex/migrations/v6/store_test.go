@@ -0,0 +1,65 @@
+package v6_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/neutron-org/neutron/v5/testutil"
+	v6 "github.com/neutron-org/neutron/v5/x/dex/migrations/v6"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+type V6DexMigrationTestSuite struct {
+	testutil.IBCConnectionTestSuite
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(V6DexMigrationTestSuite))
+}
+
+func (suite *V6DexMigrationTestSuite) TestParamsUpgrade() {
+	var (
+		app      = suite.GetNeutronZoneApp(suite.ChainA)
+		storeKey = app.GetKey(types.StoreKey)
+		ctx      = suite.ChainA.GetContext()
+		cdc      = app.AppCodec()
+	)
+
+	// Write old state
+	oldParams := types.Params{
+		FeeTiers:              []uint64{0, 1, 2, 3, 4, 5, 10, 20, 50, 100, 150, 200},
+		Paused:                true,
+		MaxJitsPerBlock:       10,
+		GoodTilPurgeAllowance: 100_000,
+	}
+	store := ctx.KVStore(storeKey)
+	bz, err := cdc.Marshal(&oldParams)
+	suite.Require().NoError(err)
+
+	store.Set(types.KeyPrefix(types.ParamsKey), bz)
+
+	// Run migration
+	suite.NoError(v6.MigrateStore(ctx, cdc, storeKey))
+
+	// Check params are correct
+	newParams := app.DexKeeper.GetParams(ctx)
+	suite.Require().EqualValues(oldParams.FeeTiers, newParams.FeeTiers)
+	suite.Require().EqualValues(oldParams.Paused, newParams.Paused)
+	suite.Require().EqualValues(oldParams.MaxJitsPerBlock, newParams.MaxJitsPerBlock)
+	suite.Require().EqualValues(oldParams.GoodTilPurgeAllowance, newParams.GoodTilPurgeAllowance)
+	suite.Require().EqualValues(types.DefaultTriggerOrderAllowance, newParams.TriggerOrderAllowance)
+	suite.Require().EqualValues(types.DefaultPeggedOrderAllowance, newParams.PeggedOrderAllowance)
+	suite.Require().EqualValues(types.DefaultLimitOrderTakerFeeBps, newParams.LimitOrderTakerFeeBps)
+	suite.Require().EqualValues(types.DefaultLimitOrderMakerRebateBps, newParams.LimitOrderMakerRebateBps)
+	suite.Require().EqualValues(types.DefaultGaugeEpochBlocks, newParams.GaugeEpochBlocks)
+	suite.Require().EqualValues(types.DefaultAutoWithdrawAllowance, newParams.AutoWithdrawAllowance)
+	suite.Require().EqualValues(types.DefaultDynamicFeeTier, newParams.DynamicFeeTier)
+	suite.Require().EqualValues(types.DefaultDynamicFeeMinBps, newParams.DynamicFeeMinBps)
+	suite.Require().EqualValues(types.DefaultDynamicFeeMaxBps, newParams.DynamicFeeMaxBps)
+	suite.Require().EqualValues(types.DefaultDynamicFeeWindow, newParams.DynamicFeeWindow)
+	suite.Require().EqualValues(types.DefaultBatchAuctionAllowance, newParams.BatchAuctionAllowance)
+	suite.Require().EqualValues(types.DefaultGaugeDistributionAllowance, newParams.GaugeDistributionAllowance)
+	suite.Require().True(newParams.GaugeCreationFee.IsZero())
+	suite.Require().EqualValues(types.DefaultMinStakeShares, newParams.MinStakeShares)
+}
@@ -165,10 +165,15 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 4, m.Migrate4to5); err != nil {
 		panic(fmt.Sprintf("failed to migrate x/dex from version 4 to 5: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 5, m.Migrate5to6); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/dex from version 5 to 6: %v", err))
+	}
 }
 
 // RegisterInvariants registers the capability module's invariants.
-func (am AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper)
+}
 
 // InitGenesis performs the capability module's genesis initialization It returns
 // no validator updates.
@@ -199,11 +204,22 @@ func (AppModule) ConsensusVersion() uint64 { return types.ConsensusVersion }
 func (am AppModule) BeginBlock(wctx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(wctx)
 	am.keeper.PurgeExpiredLimitOrders(ctx, ctx.BlockTime())
+	am.keeper.MovePeggedOrders(ctx)
+	am.keeper.DistributeGaugeRewards(ctx)
 	return nil
 }
 
 // EndBlock executes all ABCI EndBlock logic respective to the capability module. It
 // returns no validator updates.
-func (am AppModule) EndBlock(_ context.Context) ([]abci.ValidatorUpdate, error) {
+func (am AppModule) EndBlock(wctx context.Context) ([]abci.ValidatorUpdate, error) {
+	ctx := sdk.UnwrapSDKContext(wctx)
+	am.keeper.ExecuteTriggerOrders(ctx)
+	am.keeper.ClearBatchAuctions(ctx)
+	am.keeper.AutoWithdrawLimitOrders(ctx)
+	am.keeper.UpdateDynamicFees(ctx)
+	am.keeper.UpdatePriceAccumulators(ctx)
+	am.keeper.SendPendingProtocolFees(ctx)
+	am.keeper.NotifyHooks(ctx)
+	am.keeper.WriteCandles(ctx)
 	return []abci.ValidatorUpdate{}, nil
 }
@@ -0,0 +1,115 @@
+package streaming
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+var _ storetypes.ABCIListener = &Listener{}
+
+// Listener is an ABCIListener that writes the dex deltas of every committed block to a file or unix socket
+// as JSON lines of DexStreamBlocks. Deltas are collected in FinalizeBlock and only written once the block
+// has been committed.
+type Listener struct {
+	config types.StreamConfig
+	// path of the output file or unix socket
+	path string
+	// writer is opened lazily and reset after a failed write so that the output is reopened for the next block
+	writer  io.WriteCloser
+	pending *types.DexStreamBlock
+}
+
+// NewListener creates a Listener for config. Relative output files are resolved against homeDir.
+func NewListener(config types.StreamConfig, homeDir string) *Listener {
+	path := strings.TrimPrefix(config.Output, types.StreamUnixSocketPrefix)
+	if !config.IsUnixSocket() && !filepath.IsAbs(path) {
+		path = filepath.Join(homeDir, path)
+	}
+
+	return &Listener{config: config, path: path}
+}
+
+// ListenFinalizeBlock collects the dex deltas of the block
+func (l *Listener) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	block, err := types.DexStreamBlockFromFinalizeBlock(req, res)
+	if err != nil {
+		l.pending = nil
+		return fmt.Errorf("failed to collect dex deltas of block %d: %w", req.Height, err)
+	}
+	l.pending = block
+
+	return nil
+}
+
+// ListenCommit writes the dex deltas of the committed block
+func (l *Listener) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	if l.pending == nil {
+		return nil
+	}
+	block := l.pending
+	l.pending = nil
+
+	line, err := MarshalBlock(block)
+	if err != nil {
+		return err
+	}
+
+	if l.writer == nil {
+		writer, err := l.open()
+		if err != nil {
+			return fmt.Errorf("failed to open dex stream %s: %w", l.config.Output, err)
+		}
+		l.writer = writer
+	}
+
+	if _, err := l.writer.Write(line); err != nil {
+		_ = l.Close()
+		return fmt.Errorf("failed to write block %d to dex stream %s: %w", block.BlockHeight, l.config.Output, err)
+	}
+
+	return nil
+}
+
+func (l *Listener) open() (io.WriteCloser, error) {
+	if l.config.IsUnixSocket() {
+		return net.Dial("unix", l.path)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0o755); err != nil {
+		return nil, err
+	}
+
+	return os.OpenFile(l.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+}
+
+// Close closes the output. It is reopened if another block is committed.
+func (l *Listener) Close() error {
+	if l.writer == nil {
+		return nil
+	}
+	err := l.writer.Close()
+	l.writer = nil
+
+	return err
+}
+
+// MarshalBlock encodes block as a single line of proto3 JSON
+func MarshalBlock(block *types.DexStreamBlock) ([]byte, error) {
+	bz, err := codec.ProtoMarshalJSON(block, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(bz, '\n'), nil
+}
@@ -0,0 +1,117 @@
+package streaming_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/x/dex/streaming"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func tickUpdate(tickIndex, reserves int64) abci.Event {
+	return abci.Event(types.TickUpdateEvent("TokenA", "TokenB", "TokenB", tickIndex, math.NewInt(reserves),
+		sdk.NewAttribute(types.AttributeFee, "1")))
+}
+
+func commitBlock(t *testing.T, listener *streaming.Listener, height int64, events ...abci.Event) {
+	ctx := context.Background()
+	err := listener.ListenFinalizeBlock(
+		ctx,
+		abci.RequestFinalizeBlock{Height: height, Time: time.Unix(height, 0)},
+		abci.ResponseFinalizeBlock{TxResults: []*abci.ExecTxResult{{Events: events}}},
+	)
+	require.NoError(t, err)
+	require.NoError(t, listener.ListenCommit(ctx, abci.ResponseCommit{}, nil))
+}
+
+func replay(t *testing.T, path string) (*streaming.Book, error) {
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	book := streaming.NewBook()
+	return book, streaming.ReadBlocks(f, book.ApplyBlock)
+}
+
+func TestListenerWritesReplayableStream(t *testing.T) {
+	homeDir := t.TempDir()
+	config := types.DefaultStreamConfig()
+	config.Enabled = true
+	listener := streaming.NewListener(config, homeDir)
+
+	commitBlock(t, listener, 1, tickUpdate(0, 10), tickUpdate(1, 20))
+	commitBlock(t, listener, 2)
+	commitBlock(t, listener, 3, tickUpdate(0, 0), tickUpdate(1, 15))
+	require.NoError(t, listener.Close())
+
+	// One line is written per block
+	bz, err := os.ReadFile(filepath.Join(homeDir, config.Output))
+	require.NoError(t, err)
+	require.Len(t, strings.Split(strings.TrimSpace(string(bz)), "\n"), 3)
+
+	book, err := replay(t, filepath.Join(homeDir, config.Output))
+	require.NoError(t, err)
+	require.Equal(t, int64(3), book.Height)
+
+	liquidity := book.Liquidity()
+	require.Len(t, liquidity, 1)
+	require.Equal(t, int64(1), liquidity[0].TickIndexTakerToMaker)
+	require.Equal(t, uint64(1), liquidity[0].Fee)
+	require.Equal(t, math.NewInt(15), liquidity[0].ReservesMakerDenom)
+}
+
+func TestListenerReopensOutput(t *testing.T) {
+	homeDir := t.TempDir()
+	config := types.DefaultStreamConfig()
+	config.Enabled = true
+	listener := streaming.NewListener(config, homeDir)
+
+	// Blocks written after the output is closed are appended to the stream
+	commitBlock(t, listener, 1, tickUpdate(0, 10))
+	require.NoError(t, listener.Close())
+	commitBlock(t, listener, 2, tickUpdate(1, 10))
+	require.NoError(t, listener.Close())
+
+	book, err := replay(t, filepath.Join(homeDir, config.Output))
+	require.NoError(t, err)
+	require.Equal(t, int64(2), book.Height)
+	require.Len(t, book.Liquidity(), 2)
+}
+
+func TestReplayDetectsMissingBlocks(t *testing.T) {
+	homeDir := t.TempDir()
+	config := types.DefaultStreamConfig()
+	config.Enabled = true
+	listener := streaming.NewListener(config, homeDir)
+
+	commitBlock(t, listener, 1)
+	commitBlock(t, listener, 3)
+	require.NoError(t, listener.Close())
+
+	book, err := replay(t, filepath.Join(homeDir, config.Output))
+	require.ErrorContains(t, err, "stream is missing blocks 2 to 2")
+	require.Equal(t, int64(1), book.Height)
+}
+
+func TestReplayDetectsTruncatedBlock(t *testing.T) {
+	block, err := streaming.MarshalBlock(&types.DexStreamBlock{BlockHeight: 1})
+	require.NoError(t, err)
+	block2, err := streaming.MarshalBlock(&types.DexStreamBlock{BlockHeight: 2})
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "stream.jsonl")
+	require.NoError(t, os.WriteFile(path, append(block, block2[:len(block2)/2]...), 0o600))
+
+	book, err := replay(t, path)
+	require.ErrorContains(t, err, "line 2: truncated block")
+	require.Equal(t, int64(1), book.Height)
+}
@@ -0,0 +1,128 @@
+package streaming
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+// ReadBlocks decodes the DexStreamBlocks of a stream in order and passes them to fn
+func ReadBlocks(r io.Reader, fn func(block *types.DexStreamBlock) error) error {
+	reader := bufio.NewReader(r)
+	for lineNum := 1; ; lineNum++ {
+		line, err := reader.ReadBytes('\n')
+		if errors.Is(err, io.EOF) {
+			if len(bytes.TrimSpace(line)) > 0 {
+				// The last block is only partially written if the node stopped while writing it
+				return fmt.Errorf("line %d: truncated block", lineNum)
+			}
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		block := &types.DexStreamBlock{}
+		if err := jsonpb.Unmarshal(bytes.NewReader(line), block); err != nil {
+			return fmt.Errorf("line %d: %w", lineNum, err)
+		}
+
+		if err := fn(block); err != nil {
+			return err
+		}
+	}
+}
+
+// Book is the dex liquidity reconstructed by replaying a stream
+type Book struct {
+	// Height of the last applied block
+	Height         int64
+	NumFills       int
+	NumDeposits    int
+	NumExpirations int
+	ticks          map[tickKey]*types.TickDelta
+}
+
+type tickKey struct {
+	takerDenom            string
+	makerDenom            string
+	tickIndexTakerToMaker int64
+	fee                   uint64
+	trancheKey            string
+}
+
+func NewBook() *Book {
+	return &Book{ticks: make(map[tickKey]*types.TickDelta)}
+}
+
+// ApplyBlock applies the deltas of block to the book. Blocks must be applied in order without any gaps.
+func (b *Book) ApplyBlock(block *types.DexStreamBlock) error {
+	if b.Height != 0 && block.BlockHeight != b.Height+1 {
+		return fmt.Errorf("stream is missing blocks %d to %d", b.Height+1, block.BlockHeight-1)
+	}
+
+	for _, delta := range block.Deltas {
+		switch d := delta.Delta.(type) {
+		case *types.DexDelta_Tick:
+			key := tickKey{
+				takerDenom:            d.Tick.TradePairId.TakerDenom,
+				makerDenom:            d.Tick.TradePairId.MakerDenom,
+				tickIndexTakerToMaker: d.Tick.TickIndexTakerToMaker,
+				fee:                   d.Tick.Fee,
+				trancheKey:            d.Tick.TrancheKey,
+			}
+			if d.Tick.ReservesMakerDenom.IsZero() {
+				delete(b.ticks, key)
+			} else {
+				b.ticks[key] = d.Tick
+			}
+		case *types.DexDelta_Fill:
+			b.NumFills++
+		case *types.DexDelta_Deposit:
+			b.NumDeposits++
+		case *types.DexDelta_Expiration:
+			b.NumExpirations++
+		}
+	}
+	b.Height = block.BlockHeight
+
+	return nil
+}
+
+// Liquidity returns the PoolReserves and LimitOrderTranches with reserves sorted by trade pair, tick, fee and
+// tranche key
+func (b *Book) Liquidity() []*types.TickDelta {
+	keys := make([]tickKey, 0, len(b.ticks))
+	for key := range b.ticks {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		x, y := keys[i], keys[j]
+		switch {
+		case x.takerDenom != y.takerDenom:
+			return x.takerDenom < y.takerDenom
+		case x.makerDenom != y.makerDenom:
+			return x.makerDenom < y.makerDenom
+		case x.tickIndexTakerToMaker != y.tickIndexTakerToMaker:
+			return x.tickIndexTakerToMaker < y.tickIndexTakerToMaker
+		case x.fee != y.fee:
+			return x.fee < y.fee
+		default:
+			return x.trancheKey < y.trancheKey
+		}
+	})
+
+	liquidity := make([]*types.TickDelta, len(keys))
+	for i, key := range keys {
+		liquidity[i] = b.ticks[key]
+	}
+
+	return liquidity
+}
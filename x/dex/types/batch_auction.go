@@ -0,0 +1,72 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+func (o BatchAuctionOrder) KeyMarshal() []byte {
+	return BatchAuctionOrderKey(o.TradePairId.MustPairID(), o.OrderKey)
+}
+
+func (o BatchAuctionOrder) CoinIn() sdk.Coin {
+	return sdk.NewCoin(o.TradePairId.TakerDenom, o.AmountIn)
+}
+
+// MinSellPrice returns the lowest average price (in MakerDenom per TakerDenom) that the order can be filled at.
+// It is the greater of the price implied by TickIndexInToOut and MinAverageSellPrice.
+func (o BatchAuctionOrder) MinSellPrice() math_utils.PrecDec {
+	// TickIndexInToOut is validated when the order is placed so this will never fail
+	limitBuyPrice := MustCalcPrice(o.TickIndexInToOut)
+	minSellPrice := math_utils.OnePrecDec().Quo(limitBuyPrice)
+	if o.MinAverageSellPrice != nil && o.MinAverageSellPrice.GT(minSellPrice) {
+		return *o.MinAverageSellPrice
+	}
+
+	return minSellPrice
+}
+
+func (o BatchAuctionOrder) Validate() error {
+	if o.TradePairId == nil {
+		return sdkerrors.Wrap(ErrInvalidTradingPair, "missing TradePairID")
+	}
+	if _, err := o.TradePairId.PairID(); err != nil {
+		return err
+	}
+	if !o.OrderType.IsTakerOnly() {
+		return sdkerrors.Wrapf(ErrInvalidBatchAuctionOrder, "%s", o.OrderType)
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Creator); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+	if o.AmountIn.IsNil() || o.AmountIn.LTE(math.ZeroInt()) {
+		return ErrZeroLimitOrder
+	}
+	if IsTickOutOfRange(o.TickIndexInToOut) {
+		return ErrTickOutsideRange
+	}
+
+	return nil
+}
+
+// BatchAuctionSudoMsg is the sudo payload sent to a contract that placed a BatchAuctionOrder once it has been cleared.
+type BatchAuctionSudoMsg struct {
+	BatchAuctionResult *BatchAuctionResultMsg `json:"batch_auction_result"`
+}
+
+type BatchAuctionResultMsg struct {
+	OrderKey string `json:"order_key"`
+	Receiver string `json:"receiver"`
+	// CoinIn is the portion of AmountIn that was sold
+	CoinIn sdk.Coin `json:"coin_in"`
+	// CoinOut has been sent to the receiver
+	CoinOut sdk.Coin `json:"coin_out"`
+	// Refund is the unsold portion of AmountIn and has been returned to the contract
+	Refund sdk.Coin `json:"refund"`
+}
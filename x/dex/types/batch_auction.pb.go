@@ -0,0 +1,674 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/batch_auction.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_neutron_org_neutron_v5_utils_math "github.com/neutron-org/neutron/v5/utils/math"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BatchAuctionOrder is a taker order on a pair in batch auction mode. It is queued until EndBlock where all of the
+// pair's queued orders are cleared at a single uniform price.
+type BatchAuctionOrder struct {
+	// TradePairID of the taker side of the order (ie. TakerDenom == token_in)
+	TradePairId *TradePairID          `protobuf:"bytes,1,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	OrderKey    string                `protobuf:"bytes,2,opt,name=order_key,json=orderKey,proto3" json:"order_key,omitempty"`
+	OrderType   LimitOrderType        `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=neutron.dex.LimitOrderType" json:"order_type,omitempty"`
+	Creator     string                `protobuf:"bytes,4,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver    string                `protobuf:"bytes,5,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	AmountIn    cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	// Worst limit tick the order can be filled at
+	TickIndexInToOut    int64                                                 `protobuf:"varint,7,opt,name=tick_index_in_to_out,json=tickIndexInToOut,proto3" json:"tick_index_in_to_out,omitempty"`
+	MinAverageSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,8,opt,name=min_average_sell_price,json=minAverageSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"min_average_sell_price" yaml:"min_average_sell_price"`
+}
+
+func (m *BatchAuctionOrder) Reset()         { *m = BatchAuctionOrder{} }
+func (m *BatchAuctionOrder) String() string { return proto.CompactTextString(m) }
+func (*BatchAuctionOrder) ProtoMessage()    {}
+func (*BatchAuctionOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84b87daab7a18bdb, []int{0}
+}
+func (m *BatchAuctionOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchAuctionOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchAuctionOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchAuctionOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchAuctionOrder.Merge(m, src)
+}
+func (m *BatchAuctionOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchAuctionOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchAuctionOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchAuctionOrder proto.InternalMessageInfo
+
+func (m *BatchAuctionOrder) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *BatchAuctionOrder) GetOrderKey() string {
+	if m != nil {
+		return m.OrderKey
+	}
+	return ""
+}
+
+func (m *BatchAuctionOrder) GetOrderType() LimitOrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return LimitOrderType_GOOD_TIL_CANCELLED
+}
+
+func (m *BatchAuctionOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *BatchAuctionOrder) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *BatchAuctionOrder) GetTickIndexInToOut() int64 {
+	if m != nil {
+		return m.TickIndexInToOut
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BatchAuctionOrder)(nil), "neutron.dex.BatchAuctionOrder")
+}
+
+func init() { proto.RegisterFile("neutron/dex/batch_auction.proto", fileDescriptor_84b87daab7a18bdb) }
+
+var fileDescriptor_84b87daab7a18bdb = []byte{
+	// 481 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xc1, 0x6e, 0xd3, 0x40,
+	0x14, 0x8c, 0x69, 0x69, 0x93, 0xad, 0x40, 0xc5, 0x04, 0xb4, 0x4a, 0x85, 0x1d, 0xe5, 0x94, 0x4b,
+	0x6d, 0xa9, 0xc0, 0xa5, 0x42, 0x48, 0x8d, 0x2a, 0xa1, 0x08, 0xa4, 0x46, 0x26, 0x27, 0x38, 0xac,
+	0x36, 0xeb, 0xa7, 0x64, 0x15, 0x7b, 0xd7, 0x5a, 0xaf, 0x83, 0xfd, 0x17, 0x7c, 0x00, 0x9f, 0xc0,
+	0x87, 0xe4, 0xd8, 0x23, 0xea, 0xc1, 0x42, 0xc9, 0xad, 0xc7, 0x7e, 0x01, 0xb2, 0x37, 0x29, 0x44,
+	0x02, 0x71, 0x9b, 0x37, 0x33, 0x9e, 0x37, 0x5e, 0x3d, 0xe4, 0x0a, 0xc8, 0xb4, 0x92, 0xc2, 0x0f,
+	0x21, 0xf7, 0x27, 0x54, 0xb3, 0x19, 0xa1, 0x19, 0xd3, 0x5c, 0x0a, 0x2f, 0x51, 0x52, 0x4b, 0xfb,
+	0x68, 0x63, 0xf0, 0x42, 0xc8, 0x3b, 0xed, 0xa9, 0x9c, 0xca, 0x9a, 0xf7, 0x2b, 0x64, 0x2c, 0x9d,
+	0x9d, 0x0c, 0xad, 0x68, 0x08, 0x24, 0xa1, 0x5c, 0x11, 0x1e, 0x6e, 0x0c, 0xed, 0x1d, 0x43, 0x6e,
+	0xd8, 0xde, 0xb7, 0x7d, 0xf4, 0x64, 0x50, 0x6d, 0xbc, 0x30, 0x0b, 0xaf, 0x54, 0x08, 0xca, 0x7e,
+	0x83, 0x1e, 0xed, 0x44, 0x60, 0xab, 0x6b, 0xf5, 0x8f, 0xce, 0xb0, 0xf7, 0x47, 0x0f, 0x6f, 0x5c,
+	0x39, 0x46, 0x94, 0xab, 0xe1, 0x65, 0x70, 0xa4, 0xef, 0x87, 0xd0, 0x3e, 0x41, 0x2d, 0x59, 0xc5,
+	0x90, 0x39, 0x14, 0xf8, 0x41, 0xd7, 0xea, 0xb7, 0x82, 0x66, 0x4d, 0xbc, 0x87, 0xc2, 0x3e, 0x47,
+	0xc8, 0x88, 0xba, 0x48, 0x00, 0xef, 0x75, 0xad, 0xfe, 0xe3, 0xb3, 0x93, 0x9d, 0xdc, 0x0f, 0x3c,
+	0xe6, 0xba, 0xee, 0x31, 0x2e, 0x12, 0x08, 0x5a, 0x72, 0x0b, 0x6d, 0x8c, 0x0e, 0x99, 0x02, 0xaa,
+	0xa5, 0xc2, 0xfb, 0x75, 0xec, 0x76, 0xb4, 0x3b, 0xa8, 0xa9, 0x80, 0x01, 0x5f, 0x80, 0xc2, 0x0f,
+	0xcd, 0xc6, 0xed, 0x6c, 0x7f, 0x46, 0x2d, 0x1a, 0xcb, 0x4c, 0x68, 0xc2, 0x05, 0x3e, 0xa8, 0xc4,
+	0xc1, 0xdb, 0x65, 0xe9, 0x36, 0x6e, 0x4a, 0xf7, 0x19, 0x93, 0x69, 0x2c, 0xd3, 0x34, 0x9c, 0x7b,
+	0x5c, 0xfa, 0x31, 0xd5, 0x33, 0x6f, 0x28, 0xf4, 0x6d, 0xe9, 0xfe, 0xfe, 0xe2, 0xae, 0x74, 0x8f,
+	0x0b, 0x1a, 0x47, 0xe7, 0xbd, 0x7b, 0xaa, 0x17, 0x34, 0x0d, 0x1e, 0x0a, 0xdb, 0x43, 0x6d, 0xcd,
+	0xd9, 0x9c, 0x70, 0x11, 0x42, 0x4e, 0xb8, 0x20, 0x5a, 0x12, 0x99, 0x69, 0x7c, 0xd8, 0xb5, 0xfa,
+	0x7b, 0xc1, 0x71, 0xa5, 0x0d, 0x2b, 0x69, 0x28, 0xc6, 0xf2, 0x2a, 0xd3, 0xf6, 0x77, 0x0b, 0x3d,
+	0x8f, 0xb9, 0x20, 0x74, 0x01, 0x8a, 0x4e, 0x81, 0xa4, 0x10, 0x45, 0x24, 0x51, 0x9c, 0x01, 0x6e,
+	0xd6, 0xd5, 0xbe, 0x2c, 0x4b, 0xd7, 0xba, 0x29, 0xdd, 0x57, 0x53, 0xae, 0x67, 0xd9, 0xc4, 0x63,
+	0x32, 0xf6, 0x37, 0xaf, 0x73, 0x2a, 0xd5, 0x74, 0x8b, 0xfd, 0xc5, 0x6b, 0x3f, 0xd3, 0x3c, 0x4a,
+	0x4d, 0xeb, 0x91, 0x02, 0x76, 0x09, 0xec, 0xb6, 0x74, 0xff, 0x91, 0x7e, 0x57, 0xba, 0x2f, 0xcc,
+	0x6f, 0xfc, 0x5d, 0xef, 0x05, 0x4f, 0x63, 0x2e, 0x2e, 0x0c, 0xff, 0x11, 0xa2, 0x68, 0x54, 0xb1,
+	0x83, 0x77, 0xcb, 0x95, 0x63, 0x5d, 0xaf, 0x1c, 0xeb, 0xe7, 0xca, 0xb1, 0xbe, 0xae, 0x9d, 0xc6,
+	0xf5, 0xda, 0x69, 0xfc, 0x58, 0x3b, 0x8d, 0x4f, 0xa7, 0xff, 0xef, 0x97, 0x9b, 0x53, 0x2b, 0x12,
+	0x48, 0x27, 0x07, 0xf5, 0xb9, 0xbd, 0xfc, 0x35, 0x00, 0x47, 0x81, 0x4a, 0x29, 0xeb, 0x02, 0x00,
+	0x00,
+}
+
+func (m *BatchAuctionOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchAuctionOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchAuctionOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinAverageSellPrice != nil {
+		{
+			size := m.MinAverageSellPrice.Size()
+			i -= size
+			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintBatchAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.TickIndexInToOut != 0 {
+		i = encodeVarintBatchAuction(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBatchAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintBatchAuction(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintBatchAuction(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintBatchAuction(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrderKey) > 0 {
+		i -= len(m.OrderKey)
+		copy(dAtA[i:], m.OrderKey)
+		i = encodeVarintBatchAuction(dAtA, i, uint64(len(m.OrderKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBatchAuction(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBatchAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBatchAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BatchAuctionOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovBatchAuction(uint64(l))
+	}
+	l = len(m.OrderKey)
+	if l > 0 {
+		n += 1 + l + sovBatchAuction(uint64(l))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovBatchAuction(uint64(m.OrderType))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovBatchAuction(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovBatchAuction(uint64(l))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovBatchAuction(uint64(l))
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovBatchAuction(uint64(m.TickIndexInToOut))
+	}
+	if m.MinAverageSellPrice != nil {
+		l = m.MinAverageSellPrice.Size()
+		n += 1 + l + sovBatchAuction(uint64(l))
+	}
+	return n
+}
+
+func sovBatchAuction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBatchAuction(x uint64) (n int) {
+	return sovBatchAuction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BatchAuctionOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBatchAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchAuctionOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchAuctionOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
+			}
+			m.TickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.MinAverageSellPrice = &v
+			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBatchAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBatchAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBatchAuction(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBatchAuction
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBatchAuction
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBatchAuction
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBatchAuction
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBatchAuction
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBatchAuction        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBatchAuction          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBatchAuction = fmt.Errorf("proto: unexpected end of group")
+)
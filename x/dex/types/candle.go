@@ -0,0 +1,56 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+// NewCandleFromSwap creates a single-trade candle for a swap of amountIn tokenIn for amountOut of the opposing token
+func NewCandleFromSwap(pairID *PairID, startTime int64, tokenIn string, amountIn, amountOut math.Int) (candle Candle, ok bool) {
+	if !amountIn.IsPositive() || !amountOut.IsPositive() {
+		return Candle{}, false
+	}
+
+	var amount0, amount1 math.Int
+	if tokenIn == pairID.Token0 {
+		amount0, amount1 = amountIn, amountOut
+	} else {
+		amount0, amount1 = amountOut, amountIn
+	}
+	price := math_utils.NewPrecDecFromInt(amount1).QuoInt(amount0)
+
+	return Candle{
+		PairId:    pairID,
+		StartTime: startTime,
+		Open:      price,
+		High:      price,
+		Low:       price,
+		Close:     price,
+		Volume0:   amount0,
+		Volume1:   amount1,
+	}, true
+}
+
+// Merge folds a later candle into c. The start time of c is kept.
+func (c *Candle) Merge(later Candle) {
+	if later.High.GT(c.High) {
+		c.High = later.High
+	}
+	if later.Low.LT(c.Low) {
+		c.Low = later.Low
+	}
+	c.Close = later.Close
+	c.Volume0 = c.Volume0.Add(later.Volume0)
+	c.Volume1 = c.Volume1.Add(later.Volume1)
+}
+
+// CandleStartTime returns the start of the interval that contains t. Both t and interval are in seconds.
+func CandleStartTime(t, interval int64) int64 {
+	start := t - t%interval
+	if t < 0 && t%interval != 0 {
+		start -= interval
+	}
+
+	return start
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagCandlesEnabled   = "dex-candles.enabled"
+	flagCandlesInterval  = "dex-candles.interval"
+	flagCandlesRetention = "dex-candles.retention"
+
+	// CandleStoreName is the name of the node-local database that holds the candles
+	CandleStoreName = "dex_candles"
+)
+
+// DefaultCandleConfigTemplate should be appended to the app.toml template.
+const DefaultCandleConfigTemplate = `
+
+###############################################################################
+###                               Dex Candles                               ###
+###############################################################################
+[dex-candles]
+# Enabled indicates whether this node records OHLCV candles of dex trade pairs. Candles are kept in a
+# node-local database and do not affect consensus; they are served by the dex Candles query.
+enabled = "{{ .DexCandles.Enabled }}"
+
+# Interval is the length of each stored candle. Longer candles are aggregated from these at query time.
+# Changing the interval requires removing the existing candle database.
+interval = "{{ .DexCandles.Interval }}"
+
+# Retention is the maximum age of a candle before it is pruned.
+retention = "{{ .DexCandles.Retention }}"
+`
+
+// CandleConfig configures the node-local candle store
+type CandleConfig struct {
+	Enabled   bool          `mapstructure:"enabled" toml:"enabled"`
+	Interval  time.Duration `mapstructure:"interval" toml:"interval"`
+	Retention time.Duration `mapstructure:"retention" toml:"retention"`
+}
+
+func DefaultCandleConfig() CandleConfig {
+	return CandleConfig{
+		Enabled:   false,
+		Interval:  time.Minute,
+		Retention: 30 * 24 * time.Hour,
+	}
+}
+
+func (c CandleConfig) Validate() error {
+	if c.Interval < time.Second || c.Interval%time.Second != 0 {
+		return fmt.Errorf("candle interval must be a positive whole number of seconds: %s", c.Interval)
+	}
+	if c.Retention < c.Interval {
+		return fmt.Errorf("candle retention must be greater than or equal to the candle interval: %s", c.Retention)
+	}
+
+	return nil
+}
+
+// ReadCandleConfigFromAppOpts reads the candle config from the [dex-candles] section of app.toml
+func ReadCandleConfigFromAppOpts(opts servertypes.AppOptions) (CandleConfig, error) {
+	var (
+		cfg = DefaultCandleConfig()
+		err error
+	)
+
+	if v := opts.Get(flagCandlesEnabled); v != nil {
+		if cfg.Enabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if !cfg.Enabled {
+		return cfg, nil
+	}
+
+	if v := opts.Get(flagCandlesInterval); v != nil {
+		if cfg.Interval, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := opts.Get(flagCandlesRetention); v != nil {
+		if cfg.Retention, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
@@ -0,0 +1,60 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func TestNewCandleFromSwap(t *testing.T) {
+	pairID := &types.PairID{Token0: "TokenA", Token1: "TokenB"}
+
+	// Selling token0 and buying token0 produce the same price orientation
+	candle, ok := types.NewCandleFromSwap(pairID, 60, "TokenA", math.NewInt(10), math.NewInt(20))
+	require.True(t, ok)
+	require.Equal(t, math_utils.NewPrecDec(2), candle.Open)
+	require.Equal(t, math.NewInt(10), candle.Volume0)
+
+	candle, ok = types.NewCandleFromSwap(pairID, 60, "TokenB", math.NewInt(30), math.NewInt(10))
+	require.True(t, ok)
+	require.Equal(t, math_utils.NewPrecDec(3), candle.Open)
+	require.Equal(t, math.NewInt(30), candle.Volume1)
+
+	_, ok = types.NewCandleFromSwap(pairID, 60, "TokenB", math.NewInt(30), math.ZeroInt())
+	require.False(t, ok)
+}
+
+func TestCandleMerge(t *testing.T) {
+	pairID := &types.PairID{Token0: "TokenA", Token1: "TokenB"}
+	candle, _ := types.NewCandleFromSwap(pairID, 60, "TokenA", math.NewInt(10), math.NewInt(20))
+	high, _ := types.NewCandleFromSwap(pairID, 60, "TokenA", math.NewInt(10), math.NewInt(50))
+	low, _ := types.NewCandleFromSwap(pairID, 60, "TokenA", math.NewInt(10), math.NewInt(10))
+
+	candle.Merge(high)
+	candle.Merge(low)
+
+	require.Equal(t, math_utils.NewPrecDec(2), candle.Open)
+	require.Equal(t, math_utils.NewPrecDec(5), candle.High)
+	require.Equal(t, math_utils.NewPrecDec(1), candle.Low)
+	require.Equal(t, math_utils.NewPrecDec(1), candle.Close)
+	require.Equal(t, math.NewInt(30), candle.Volume0)
+	require.Equal(t, math.NewInt(80), candle.Volume1)
+}
+
+func TestCandleStartTime(t *testing.T) {
+	require.Equal(t, int64(120), types.CandleStartTime(120, 60))
+	require.Equal(t, int64(120), types.CandleStartTime(179, 60))
+	require.Equal(t, int64(-60), types.CandleStartTime(-1, 60))
+}
+
+func TestCandleConfigValidate(t *testing.T) {
+	require.NoError(t, types.DefaultCandleConfig().Validate())
+	require.Error(t, types.CandleConfig{Interval: 0, Retention: time.Hour}.Validate())
+	require.Error(t, types.CandleConfig{Interval: 1500 * time.Millisecond, Retention: time.Hour}.Validate())
+	require.Error(t, types.CandleConfig{Interval: time.Hour, Retention: time.Minute}.Validate())
+}
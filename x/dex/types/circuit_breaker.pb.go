@@ -0,0 +1,405 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/circuit_breaker.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CircuitBreaker tracks how far the best tick of a TradePairID has moved during the current window.
+// Swaps that would move it further than circuit_breaker_max_tick_move from the start of the window are rejected.
+// A new window starts automatically circuit_breaker_window blocks after the current one.
+type CircuitBreaker struct {
+	TradePairId *TradePairID `protobuf:"bytes,1,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	// Block height at which the current window started
+	WindowStartHeight int64 `protobuf:"varint,2,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// Best tick_index_taker_to_maker of the TradePairID at the start of the window
+	WindowStartTickIndex int64 `protobuf:"varint,3,opt,name=window_start_tick_index,json=windowStartTickIndex,proto3" json:"window_start_tick_index,omitempty"`
+}
+
+func (m *CircuitBreaker) Reset()         { *m = CircuitBreaker{} }
+func (m *CircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*CircuitBreaker) ProtoMessage()    {}
+func (*CircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e85b9ce159dedf, []int{0}
+}
+func (m *CircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CircuitBreaker.Merge(m, src)
+}
+func (m *CircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *CircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_CircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CircuitBreaker proto.InternalMessageInfo
+
+func (m *CircuitBreaker) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *CircuitBreaker) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+func (m *CircuitBreaker) GetWindowStartTickIndex() int64 {
+	if m != nil {
+		return m.WindowStartTickIndex
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CircuitBreaker)(nil), "neutron.dex.CircuitBreaker")
+}
+
+func init() { proto.RegisterFile("neutron/dex/circuit_breaker.proto", fileDescriptor_32e85b9ce159dedf) }
+
+var fileDescriptor_32e85b9ce159dedf = []byte{
+	// 262 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0x4b, 0x2d, 0x2d,
+	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0x49, 0xad, 0xd0, 0x4f, 0xce, 0x2c, 0x4a, 0x2e, 0xcd, 0x2c, 0x89,
+	0x4f, 0x2a, 0x4a, 0x4d, 0xcc, 0x4e, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86,
+	0x2a, 0xd1, 0x4b, 0x49, 0xad, 0x90, 0x92, 0x47, 0x56, 0x5f, 0x52, 0x94, 0x98, 0x92, 0x1a, 0x5f,
+	0x90, 0x98, 0x59, 0x14, 0x9f, 0x99, 0x02, 0x51, 0xad, 0xb4, 0x95, 0x91, 0x8b, 0xcf, 0x19, 0x62,
+	0x8e, 0x13, 0xc4, 0x18, 0x21, 0x1b, 0x2e, 0x5e, 0x14, 0x95, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc,
+	0x46, 0x12, 0x7a, 0x48, 0x06, 0xeb, 0x85, 0x80, 0x54, 0x04, 0x24, 0x66, 0x16, 0x79, 0xba, 0x04,
+	0x71, 0x97, 0xc0, 0x39, 0x29, 0x42, 0x7a, 0x5c, 0xc2, 0xe5, 0x99, 0x79, 0x29, 0xf9, 0xe5, 0xf1,
+	0xc5, 0x25, 0x89, 0x45, 0x25, 0xf1, 0x19, 0xa9, 0x99, 0xe9, 0x19, 0x25, 0x12, 0x4c, 0x0a, 0x8c,
+	0x1a, 0xcc, 0x41, 0x82, 0x10, 0xa9, 0x60, 0x90, 0x8c, 0x07, 0x58, 0x42, 0xc8, 0x94, 0x4b, 0x1c,
+	0x45, 0x7d, 0x49, 0x66, 0x72, 0x76, 0x7c, 0x66, 0x5e, 0x4a, 0x6a, 0x85, 0x04, 0x33, 0x58, 0x8f,
+	0x08, 0x92, 0x9e, 0x90, 0xcc, 0xe4, 0x6c, 0x4f, 0x90, 0x9c, 0x93, 0xfb, 0x89, 0x47, 0x72, 0x8c,
+	0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72,
+	0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xe9, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7,
+	0xe7, 0xea, 0x43, 0x5d, 0xac, 0x9b, 0x5f, 0x94, 0x0e, 0x63, 0xeb, 0x97, 0x99, 0xea, 0x57, 0x40,
+	0x82, 0xa3, 0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x0e, 0xc6, 0x80, 0x01, 0x00, 0x2a, 0x31,
+	0xda, 0x1f, 0x5a, 0x01, 0x00, 0x00,
+}
+
+func (m *CircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowStartTickIndex != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.WindowStartTickIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintCircuitBreaker(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCircuitBreaker(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCircuitBreaker(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCircuitBreaker(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovCircuitBreaker(uint64(l))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.WindowStartHeight))
+	}
+	if m.WindowStartTickIndex != 0 {
+		n += 1 + sovCircuitBreaker(uint64(m.WindowStartTickIndex))
+	}
+	return n
+}
+
+func sovCircuitBreaker(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCircuitBreaker(x uint64) (n int) {
+	return sovCircuitBreaker(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CircuitBreaker) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CircuitBreaker: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartTickIndex", wireType)
+			}
+			m.WindowStartTickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartTickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCircuitBreaker(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCircuitBreaker
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCircuitBreaker(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCircuitBreaker
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCircuitBreaker
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCircuitBreaker
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCircuitBreaker
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCircuitBreaker
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCircuitBreaker        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCircuitBreaker          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCircuitBreaker = fmt.Errorf("proto: unexpected end of group")
+)
@@ -14,6 +14,16 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgWithdrawFilledLimitOrder{}, "dex/WithdrawFilledLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgCancelLimitOrder{}, "dex/CancelLimitOrder", nil)
 	cdc.RegisterConcrete(&MsgMultiHopSwap{}, "dex/MultiHopSwap", nil)
+	cdc.RegisterConcrete(&MsgMultiHopSwapExactOut{}, "dex/MultiHopSwapExactOut", nil)
+	cdc.RegisterConcrete(&MsgBatchOps{}, "dex/BatchOps", nil)
+	cdc.RegisterConcrete(&MsgDepositRange{}, "dex/DepositRange", nil)
+	cdc.RegisterConcrete(&MsgWithdrawRange{}, "dex/WithdrawRange", nil)
+	cdc.RegisterConcrete(&MsgAmendLimitOrder{}, "dex/AmendLimitOrder", nil)
+	cdc.RegisterConcrete(&MsgFlashSwap{}, "dex/FlashSwap", nil)
+	cdc.RegisterConcrete(&MsgCreateGauge{}, "dex/CreateGauge", nil)
+	cdc.RegisterConcrete(&MsgStake{}, "dex/Stake", nil)
+	cdc.RegisterConcrete(&MsgUnstake{}, "dex/Unstake", nil)
+	cdc.RegisterConcrete(&MsgClaim{}, "dex/Claim", nil)
 	// this line is used by starport scaffolding # 2
 }
 
@@ -36,6 +46,36 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMultiHopSwap{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgMultiHopSwapExactOut{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgBatchOps{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgDepositRange{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgWithdrawRange{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgAmendLimitOrder{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFlashSwap{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateGauge{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgStake{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUnstake{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgClaim{},
+	)
 	// this line is used by starport scaffolding # 3
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
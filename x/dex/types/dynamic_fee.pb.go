@@ -0,0 +1,625 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/dynamic_fee.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DynamicFee tracks the realised tick movement of a pair with pools of the dynamic fee tier and the fee charged by
+// those pools. A DynamicFee is created when the first pool of the dynamic fee tier is initialized for the pair.
+type DynamicFee struct {
+	PairId *PairID `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Absolute tick movement caused by swaps in each recent block in which the best tick of the pair moved, oldest
+	// first. Blocks that have fallen out of the dynamic_fee_window are pruned at the end of every block.
+	TickMoves []DynamicFeeTickMove `protobuf:"bytes,2,rep,name=tick_moves,json=tickMoves,proto3" json:"tick_moves"`
+	// Effective fee, in basis points of the amount swapped, of the pair's dynamic fee pools. It is the sum of
+	// tick_moves bounded by dynamic_fee_min_bps and dynamic_fee_max_bps.
+	FeeBps uint64 `protobuf:"varint,3,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"`
+}
+
+func (m *DynamicFee) Reset()         { *m = DynamicFee{} }
+func (m *DynamicFee) String() string { return proto.CompactTextString(m) }
+func (*DynamicFee) ProtoMessage()    {}
+func (*DynamicFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c2348571805082, []int{0}
+}
+func (m *DynamicFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicFee.Merge(m, src)
+}
+func (m *DynamicFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicFee proto.InternalMessageInfo
+
+func (m *DynamicFee) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *DynamicFee) GetTickMoves() []DynamicFeeTickMove {
+	if m != nil {
+		return m.TickMoves
+	}
+	return nil
+}
+
+func (m *DynamicFee) GetFeeBps() uint64 {
+	if m != nil {
+		return m.FeeBps
+	}
+	return 0
+}
+
+type DynamicFeeTickMove struct {
+	Height   int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	TickMove uint64 `protobuf:"varint,2,opt,name=tick_move,json=tickMove,proto3" json:"tick_move,omitempty"`
+}
+
+func (m *DynamicFeeTickMove) Reset()         { *m = DynamicFeeTickMove{} }
+func (m *DynamicFeeTickMove) String() string { return proto.CompactTextString(m) }
+func (*DynamicFeeTickMove) ProtoMessage()    {}
+func (*DynamicFeeTickMove) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e8c2348571805082, []int{1}
+}
+func (m *DynamicFeeTickMove) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicFeeTickMove) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicFeeTickMove.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicFeeTickMove) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicFeeTickMove.Merge(m, src)
+}
+func (m *DynamicFeeTickMove) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicFeeTickMove) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicFeeTickMove.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicFeeTickMove proto.InternalMessageInfo
+
+func (m *DynamicFeeTickMove) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DynamicFeeTickMove) GetTickMove() uint64 {
+	if m != nil {
+		return m.TickMove
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*DynamicFee)(nil), "neutron.dex.DynamicFee")
+	proto.RegisterType((*DynamicFeeTickMove)(nil), "neutron.dex.DynamicFeeTickMove")
+}
+
+func init() { proto.RegisterFile("neutron/dex/dynamic_fee.proto", fileDescriptor_e8c2348571805082) }
+
+var fileDescriptor_e8c2348571805082 = []byte{
+	// 301 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0x33, 0x6d, 0x49, 0xbf, 0x4e, 0x77, 0xf3, 0x89, 0xc6, 0x8a, 0xd3, 0xd2, 0x55, 0x17,
+	0x36, 0x03, 0x15, 0x5f, 0xa0, 0x14, 0x25, 0x0b, 0x41, 0x82, 0x2b, 0x37, 0x21, 0x7f, 0x6e, 0x92,
+	0xa1, 0x24, 0x13, 0x92, 0x69, 0x48, 0xdf, 0xc2, 0xad, 0x6f, 0xd4, 0x65, 0x97, 0xae, 0x44, 0x92,
+	0x17, 0x91, 0x26, 0xa9, 0x56, 0xdc, 0xdd, 0x3b, 0xe7, 0x9e, 0xdf, 0x1c, 0x0e, 0xbe, 0x8e, 0x61,
+	0x23, 0x53, 0x11, 0x33, 0x0f, 0x0a, 0xe6, 0x6d, 0x63, 0x3b, 0xe2, 0xae, 0xe5, 0x03, 0xe8, 0x49,
+	0x2a, 0xa4, 0x20, 0xc3, 0x56, 0xd6, 0x3d, 0x28, 0x46, 0x67, 0x81, 0x08, 0x44, 0xfd, 0xce, 0x0e,
+	0x53, 0x73, 0x32, 0xba, 0x3c, 0x25, 0x24, 0x36, 0x4f, 0x2d, 0xee, 0x35, 0xd2, 0xf4, 0x0d, 0x61,
+	0xbc, 0x6a, 0x98, 0xf7, 0x00, 0xe4, 0x06, 0xf7, 0x5b, 0x5d, 0x43, 0x13, 0x34, 0x1b, 0x2e, 0xfe,
+	0xeb, 0x27, 0x78, 0xfd, 0xc9, 0xe6, 0xa9, 0xb1, 0x32, 0xd5, 0xc3, 0x8d, 0xe1, 0x91, 0x15, 0xc6,
+	0x92, 0xbb, 0x6b, 0x2b, 0x12, 0x39, 0x64, 0x5a, 0x67, 0xd2, 0x9d, 0x0d, 0x17, 0xe3, 0x5f, 0x86,
+	0x1f, 0xf4, 0x33, 0x77, 0xd7, 0x8f, 0x22, 0x87, 0x65, 0x6f, 0xf7, 0x31, 0x56, 0xcc, 0x81, 0x6c,
+	0xf7, 0x8c, 0x5c, 0xe0, 0xbe, 0x0f, 0x60, 0x39, 0x49, 0xa6, 0x75, 0x27, 0x68, 0xd6, 0x33, 0x55,
+	0x1f, 0x60, 0x99, 0x64, 0x53, 0x03, 0x93, 0xbf, 0x7e, 0x72, 0x8e, 0xd5, 0x10, 0x78, 0x10, 0xca,
+	0x3a, 0x61, 0xd7, 0x6c, 0x37, 0x72, 0x85, 0x07, 0xdf, 0x61, 0xb4, 0x4e, 0x0d, 0xfa, 0x77, 0xfc,
+	0x64, 0xf9, 0xb0, 0x2b, 0x29, 0xda, 0x97, 0x14, 0x7d, 0x96, 0x14, 0xbd, 0x56, 0x54, 0xd9, 0x57,
+	0x54, 0x79, 0xaf, 0xa8, 0xf2, 0x32, 0x0f, 0xb8, 0x0c, 0x37, 0x8e, 0xee, 0x8a, 0x88, 0xb5, 0xc9,
+	0xe7, 0x22, 0x0d, 0x8e, 0x33, 0xcb, 0xef, 0x58, 0x51, 0xf7, 0x26, 0xb7, 0x09, 0x64, 0x8e, 0x5a,
+	0xd7, 0x76, 0xfb, 0x35, 0x00, 0x78, 0x6c, 0x26, 0xa4, 0x95, 0x01, 0x00, 0x00,
+}
+
+func (m *DynamicFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeBps != 0 {
+		i = encodeVarintDynamicFee(dAtA, i, uint64(m.FeeBps))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TickMoves) > 0 {
+		for iNdEx := len(m.TickMoves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TickMoves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDynamicFee(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDynamicFee(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DynamicFeeTickMove) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DynamicFeeTickMove) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicFeeTickMove) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TickMove != 0 {
+		i = encodeVarintDynamicFee(dAtA, i, uint64(m.TickMove))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintDynamicFee(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDynamicFee(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDynamicFee(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DynamicFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovDynamicFee(uint64(l))
+	}
+	if len(m.TickMoves) > 0 {
+		for _, e := range m.TickMoves {
+			l = e.Size()
+			n += 1 + l + sovDynamicFee(uint64(l))
+		}
+	}
+	if m.FeeBps != 0 {
+		n += 1 + sovDynamicFee(uint64(m.FeeBps))
+	}
+	return n
+}
+
+func (m *DynamicFeeTickMove) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovDynamicFee(uint64(m.Height))
+	}
+	if m.TickMove != 0 {
+		n += 1 + sovDynamicFee(uint64(m.TickMove))
+	}
+	return n
+}
+
+func sovDynamicFee(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDynamicFee(x uint64) (n int) {
+	return sovDynamicFee(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DynamicFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickMoves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickMoves = append(m.TickMoves, DynamicFeeTickMove{})
+			if err := m.TickMoves[len(m.TickMoves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeBps", wireType)
+			}
+			m.FeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DynamicFeeTickMove) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDynamicFee
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DynamicFeeTickMove: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DynamicFeeTickMove: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickMove", wireType)
+			}
+			m.TickMove = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickMove |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDynamicFee(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDynamicFee
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDynamicFee(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDynamicFee
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDynamicFee
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDynamicFee
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDynamicFee
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDynamicFee
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDynamicFee        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDynamicFee          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDynamicFee = fmt.Errorf("proto: unexpected end of group")
+)
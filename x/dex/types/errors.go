@@ -107,7 +107,7 @@ var (
 	ErrInvalidOrderType = sdkerrors.Register(
 		ModuleName,
 		1138,
-		"Order type must be one of: GOOD_TIL_CANCELLED, FILL_OR_KILL, IMMEDIATE_OR_CANCEL, JUST_IN_TIME, or GOOD_TIL_TIME.",
+		"Order type must be one of: GOOD_TIL_CANCELLED, FILL_OR_KILL, IMMEDIATE_OR_CANCEL, JUST_IN_TIME, GOOD_TIL_TIME, STOP_LOSS or TAKE_PROFIT.",
 	)
 	ErrExpirationTimeInPast = sdkerrors.Register(
 		ModuleName,
@@ -229,4 +229,204 @@ var (
 		1165,
 		"MinAverageSellPrice must be nil or > 0.",
 	)
+	ErrInvalidTWAPWindow = sdkerrors.Register(
+		ModuleName,
+		1166,
+		"TWAP window must satisfy start_time < end_time <= current block time",
+	)
+	ErrTWAPWindowUnavailable = sdkerrors.Register(
+		ModuleName,
+		1167,
+		"No price history is available for the requested TWAP window",
+	)
+	ErrTriggerOrderWithoutTriggerPrice = sdkerrors.Register(
+		ModuleName,
+		1168,
+		"Limit orders of type STOP_LOSS or TAKE_PROFIT must supply a TriggerSellPrice.",
+	)
+	ErrTriggerPriceOnWrongOrderType = sdkerrors.Register(
+		ModuleName,
+		1169,
+		"Only Limit orders of type STOP_LOSS or TAKE_PROFIT can supply a TriggerSellPrice.",
+	)
+	ErrTriggerOrderNotExecutable = sdkerrors.Register(
+		ModuleName,
+		1170,
+		"STOP_LOSS and TAKE_PROFIT orders cannot be executed or simulated directly",
+	)
+	ErrInvalidBatchOp = sdkerrors.Register(
+		ModuleName,
+		1171,
+		"Each BatchOp must contain exactly one operation",
+	)
+	ErrEmptyBatchOps = sdkerrors.Register(
+		ModuleName,
+		1172,
+		"MsgBatchOps must contain at least one operation",
+	)
+	ErrBatchOpCreatorMismatch = sdkerrors.Register(
+		ModuleName,
+		1173,
+		"The creator of each BatchOp must match the creator of MsgBatchOps",
+	)
+	ErrSplitRoutesWithPickBestRoute = sdkerrors.Register(
+		ModuleName,
+		1174,
+		"MultihopSwap cannot set both pick_best_route and split_routes",
+	)
+	ErrMaxAmountInExceeded = sdkerrors.Register(
+		ModuleName,
+		1175,
+		"Amount in required to swap for amount_out exceeds max_amount_in",
+	)
+	ErrInvalidRange = sdkerrors.Register(
+		ModuleName,
+		1176,
+		"Invalid range: lower_tick_index must be less than or equal to upper_tick_index",
+	)
+	ErrRangeTooWide = sdkerrors.Register(
+		ModuleName,
+		1177,
+		"Range spans too many pools",
+	)
+	ErrRangePositionNotFound = sdkerrors.Register(
+		ModuleName,
+		1178,
+		"Range position not found",
+	)
+	ErrPairPaused = sdkerrors.Register(
+		ModuleName,
+		1179,
+		"Pair has been paused, deposits, limit orders and swaps are disabled for this pair",
+	)
+	ErrCircuitBreakerTripped = sdkerrors.Register(
+		ModuleName,
+		1180,
+		"Swap would move the price further than the circuit breaker allows within the current window",
+	)
+	ErrInvalidCandleWindow = sdkerrors.Register(
+		ModuleName,
+		1181,
+		"Candle window must satisfy start_time < end_time and span at most 1000 candles",
+	)
+	ErrInvalidCandleInterval = sdkerrors.Register(
+		ModuleName,
+		1182,
+		"Candle interval must be a positive multiple of the node's candle interval",
+	)
+	ErrInvalidAmendOrderType = sdkerrors.Register(
+		ModuleName,
+		1183,
+		"Only GOOD_TIL_CANCELLED, GOOD_TIL_TIME, GOOD_TIL_BLOCK and POST_ONLY limit orders can be amended",
+	)
+	ErrAmendFilledLimitOrder = sdkerrors.Register(
+		ModuleName,
+		1184,
+		"Cannot amend a limit order with no unfilled amount",
+	)
+	ErrAmendAmountTooLarge = sdkerrors.Register(
+		ModuleName,
+		1185,
+		"AmountIn for an amended limit order cannot exceed the unfilled amount of the order",
+	)
+	ErrEmptyAmendLimitOrder = sdkerrors.Register(
+		ModuleName,
+		1186,
+		"MsgAmendLimitOrder must set amount_in or limit_sell_price",
+	)
+	ErrPostOnlyOrderCrossesBook = sdkerrors.Register(
+		ModuleName,
+		1187,
+		"POST_ONLY limit order would fill against existing liquidity",
+	)
+	ErrOraclePriceDeviation = sdkerrors.Register(
+		ModuleName,
+		1188,
+		"Swap price deviates from the oracle price by more than the pair's OraclePriceGuard allows",
+	)
+	ErrInvalidOraclePeg = sdkerrors.Register(
+		ModuleName,
+		1189,
+		"Invalid OraclePeg",
+	)
+	ErrInvalidPeggedOrderType = sdkerrors.Register(
+		ModuleName,
+		1190,
+		"Only GOOD_TIL_CANCELLED limit orders can be pegged to an oracle price",
+	)
+	ErrOraclePriceNotFound = sdkerrors.Register(
+		ModuleName,
+		1191,
+		"No current oracle price found for OraclePeg",
+	)
+	ErrFlashSwapCallbackFailed = sdkerrors.Register(
+		ModuleName,
+		1192,
+		"FlashSwap callback failed",
+	)
+	ErrFlashSwapNotRepaid = sdkerrors.Register(
+		ModuleName,
+		1193,
+		"FlashSwap amount in was not repaid",
+	)
+	ErrGoodTilBlockOrderWithoutExpirationHeight = sdkerrors.Register(
+		ModuleName,
+		1194,
+		"Limit orders of type GOOD_TIL_BLOCK must supply an ExpirationHeight.",
+	)
+	ErrExpirationHeightOnWrongOrderType = sdkerrors.Register(
+		ModuleName,
+		1195,
+		"Only Limit orders of type GOOD_TIL_BLOCK can supply an ExpirationHeight.",
+	)
+	ErrExpirationHeightInPast = sdkerrors.Register(
+		ModuleName,
+		1196,
+		"Limit order expiration height must be greater than current block height:",
+	)
+	ErrInvalidGauge = sdkerrors.Register(
+		ModuleName,
+		1197,
+		"Invalid Gauge",
+	)
+	ErrInvalidStake = sdkerrors.Register(
+		ModuleName,
+		1198,
+		"Only pool shares of existing pools can be staked",
+	)
+	ErrStakeNotFound = sdkerrors.Register(
+		ModuleName,
+		1199,
+		"Stake not found",
+	)
+	ErrNoRewardsToClaim = sdkerrors.Register(
+		ModuleName,
+		1200,
+		"No Gauge rewards to claim",
+	)
+	ErrInvalidBatchAuctionOrder = sdkerrors.Register(
+		ModuleName,
+		1201,
+		"Orders on pairs in batch auction mode must be IMMEDIATE_OR_CANCEL or FILL_OR_KILL without MaxAmountOut",
+	)
+	ErrPairInBatchAuction = sdkerrors.Register(
+		ModuleName,
+		1202,
+		"Pair is in batch auction mode; taker liquidity can only be consumed when the batch auction clears",
+	)
+	ErrAutoWithdrawOnWrongOrderType = sdkerrors.Register(
+		ModuleName,
+		1203,
+		"AutoWithdraw can only be used with limit orders that place maker liquidity",
+	)
+	ErrBatchAuctionGasLimit = sdkerrors.Register(
+		ModuleName,
+		1204,
+		"Batch auction could not be cleared within BatchAuctionAllowance gas",
+	)
+	ErrStakeTooSmall = sdkerrors.Register(
+		ModuleName,
+		1205,
+		"Every pool share in a Stake must be at least MinStakeShares",
+	)
 )
@@ -23,6 +23,8 @@ const (
 	AttributeAmountOut            = "AmountOut"
 	AttributeSwapAmountIn         = "SwapAmountIn"
 	AttributeSwapAmountOut        = "SwapAmountOut"
+	AttributeTakerFee             = "TakerFee"
+	AttributeMakerRebate          = "MakerRebate"
 	AttributeTokenInAmountOut     = "TokenInAmountOut"
 	AttributeTokenOutAmountOut    = "TokenOutAmountOut"
 	AttributeTickIndex            = "TickIndex"
@@ -56,21 +58,56 @@ const (
 	AttributeSharesOwned          = "SharesOwned"
 	AttributeSharesWithdrawn      = "SharesWithdrawn"
 	AttributeMinAvgSellPrice      = "MinAvgSellPrice"
+	AttributeTriggerTick          = "TriggerTick"
+	AttributeSuccess              = "Success"
+	AttributeError                = "Error"
+	AttributeNewTrancheKey        = "NewTrancheKey"
+	AttributeNewTickIndex         = "NewTickIndex"
+	AttributeGaugeID              = "GaugeID"
+	AttributeStakeID              = "StakeID"
+	AttributeStartTick            = "StartTick"
+	AttributeEndTick              = "EndTick"
+	AttributeNumEpochs            = "NumEpochs"
+	AttributeFilledEpochs         = "FilledEpochs"
+	AttributeCoins                = "Coins"
+	AttributeRefund               = "Refund"
+	AttributeClearingPrice        = "ClearingPrice"
+	AttributeNumOrders            = "NumOrders"
+	AttributeDynamicFee           = "DynamicFee"
+	AttributeFeeBps               = "FeeBps"
+	AttributeExpired              = "Expired"
 )
 
 // Event Keys
 const (
-	DepositEventKey                  = "DepositLP"
-	WithdrawEventKey                 = "WithdrawLP"
-	MultihopSwapEventKey             = "MultihopSwap"
-	PlaceLimitOrderEventKey          = "PlaceLimitOrder"
-	WithdrawFilledLimitOrderEventKey = "WithdrawLimitOrder"
-	CancelLimitOrderEventKey         = "CancelLimitOrder"
-	EventTypeTickUpdate              = "TickUpdate"
-	TickUpdateEventKey               = "TickUpdate"
-	EventTypeGoodTilPurgeHitGasLimit = "GoodTilPurgeHitGasLimit"
-	TrancheUserUpdateEventKey        = "TrancheUserUpdate"
-	EventTypeTrancheUserUpdate       = "TrancheUserUpdate"
+	DepositEventKey                       = "DepositLP"
+	WithdrawEventKey                      = "WithdrawLP"
+	MultihopSwapEventKey                  = "MultihopSwap"
+	FlashSwapEventKey                     = "FlashSwap"
+	PlaceLimitOrderEventKey               = "PlaceLimitOrder"
+	WithdrawFilledLimitOrderEventKey      = "WithdrawLimitOrder"
+	CancelLimitOrderEventKey              = "CancelLimitOrder"
+	AmendLimitOrderEventKey               = "AmendLimitOrder"
+	EventTypeTickUpdate                   = "TickUpdate"
+	TickUpdateEventKey                    = "TickUpdate"
+	EventTypeGoodTilPurgeHitGasLimit      = "GoodTilPurgeHitGasLimit"
+	EventTypeTriggerOrderHitGasLimit      = "TriggerOrderHitGasLimit"
+	EventTypePeggedOrderHitGasLimit       = "PeggedOrderHitGasLimit"
+	EventTypeAutoWithdrawHitGasLimit      = "AutoWithdrawHitGasLimit"
+	EventTypeBatchAuctionHitGasLimit      = "BatchAuctionHitGasLimit"
+	EventTypeGaugeDistributionHitGasLimit = "GaugeDistributionHitGasLimit"
+	PeggedOrderMovedEventKey              = "PeggedOrderMoved"
+	TriggerOrderExecutedEventKey          = "TriggerOrderExecuted"
+	TrancheUserUpdateEventKey             = "TrancheUserUpdate"
+	EventTypeTrancheUserUpdate            = "TrancheUserUpdate"
+	CreateGaugeEventKey                   = "CreateGauge"
+	GaugeDistributionEventKey             = "GaugeDistribution"
+	StakeEventKey                         = "Stake"
+	UnstakeEventKey                       = "Unstake"
+	ClaimEventKey                         = "Claim"
+	BatchAuctionOrderClearedEventKey      = "BatchAuctionOrderCleared"
+	BatchAuctionClearedEventKey           = "BatchAuctionCleared"
+	EventTypeDynamicFeeUpdate             = "DynamicFeeUpdate"
 	// EventTypeNeutronMessage defines the event type used by the Interchain Queries module events.
 	EventTypeNeutronMessage = "neutron"
 )
@@ -176,6 +213,7 @@ func CreatePlaceLimitOrderEvent(
 	trancheKey string,
 	swapAmountIn math.Int,
 	swapAmountOut math.Int,
+	takerFee math.Int,
 ) sdk.Event {
 	attrs := []sdk.Attribute{
 		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
@@ -193,6 +231,7 @@ func CreatePlaceLimitOrderEvent(
 		sdk.NewAttribute(AttributeTrancheKey, trancheKey),
 		sdk.NewAttribute(AttributeSwapAmountIn, swapAmountIn.String()),
 		sdk.NewAttribute(AttributeSwapAmountOut, swapAmountOut.String()),
+		sdk.NewAttribute(AttributeTakerFee, takerFee.String()),
 		sdk.NewAttribute(AttributeMinAvgSellPrice, minAvgSellPrice.String()),
 	}
 
@@ -263,10 +302,72 @@ func CancelLimitOrderEvent(
 	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
 }
 
+func AmendLimitOrderEvent(
+	creator sdk.AccAddress,
+	token0 string,
+	token1 string,
+	makerDenom string,
+	tokenOut string,
+	amountIn math.Int,
+	limitTick int64,
+	amountOutTaker math.Int,
+	amountOutMaker math.Int,
+	trancheKey string,
+	newTrancheKey string,
+	swapAmountIn math.Int,
+	swapAmountOut math.Int,
+	takerFee math.Int,
+) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, AmendLimitOrderEventKey),
+		sdk.NewAttribute(AttributeCreator, creator.String()),
+		sdk.NewAttribute(AttributeReceiver, creator.String()),
+		sdk.NewAttribute(AttributeToken0, token0),
+		sdk.NewAttribute(AttributeToken1, token1),
+		sdk.NewAttribute(AttributeTokenIn, makerDenom),
+		sdk.NewAttribute(AttributeTokenOut, tokenOut),
+		sdk.NewAttribute(AttributeAmountIn, amountIn.String()),
+		sdk.NewAttribute(AttributeLimitTick, strconv.FormatInt(limitTick, 10)),
+		sdk.NewAttribute(AttributeTokenInAmountOut, amountOutMaker.String()),
+		sdk.NewAttribute(AttributeTokenOutAmountOut, amountOutTaker.String()),
+		sdk.NewAttribute(AttributeTrancheKey, trancheKey),
+		sdk.NewAttribute(AttributeNewTrancheKey, newTrancheKey),
+		sdk.NewAttribute(AttributeSwapAmountIn, swapAmountIn.String()),
+		sdk.NewAttribute(AttributeSwapAmountOut, swapAmountOut.String()),
+		sdk.NewAttribute(AttributeTakerFee, takerFee.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func FlashSwapEvent(creator sdk.AccAddress, coinIn, coinOut sdk.Coin) sdk.Event {
+	// This will never panic since the PairID has already been constructed for the swap
+	pairID := MustNewPairID(coinIn.Denom, coinOut.Denom)
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, FlashSwapEventKey),
+		sdk.NewAttribute(AttributeCreator, creator.String()),
+		sdk.NewAttribute(AttributeToken0, pairID.Token0),
+		sdk.NewAttribute(AttributeToken1, pairID.Token1),
+		sdk.NewAttribute(AttributeTokenIn, coinIn.Denom),
+		sdk.NewAttribute(AttributeTokenOut, coinOut.Denom),
+		sdk.NewAttribute(AttributeAmountIn, coinIn.Amount.String()),
+		sdk.NewAttribute(AttributeAmountOut, coinOut.Amount.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
 type SwapMetadata struct {
 	AmountIn  math.Int
 	AmountOut math.Int
 	TokenIn   string
+	// TakerFee and MakerRebate are only set for swaps against LimitOrderTranches
+	TakerFee    math.Int
+	MakerRebate math.Int
+	// DynamicFee is only set for swaps against pools of the dynamic fee tier
+	DynamicFee math.Int
 }
 
 func addSwapMetadata(event sdk.Event, swapMetadata SwapMetadata) sdk.Event {
@@ -274,10 +375,83 @@ func addSwapMetadata(event sdk.Event, swapMetadata SwapMetadata) sdk.Event {
 		sdk.NewAttribute(AttributeSwapAmountIn, swapMetadata.AmountIn.String()),
 		sdk.NewAttribute(AttributeSwapAmountOut, swapMetadata.AmountOut.String()),
 	}
+	if !swapMetadata.TakerFee.IsNil() {
+		swapAttrs = append(swapAttrs,
+			sdk.NewAttribute(AttributeTakerFee, swapMetadata.TakerFee.String()),
+			sdk.NewAttribute(AttributeMakerRebate, swapMetadata.MakerRebate.String()),
+		)
+	}
+	if !swapMetadata.DynamicFee.IsNil() {
+		swapAttrs = append(swapAttrs, sdk.NewAttribute(AttributeDynamicFee, swapMetadata.DynamicFee.String()))
+	}
 
 	return event.AppendAttributes(swapAttrs...)
 }
 
+func CreateGaugeEvent(gauge *Gauge) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, CreateGaugeEventKey),
+		sdk.NewAttribute(AttributeCreator, gauge.Owner),
+		sdk.NewAttribute(AttributeGaugeID, strconv.FormatUint(gauge.Id, 10)),
+		sdk.NewAttribute(AttributeToken0, gauge.PairId.Token0),
+		sdk.NewAttribute(AttributeToken1, gauge.PairId.Token1),
+		sdk.NewAttribute(AttributeStartTick, strconv.FormatInt(gauge.StartTick, 10)),
+		sdk.NewAttribute(AttributeEndTick, strconv.FormatInt(gauge.EndTick, 10)),
+		sdk.NewAttribute(AttributeNumEpochs, strconv.FormatUint(gauge.NumEpochs, 10)),
+		sdk.NewAttribute(AttributeCoins, gauge.Coins.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func GaugeDistributionEvent(gauge *Gauge, distributed sdk.Coins) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, GaugeDistributionEventKey),
+		sdk.NewAttribute(AttributeGaugeID, strconv.FormatUint(gauge.Id, 10)),
+		sdk.NewAttribute(AttributeFilledEpochs, strconv.FormatUint(gauge.FilledEpochs, 10)),
+		sdk.NewAttribute(AttributeCoins, distributed.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func StakeEvent(stake *Stake) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, StakeEventKey),
+		sdk.NewAttribute(AttributeCreator, stake.Owner),
+		sdk.NewAttribute(AttributeStakeID, strconv.FormatUint(stake.Id, 10)),
+		sdk.NewAttribute(AttributeShares, stake.Shares.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func UnstakeEvent(stake *Stake) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, UnstakeEventKey),
+		sdk.NewAttribute(AttributeCreator, stake.Owner),
+		sdk.NewAttribute(AttributeStakeID, strconv.FormatUint(stake.Id, 10)),
+		sdk.NewAttribute(AttributeShares, stake.Shares.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func ClaimEvent(creator sdk.AccAddress, rewards sdk.Coins) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, ClaimEventKey),
+		sdk.NewAttribute(AttributeCreator, creator.String()),
+		sdk.NewAttribute(AttributeCoins, rewards.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
 func TickUpdateEvent(
 	token0 string,
 	token1 string,
@@ -347,6 +521,7 @@ func CreateTickUpdateLimitOrderTranchePurge(tranche *LimitOrderTranche) sdk.Even
 		tranche.Key.TickIndexTakerToMaker,
 		math.ZeroInt(),
 		sdk.NewAttribute(AttributeTrancheKey, tranche.Key.TrancheKey),
+		sdk.NewAttribute(AttributeExpired, strconv.FormatBool(true)),
 	)
 }
 
@@ -359,6 +534,160 @@ func GoodTilPurgeHitLimitEvent(gas types.Gas) sdk.Event {
 	return sdk.NewEvent(EventTypeGoodTilPurgeHitGasLimit, attrs...)
 }
 
+func TriggerOrderHitLimitEvent(gas types.Gas) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(AttributeGas, strconv.FormatUint(gas, 10)),
+	}
+
+	return sdk.NewEvent(EventTypeTriggerOrderHitGasLimit, attrs...)
+}
+
+func DynamicFeeUpdateEvent(dynamicFee *DynamicFee) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(AttributeToken0, dynamicFee.PairId.Token0),
+		sdk.NewAttribute(AttributeToken1, dynamicFee.PairId.Token1),
+		sdk.NewAttribute(AttributeFeeBps, strconv.FormatUint(dynamicFee.FeeBps, 10)),
+	}
+
+	return sdk.NewEvent(EventTypeDynamicFeeUpdate, attrs...)
+}
+
+func PeggedOrderHitLimitEvent(gas types.Gas) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(AttributeGas, strconv.FormatUint(gas, 10)),
+	}
+
+	return sdk.NewEvent(EventTypePeggedOrderHitGasLimit, attrs...)
+}
+
+func AutoWithdrawHitLimitEvent(gas types.Gas) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(AttributeGas, strconv.FormatUint(gas, 10)),
+	}
+
+	return sdk.NewEvent(EventTypeAutoWithdrawHitGasLimit, attrs...)
+}
+
+func BatchAuctionHitLimitEvent(gas types.Gas) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(AttributeGas, strconv.FormatUint(gas, 10)),
+	}
+
+	return sdk.NewEvent(EventTypeBatchAuctionHitGasLimit, attrs...)
+}
+
+func GaugeDistributionHitLimitEvent(gas types.Gas) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(AttributeGas, strconv.FormatUint(gas, 10)),
+	}
+
+	return sdk.NewEvent(EventTypeGaugeDistributionHitGasLimit, attrs...)
+}
+
+func PeggedOrderMovedEvent(order *PeggedOrder, newTrancheKey string, newTickIndexInToOut int64, err error) sdk.Event {
+	pairID := order.TradePairId.MustPairID()
+	errStr := ""
+	if err != nil {
+		errStr = err.Error()
+	}
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, PeggedOrderMovedEventKey),
+		sdk.NewAttribute(AttributeCreator, order.Address),
+		sdk.NewAttribute(AttributeToken0, pairID.Token0),
+		sdk.NewAttribute(AttributeToken1, pairID.Token1),
+		sdk.NewAttribute(AttributeTokenIn, order.TradePairId.TakerDenom),
+		sdk.NewAttribute(AttributeTokenOut, order.TradePairId.MakerDenom),
+		sdk.NewAttribute(AttributeTrancheKey, order.TrancheKey),
+		sdk.NewAttribute(AttributeNewTrancheKey, newTrancheKey),
+		sdk.NewAttribute(AttributeTickIndex, strconv.FormatInt(order.TickIndexInToOut, 10)),
+		sdk.NewAttribute(AttributeNewTickIndex, strconv.FormatInt(newTickIndexInToOut, 10)),
+		sdk.NewAttribute(AttributeSuccess, strconv.FormatBool(err == nil)),
+		sdk.NewAttribute(AttributeError, errStr),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func TriggerOrderExecutedEvent(order *TriggerOrder, swapAmountOut math.Int, err error) sdk.Event {
+	pairID := order.TradePairId.MustPairID()
+	errStr := ""
+	if err != nil {
+		swapAmountOut = math.ZeroInt()
+		errStr = err.Error()
+	}
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, TriggerOrderExecutedEventKey),
+		sdk.NewAttribute(AttributeCreator, order.Creator),
+		sdk.NewAttribute(AttributeReceiver, order.Receiver),
+		sdk.NewAttribute(AttributeToken0, pairID.Token0),
+		sdk.NewAttribute(AttributeToken1, pairID.Token1),
+		sdk.NewAttribute(AttributeTokenIn, order.TradePairId.TakerDenom),
+		sdk.NewAttribute(AttributeTokenOut, order.TradePairId.MakerDenom),
+		sdk.NewAttribute(AttributeAmountIn, order.AmountIn.String()),
+		sdk.NewAttribute(AttributeTriggerTick, strconv.FormatInt(order.TriggerTickIndexInToOut, 10)),
+		sdk.NewAttribute(AttributeLimitTick, strconv.FormatInt(order.TickIndexInToOut, 10)),
+		sdk.NewAttribute(AttributeOrderType, order.OrderType.String()),
+		sdk.NewAttribute(AttributeTrancheKey, order.OrderKey),
+		sdk.NewAttribute(AttributeSwapAmountOut, swapAmountOut.String()),
+		sdk.NewAttribute(AttributeSuccess, strconv.FormatBool(err == nil)),
+		sdk.NewAttribute(AttributeError, errStr),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+func BatchAuctionOrderClearedEvent(order *BatchAuctionOrder, coinIn, coinOut, refund sdk.Coin, err error) sdk.Event {
+	pairID := order.TradePairId.MustPairID()
+	errStr := ""
+	if err != nil {
+		errStr = err.Error()
+	}
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, BatchAuctionOrderClearedEventKey),
+		sdk.NewAttribute(AttributeCreator, order.Creator),
+		sdk.NewAttribute(AttributeReceiver, order.Receiver),
+		sdk.NewAttribute(AttributeToken0, pairID.Token0),
+		sdk.NewAttribute(AttributeToken1, pairID.Token1),
+		sdk.NewAttribute(AttributeTokenIn, order.TradePairId.TakerDenom),
+		sdk.NewAttribute(AttributeTokenOut, order.TradePairId.MakerDenom),
+		sdk.NewAttribute(AttributeOrderType, order.OrderType.String()),
+		sdk.NewAttribute(AttributeTrancheKey, order.OrderKey),
+		sdk.NewAttribute(AttributeAmountIn, order.AmountIn.String()),
+		sdk.NewAttribute(AttributeSwapAmountIn, coinIn.Amount.String()),
+		sdk.NewAttribute(AttributeSwapAmountOut, coinOut.Amount.String()),
+		sdk.NewAttribute(AttributeRefund, refund.Amount.String()),
+		sdk.NewAttribute(AttributeSuccess, strconv.FormatBool(err == nil)),
+		sdk.NewAttribute(AttributeError, errStr),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
+// BatchAuctionClearedEvent reports the uniform clearing price of a pair's batch auction in Token1 per Token0
+func BatchAuctionClearedEvent(pairID *PairID, clearingPrice math_utils.PrecDec, numOrders int, bookIn, bookOut sdk.Coin) sdk.Event {
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, "dex"),
+		sdk.NewAttribute(sdk.AttributeKeyAction, BatchAuctionClearedEventKey),
+		sdk.NewAttribute(AttributeToken0, pairID.Token0),
+		sdk.NewAttribute(AttributeToken1, pairID.Token1),
+		sdk.NewAttribute(AttributeClearingPrice, clearingPrice.String()),
+		sdk.NewAttribute(AttributeNumOrders, strconv.Itoa(numOrders)),
+		sdk.NewAttribute(AttributeSwapAmountIn, bookIn.String()),
+		sdk.NewAttribute(AttributeSwapAmountOut, bookOut.String()),
+	}
+
+	return sdk.NewEvent(sdk.EventTypeMessage, attrs...)
+}
+
 func GetEventsWithdrawnAmount(coins sdk.Coins) sdk.Events {
 	events := sdk.Events{}
 	for _, coin := range coins {
@@ -5,6 +5,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+	oracletypes "github.com/skip-mev/slinky/x/oracle/types"
 )
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -12,6 +14,7 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(sdk.Coin) bool)
@@ -19,3 +22,21 @@ type BankKeeper interface {
 	GetAccountsBalances(ctx context.Context) []banktypes.Balance
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
+
+// WasmKeeper defines the expected interface needed to notify the dex hook contract and contracts that placed orders.
+type WasmKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// ContractKeeper defines the expected interface needed to call flash swap contracts within the gas limit of the
+// transaction. Unlike WasmKeeper it must not apply a gas limit of its own.
+type ContractKeeper interface {
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// OracleKeeper defines the expected interface needed to read x/oracle prices for OraclePriceGuards.
+type OracleKeeper interface {
+	GetPriceForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) (oracletypes.QuotePrice, error)
+	GetDecimalsForCurrencyPair(ctx sdk.Context, cp slinkytypes.CurrencyPair) (uint64, error)
+}
@@ -0,0 +1,92 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidateGaugeParams checks the PairID, tick range and duration of a Gauge
+func ValidateGaugeParams(pairID *PairID, startTick, endTick int64, numEpochs uint64) error {
+	if pairID == nil {
+		return sdkerrors.Wrap(ErrInvalidGauge, "pair_id is required")
+	}
+
+	if err := sdk.ValidateDenom(pairID.Token0); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "Error Token0 denom (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(pairID.Token1); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "Error Token1 denom (%s)", err)
+	}
+
+	if pairID.Token0 >= pairID.Token1 {
+		return sdkerrors.Wrapf(ErrInvalidGauge, "pair_id tokens must be sorted and distinct: %s", pairID.CanonicalString())
+	}
+
+	if IsTickOutOfRange(startTick) || IsTickOutOfRange(endTick) {
+		return ErrTickOutsideRange
+	}
+
+	if startTick > endTick {
+		return sdkerrors.Wrapf(ErrInvalidGauge, "start_tick %d is greater than end_tick %d", startTick, endTick)
+	}
+
+	if numEpochs == 0 {
+		return sdkerrors.Wrap(ErrInvalidGauge, "num_epochs must be greater than 0")
+	}
+
+	return nil
+}
+
+func (g Gauge) Validate() error {
+	if err := validateAddress(g.Owner, "owner"); err != nil {
+		return err
+	}
+
+	if err := ValidateGaugeParams(g.PairId, g.StartTick, g.EndTick, g.NumEpochs); err != nil {
+		return err
+	}
+
+	if g.FilledEpochs >= g.NumEpochs {
+		return sdkerrors.Wrapf(ErrInvalidGauge, "filled_epochs %d must be less than num_epochs %d", g.FilledEpochs, g.NumEpochs)
+	}
+
+	if !g.Coins.IsValid() || !g.DistributedCoins.IsValid() || !g.DistributedCoins.IsAllLTE(g.Coins) {
+		return sdkerrors.Wrapf(ErrInvalidGauge, "distributed_coins %s exceed coins %s", g.DistributedCoins, g.Coins)
+	}
+
+	return nil
+}
+
+// RemainingCoins returns the coins of the Gauge that have not yet been distributed
+func (g Gauge) RemainingCoins() sdk.Coins {
+	return g.Coins.Sub(g.DistributedCoins...)
+}
+
+// EpochCoins returns the coins to distribute in the next epoch. The remaining coins are split evenly across the
+// remaining epochs so that rounding dust is carried forward rather than lost.
+func (g Gauge) EpochCoins() sdk.Coins {
+	remainingEpochs := math.NewIntFromUint64(g.NumEpochs - g.FilledEpochs)
+	epochCoins := sdk.NewCoins()
+	for _, coin := range g.RemainingCoins() {
+		epochCoins = epochCoins.Add(sdk.NewCoin(coin.Denom, coin.Amount.Quo(remainingEpochs)))
+	}
+
+	return epochCoins
+}
+
+// IsFinished returns true once the Gauge has distributed over all of its epochs
+func (g Gauge) IsFinished() bool {
+	return g.FilledEpochs >= g.NumEpochs
+}
+
+// RewardsPool returns true if the pool is in the Gauge's pair and both of its ticks lie within the rewarded range
+func (g Gauge) RewardsPool(poolMetadata PoolMetadata) bool {
+	if !g.PairId.Equal(poolMetadata.PairId) {
+		return false
+	}
+
+	fee := int64(poolMetadata.Fee)
+	return poolMetadata.Tick-fee >= g.StartTick && poolMetadata.Tick+fee <= g.EndTick
+}
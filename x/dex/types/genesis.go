@@ -2,6 +2,8 @@ package types
 
 import (
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DefaultGenesis returns the default Capability genesis state
@@ -11,6 +13,22 @@ func DefaultGenesis() *GenesisState {
 		TickLiquidityList:             []*TickLiquidity{},
 		InactiveLimitOrderTrancheList: []*LimitOrderTranche{},
 		PoolMetadataList:              []PoolMetadata{},
+		PriceAccumulatorList:          []*PriceAccumulator{},
+		TriggerOrderList:              []*TriggerOrder{},
+		PeggedOrderList:               []*PeggedOrder{},
+		RangePositionList:             []*RangePosition{},
+		PausedPairList:                []*PairID{},
+		PausedDenomList:               []string{},
+		CircuitBreakerList:            []*CircuitBreaker{},
+		ProtocolFeesList:              []PairProtocolFees{},
+		GaugeList:                     []*Gauge{},
+		StakeList:                     []*Stake{},
+		StakerRewardsList:             []StakerRewards{},
+		BatchAuctionPairList:          []*PairID{},
+		BatchAuctionOrderList:         []*BatchAuctionOrder{},
+		AutoWithdrawTrancheList:       []string{},
+		DynamicFeeList:                []*DynamicFee{},
+		PendingProtocolFees:           sdk.NewCoins(),
 		// this line is used by starport scaffolding # genesis/types/default
 		Params: DefaultParams(),
 	}
@@ -68,6 +86,235 @@ func (gs GenesisState) Validate() error {
 		}
 		poolMetadataIDMap[elem.Id] = true
 	}
+	// Check for duplicated index in priceAccumulator
+	priceAccumulatorKeyMap := make(map[string]struct{})
+
+	for _, elem := range gs.PriceAccumulatorList {
+		if elem.TradePairId == nil {
+			return fmt.Errorf("missing trade pair for priceAccumulator")
+		}
+		if _, err := elem.TradePairId.PairID(); err != nil {
+			return fmt.Errorf("invalid trade pair for priceAccumulator: %w", err)
+		}
+		index := string(PriceAccumulatorKey(elem.TradePairId, elem.Timestamp))
+		if _, ok := priceAccumulatorKeyMap[index]; ok {
+			return fmt.Errorf("duplicated index for priceAccumulator")
+		}
+		priceAccumulatorKeyMap[index] = struct{}{}
+	}
+	// Check for duplicated index in triggerOrder
+	triggerOrderRefMap := make(map[string]struct{})
+
+	for _, elem := range gs.TriggerOrderList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid triggerOrder: %w", err)
+		}
+		index := string(TriggerOrderRefKey(elem.Creator, elem.OrderKey))
+		if _, ok := triggerOrderRefMap[index]; ok {
+			return fmt.Errorf("duplicated index for triggerOrder")
+		}
+		triggerOrderRefMap[index] = struct{}{}
+	}
+	// Check for duplicated index in peggedOrder
+	peggedOrderKeyMap := make(map[string]struct{})
+
+	for _, elem := range gs.PeggedOrderList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid peggedOrder: %w", err)
+		}
+		index := string(PeggedOrderKey(elem.Address, elem.TrancheKey))
+		if _, ok := peggedOrderKeyMap[index]; ok {
+			return fmt.Errorf("duplicated index for peggedOrder")
+		}
+		peggedOrderKeyMap[index] = struct{}{}
+	}
+	// Check for duplicated ID in rangePosition
+	rangePositionIDMap := make(map[uint64]struct{})
+	rangePositionCount := gs.GetRangePositionCount()
+	for _, elem := range gs.RangePositionList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid rangePosition: %w", err)
+		}
+		if _, ok := rangePositionIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for rangePosition")
+		}
+		if elem.Id >= rangePositionCount {
+			return fmt.Errorf("rangePosition id should be lower than the rangePosition count")
+		}
+		rangePositionIDMap[elem.Id] = struct{}{}
+	}
+
+	// Check for invalid or duplicated pairs in the pause registry
+	pausedPairMap := make(map[string]struct{})
+	for _, elem := range gs.PausedPairList {
+		pairID, err := NewPairID(elem.GetToken0(), elem.GetToken1())
+		if err != nil {
+			return fmt.Errorf("invalid paused pair: %w", err)
+		}
+		if *pairID != *elem {
+			return fmt.Errorf("paused pair %s is not sorted", elem.CanonicalString())
+		}
+		if _, ok := pausedPairMap[pairID.CanonicalString()]; ok {
+			return fmt.Errorf("duplicated paused pair")
+		}
+		pausedPairMap[pairID.CanonicalString()] = struct{}{}
+	}
+
+	// Check for invalid or duplicated pairs in the batch auction registry
+	batchAuctionPairMap := make(map[string]struct{})
+	for _, elem := range gs.BatchAuctionPairList {
+		pairID, err := NewPairID(elem.GetToken0(), elem.GetToken1())
+		if err != nil {
+			return fmt.Errorf("invalid batch auction pair: %w", err)
+		}
+		if *pairID != *elem {
+			return fmt.Errorf("batch auction pair %s is not sorted", elem.CanonicalString())
+		}
+		if _, ok := batchAuctionPairMap[pairID.CanonicalString()]; ok {
+			return fmt.Errorf("duplicated batch auction pair")
+		}
+		batchAuctionPairMap[pairID.CanonicalString()] = struct{}{}
+	}
+
+	// Check for duplicated index in batchAuctionOrder
+	batchAuctionOrderIndexMap := make(map[string]struct{})
+	for _, elem := range gs.BatchAuctionOrderList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid batchAuctionOrder: %w", err)
+		}
+		index := string(elem.KeyMarshal())
+		if _, ok := batchAuctionOrderIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for batchAuctionOrder")
+		}
+		batchAuctionOrderIndexMap[index] = struct{}{}
+	}
+
+	// Check for empty or duplicated tranche keys in the auto withdraw queue
+	autoWithdrawTrancheMap := make(map[string]struct{})
+	for _, elem := range gs.AutoWithdrawTrancheList {
+		if elem == "" {
+			return fmt.Errorf("empty auto withdraw tranche key")
+		}
+		if _, ok := autoWithdrawTrancheMap[elem]; ok {
+			return fmt.Errorf("duplicated auto withdraw tranche key")
+		}
+		autoWithdrawTrancheMap[elem] = struct{}{}
+	}
+
+	// Check for invalid or duplicated pairs in dynamicFee
+	dynamicFeeIndexMap := make(map[string]struct{})
+	for _, elem := range gs.DynamicFeeList {
+		if elem.PairId == nil {
+			return fmt.Errorf("dynamicFee pair_id must be set")
+		}
+		pairID, err := NewPairID(elem.PairId.Token0, elem.PairId.Token1)
+		if err != nil {
+			return fmt.Errorf("invalid dynamicFee: %w", err)
+		}
+		if *pairID != *elem.PairId {
+			return fmt.Errorf("dynamicFee pair %s is not sorted", elem.PairId.CanonicalString())
+		}
+		index := string(DynamicFeeKey(elem.PairId))
+		if _, ok := dynamicFeeIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for dynamicFee")
+		}
+		dynamicFeeIndexMap[index] = struct{}{}
+	}
+
+	// Check for invalid or duplicated denoms in the pause registry
+	pausedDenomMap := make(map[string]struct{})
+	for _, elem := range gs.PausedDenomList {
+		if err := sdk.ValidateDenom(elem); err != nil {
+			return fmt.Errorf("invalid paused denom: %w", err)
+		}
+		if _, ok := pausedDenomMap[elem]; ok {
+			return fmt.Errorf("duplicated paused denom")
+		}
+		pausedDenomMap[elem] = struct{}{}
+	}
+
+	// Check for duplicated index in circuitBreaker
+	circuitBreakerIndexMap := make(map[string]struct{})
+	for _, elem := range gs.CircuitBreakerList {
+		if elem.TradePairId == nil {
+			return fmt.Errorf("circuitBreaker trade_pair_id must be set")
+		}
+		if _, err := elem.TradePairId.PairID(); err != nil {
+			return fmt.Errorf("invalid circuitBreaker: %w", err)
+		}
+		index := string(CircuitBreakerKey(elem.TradePairId))
+		if _, ok := circuitBreakerIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for circuitBreaker")
+		}
+		circuitBreakerIndexMap[index] = struct{}{}
+	}
+
+	// Check for invalid or duplicated pairs in protocolFees
+	protocolFeesIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ProtocolFeesList {
+		if elem.PairId == nil {
+			return fmt.Errorf("protocolFees pair_id must be set")
+		}
+		if err := elem.Fees.Validate(); err != nil {
+			return fmt.Errorf("invalid protocolFees: %w", err)
+		}
+		index := elem.PairId.CanonicalString()
+		if _, ok := protocolFeesIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for protocolFees")
+		}
+		protocolFeesIndexMap[index] = struct{}{}
+	}
+
+	if err := gs.PendingProtocolFees.Validate(); err != nil {
+		return fmt.Errorf("invalid pendingProtocolFees: %w", err)
+	}
+
+	// Check for duplicated ID in gauge
+	gaugeIDMap := make(map[uint64]struct{})
+	gaugeCount := gs.GetGaugeCount()
+	for _, elem := range gs.GaugeList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid gauge: %w", err)
+		}
+		if _, ok := gaugeIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for gauge")
+		}
+		if elem.Id >= gaugeCount {
+			return fmt.Errorf("gauge id should be lower than the gauge count")
+		}
+		gaugeIDMap[elem.Id] = struct{}{}
+	}
+
+	// Check for duplicated ID in stake
+	stakeIDMap := make(map[uint64]struct{})
+	stakeCount := gs.GetStakeCount()
+	for _, elem := range gs.StakeList {
+		if err := elem.Validate(); err != nil {
+			return fmt.Errorf("invalid stake: %w", err)
+		}
+		if _, ok := stakeIDMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for stake")
+		}
+		if elem.Id >= stakeCount {
+			return fmt.Errorf("stake id should be lower than the stake count")
+		}
+		stakeIDMap[elem.Id] = struct{}{}
+	}
+
+	// Check for duplicated address in stakerRewards
+	stakerRewardsMap := make(map[string]struct{})
+	for _, elem := range gs.StakerRewardsList {
+		if err := validateAddress(elem.Address, "address"); err != nil {
+			return fmt.Errorf("invalid stakerRewards: %w", err)
+		}
+		if !elem.Rewards.IsValid() {
+			return fmt.Errorf("invalid stakerRewards rewards %s", elem.Rewards)
+		}
+		if _, ok := stakerRewardsMap[elem.Address]; ok {
+			return fmt.Errorf("duplicated address for stakerRewards")
+		}
+		stakerRewardsMap[elem.Address] = struct{}{}
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
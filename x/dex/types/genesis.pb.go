@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -31,6 +33,27 @@ type GenesisState struct {
 	LimitOrderTrancheUserList     []*LimitOrderTrancheUser `protobuf:"bytes,4,rep,name=limit_order_tranche_user_list,json=limitOrderTrancheUserList,proto3" json:"limit_order_tranche_user_list,omitempty"`
 	PoolMetadataList              []PoolMetadata           `protobuf:"bytes,5,rep,name=pool_metadata_list,json=poolMetadataList,proto3" json:"pool_metadata_list"`
 	PoolCount                     uint64                   `protobuf:"varint,6,opt,name=pool_count,json=poolCount,proto3" json:"pool_count,omitempty"`
+	PriceAccumulatorList          []*PriceAccumulator      `protobuf:"bytes,7,rep,name=price_accumulator_list,json=priceAccumulatorList,proto3" json:"price_accumulator_list,omitempty"`
+	TriggerOrderList              []*TriggerOrder          `protobuf:"bytes,8,rep,name=trigger_order_list,json=triggerOrderList,proto3" json:"trigger_order_list,omitempty"`
+	RangePositionList             []*RangePosition         `protobuf:"bytes,9,rep,name=range_position_list,json=rangePositionList,proto3" json:"range_position_list,omitempty"`
+	RangePositionCount            uint64                   `protobuf:"varint,10,opt,name=range_position_count,json=rangePositionCount,proto3" json:"range_position_count,omitempty"`
+	PausedPairList                []*PairID                `protobuf:"bytes,11,rep,name=paused_pair_list,json=pausedPairList,proto3" json:"paused_pair_list,omitempty"`
+	PausedDenomList               []string                 `protobuf:"bytes,12,rep,name=paused_denom_list,json=pausedDenomList,proto3" json:"paused_denom_list,omitempty"`
+	CircuitBreakerList            []*CircuitBreaker        `protobuf:"bytes,13,rep,name=circuit_breaker_list,json=circuitBreakerList,proto3" json:"circuit_breaker_list,omitempty"`
+	ProtocolFeesList              []PairProtocolFees       `protobuf:"bytes,14,rep,name=protocol_fees_list,json=protocolFeesList,proto3" json:"protocol_fees_list"`
+	PeggedOrderList               []*PeggedOrder           `protobuf:"bytes,15,rep,name=pegged_order_list,json=peggedOrderList,proto3" json:"pegged_order_list,omitempty"`
+	GaugeList                     []*Gauge                 `protobuf:"bytes,16,rep,name=gauge_list,json=gaugeList,proto3" json:"gauge_list,omitempty"`
+	GaugeCount                    uint64                   `protobuf:"varint,17,opt,name=gauge_count,json=gaugeCount,proto3" json:"gauge_count,omitempty"`
+	StakeList                     []*Stake                 `protobuf:"bytes,18,rep,name=stake_list,json=stakeList,proto3" json:"stake_list,omitempty"`
+	StakeCount                    uint64                   `protobuf:"varint,19,opt,name=stake_count,json=stakeCount,proto3" json:"stake_count,omitempty"`
+	StakerRewardsList             []StakerRewards          `protobuf:"bytes,20,rep,name=staker_rewards_list,json=stakerRewardsList,proto3" json:"staker_rewards_list"`
+	BatchAuctionPairList          []*PairID                `protobuf:"bytes,21,rep,name=batch_auction_pair_list,json=batchAuctionPairList,proto3" json:"batch_auction_pair_list,omitempty"`
+	BatchAuctionOrderList         []*BatchAuctionOrder     `protobuf:"bytes,22,rep,name=batch_auction_order_list,json=batchAuctionOrderList,proto3" json:"batch_auction_order_list,omitempty"`
+	// Keys of the filled or expired LimitOrderTranches whose auto_withdraw orders have not been withdrawn yet
+	AutoWithdrawTrancheList []string      `protobuf:"bytes,23,rep,name=auto_withdraw_tranche_list,json=autoWithdrawTrancheList,proto3" json:"auto_withdraw_tranche_list,omitempty"`
+	DynamicFeeList          []*DynamicFee `protobuf:"bytes,24,rep,name=dynamic_fee_list,json=dynamicFeeList,proto3" json:"dynamic_fee_list,omitempty"`
+	// Accrued protocol fees held by the dex module that have not yet been sent to the protocol fee collector
+	PendingProtocolFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,25,rep,name=pending_protocol_fees,json=pendingProtocolFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_protocol_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -108,6 +131,139 @@ func (m *GenesisState) GetPoolCount() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPriceAccumulatorList() []*PriceAccumulator {
+	if m != nil {
+		return m.PriceAccumulatorList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTriggerOrderList() []*TriggerOrder {
+	if m != nil {
+		return m.TriggerOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRangePositionList() []*RangePosition {
+	if m != nil {
+		return m.RangePositionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRangePositionCount() uint64 {
+	if m != nil {
+		return m.RangePositionCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPausedPairList() []*PairID {
+	if m != nil {
+		return m.PausedPairList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPausedDenomList() []string {
+	if m != nil {
+		return m.PausedDenomList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCircuitBreakerList() []*CircuitBreaker {
+	if m != nil {
+		return m.CircuitBreakerList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProtocolFeesList() []PairProtocolFees {
+	if m != nil {
+		return m.ProtocolFeesList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPeggedOrderList() []*PeggedOrder {
+	if m != nil {
+		return m.PeggedOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGaugeList() []*Gauge {
+	if m != nil {
+		return m.GaugeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGaugeCount() uint64 {
+	if m != nil {
+		return m.GaugeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStakeList() []*Stake {
+	if m != nil {
+		return m.StakeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetStakeCount() uint64 {
+	if m != nil {
+		return m.StakeCount
+	}
+	return 0
+}
+
+func (m *GenesisState) GetStakerRewardsList() []StakerRewards {
+	if m != nil {
+		return m.StakerRewardsList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchAuctionPairList() []*PairID {
+	if m != nil {
+		return m.BatchAuctionPairList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBatchAuctionOrderList() []*BatchAuctionOrder {
+	if m != nil {
+		return m.BatchAuctionOrderList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoWithdrawTrancheList() []string {
+	if m != nil {
+		return m.AutoWithdrawTrancheList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDynamicFeeList() []*DynamicFee {
+	if m != nil {
+		return m.DynamicFeeList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingProtocolFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PendingProtocolFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "neutron.dex.GenesisState")
 }
@@ -115,33 +271,69 @@ func init() {
 func init() { proto.RegisterFile("neutron/dex/genesis.proto", fileDescriptor_0c051a8a0d58cd8b) }
 
 var fileDescriptor_0c051a8a0d58cd8b = []byte{
-	// 407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0xaa, 0xda, 0x40,
-	0x14, 0x86, 0x93, 0x6a, 0x85, 0x8e, 0x5d, 0xb4, 0xb1, 0x8b, 0x18, 0x30, 0xa6, 0x42, 0x41, 0x0a,
-	0x26, 0xd4, 0xd2, 0x17, 0xb0, 0x0b, 0x37, 0x4a, 0xc5, 0xda, 0x4d, 0x37, 0x61, 0x4c, 0x86, 0x38,
-	0x35, 0xc9, 0xa4, 0x93, 0x13, 0xd1, 0xb7, 0xe8, 0x63, 0xb9, 0x74, 0xd9, 0x4d, 0xcb, 0x45, 0x5f,
-	0xe4, 0x92, 0x99, 0x11, 0x12, 0xae, 0xf7, 0xde, 0xdd, 0x70, 0xce, 0x37, 0xff, 0x37, 0x73, 0x66,
-	0x50, 0x37, 0x25, 0x05, 0x70, 0x96, 0x7a, 0x21, 0xd9, 0x7b, 0x11, 0x49, 0x49, 0x4e, 0x73, 0x37,
-	0xe3, 0x0c, 0x98, 0xd1, 0x56, 0x2d, 0x37, 0x24, 0x7b, 0xeb, 0x5d, 0xc4, 0x22, 0x26, 0xea, 0x5e,
-	0xb9, 0x92, 0x88, 0xf5, 0xa1, 0xba, 0x3b, 0xa6, 0x09, 0x05, 0x9f, 0xf1, 0x90, 0x70, 0x1f, 0x38,
-	0x4e, 0x83, 0x0d, 0x51, 0xd8, 0xc7, 0x67, 0x30, 0xbf, 0xc8, 0x09, 0x57, 0xac, 0x59, 0x65, 0x33,
-	0xcc, 0x71, 0xa2, 0xce, 0x63, 0xf5, 0x6b, 0x1d, 0xc6, 0x62, 0x3f, 0x21, 0x80, 0x43, 0x0c, 0x58,
-	0x01, 0x4e, 0x15, 0x00, 0x1a, 0x6c, 0xfd, 0x98, 0xfe, 0x2e, 0x68, 0x48, 0xe1, 0x20, 0x89, 0xc1,
-	0xbf, 0x06, 0x7a, 0x3d, 0x95, 0x97, 0xfc, 0x0e, 0x18, 0x88, 0xf1, 0x09, 0xb5, 0xa4, 0xc3, 0xd4,
-	0x1d, 0x7d, 0xd8, 0x1e, 0x77, 0xdc, 0xca, 0xa5, 0xdd, 0x85, 0x68, 0x4d, 0x9a, 0xc7, 0xff, 0x7d,
-	0x6d, 0xa9, 0x40, 0x63, 0x81, 0x3a, 0xf5, 0x6c, 0x3f, 0xa6, 0x39, 0x98, 0x2f, 0x9c, 0xc6, 0xb0,
-	0x3d, 0xb6, 0x6a, 0xfb, 0x57, 0x34, 0xd8, 0xce, 0xae, 0x98, 0x88, 0xd1, 0x97, 0x6f, 0xa1, 0x5a,
-	0x9c, 0xd1, 0x1c, 0x8c, 0x14, 0xbd, 0xa7, 0x29, 0x0e, 0x80, 0xee, 0x88, 0x7f, 0x6b, 0x3a, 0x22,
-	0xbf, 0x21, 0xf2, 0xed, 0x5a, 0xfe, 0xac, 0x84, 0xbf, 0x95, 0xec, 0x4a, 0xa2, 0xca, 0xd1, 0xbb,
-	0xc6, 0x3d, 0x00, 0x84, 0xef, 0x17, 0xea, 0x3d, 0xf6, 0x08, 0xd2, 0xd5, 0x14, 0xae, 0xc1, 0xd3,
-	0xae, 0x1f, 0x39, 0xe1, 0xca, 0xd7, 0x8d, 0x6f, 0x35, 0x85, 0x6b, 0x8e, 0x8c, 0xda, 0x53, 0x49,
-	0xc1, 0x4b, 0x21, 0xe8, 0xd6, 0x87, 0xcd, 0x58, 0x3c, 0x57, 0x94, 0x1a, 0xf9, 0x9b, 0xac, 0x52,
-	0x13, 0x71, 0x3d, 0x84, 0x44, 0x5c, 0xc0, 0x8a, 0x14, 0xcc, 0x96, 0xa3, 0x0f, 0x9b, 0xcb, 0x57,
-	0x65, 0xe5, 0x6b, 0x59, 0x98, 0x4c, 0x8f, 0x67, 0x5b, 0x3f, 0x9d, 0x6d, 0xfd, 0xee, 0x6c, 0xeb,
-	0x7f, 0x2e, 0xb6, 0x76, 0xba, 0xd8, 0xda, 0xdf, 0x8b, 0xad, 0xfd, 0x1c, 0x45, 0x14, 0x36, 0xc5,
-	0xda, 0x0d, 0x58, 0xe2, 0x29, 0xeb, 0x88, 0xf1, 0xe8, 0xba, 0xf6, 0x76, 0x5f, 0xbc, 0xbd, 0xfc,
-	0x37, 0x87, 0x8c, 0xe4, 0xeb, 0x96, 0xf8, 0x2f, 0x9f, 0xef, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6c,
-	0x1a, 0x11, 0x18, 0x1f, 0x03, 0x00, 0x00,
+	// 978 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0xdf, 0x6e, 0x1b, 0x45,
+	0x14, 0xc6, 0x63, 0x12, 0x02, 0x19, 0x97, 0xc4, 0x5e, 0x3b, 0x8d, 0x63, 0xb0, 0xe3, 0x16, 0x21,
+	0x59, 0x95, 0xe2, 0x6d, 0x8a, 0x50, 0x2f, 0xb8, 0x6a, 0x12, 0x35, 0x02, 0xa5, 0xc2, 0x38, 0x41,
+	0x08, 0x24, 0xb4, 0x1a, 0xcf, 0x0e, 0x9b, 0xc1, 0xf6, 0xce, 0x32, 0x3b, 0x9b, 0x3f, 0x57, 0xbc,
+	0x02, 0xcf, 0xc1, 0x05, 0xcf, 0xd1, 0xcb, 0x5e, 0x72, 0x05, 0x28, 0x79, 0x11, 0x34, 0xe7, 0xcc,
+	0xa6, 0x33, 0xe9, 0xd2, 0x5e, 0x65, 0x73, 0xce, 0x77, 0x7e, 0xdf, 0xcc, 0x99, 0xb3, 0xe3, 0x25,
+	0xdb, 0x29, 0x2f, 0xb4, 0x92, 0x69, 0x18, 0xf3, 0xcb, 0x30, 0xe1, 0x29, 0xcf, 0x45, 0x3e, 0xca,
+	0x94, 0xd4, 0x32, 0xa8, 0xdb, 0xd4, 0x28, 0xe6, 0x97, 0xdd, 0x3e, 0x93, 0xf9, 0x42, 0xe6, 0xe1,
+	0x94, 0xe6, 0x3c, 0x3c, 0xdf, 0x9b, 0x72, 0x4d, 0xf7, 0x42, 0x26, 0x45, 0x8a, 0xe2, 0x6e, 0x3b,
+	0x91, 0x89, 0x84, 0xc7, 0xd0, 0x3c, 0xd9, 0xe8, 0x8e, 0x4b, 0x9f, 0x52, 0xcd, 0xce, 0x22, 0x5a,
+	0x30, 0x2d, 0x64, 0x59, 0xf6, 0xc0, 0x15, 0x30, 0xa1, 0x58, 0x21, 0x74, 0x34, 0x55, 0x9c, 0xce,
+	0xb8, 0xb2, 0x92, 0x9e, 0x2b, 0x89, 0xaf, 0x52, 0xba, 0x10, 0x2c, 0xfa, 0x99, 0x73, 0x9b, 0xfe,
+	0xc4, 0x4d, 0x8b, 0x94, 0xf1, 0x54, 0x8b, 0x73, 0x6e, 0xf7, 0xd0, 0xfd, 0xcc, 0xcd, 0xce, 0xc5,
+	0x42, 0xe8, 0x48, 0xaa, 0x98, 0xab, 0x48, 0x2b, 0x9a, 0xb2, 0xb3, 0x12, 0xf2, 0xe8, 0x1d, 0xb2,
+	0xa8, 0xc8, 0x6f, 0xd7, 0xe3, 0x75, 0x2c, 0xa3, 0x42, 0x45, 0x22, 0xb6, 0xa9, 0x8e, 0x9f, 0x52,
+	0x74, 0x51, 0xae, 0xa3, 0xef, 0x65, 0x78, 0x92, 0xf0, 0x18, 0x1d, 0xaa, 0x1a, 0x95, 0x49, 0x39,
+	0x8f, 0x16, 0x5c, 0xd3, 0x98, 0x6a, 0x5a, 0x29, 0x30, 0x21, 0x26, 0xe7, 0xa6, 0x0d, 0xa5, 0xc3,
+	0xa7, 0xbe, 0x40, 0x30, 0x1e, 0x51, 0xc6, 0x8a, 0x45, 0x31, 0xa7, 0x5a, 0x96, 0x36, 0x03, 0x57,
+	0xa4, 0x68, 0x9a, 0xf0, 0x28, 0x93, 0xb9, 0x70, 0x0e, 0xc4, 0x53, 0x68, 0xc1, 0x66, 0xd1, 0x5c,
+	0xfc, 0x5a, 0x88, 0x58, 0xe8, 0xab, 0xaa, 0x95, 0x68, 0x25, 0x92, 0x84, 0x2b, 0x77, 0x2f, 0x0f,
+	0xff, 0x5c, 0x27, 0xf7, 0x8e, 0x70, 0x92, 0x4e, 0x34, 0xd5, 0x3c, 0xd8, 0x23, 0xab, 0xd8, 0x8c,
+	0x4e, 0x6d, 0x50, 0x1b, 0xd6, 0x9f, 0xb4, 0x46, 0xce, 0x64, 0x8d, 0xc6, 0x90, 0xda, 0x5f, 0x79,
+	0xf9, 0xf7, 0xce, 0xd2, 0xc4, 0x0a, 0x83, 0x31, 0x69, 0xf9, 0xe6, 0xd1, 0x5c, 0xe4, 0xba, 0xf3,
+	0xde, 0x60, 0x79, 0x58, 0x7f, 0xd2, 0xf5, 0xea, 0x4f, 0x05, 0x9b, 0x1d, 0x97, 0x32, 0xc0, 0xd4,
+	0x26, 0x4d, 0xed, 0x06, 0x8f, 0x45, 0xae, 0x83, 0x94, 0x3c, 0x10, 0x29, 0x65, 0x66, 0x38, 0xa2,
+	0xaa, 0x13, 0x06, 0xfe, 0x32, 0xf0, 0xfb, 0x1e, 0xff, 0xd8, 0x88, 0xbf, 0x31, 0xda, 0x53, 0x94,
+	0x5a, 0x8f, 0x5e, 0x89, 0x7b, 0x43, 0x00, 0x7e, 0xbf, 0x90, 0xde, 0xff, 0x0d, 0x12, 0x7a, 0xad,
+	0x80, 0xd7, 0xc3, 0xb7, 0x7b, 0x7d, 0x97, 0x73, 0x65, 0xfd, 0xb6, 0xe7, 0x55, 0x49, 0xf0, 0x7a,
+	0x41, 0x02, 0x6f, 0x66, 0xd0, 0xe0, 0x7d, 0x30, 0xd8, 0xf6, 0x9b, 0x2d, 0xe5, 0xfc, 0x85, 0x55,
+	0xd9, 0x96, 0x37, 0x32, 0x27, 0x06, 0xb8, 0x1e, 0x21, 0x80, 0x63, 0xb2, 0x48, 0x75, 0x67, 0x75,
+	0x50, 0x1b, 0xae, 0x4c, 0xd6, 0x4c, 0xe4, 0xc0, 0x04, 0x82, 0x1f, 0xc8, 0xfd, 0x37, 0xe6, 0x0b,
+	0x1d, 0x3f, 0x00, 0xc7, 0x9e, 0xef, 0x68, 0xa4, 0xcf, 0x5e, 0x2b, 0xed, 0x6e, 0xda, 0xd9, 0x9d,
+	0x78, 0xb9, 0x11, 0x6f, 0xa2, 0x10, 0xfb, 0x61, 0xc5, 0x46, 0x4e, 0x51, 0x06, 0xed, 0xb0, 0xc8,
+	0x86, 0x76, 0x62, 0x80, 0x1b, 0x93, 0x96, 0x3f, 0xe4, 0xc8, 0x5b, 0xab, 0x98, 0xa2, 0x89, 0xd1,
+	0x8d, 0xad, 0xac, 0x9c, 0x22, 0xe5, 0x06, 0x81, 0xf8, 0x98, 0xb4, 0xef, 0x10, 0xb1, 0x49, 0x04,
+	0x9a, 0x14, 0x78, 0x05, 0xd8, 0xad, 0x03, 0xd2, 0xc8, 0x68, 0x91, 0xf3, 0x38, 0x82, 0xbb, 0x02,
+	0x16, 0x50, 0x1f, 0x2c, 0x57, 0xbc, 0x06, 0x42, 0x7d, 0x75, 0x68, 0x9d, 0xd7, 0xb1, 0xc4, 0xc4,
+	0xc0, 0xf6, 0x11, 0x69, 0x5a, 0x48, 0xcc, 0x53, 0xb9, 0x40, 0xca, 0xbd, 0xc1, 0xf2, 0x70, 0x6d,
+	0xb2, 0x81, 0x89, 0x43, 0x13, 0x07, 0xed, 0x09, 0x69, 0xdf, 0xb9, 0x48, 0x51, 0xfe, 0x11, 0x98,
+	0x7e, 0xec, 0x99, 0x1e, 0xa0, 0x70, 0x1f, 0x75, 0xd6, 0x3c, 0x60, 0x5e, 0x14, 0xa0, 0xdf, 0x92,
+	0xc0, 0xbb, 0x74, 0x10, 0xb9, 0x5e, 0x75, 0xde, 0x54, 0xa8, 0xb1, 0x95, 0x3e, 0xe7, 0x3c, 0xbf,
+	0x9d, 0x32, 0x27, 0x06, 0xc8, 0xaf, 0x49, 0xd3, 0xbd, 0x08, 0x91, 0xb8, 0x01, 0xc4, 0x8e, 0x4f,
+	0x04, 0x95, 0x7b, 0xd2, 0x1b, 0xd9, 0xeb, 0x10, 0xb0, 0x9e, 0x12, 0x92, 0xd0, 0x22, 0xb1, 0x6f,
+	0x71, 0x03, 0x20, 0x81, 0x07, 0x39, 0x32, 0x69, 0x5b, 0xbe, 0x06, 0x5a, 0x28, 0xdc, 0x21, 0x75,
+	0x2c, 0xc4, 0x63, 0x6c, 0xc2, 0x31, 0x22, 0x0b, 0x8f, 0xef, 0x29, 0x21, 0xb9, 0xa6, 0x33, 0x4b,
+	0x0e, 0x2a, 0xc8, 0x27, 0x26, 0x5d, 0x92, 0x41, 0x5b, 0x92, 0xb1, 0x10, 0xc9, 0x2d, 0x24, 0x43,
+	0x08, 0xc9, 0x63, 0xd2, 0x82, 0xff, 0x54, 0xa4, 0xf8, 0x05, 0x55, 0xb1, 0xed, 0x69, 0xbb, 0x62,
+	0x38, 0xc1, 0x42, 0x4d, 0x50, 0x66, 0x1b, 0xda, 0xcc, 0xdd, 0xa0, 0x1d, 0xf7, 0x2d, 0xef, 0x37,
+	0xd6, 0x99, 0xb8, 0xcd, 0x77, 0x4d, 0x5c, 0x1b, 0x2a, 0x9f, 0x61, 0xe1, 0xed, 0xdc, 0xfd, 0x44,
+	0x3a, 0x3e, 0xd1, 0x39, 0xaa, 0xfb, 0x15, 0x77, 0xe5, 0xbe, 0x03, 0x71, 0x0f, 0x6c, 0x73, 0x7a,
+	0x37, 0x01, 0xf8, 0x2f, 0x49, 0x97, 0x16, 0x5a, 0x46, 0x17, 0x42, 0x9f, 0xc5, 0x8a, 0x5e, 0xf8,
+	0x97, 0xf1, 0x16, 0xcc, 0xf7, 0x96, 0x51, 0x7c, 0x6f, 0x05, 0xee, 0x05, 0x7b, 0x44, 0x1a, 0xce,
+	0xd7, 0x00, 0x96, 0x74, 0x60, 0x4d, 0x5b, 0xde, 0x9a, 0x0e, 0x51, 0xf4, 0x9c, 0x97, 0x87, 0xb4,
+	0x1e, 0xdf, 0x46, 0x00, 0xf4, 0x1b, 0xd9, 0xcc, 0x78, 0x1a, 0x8b, 0x34, 0x89, 0xbc, 0x19, 0xef,
+	0x6c, 0xdb, 0x7b, 0x07, 0x3f, 0x7d, 0x46, 0xe6, 0xd3, 0x67, 0x64, 0x3f, 0x7d, 0x46, 0x07, 0x52,
+	0xa4, 0xfb, 0x8f, 0xcd, 0x49, 0xfc, 0xf1, 0xcf, 0xce, 0x30, 0x11, 0xfa, 0xac, 0x98, 0x8e, 0x98,
+	0x5c, 0x84, 0x28, 0xb6, 0x7f, 0x76, 0xf3, 0x78, 0x16, 0xea, 0xab, 0x8c, 0xe7, 0x50, 0x90, 0x4f,
+	0x5a, 0xd6, 0xc9, 0x7b, 0x43, 0x8e, 0x5e, 0x5e, 0xf7, 0x6b, 0xaf, 0xae, 0xfb, 0xb5, 0x7f, 0xaf,
+	0xfb, 0xb5, 0xdf, 0x6f, 0xfa, 0x4b, 0xaf, 0x6e, 0xfa, 0x4b, 0x7f, 0xdd, 0xf4, 0x97, 0x7e, 0xdc,
+	0x75, 0xc0, 0x76, 0x4f, 0xbb, 0x52, 0x25, 0xe5, 0x73, 0x78, 0xfe, 0x45, 0x78, 0x89, 0xbf, 0xc3,
+	0xc6, 0x63, 0xba, 0x0a, 0xeb, 0xff, 0xfc, 0xbf, 0x01, 0x00, 0xbe, 0x24, 0x3f, 0xbb, 0xd5, 0x09,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -164,15 +356,108 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.PoolCount != 0 {
-		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolCount))
+	if len(m.PendingProtocolFees) > 0 {
+		for iNdEx := len(m.PendingProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if len(m.DynamicFeeList) > 0 {
+		for iNdEx := len(m.DynamicFeeList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DynamicFeeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	if len(m.AutoWithdrawTrancheList) > 0 {
+		for iNdEx := len(m.AutoWithdrawTrancheList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AutoWithdrawTrancheList[iNdEx])
+			copy(dAtA[i:], m.AutoWithdrawTrancheList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.AutoWithdrawTrancheList[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.BatchAuctionOrderList) > 0 {
+		for iNdEx := len(m.BatchAuctionOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchAuctionOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.BatchAuctionPairList) > 0 {
+		for iNdEx := len(m.BatchAuctionPairList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BatchAuctionPairList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.StakerRewardsList) > 0 {
+		for iNdEx := len(m.StakerRewardsList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StakerRewardsList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.StakeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.StakeCount))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	if len(m.PoolMetadataList) > 0 {
-		for iNdEx := len(m.PoolMetadataList) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.StakeList) > 0 {
+		for iNdEx := len(m.StakeList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PoolMetadataList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.StakeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -180,151 +465,942 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x2a
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
 		}
 	}
-	if len(m.LimitOrderTrancheUserList) > 0 {
-		for iNdEx := len(m.LimitOrderTrancheUserList) - 1; iNdEx >= 0; iNdEx-- {
+	if m.GaugeCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.GaugeCount))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.GaugeList) > 0 {
+		for iNdEx := len(m.GaugeList) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.LimitOrderTrancheUserList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.GaugeList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.PeggedOrderList) > 0 {
+		for iNdEx := len(m.PeggedOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeggedOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ProtocolFeesList) > 0 {
+		for iNdEx := len(m.ProtocolFeesList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFeesList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CircuitBreakerList) > 0 {
+		for iNdEx := len(m.CircuitBreakerList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CircuitBreakerList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.PausedDenomList) > 0 {
+		for iNdEx := len(m.PausedDenomList) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PausedDenomList[iNdEx])
+			copy(dAtA[i:], m.PausedDenomList[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.PausedDenomList[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PausedPairList) > 0 {
+		for iNdEx := len(m.PausedPairList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedPairList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.RangePositionCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RangePositionCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.RangePositionList) > 0 {
+		for iNdEx := len(m.RangePositionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RangePositionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.TriggerOrderList) > 0 {
+		for iNdEx := len(m.TriggerOrderList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TriggerOrderList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PriceAccumulatorList) > 0 {
+		for iNdEx := len(m.PriceAccumulatorList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceAccumulatorList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.PoolCount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.PoolMetadataList) > 0 {
+		for iNdEx := len(m.PoolMetadataList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolMetadataList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LimitOrderTrancheUserList) > 0 {
+		for iNdEx := len(m.LimitOrderTrancheUserList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LimitOrderTrancheUserList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.InactiveLimitOrderTrancheList) > 0 {
+		for iNdEx := len(m.InactiveLimitOrderTrancheList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InactiveLimitOrderTrancheList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TickLiquidityList) > 0 {
+		for iNdEx := len(m.TickLiquidityList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TickLiquidityList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TickLiquidityList) > 0 {
+		for _, e := range m.TickLiquidityList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.InactiveLimitOrderTrancheList) > 0 {
+		for _, e := range m.InactiveLimitOrderTrancheList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LimitOrderTrancheUserList) > 0 {
+		for _, e := range m.LimitOrderTrancheUserList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolMetadataList) > 0 {
+		for _, e := range m.PoolMetadataList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.PoolCount != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolCount))
+	}
+	if len(m.PriceAccumulatorList) > 0 {
+		for _, e := range m.PriceAccumulatorList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TriggerOrderList) > 0 {
+		for _, e := range m.TriggerOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RangePositionList) > 0 {
+		for _, e := range m.RangePositionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RangePositionCount != 0 {
+		n += 1 + sovGenesis(uint64(m.RangePositionCount))
+	}
+	if len(m.PausedPairList) > 0 {
+		for _, e := range m.PausedPairList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PausedDenomList) > 0 {
+		for _, s := range m.PausedDenomList {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CircuitBreakerList) > 0 {
+		for _, e := range m.CircuitBreakerList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProtocolFeesList) > 0 {
+		for _, e := range m.ProtocolFeesList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PeggedOrderList) > 0 {
+		for _, e := range m.PeggedOrderList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GaugeList) > 0 {
+		for _, e := range m.GaugeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.GaugeCount != 0 {
+		n += 2 + sovGenesis(uint64(m.GaugeCount))
+	}
+	if len(m.StakeList) > 0 {
+		for _, e := range m.StakeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.StakeCount != 0 {
+		n += 2 + sovGenesis(uint64(m.StakeCount))
+	}
+	if len(m.StakerRewardsList) > 0 {
+		for _, e := range m.StakerRewardsList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchAuctionPairList) > 0 {
+		for _, e := range m.BatchAuctionPairList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BatchAuctionOrderList) > 0 {
+		for _, e := range m.BatchAuctionOrderList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoWithdrawTrancheList) > 0 {
+		for _, s := range m.AutoWithdrawTrancheList {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DynamicFeeList) > 0 {
+		for _, e := range m.DynamicFeeList {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingProtocolFees) > 0 {
+		for _, e := range m.PendingProtocolFees {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickLiquidityList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickLiquidityList = append(m.TickLiquidityList, &TickLiquidity{})
+			if err := m.TickLiquidityList[len(m.TickLiquidityList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InactiveLimitOrderTrancheList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InactiveLimitOrderTrancheList = append(m.InactiveLimitOrderTrancheList, &LimitOrderTranche{})
+			if err := m.InactiveLimitOrderTrancheList[len(m.InactiveLimitOrderTrancheList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTrancheUserList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LimitOrderTrancheUserList = append(m.LimitOrderTrancheUserList, &LimitOrderTrancheUser{})
+			if err := m.LimitOrderTrancheUserList[len(m.LimitOrderTrancheUserList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolMetadataList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolMetadataList = append(m.PoolMetadataList, PoolMetadata{})
+			if err := m.PoolMetadataList[len(m.PoolMetadataList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolCount", wireType)
+			}
+			m.PoolCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceAccumulatorList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceAccumulatorList = append(m.PriceAccumulatorList, &PriceAccumulator{})
+			if err := m.PriceAccumulatorList[len(m.PriceAccumulatorList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TriggerOrderList = append(m.TriggerOrderList, &TriggerOrder{})
+			if err := m.TriggerOrderList[len(m.TriggerOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangePositionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RangePositionList = append(m.RangePositionList, &RangePosition{})
+			if err := m.RangePositionList[len(m.RangePositionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RangePositionCount", wireType)
+			}
+			m.RangePositionCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RangePositionCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedPairList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedPairList = append(m.PausedPairList, &PairID{})
+			if err := m.PausedPairList[len(m.PausedPairList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedDenomList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedDenomList = append(m.PausedDenomList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CircuitBreakerList = append(m.CircuitBreakerList, &CircuitBreaker{})
+			if err := m.CircuitBreakerList[len(m.CircuitBreakerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeesList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeesList = append(m.ProtocolFeesList, PairProtocolFees{})
+			if err := m.ProtocolFeesList[len(m.ProtocolFeesList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeggedOrderList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.InactiveLimitOrderTrancheList) > 0 {
-		for iNdEx := len(m.InactiveLimitOrderTrancheList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InactiveLimitOrderTrancheList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.TickLiquidityList) > 0 {
-		for iNdEx := len(m.TickLiquidityList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TickLiquidityList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeggedOrderList = append(m.PeggedOrderList, &PeggedOrder{})
+			if err := m.PeggedOrderList[len(m.PeggedOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				i -= size
-				i = encodeVarintGenesis(dAtA, i, uint64(size))
 			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenesis(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenesis(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *GenesisState) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovGenesis(uint64(l))
-	if len(m.TickLiquidityList) > 0 {
-		for _, e := range m.TickLiquidityList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.InactiveLimitOrderTrancheList) > 0 {
-		for _, e := range m.InactiveLimitOrderTrancheList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.LimitOrderTrancheUserList) > 0 {
-		for _, e := range m.LimitOrderTrancheUserList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if len(m.PoolMetadataList) > 0 {
-		for _, e := range m.PoolMetadataList {
-			l = e.Size()
-			n += 1 + l + sovGenesis(uint64(l))
-		}
-	}
-	if m.PoolCount != 0 {
-		n += 1 + sovGenesis(uint64(m.PoolCount))
-	}
-	return n
-}
-
-func sovGenesis(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenesis(x uint64) (n int) {
-	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.GaugeList = append(m.GaugeList, &Gauge{})
+			if err := m.GaugeList[len(m.GaugeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeCount", wireType)
+			}
+			m.GaugeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -351,13 +1427,33 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StakeList = append(m.StakeList, &Stake{})
+			if err := m.StakeList[len(m.StakeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeCount", wireType)
+			}
+			m.StakeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickLiquidityList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StakerRewardsList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -384,14 +1480,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TickLiquidityList = append(m.TickLiquidityList, &TickLiquidity{})
-			if err := m.TickLiquidityList[len(m.TickLiquidityList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.StakerRewardsList = append(m.StakerRewardsList, StakerRewards{})
+			if err := m.StakerRewardsList[len(m.StakerRewardsList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InactiveLimitOrderTrancheList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuctionPairList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -418,14 +1514,14 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InactiveLimitOrderTrancheList = append(m.InactiveLimitOrderTrancheList, &LimitOrderTranche{})
-			if err := m.InactiveLimitOrderTrancheList[len(m.InactiveLimitOrderTrancheList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BatchAuctionPairList = append(m.BatchAuctionPairList, &PairID{})
+			if err := m.BatchAuctionPairList[len(m.BatchAuctionPairList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 22:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTrancheUserList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuctionOrderList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -452,14 +1548,46 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LimitOrderTrancheUserList = append(m.LimitOrderTrancheUserList, &LimitOrderTrancheUser{})
-			if err := m.LimitOrderTrancheUserList[len(m.LimitOrderTrancheUserList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BatchAuctionOrderList = append(m.BatchAuctionOrderList, &BatchAuctionOrder{})
+			if err := m.BatchAuctionOrderList[len(m.BatchAuctionOrderList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 23:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolMetadataList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoWithdrawTrancheList", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoWithdrawTrancheList = append(m.AutoWithdrawTrancheList, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -486,16 +1614,16 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolMetadataList = append(m.PoolMetadataList, PoolMetadata{})
-			if err := m.PoolMetadataList[len(m.PoolMetadataList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.DynamicFeeList = append(m.DynamicFeeList, &DynamicFee{})
+			if err := m.DynamicFeeList[len(m.DynamicFeeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolCount", wireType)
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingProtocolFees", wireType)
 			}
-			m.PoolCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenesis
@@ -505,11 +1633,26 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolCount |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingProtocolFees = append(m.PendingProtocolFees, types.Coin{})
+			if err := m.PendingProtocolFees[len(m.PendingProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
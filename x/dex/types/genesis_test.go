@@ -2,7 +2,10 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/neutron-org/neutron/v5/x/dex/types"
@@ -82,7 +85,8 @@ func TestGenesisState_Validate(t *testing.T) {
 						Id: 1,
 					},
 				},
-				PoolCount: 2,
+				PoolCount:           2,
+				PendingProtocolFees: sdk.NewCoins(sdk.NewInt64Coin("TokenA", 4)),
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -183,6 +187,194 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated priceAccumulator",
+			genState: &types.GenesisState{
+				PriceAccumulatorList: []*types.PriceAccumulator{
+					{
+						TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"},
+						Timestamp:   time.Unix(100, 0),
+					},
+					{
+						TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"},
+						Timestamp:   time.Unix(100, 0),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated triggerOrder",
+			genState: &types.GenesisState{
+				TriggerOrderList: []*types.TriggerOrder{
+					{
+						TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"},
+						OrderType:   types.LimitOrderType_STOP_LOSS,
+						OrderKey:    "0",
+						Creator:     "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						Receiver:    "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						AmountIn:    math.OneInt(),
+					},
+					{
+						TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"},
+						OrderType:   types.LimitOrderType_TAKE_PROFIT,
+						OrderKey:    "0",
+						Creator:     "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						Receiver:    "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						AmountIn:    math.OneInt(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid triggerOrder type",
+			genState: &types.GenesisState{
+				TriggerOrderList: []*types.TriggerOrder{
+					{
+						TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"},
+						OrderType:   types.LimitOrderType_GOOD_TIL_CANCELLED,
+						OrderKey:    "0",
+						Creator:     "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						Receiver:    "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						AmountIn:    math.OneInt(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated peggedOrder",
+			genState: &types.GenesisState{
+				PeggedOrderList: []*types.PeggedOrder{
+					{
+						Address:     "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						TrancheKey:  "0",
+						TradePairId: &types.TradePairID{MakerDenom: "TokenB", TakerDenom: "TokenA"},
+						Peg:         types.OraclePeg{CurrencyPair: "TOKENA/TOKENB", BaseDenom: "TokenA"},
+					},
+					{
+						Address:     "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						TrancheKey:  "0",
+						TradePairId: &types.TradePairID{MakerDenom: "TokenB", TakerDenom: "TokenA"},
+						Peg:         types.OraclePeg{CurrencyPair: "TOKENA/TOKENB", BaseDenom: "TokenA"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid peggedOrder peg",
+			genState: &types.GenesisState{
+				PeggedOrderList: []*types.PeggedOrder{
+					{
+						Address:     "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						TrancheKey:  "0",
+						TradePairId: &types.TradePairID{MakerDenom: "TokenB", TakerDenom: "TokenA"},
+						Peg:         types.OraclePeg{CurrencyPair: "TOKENA/TOKENB", BaseDenom: "TokenC"},
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated paused pair",
+			genState: &types.GenesisState{
+				PausedPairList: []*types.PairID{
+					{Token0: "TokenA", Token1: "TokenB"},
+					{Token0: "TokenA", Token1: "TokenB"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unsorted paused pair",
+			genState: &types.GenesisState{
+				PausedPairList: []*types.PairID{{Token0: "TokenB", Token1: "TokenA"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "unsorted batch auction pair",
+			genState: &types.GenesisState{
+				BatchAuctionPairList: []*types.PairID{{Token0: "TokenB", Token1: "TokenA"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "batch auction order with maker order type",
+			genState: &types.GenesisState{
+				BatchAuctionOrderList: []*types.BatchAuctionOrder{
+					{
+						TradePairId: types.MustNewTradePairID("TokenA", "TokenB"),
+						OrderKey:    "0",
+						OrderType:   types.LimitOrderType_GOOD_TIL_CANCELLED,
+						Creator:     "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						Receiver:    "neutron1m9l358xunhhwds0568za49mzhvuxx9uxl4sqxn",
+						AmountIn:    math.NewInt(10),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auto withdraw tranche",
+			genState: &types.GenesisState{
+				AutoWithdrawTrancheList: []string{"0", "0"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated dynamicFee",
+			genState: &types.GenesisState{
+				DynamicFeeList: []*types.DynamicFee{
+					{PairId: &types.PairID{Token0: "TokenA", Token1: "TokenB"}, FeeBps: 5},
+					{PairId: &types.PairID{Token0: "TokenA", Token1: "TokenB"}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated paused denom",
+			genState: &types.GenesisState{
+				PausedDenomList: []string{"TokenA", "TokenA"},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated circuitBreaker",
+			genState: &types.GenesisState{
+				CircuitBreakerList: []*types.CircuitBreaker{
+					{TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"}, WindowStartHeight: 1},
+					{TradePairId: &types.TradePairID{MakerDenom: "TokenA", TakerDenom: "TokenB"}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated protocolFees",
+			genState: &types.GenesisState{
+				ProtocolFeesList: []types.PairProtocolFees{
+					{PairId: &types.PairID{Token0: "TokenA", Token1: "TokenB"}},
+					{PairId: &types.PairID{Token0: "TokenA", Token1: "TokenB"}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated pendingProtocolFees denom",
+			genState: &types.GenesisState{
+				PendingProtocolFees: sdk.Coins{sdk.NewInt64Coin("TokenA", 1), sdk.NewInt64Coin("TokenA", 2)},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero pendingProtocolFees",
+			genState: &types.GenesisState{
+				PendingProtocolFees: sdk.Coins{sdk.NewInt64Coin("TokenA", 0)},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DexHooks is notified after dex state transitions. Hooks are purely informational and cannot fail
+// the dex operation that triggered them. AfterSwap, AfterDeposit, AfterWithdraw and AfterTrancheFilled are queued
+// and called at the end of the block, so they are never called from simulate or estimate queries.
+type DexHooks interface {
+	// AfterSwap is called at the end of the block for every taker swap (MultiHopSwap, MultiHopSwapExactOut or the
+	// taker portion of PlaceLimitOrder) settled during the block.
+	AfterSwap(ctx sdk.Context, creator, receiver sdk.AccAddress, coinIn, coinOut sdk.Coin)
+	// AfterDeposit is called at the end of the block for every deposit that minted shares during the block.
+	AfterDeposit(
+		ctx sdk.Context,
+		creator, receiver sdk.AccAddress,
+		pairID *PairID,
+		amount0, amount1 math.Int,
+		sharesIssued sdk.Coins,
+	)
+	// AfterWithdraw is called at the end of the block for every withdrawal that burned shares during the block.
+	AfterWithdraw(
+		ctx sdk.Context,
+		creator, receiver sdk.AccAddress,
+		pairID *PairID,
+		amount0, amount1 math.Int,
+		sharesBurned sdk.Coins,
+	)
+	// AfterTrancheFilled is called at the end of the block for every tranche whose last maker reserves were
+	// consumed by a swap during the block.
+	AfterTrancheFilled(ctx sdk.Context, tranche *LimitOrderTranche)
+	// AfterTrancheExpired is called when an expiring tranche is purged from the orderbook.
+	AfterTrancheExpired(ctx sdk.Context, tranche *LimitOrderTranche)
+}
+
+var _ DexHooks = MultiDexHooks{}
+
+// MultiDexHooks combines multiple dex hooks, all hook functions are run in array sequence.
+type MultiDexHooks []DexHooks
+
+func NewMultiDexHooks(hooks ...DexHooks) MultiDexHooks {
+	return hooks
+}
+
+func (h MultiDexHooks) AfterSwap(ctx sdk.Context, creator, receiver sdk.AccAddress, coinIn, coinOut sdk.Coin) {
+	for i := range h {
+		h[i].AfterSwap(ctx, creator, receiver, coinIn, coinOut)
+	}
+}
+
+func (h MultiDexHooks) AfterDeposit(
+	ctx sdk.Context,
+	creator, receiver sdk.AccAddress,
+	pairID *PairID,
+	amount0, amount1 math.Int,
+	sharesIssued sdk.Coins,
+) {
+	for i := range h {
+		h[i].AfterDeposit(ctx, creator, receiver, pairID, amount0, amount1, sharesIssued)
+	}
+}
+
+func (h MultiDexHooks) AfterWithdraw(
+	ctx sdk.Context,
+	creator, receiver sdk.AccAddress,
+	pairID *PairID,
+	amount0, amount1 math.Int,
+	sharesBurned sdk.Coins,
+) {
+	for i := range h {
+		h[i].AfterWithdraw(ctx, creator, receiver, pairID, amount0, amount1, sharesBurned)
+	}
+}
+
+func (h MultiDexHooks) AfterTrancheFilled(ctx sdk.Context, tranche *LimitOrderTranche) {
+	for i := range h {
+		h[i].AfterTrancheFilled(ctx, tranche)
+	}
+}
+
+func (h MultiDexHooks) AfterTrancheExpired(ctx sdk.Context, tranche *LimitOrderTranche) {
+	for i := range h {
+		h[i].AfterTrancheExpired(ctx, tranche)
+	}
+}
+
+// DexHookSudoMsg is the sudo payload sent to the hook contract. Exactly one field is set.
+type DexHookSudoMsg struct {
+	AfterSwap           *AfterSwapHookMsg      `json:"after_swap,omitempty"`
+	AfterDeposit        *AfterLiquidityHookMsg `json:"after_deposit,omitempty"`
+	AfterWithdraw       *AfterLiquidityHookMsg `json:"after_withdraw,omitempty"`
+	AfterTrancheFilled  *AfterTrancheHookMsg   `json:"after_tranche_filled,omitempty"`
+	AfterTrancheExpired *AfterTrancheHookMsg   `json:"after_tranche_expired,omitempty"`
+}
+
+type AfterSwapHookMsg struct {
+	Creator  string   `json:"creator"`
+	Receiver string   `json:"receiver"`
+	CoinIn   sdk.Coin `json:"coin_in"`
+	CoinOut  sdk.Coin `json:"coin_out"`
+}
+
+type AfterLiquidityHookMsg struct {
+	Creator  string    `json:"creator"`
+	Receiver string    `json:"receiver"`
+	Token0   string    `json:"token0"`
+	Token1   string    `json:"token1"`
+	Amount0  math.Int  `json:"amount0"`
+	Amount1  math.Int  `json:"amount1"`
+	Shares   sdk.Coins `json:"shares"`
+}
+
+type AfterTrancheHookMsg struct {
+	TradePairID *TradePairID `json:"trade_pair_id"`
+	TickIndex   int64        `json:"tick_index_taker_to_maker"`
+	TrancheKey  string       `json:"tranche_key"`
+}
+
+// FlashSwapSudoMsg is the sudo payload sent to the creator of a MsgFlashSwap once the swap output has been released.
+type FlashSwapSudoMsg struct {
+	FlashSwapCallback *FlashSwapCallbackMsg `json:"flash_swap_callback"`
+}
+
+type FlashSwapCallbackMsg struct {
+	// CoinIn is collected from the contract once the callback returns
+	CoinIn sdk.Coin `json:"coin_in"`
+	// CoinOut has already been sent to the contract
+	CoinOut sdk.Coin `json:"coin_out"`
+	Data    []byte   `json:"data,omitempty"`
+}
@@ -0,0 +1,1660 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/incentives.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Gauge streams coins to the stakers of pool shares of pair_id over num_epochs. Only pools that lie entirely
+// within [start_tick, end_tick] earn rewards. The undistributed coins of a Gauge are held by the dex incentives
+// module account.
+type Gauge struct {
+	Id     uint64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner  string  `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	PairId *PairID `protobuf:"bytes,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Normalized (token0) tick of the lower bound of the rewarded range
+	StartTick int64 `protobuf:"varint,4,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty"`
+	// Normalized (token0) tick of the upper bound of the rewarded range
+	EndTick      int64  `protobuf:"varint,5,opt,name=end_tick,json=endTick,proto3" json:"end_tick,omitempty"`
+	NumEpochs    uint64 `protobuf:"varint,6,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
+	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
+	// Total coins funded to the Gauge
+	Coins            github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins" yaml:"coins"`
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins" yaml:"distributed_coins"`
+}
+
+func (m *Gauge) Reset()         { *m = Gauge{} }
+func (m *Gauge) String() string { return proto.CompactTextString(m) }
+func (*Gauge) ProtoMessage()    {}
+func (*Gauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6890d623da9bae8c, []int{0}
+}
+func (m *Gauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Gauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Gauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Gauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Gauge.Merge(m, src)
+}
+func (m *Gauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Gauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Gauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Gauge proto.InternalMessageInfo
+
+func (m *Gauge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Gauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Gauge) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *Gauge) GetStartTick() int64 {
+	if m != nil {
+		return m.StartTick
+	}
+	return 0
+}
+
+func (m *Gauge) GetEndTick() int64 {
+	if m != nil {
+		return m.EndTick
+	}
+	return 0
+}
+
+func (m *Gauge) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+func (m *Gauge) GetFilledEpochs() uint64 {
+	if m != nil {
+		return m.FilledEpochs
+	}
+	return 0
+}
+
+func (m *Gauge) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DistributedCoins
+	}
+	return nil
+}
+
+// Stake is a set of pool shares held by the dex incentives module account on behalf of owner
+type Stake struct {
+	Id     uint64                                   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner  string                                   `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Shares github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=shares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shares" yaml:"shares"`
+}
+
+func (m *Stake) Reset()         { *m = Stake{} }
+func (m *Stake) String() string { return proto.CompactTextString(m) }
+func (*Stake) ProtoMessage()    {}
+func (*Stake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6890d623da9bae8c, []int{1}
+}
+func (m *Stake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Stake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Stake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Stake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stake.Merge(m, src)
+}
+func (m *Stake) XXX_Size() int {
+	return m.Size()
+}
+func (m *Stake) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stake proto.InternalMessageInfo
+
+func (m *Stake) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Stake) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Stake) GetShares() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+// StakerRewards are the Gauge rewards accrued to an address that have not yet been claimed
+type StakerRewards struct {
+	Address string                                   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards" yaml:"rewards"`
+}
+
+func (m *StakerRewards) Reset()         { *m = StakerRewards{} }
+func (m *StakerRewards) String() string { return proto.CompactTextString(m) }
+func (*StakerRewards) ProtoMessage()    {}
+func (*StakerRewards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6890d623da9bae8c, []int{2}
+}
+func (m *StakerRewards) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StakerRewards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StakerRewards.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StakerRewards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StakerRewards.Merge(m, src)
+}
+func (m *StakerRewards) XXX_Size() int {
+	return m.Size()
+}
+func (m *StakerRewards) XXX_DiscardUnknown() {
+	xxx_messageInfo_StakerRewards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StakerRewards proto.InternalMessageInfo
+
+func (m *StakerRewards) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *StakerRewards) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+// GaugeDistribution is the progress of the epoch distribution of a Gauge. The Stakes of the Gauge's pair are paged
+// through twice, first to sum their weights and then to split the epoch coins between them pro-rata.
+type GaugeDistribution struct {
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+	// Stakes created after the distribution started (id >= stake_count) do not take part in it
+	StakeCount uint64 `protobuf:"varint,2,opt,name=stake_count,json=stakeCount,proto3" json:"stake_count,omitempty"`
+	// Lowest id of the Stakes that have not yet been processed in the current pass
+	NextStakeId uint64 `protobuf:"varint,3,opt,name=next_stake_id,json=nextStakeId,proto3" json:"next_stake_id,omitempty"`
+	// True once the weights of all Stakes have been summed
+	Distributing     bool                                     `protobuf:"varint,4,opt,name=distributing,proto3" json:"distributing,omitempty"`
+	TotalWeight      cosmossdk_io_math.Int                    `protobuf:"bytes,5,opt,name=total_weight,json=totalWeight,proto3,customtype=cosmossdk.io/math.Int" json:"total_weight" yaml:"total_weight"`
+	EpochCoins       github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=epoch_coins,json=epochCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"epoch_coins" yaml:"epoch_coins"`
+	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins" yaml:"distributed_coins"`
+}
+
+func (m *GaugeDistribution) Reset()         { *m = GaugeDistribution{} }
+func (m *GaugeDistribution) String() string { return proto.CompactTextString(m) }
+func (*GaugeDistribution) ProtoMessage()    {}
+func (*GaugeDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6890d623da9bae8c, []int{3}
+}
+func (m *GaugeDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugeDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugeDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugeDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugeDistribution.Merge(m, src)
+}
+func (m *GaugeDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugeDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugeDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugeDistribution proto.InternalMessageInfo
+
+func (m *GaugeDistribution) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+func (m *GaugeDistribution) GetStakeCount() uint64 {
+	if m != nil {
+		return m.StakeCount
+	}
+	return 0
+}
+
+func (m *GaugeDistribution) GetNextStakeId() uint64 {
+	if m != nil {
+		return m.NextStakeId
+	}
+	return 0
+}
+
+func (m *GaugeDistribution) GetDistributing() bool {
+	if m != nil {
+		return m.Distributing
+	}
+	return false
+}
+
+func (m *GaugeDistribution) GetEpochCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EpochCoins
+	}
+	return nil
+}
+
+func (m *GaugeDistribution) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DistributedCoins
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Gauge)(nil), "neutron.dex.Gauge")
+	proto.RegisterType((*Stake)(nil), "neutron.dex.Stake")
+	proto.RegisterType((*StakerRewards)(nil), "neutron.dex.StakerRewards")
+	proto.RegisterType((*GaugeDistribution)(nil), "neutron.dex.GaugeDistribution")
+}
+
+func init() { proto.RegisterFile("neutron/dex/incentives.proto", fileDescriptor_6890d623da9bae8c) }
+
+var fileDescriptor_6890d623da9bae8c = []byte{
+	// 709 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0xcd, 0x6e, 0x13, 0x3d,
+	0x14, 0xcd, 0xe4, 0xb7, 0x71, 0x92, 0xea, 0xab, 0xdb, 0x4f, 0x9a, 0x56, 0x34, 0x13, 0x0d, 0x9b,
+	0x2c, 0xe8, 0x8c, 0x5a, 0xc4, 0x86, 0x65, 0x5b, 0x54, 0x45, 0x08, 0x09, 0x0d, 0x20, 0x24, 0x36,
+	0x23, 0x67, 0x6c, 0x26, 0x56, 0x12, 0x3b, 0x1a, 0x3b, 0x6d, 0xca, 0x02, 0x09, 0x9e, 0x00, 0x1e,
+	0x03, 0x24, 0x78, 0x06, 0x96, 0x5d, 0x76, 0x89, 0x58, 0x0c, 0xa8, 0xdd, 0x75, 0x99, 0x27, 0x40,
+	0x63, 0x3b, 0x55, 0xaa, 0x4a, 0x84, 0xec, 0x58, 0x8d, 0xef, 0xb9, 0x3f, 0x3e, 0xf7, 0xdc, 0xb9,
+	0x06, 0x77, 0x18, 0x19, 0xcb, 0x84, 0x33, 0x1f, 0x93, 0x89, 0x4f, 0x59, 0x44, 0x98, 0xa4, 0xc7,
+	0x44, 0x78, 0xa3, 0x84, 0x4b, 0x0e, 0x6b, 0xc6, 0xeb, 0x61, 0x32, 0xd9, 0x6a, 0x46, 0x5c, 0x0c,
+	0xb9, 0xf0, 0xbb, 0x48, 0x10, 0xff, 0x78, 0xb7, 0x4b, 0x24, 0xda, 0xf5, 0x23, 0x4e, 0x99, 0x0e,
+	0xde, 0xda, 0x88, 0x79, 0xcc, 0xd5, 0xd1, 0xcf, 0x4e, 0x06, 0xdd, 0x9c, 0xbf, 0x60, 0x84, 0x68,
+	0x12, 0x52, 0xac, 0x5d, 0xee, 0xbb, 0x22, 0x28, 0x1d, 0xa1, 0x71, 0x4c, 0xe0, 0x2a, 0xc8, 0x53,
+	0x6c, 0x5b, 0x2d, 0xab, 0x5d, 0x0c, 0xf2, 0x14, 0xc3, 0x0d, 0x50, 0xe2, 0x27, 0x8c, 0x24, 0x76,
+	0xbe, 0x65, 0xb5, 0xab, 0x81, 0x36, 0xe0, 0x3d, 0x50, 0x31, 0x05, 0xec, 0x42, 0xcb, 0x6a, 0xd7,
+	0xf6, 0xd6, 0xbd, 0x39, 0x7e, 0xde, 0x53, 0x44, 0x93, 0xce, 0x61, 0x50, 0xce, 0x62, 0x3a, 0x18,
+	0x6e, 0x03, 0x20, 0x24, 0x4a, 0x64, 0x28, 0x69, 0xd4, 0xb7, 0x8b, 0x2d, 0xab, 0x5d, 0x08, 0xaa,
+	0x0a, 0x79, 0x4e, 0xa3, 0x3e, 0xdc, 0x04, 0x2b, 0x84, 0x61, 0xed, 0x2c, 0x29, 0x67, 0x85, 0x30,
+	0xac, 0x5c, 0xdb, 0x00, 0xb0, 0xf1, 0x30, 0x24, 0x23, 0x1e, 0xf5, 0x84, 0x5d, 0x56, 0xac, 0xaa,
+	0x6c, 0x3c, 0x7c, 0xa4, 0x00, 0x78, 0x17, 0x34, 0x5e, 0xd3, 0xc1, 0x80, 0xe0, 0x59, 0x44, 0x45,
+	0x45, 0xd4, 0x35, 0x68, 0x82, 0xde, 0x80, 0x52, 0x26, 0x8d, 0xb0, 0x57, 0x5a, 0x85, 0x76, 0x6d,
+	0x6f, 0xd3, 0xd3, 0xe2, 0x79, 0x99, 0x78, 0x9e, 0x11, 0xcf, 0x3b, 0xe0, 0x94, 0xed, 0x77, 0xce,
+	0x52, 0x27, 0x77, 0x95, 0x3a, 0x3a, 0x7e, 0x9a, 0x3a, 0xf5, 0x53, 0x34, 0x1c, 0x3c, 0x74, 0x95,
+	0xe9, 0x7e, 0xfe, 0xe9, 0xb4, 0x63, 0x2a, 0x7b, 0xe3, 0xae, 0x17, 0xf1, 0xa1, 0x6f, 0x46, 0xa0,
+	0x3f, 0x3b, 0x02, 0xf7, 0x7d, 0x79, 0x3a, 0x22, 0x42, 0x55, 0x12, 0x81, 0x2e, 0x01, 0x3f, 0x59,
+	0x60, 0x0d, 0x53, 0x21, 0x13, 0xda, 0x1d, 0x4b, 0x82, 0x43, 0x4d, 0xa4, 0xba, 0x88, 0x08, 0x32,
+	0x44, 0x6e, 0xe7, 0x4e, 0x53, 0xc7, 0xd6, 0xa4, 0x6e, 0xb9, 0x96, 0x23, 0xf8, 0xdf, 0x5c, 0xbe,
+	0x42, 0xdc, 0x2f, 0x16, 0x28, 0x3d, 0x93, 0xa8, 0xff, 0xb7, 0xff, 0xc0, 0x5b, 0x50, 0x16, 0x3d,
+	0x94, 0x10, 0x61, 0x17, 0x16, 0xf5, 0xf3, 0xd8, 0xf4, 0x63, 0x12, 0xa6, 0xa9, 0xd3, 0xd0, 0x4d,
+	0x68, 0x7b, 0x39, 0xe6, 0xa6, 0x88, 0xfb, 0xd5, 0x02, 0x0d, 0xc5, 0x37, 0x09, 0xc8, 0x09, 0x4a,
+	0xb0, 0x80, 0x36, 0xa8, 0x20, 0x8c, 0x13, 0x22, 0x84, 0x22, 0x5f, 0x0d, 0x66, 0x26, 0x7c, 0x6f,
+	0x81, 0x4a, 0xa2, 0xa3, 0xec, 0xfc, 0x22, 0xb6, 0x4f, 0x0c, 0xdb, 0x59, 0xc6, 0x34, 0x75, 0x56,
+	0x35, 0x5d, 0x03, 0x2c, 0xc7, 0x77, 0x56, 0xc6, 0xfd, 0x56, 0x04, 0x6b, 0x6a, 0xc9, 0x0e, 0x67,
+	0xd2, 0x53, 0xce, 0xb2, 0xbf, 0x3f, 0xce, 0xc0, 0xf0, 0x5a, 0xf2, 0x8a, 0xb2, 0x3b, 0x18, 0x3a,
+	0xa0, 0x26, 0xb2, 0x06, 0xc3, 0x88, 0x8f, 0x99, 0x54, 0xea, 0x17, 0x03, 0xa0, 0xa0, 0x83, 0x0c,
+	0x81, 0x2e, 0x68, 0x30, 0x32, 0x91, 0xa1, 0x8e, 0x32, 0xcb, 0x58, 0x0c, 0x6a, 0x19, 0xa8, 0xa4,
+	0xe9, 0x60, 0xe8, 0x82, 0xfa, 0xf5, 0xa8, 0x29, 0x8b, 0xd5, 0xfa, 0xad, 0x04, 0x37, 0x30, 0x18,
+	0x83, 0xba, 0xe4, 0x12, 0x0d, 0xc2, 0x13, 0x42, 0xe3, 0x9e, 0x54, 0x5b, 0x58, 0xdd, 0x3f, 0xcc,
+	0x74, 0xf8, 0x91, 0x3a, 0xff, 0xeb, 0xc6, 0x04, 0xee, 0x7b, 0x94, 0xfb, 0x43, 0x24, 0x7b, 0x5e,
+	0x87, 0xc9, 0xab, 0xd4, 0xb9, 0x91, 0x34, 0x4d, 0x9d, 0x75, 0xad, 0xd2, 0x3c, 0xea, 0x06, 0x35,
+	0x65, 0xbe, 0x54, 0x16, 0xfc, 0x68, 0x81, 0x9a, 0x5a, 0x55, 0xb3, 0x09, 0xe5, 0x45, 0xb3, 0x78,
+	0x61, 0x66, 0x31, 0x9f, 0x35, 0x4d, 0x1d, 0xa8, 0x6f, 0x9a, 0x03, 0x97, 0x9b, 0x09, 0x50, 0x99,
+	0x07, 0x7f, 0xd8, 0xd1, 0xca, 0xbf, 0xb8, 0xa3, 0xfb, 0x47, 0x67, 0x17, 0x4d, 0xeb, 0xfc, 0xa2,
+	0x69, 0xfd, 0xba, 0x68, 0x5a, 0x1f, 0x2e, 0x9b, 0xb9, 0xf3, 0xcb, 0x66, 0xee, 0xfb, 0x65, 0x33,
+	0xf7, 0x6a, 0x67, 0xae, 0xaa, 0x79, 0x8a, 0x77, 0x78, 0x12, 0xcf, 0xce, 0xfe, 0xf1, 0x03, 0x7f,
+	0xa2, 0x1e, 0x7e, 0x75, 0x41, 0xb7, 0xac, 0xde, 0xfd, 0xfb, 0xbf, 0x07, 0x00, 0xa4, 0x33, 0x69,
+	0x87, 0x75, 0x06, 0x00, 0x00,
+}
+
+func (m *Gauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Gauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.FilledEpochs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.FilledEpochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.NumEpochs != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndTick != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.EndTick))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTick != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.StartTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIncentives(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Stake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Stake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Stake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StakerRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StakerRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StakerRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintIncentives(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaugeDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugeDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugeDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DistributedCoins) > 0 {
+		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DistributedCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.EpochCoins) > 0 {
+		for iNdEx := len(m.EpochCoins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EpochCoins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintIncentives(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size := m.TotalWeight.Size()
+		i -= size
+		if _, err := m.TotalWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIncentives(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Distributing {
+		i--
+		if m.Distributing {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NextStakeId != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.NextStakeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StakeCount != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.StakeCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.GaugeId != 0 {
+		i = encodeVarintIncentives(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIncentives(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIncentives(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Gauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIncentives(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovIncentives(uint64(m.StartTick))
+	}
+	if m.EndTick != 0 {
+		n += 1 + sovIncentives(uint64(m.EndTick))
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovIncentives(uint64(m.NumEpochs))
+	}
+	if m.FilledEpochs != 0 {
+		n += 1 + sovIncentives(uint64(m.FilledEpochs))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Stake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIncentives(uint64(m.Id))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StakerRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovIncentives(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GaugeDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovIncentives(uint64(m.GaugeId))
+	}
+	if m.StakeCount != 0 {
+		n += 1 + sovIncentives(uint64(m.StakeCount))
+	}
+	if m.NextStakeId != 0 {
+		n += 1 + sovIncentives(uint64(m.NextStakeId))
+	}
+	if m.Distributing {
+		n += 2
+	}
+	l = m.TotalWeight.Size()
+	n += 1 + l + sovIncentives(uint64(l))
+	if len(m.EpochCoins) > 0 {
+		for _, e := range m.EpochCoins {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	if len(m.DistributedCoins) > 0 {
+		for _, e := range m.DistributedCoins {
+			l = e.Size()
+			n += 1 + l + sovIncentives(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovIncentives(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIncentives(x uint64) (n int) {
+	return sovIncentives(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Gauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Gauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Gauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+			}
+			m.StartTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTick", wireType)
+			}
+			m.EndTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilledEpochs", wireType)
+			}
+			m.FilledEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FilledEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Stake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Stake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Stake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StakerRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StakerRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StakerRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugeDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugeDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugeDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeCount", wireType)
+			}
+			m.StakeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextStakeId", wireType)
+			}
+			m.NextStakeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextStakeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distributing", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Distributing = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalWeight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochCoins = append(m.EpochCoins, types.Coin{})
+			if err := m.EpochCoins[len(m.EpochCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributedCoins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributedCoins = append(m.DistributedCoins, types.Coin{})
+			if err := m.DistributedCoins[len(m.DistributedCoins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIncentives(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIncentives
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIncentives(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIncentives
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIncentives
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIncentives
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIncentives
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIncentives
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIncentives        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIncentives          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIncentives = fmt.Errorf("proto: unexpected end of group")
+)
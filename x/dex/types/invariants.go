@@ -0,0 +1,158 @@
+package types
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// BalanceDifference is a denom for which the amount held differs from the amount expected by the dex state
+type BalanceDifference struct {
+	Denom    string
+	Expected math.Int
+	Actual   math.Int
+}
+
+func (d BalanceDifference) String() string {
+	return fmt.Sprintf("%s: expected %s, actual %s (%s)", d.Denom, d.Expected, d.Actual, d.Actual.Sub(d.Expected))
+}
+
+func (d BalanceDifference) IsDeficit() bool {
+	return d.Actual.LT(d.Expected)
+}
+
+// DexLiabilities returns the amount of each denom that the dex module account holds on behalf of users:
+// the reserves of PoolReserves and active and inactive LimitOrderTranches, the unwithdrawn maker rebates of
+// LimitOrderTranches, the AmountIn escrowed by TriggerOrders and BatchAuctionOrders and the protocol fees that have
+// not yet been sent to the protocol fee collector.
+func DexLiabilities(
+	tickLiquidity []*TickLiquidity,
+	inactiveTranches []*LimitOrderTranche,
+	triggerOrders []*TriggerOrder,
+	batchAuctionOrders []*BatchAuctionOrder,
+	pendingProtocolFees sdk.Coins,
+) sdk.Coins {
+	liabilities := sdk.NewCoins(pendingProtocolFees...)
+	addTranche := func(tranche *LimitOrderTranche) {
+		tradePairID := tranche.Key.TradePairId
+		liabilities = liabilities.Add(
+			sdk.NewCoin(tradePairID.MakerDenom, tranche.ReservesMakerDenom),
+			sdk.NewCoin(tradePairID.TakerDenom, tranche.ReservesTakerDenom),
+			sdk.NewCoin(tradePairID.TakerDenom, tranche.AccruedMakerRebate()),
+		)
+	}
+
+	for _, tick := range tickLiquidity {
+		switch liquidity := tick.Liquidity.(type) {
+		case *TickLiquidity_PoolReserves:
+			reserves := liquidity.PoolReserves
+			liabilities = liabilities.Add(sdk.NewCoin(reserves.Key.TradePairId.MakerDenom, reserves.ReservesMakerDenom))
+		case *TickLiquidity_LimitOrderTranche:
+			addTranche(liquidity.LimitOrderTranche)
+		}
+	}
+
+	for _, tranche := range inactiveTranches {
+		addTranche(tranche)
+	}
+
+	for _, order := range triggerOrders {
+		liabilities = liabilities.Add(order.CoinIn())
+	}
+
+	for _, order := range batchAuctionOrders {
+		liabilities = liabilities.Add(order.CoinIn())
+	}
+
+	return liabilities
+}
+
+// PoolShareholderTotals returns the total amount of each PoolShare denom held across balances
+func PoolShareholderTotals(balances []banktypes.Balance) sdk.Coins {
+	totals := sdk.NewCoins()
+	for _, balance := range balances {
+		for _, coin := range balance.Coins {
+			if _, err := ParsePoolIDFromDenom(coin.Denom); err == nil {
+				totals = totals.Add(coin)
+			}
+		}
+	}
+
+	return totals
+}
+
+// CompareDexBalance returns the denoms for which the dex module balance differs from liabilities. PoolShare denoms
+// are ignored since they are not owed to users. Rounding always favours the dex, so a surplus of dust is expected;
+// only a deficit breaks the invariant.
+func CompareDexBalance(balance, liabilities sdk.Coins) []BalanceDifference {
+	var held sdk.Coins
+	for _, coin := range balance {
+		if _, err := ParsePoolIDFromDenom(coin.Denom); err != nil {
+			held = append(held, coin)
+		}
+	}
+
+	return compareCoins(liabilities, held)
+}
+
+// ComparePoolShares returns the PoolShare denoms for which the bank supply differs from the shareholder totals
+func ComparePoolShares(supply, shareholderTotals sdk.Coins) []BalanceDifference {
+	return compareCoins(shareholderTotals, supply)
+}
+
+func compareCoins(expected, actual sdk.Coins) []BalanceDifference {
+	denoms := make(map[string]struct{})
+	for _, coin := range expected {
+		denoms[coin.Denom] = struct{}{}
+	}
+	for _, coin := range actual {
+		denoms[coin.Denom] = struct{}{}
+	}
+
+	var differences []BalanceDifference
+	for denom := range denoms {
+		expectedAmount, actualAmount := expected.AmountOf(denom), actual.AmountOf(denom)
+		if !expectedAmount.Equal(actualAmount) {
+			differences = append(differences, BalanceDifference{Denom: denom, Expected: expectedAmount, Actual: actualAmount})
+		}
+	}
+	sort.Slice(differences, func(i, j int) bool { return differences[i].Denom < differences[j].Denom })
+
+	return differences
+}
+
+// AuditGenesis checks the dex conservation invariants against an exported genesis. It returns the differences
+// between the dex module balance and its liabilities and between the bank supply of PoolShare denoms and the
+// shareholder totals.
+func AuditGenesis(dexGenesis GenesisState, bankGenesis banktypes.GenesisState, dexModuleAddr sdk.AccAddress) (
+	balanceDiffs, poolShareDiffs []BalanceDifference,
+) {
+	liabilities := DexLiabilities(
+		dexGenesis.TickLiquidityList,
+		dexGenesis.InactiveLimitOrderTrancheList,
+		dexGenesis.TriggerOrderList,
+		dexGenesis.BatchAuctionOrderList,
+		dexGenesis.PendingProtocolFees,
+	)
+
+	var moduleBalance sdk.Coins
+	for _, balance := range bankGenesis.Balances {
+		if balance.Address == dexModuleAddr.String() {
+			moduleBalance = balance.Coins
+			break
+		}
+	}
+
+	var poolShareSupply sdk.Coins
+	for _, coin := range bankGenesis.Supply {
+		if _, err := ParsePoolIDFromDenom(coin.Denom); err == nil {
+			poolShareSupply = append(poolShareSupply, coin)
+		}
+	}
+
+	return CompareDexBalance(moduleBalance, liabilities),
+		ComparePoolShares(poolShareSupply, PoolShareholderTotals(bankGenesis.Balances))
+}
@@ -0,0 +1,67 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	dextypes "github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func TestAuditGenesis(t *testing.T) {
+	dexAddr := authtypes.NewModuleAddress(dextypes.ModuleName)
+	userAddr := sdk.AccAddress([]byte("user________________"))
+	poolDenom := dextypes.NewPoolDenom(0)
+
+	tranche := dextypes.MustNewLimitOrderTranche("TokenA", "TokenB", "key", 0, math.NewInt(10), math.NewInt(5), math.NewInt(10), math.NewInt(5))
+	inactiveTranche := dextypes.MustNewLimitOrderTranche("TokenB", "TokenA", "key2", 0, math.ZeroInt(), math.NewInt(7), math.NewInt(7), math.NewInt(7))
+	rebate := math.NewInt(1)
+	inactiveTranche.MakerRebate = &rebate
+	dexGenesis := dextypes.GenesisState{
+		TickLiquidityList: []*dextypes.TickLiquidity{
+			{Liquidity: &dextypes.TickLiquidity_LimitOrderTranche{LimitOrderTranche: tranche}},
+		},
+		InactiveLimitOrderTrancheList: []*dextypes.LimitOrderTranche{inactiveTranche},
+	}
+
+	bankGenesis := banktypes.GenesisState{
+		Balances: []banktypes.Balance{
+			{Address: dexAddr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin("TokenA", 19), sdk.NewInt64Coin("TokenB", 5), sdk.NewInt64Coin(poolDenom, 3))},
+			{Address: userAddr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(poolDenom, 7))},
+		},
+		Supply: sdk.NewCoins(sdk.NewInt64Coin(poolDenom, 10)),
+	}
+
+	// Dust held by the dex is reported but is not a deficit
+	balanceDiffs, poolShareDiffs := dextypes.AuditGenesis(dexGenesis, bankGenesis, dexAddr)
+	require.Len(t, balanceDiffs, 1)
+	require.Equal(t, "TokenA", balanceDiffs[0].Denom)
+	require.False(t, balanceDiffs[0].IsDeficit())
+	require.Empty(t, poolShareDiffs)
+
+	// A missing balance and unbacked pool shares are reported
+	bankGenesis.Balances[0].Coins = sdk.NewCoins(sdk.NewInt64Coin("TokenA", 19), sdk.NewInt64Coin(poolDenom, 3))
+	bankGenesis.Supply = sdk.NewCoins(sdk.NewInt64Coin(poolDenom, 11))
+	balanceDiffs, poolShareDiffs = dextypes.AuditGenesis(dexGenesis, bankGenesis, dexAddr)
+	require.Len(t, balanceDiffs, 2)
+	require.Equal(t, "TokenB", balanceDiffs[1].Denom)
+	require.True(t, balanceDiffs[1].IsDeficit())
+	require.Len(t, poolShareDiffs, 1)
+	require.Equal(t, poolDenom, poolShareDiffs[0].Denom)
+
+	// Pending protocol fees are owed to the protocol fee collector
+	bankGenesis.Balances[0].Coins = sdk.NewCoins(sdk.NewInt64Coin("TokenA", 19), sdk.NewInt64Coin("TokenB", 5), sdk.NewInt64Coin(poolDenom, 3))
+	dexGenesis.PendingProtocolFees = sdk.NewCoins(sdk.NewInt64Coin("TokenA", 1))
+	balanceDiffs, _ = dextypes.AuditGenesis(dexGenesis, bankGenesis, dexAddr)
+	require.Empty(t, balanceDiffs)
+
+	dexGenesis.PendingProtocolFees = sdk.NewCoins(sdk.NewInt64Coin("TokenA", 2))
+	balanceDiffs, _ = dextypes.AuditGenesis(dexGenesis, bankGenesis, dexAddr)
+	require.Len(t, balanceDiffs, 1)
+	require.Equal(t, "TokenA", balanceDiffs[0].Denom)
+	require.True(t, balanceDiffs[0].IsDeficit())
+}
@@ -26,6 +26,12 @@ const (
 
 	// TStoreKey defines the transient store key
 	TStoreKey = "transient_dex"
+
+	// ProtocolFeeCollectorName defines the module account that collects the protocol fees taken on swaps
+	ProtocolFeeCollectorName = "dex_fee_collector"
+
+	// IncentivesModuleName defines the module account that holds Gauge rewards and staked pool shares
+	IncentivesModuleName = "dex_incentives"
 )
 
 const (
@@ -41,6 +47,9 @@ const (
 	// LimitOrderExpirationKeyPrefix is the prefix to retrieve all LimitOrderExpiration
 	LimitOrderExpirationKeyPrefix = "LimitOrderExpiration/value/"
 
+	// LimitOrderHeightExpirationKeyPrefix is the prefix to retrieve all LimitOrderHeightExpiration
+	LimitOrderHeightExpirationKeyPrefix = "LimitOrderHeightExpiration/value/"
+
 	// PoolIDKeyPrefix is the prefix to retrieve all PoolIds or retrieve a specific pool by pair+tick+fee
 	PoolIDKeyPrefix = "Pool/id/"
 
@@ -55,6 +64,125 @@ const (
 
 	// JITPerBlock is the key to retrieve the number of JIT limit orders place in a single block
 	JITsInBlockKey = "JITsInBlock/count/"
+
+	// PriceAccumulatorKeyPrefix is the prefix to retrieve all PriceAccumulators
+	PriceAccumulatorKeyPrefix = "PriceAccumulator/value/"
+
+	// DirtyPriceAccumulatorKeyPrefix is the transient store prefix for TradePairIDs whose liquidity changed in the current block
+	DirtyPriceAccumulatorKeyPrefix = "PriceAccumulator/dirty/"
+
+	// PriceAccumulatorPairKeyPrefix is the prefix to retrieve all TradePairIDs with PriceAccumulators
+	PriceAccumulatorPairKeyPrefix = "PriceAccumulator/pair/"
+
+	// PriceAccumulatorCursorKey is the key of the last TradePairID visited by the PriceAccumulator sweep
+	PriceAccumulatorCursorKey = "PriceAccumulator/cursor/"
+
+	// TriggerOrderKeyPrefix is the prefix to retrieve all TriggerOrders
+	TriggerOrderKeyPrefix = "TriggerOrder/value/"
+
+	// TriggerOrderRefKeyPrefix is the prefix to retrieve a TriggerOrder's key by creator and order key
+	TriggerOrderRefKeyPrefix = "TriggerOrder/ref/"
+
+	// TriggerOrderTradePairKeyPrefix is the prefix to retrieve all TradePairIDs with pending TriggerOrders
+	TriggerOrderTradePairKeyPrefix = "TriggerOrder/pair/"
+
+	// RangePositionKeyPrefix is the prefix to retrieve all RangePositions
+	RangePositionKeyPrefix = "RangePosition/value/"
+
+	// RangePositionCountKeyPrefix is the prefix to retrieve the RangePosition count
+	RangePositionCountKeyPrefix = "RangePosition/count/"
+
+	// PausedPairKeyPrefix is the prefix to retrieve all paused PairIDs
+	PausedPairKeyPrefix = "Paused/pair/"
+
+	// BatchAuctionPairKeyPrefix is the prefix to retrieve all PairIDs in batch auction mode
+	BatchAuctionPairKeyPrefix = "BatchAuction/pair/"
+
+	// BatchAuctionOrderKeyPrefix is the prefix to retrieve all queued BatchAuctionOrders
+	BatchAuctionOrderKeyPrefix = "BatchAuction/order/"
+
+	// BatchAuctionCursorKey is the key of the pair prefix of the last batch auction that was cleared
+	BatchAuctionCursorKey = "BatchAuction/cursor/"
+
+	// AutoWithdrawOrderKeyPrefix is the prefix of the index of auto_withdraw LimitOrderTrancheUsers by TrancheKey
+	AutoWithdrawOrderKeyPrefix = "AutoWithdraw/order/"
+
+	// AutoWithdrawTrancheKeyPrefix is the prefix to retrieve the keys of filled or expired LimitOrderTranches
+	// whose auto_withdraw LimitOrderTrancheUsers are waiting to be withdrawn
+	AutoWithdrawTrancheKeyPrefix = "AutoWithdraw/tranche/"
+
+	// DynamicFeeKeyPrefix is the prefix to retrieve all DynamicFees
+	DynamicFeeKeyPrefix = "DynamicFee/value/"
+
+	// DirtyDynamicFeeKeyPrefix is the transient store prefix for PairIDs whose tick moved in the current block
+	DirtyDynamicFeeKeyPrefix = "DynamicFee/dirty/"
+
+	// FilledTrancheKeyPrefix is the transient store prefix for tranches filled in the current block whose
+	// AfterTrancheFilled hooks have not yet been called
+	FilledTrancheKeyPrefix = "LimitOrderTranche/filled/"
+
+	// QueuedHookKeyPrefix is the transient store prefix for AfterSwap, AfterDeposit and AfterWithdraw hook calls of
+	// the current block that have not yet been made, keyed by their sequence in the block
+	QueuedHookKeyPrefix = "DexHook/queued/"
+
+	// QueuedHookCountKey is the transient store key of the number of hook calls queued in the current block
+	QueuedHookCountKey = "DexHook/count/"
+
+	// HookFailureKeyPrefix is the prefix to retrieve the number of consecutive failed calls to a hook contract
+	HookFailureKeyPrefix = "DexHook/failures/"
+
+	// PausedDenomKeyPrefix is the prefix to retrieve all paused denoms
+	PausedDenomKeyPrefix = "Paused/denom/"
+
+	// CircuitBreakerKeyPrefix is the prefix to retrieve all CircuitBreakers
+	CircuitBreakerKeyPrefix = "CircuitBreaker/value/"
+
+	// PeggedOrderKeyPrefix is the prefix to retrieve all PeggedOrders
+	PeggedOrderKeyPrefix = "PeggedOrder/value/"
+
+	// PeggedOrderCursorKey is the key of the PeggedOrder at which BeginBlock resumes moving PeggedOrders
+	PeggedOrderCursorKey = "PeggedOrder/cursor/"
+
+	// ProtocolFeesKeyPrefix is the prefix to retrieve all PairProtocolFees
+	ProtocolFeesKeyPrefix = "ProtocolFee/value/"
+
+	// PendingProtocolFeeKeyPrefix is the prefix for accrued protocol fees that have not yet been sent to the
+	// protocol fee collector
+	PendingProtocolFeeKeyPrefix = "ProtocolFee/pending/"
+
+	// GaugeKeyPrefix is the prefix to retrieve all Gauges
+	GaugeKeyPrefix = "Gauge/value/"
+
+	// GaugeCountKeyPrefix is the prefix to retrieve the Gauge count
+	GaugeCountKeyPrefix = "Gauge/count/"
+
+	// GaugeDistributionCursorKey is the key of the GaugeDistribution in progress. It is only set while the
+	// distribution of an epoch is spread over multiple blocks.
+	GaugeDistributionCursorKey = "Gauge/cursor/"
+
+	// StakeKeyPrefix is the prefix to retrieve all Stakes
+	StakeKeyPrefix = "Stake/value/"
+
+	// StakePairKeyPrefix is the prefix of the index of Stakes by the pairs of their shares
+	StakePairKeyPrefix = "Stake/pair/"
+
+	// StakeCountKeyPrefix is the prefix to retrieve the Stake count
+	StakeCountKeyPrefix = "Stake/count/"
+
+	// StakerRewardsKeyPrefix is the prefix to retrieve all StakerRewards
+	StakerRewardsKeyPrefix = "StakerRewards/value/"
+
+	// PendingCandleKeyPrefix is the transient store prefix for the candles of the swaps executed in the current block
+	PendingCandleKeyPrefix = "Candle/pending/"
+
+	// CandleKeyPrefix is the candle store prefix to retrieve all Candles
+	CandleKeyPrefix = "Candle/value/"
+
+	// CandleTimeKeyPrefix is the candle store prefix of the index of Candles by start time
+	CandleTimeKeyPrefix = "Candle/time/"
+
+	// CandleHeightKey is the candle store key of the last block height written to the candle store
+	CandleHeightKey = "Candle/height/"
 )
 
 func KeyPrefix(p string) []byte {
@@ -159,6 +287,132 @@ func LimitOrderExpirationKey(
 	return key
 }
 
+func LimitOrderHeightExpirationKey(
+	goodTilHeight uint64,
+	trancheRef []byte,
+) []byte {
+	var key []byte
+
+	key = append(key, sdk.Uint64ToBigEndian(goodTilHeight)...)
+	key = append(key, []byte("/")...)
+
+	key = append(key, trancheRef...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func TradePairIDKey(tradePairID *TradePairID) []byte {
+	key := KeyPrefix(tradePairID.MustPairID().CanonicalString())
+	key = append(key, KeyPrefix(tradePairID.MakerDenom)...)
+
+	return key
+}
+
+func PriceAccumulatorPrefix(tradePairID *TradePairID) []byte {
+	return append(KeyPrefix(PriceAccumulatorKeyPrefix), TradePairIDKey(tradePairID)...)
+}
+
+func PriceAccumulatorPairKey(tradePairID *TradePairID) []byte {
+	return append(KeyPrefix(PriceAccumulatorPairKeyPrefix), TradePairIDKey(tradePairID)...)
+}
+
+func PriceAccumulatorKey(tradePairID *TradePairID, timestamp time.Time) []byte {
+	key := PriceAccumulatorPrefix(tradePairID)
+	key = append(key, TimeBytes(timestamp)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func TriggerOrderPrefix(tradePairID *TradePairID, orderType LimitOrderType) []byte {
+	key := KeyPrefix(TriggerOrderKeyPrefix)
+	key = append(key, TradePairIDKey(tradePairID)...)
+	key = append(key, KeyPrefix(orderType.String())...)
+
+	return key
+}
+
+func TriggerOrderKey(
+	tradePairID *TradePairID,
+	orderType LimitOrderType,
+	triggerTickIndexInToOut int64,
+	orderKey string,
+) []byte {
+	key := TriggerOrderPrefix(tradePairID, orderType)
+	key = append(key, TickIndexToBytes(triggerTickIndexInToOut)...)
+	key = append(key, []byte("/")...)
+	key = append(key, KeyPrefix(orderKey)...)
+
+	return key
+}
+
+func TriggerOrderRefKey(address, orderKey string) []byte {
+	key := KeyPrefix(TriggerOrderRefKeyPrefix)
+	key = append(key, KeyPrefix(address)...)
+	key = append(key, KeyPrefix(orderKey)...)
+
+	return key
+}
+
+func TriggerOrderRefAddressPrefix(address string) []byte {
+	return append(KeyPrefix(TriggerOrderRefKeyPrefix), KeyPrefix(address)...)
+}
+
+func PeggedOrderAddressPrefix(address string) []byte {
+	return append(KeyPrefix(PeggedOrderKeyPrefix), KeyPrefix(address)...)
+}
+
+func PeggedOrderKey(address, trancheKey string) []byte {
+	return append(PeggedOrderAddressPrefix(address), KeyPrefix(trancheKey)...)
+}
+
+func RangePositionOwnerPrefix(owner string) []byte {
+	return append(KeyPrefix(RangePositionKeyPrefix), KeyPrefix(owner)...)
+}
+
+func RangePositionKey(owner string, id uint64) []byte {
+	key := RangePositionOwnerPrefix(owner)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func GaugeKey(id uint64) []byte {
+	key := KeyPrefix(GaugeKeyPrefix)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func StakeOwnerPrefix(owner string) []byte {
+	return append(KeyPrefix(StakeKeyPrefix), KeyPrefix(owner)...)
+}
+
+func StakeKey(owner string, id uint64) []byte {
+	key := StakeOwnerPrefix(owner)
+	key = append(key, sdk.Uint64ToBigEndian(id)...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+func StakePairPrefix(pairID *PairID) []byte {
+	return append(KeyPrefix(StakePairKeyPrefix), KeyPrefix(pairID.CanonicalString())...)
+}
+
+// StakePairKey is the key of a Stake in the index of its pair. Keys are ordered by Stake id so that Gauges can page
+// through the Stakes of a pair.
+func StakePairKey(pairID *PairID, id uint64) []byte {
+	return append(StakePairPrefix(pairID), sdk.Uint64ToBigEndian(id)...)
+}
+
+func StakerRewardsKey(address string) []byte {
+	return append(KeyPrefix(StakerRewardsKeyPrefix), KeyPrefix(address)...)
+}
+
 func PoolIDKey(
 	pairID *PairID,
 	tickIndex int64,
@@ -190,7 +444,103 @@ func JITGoodTilTime() time.Time {
 
 const (
 	ExpiringLimitOrderGas = 10_000
+	TriggerOrderGas       = 30_000
+	PeggedOrderGas        = 30_000
+	BatchAuctionOrderGas  = 30_000
+	AutoWithdrawOrderGas  = 30_000
 )
 
+// PriceAccumulatorRetention is the maximum age of a PriceAccumulator snapshot before it is pruned.
+// It bounds the lookback window of TWAP queries.
+const PriceAccumulatorRetention = 7 * 24 * time.Hour
+
+// PriceAccumulatorSweepLimit is the maximum number of TradePairIDs without liquidity updates whose PriceAccumulators
+// are advanced and pruned per block. The sweep resumes from where it stopped in the next block.
+const PriceAccumulatorSweepLimit = 100
+
+// MultiHopSplitChunks is the number of equal portions amountIn is divided into when a MultiHopSwap is split across routes.
+// Each portion is allocated to the route with the best marginal output.
+const MultiHopSplitChunks = 10
+
 // Dummy Address used for simulate queries
 const DummyAddress = "neutron1pq7j6za5zjcl3um9t5gfyleues336tv04tyq0k"
+
+// MaxRangePositionPools is the maximum number of pools a single RangePosition can span.
+const MaxRangePositionPools = 100
+
+// MaxHookContractFailures is the number of consecutive failed calls after which the hook contract is no longer called.
+// Setting Params.HookContract again re-enables it.
+const MaxHookContractFailures = 10
+
+func HookFailureKey(contract string) []byte {
+	return append(KeyPrefix(HookFailureKeyPrefix), KeyPrefix(contract)...)
+}
+
+func CircuitBreakerKey(tradePairID *TradePairID) []byte {
+	return append(KeyPrefix(CircuitBreakerKeyPrefix), TradePairIDKey(tradePairID)...)
+}
+
+func BatchAuctionOrderPairPrefix(pairID *PairID) []byte {
+	return append(KeyPrefix(BatchAuctionOrderKeyPrefix), KeyPrefix(pairID.CanonicalString())...)
+}
+
+func BatchAuctionOrderKey(pairID *PairID, orderKey string) []byte {
+	return append(BatchAuctionOrderPairPrefix(pairID), KeyPrefix(orderKey)...)
+}
+
+func AutoWithdrawOrderTranchePrefix(trancheKey string) []byte {
+	return append(KeyPrefix(AutoWithdrawOrderKeyPrefix), KeyPrefix(trancheKey)...)
+}
+
+func AutoWithdrawOrderKey(trancheKey, address string) []byte {
+	return append(AutoWithdrawOrderTranchePrefix(trancheKey), KeyPrefix(address)...)
+}
+
+func AutoWithdrawTrancheKey(trancheKey string) []byte {
+	return append(KeyPrefix(AutoWithdrawTrancheKeyPrefix), KeyPrefix(trancheKey)...)
+}
+
+func DynamicFeeKey(pairID *PairID) []byte {
+	return append(KeyPrefix(DynamicFeeKeyPrefix), KeyPrefix(pairID.CanonicalString())...)
+}
+
+func ProtocolFeesKey(pairID *PairID) []byte {
+	return append(KeyPrefix(ProtocolFeesKeyPrefix), KeyPrefix(pairID.CanonicalString())...)
+}
+
+// MaxCandlesPerQuery is the maximum number of candles returned by a single Candles query.
+const MaxCandlesPerQuery = 1000
+
+const (
+	// DefaultOrderBookDepth is the number of price levels returned by an OrderBook query that does not set depth.
+	DefaultOrderBookDepth = 20
+	// MaxOrderBookDepth is the maximum number of price levels returned by a single OrderBook query.
+	MaxOrderBookDepth = 500
+)
+
+func CandlePairPrefix(pairID *PairID) []byte {
+	return append(KeyPrefix(CandleKeyPrefix), KeyPrefix(pairID.CanonicalString())...)
+}
+
+func CandleKey(pairID *PairID, startTime int64) []byte {
+	return append(CandlePairPrefix(pairID), sdk.Uint64ToBigEndian(uint64(startTime))...) //nolint:gosec
+}
+
+func CandleTimeKey(startTime int64, pairID *PairID) []byte {
+	key := KeyPrefix(CandleTimeKeyPrefix)
+	key = append(key, sdk.Uint64ToBigEndian(uint64(startTime))...) //nolint:gosec
+	key = append(key, KeyPrefix(pairID.CanonicalString())...)
+
+	return key
+}
+
+const (
+	// DefaultFindRoutesMaxHops is the maximum number of swaps in a route returned by a FindRoutes query that does not set max_hops.
+	DefaultFindRoutesMaxHops = 3
+	// MaxFindRoutesMaxHops is the largest max_hops accepted by a FindRoutes query.
+	MaxFindRoutesMaxHops = 4
+	// MaxFindRoutesCandidates is the maximum number of routes simulated by a single FindRoutes query.
+	MaxFindRoutesCandidates = 50
+	// MaxFindRoutesExploredPaths is the maximum number of partial routes explored by a single FindRoutes query.
+	MaxFindRoutesExploredPaths = 10_000
+)
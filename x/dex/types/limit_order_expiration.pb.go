@@ -80,8 +80,62 @@ func (m *LimitOrderExpiration) GetTrancheRef() []byte {
 	return nil
 }
 
+type LimitOrderHeightExpiration struct {
+	// see limitOrderTranche.proto for details on expiration_height
+	ExpirationHeight uint64 `protobuf:"varint,1,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	TrancheRef       []byte `protobuf:"bytes,2,opt,name=tranche_ref,json=trancheRef,proto3" json:"tranche_ref,omitempty"`
+}
+
+func (m *LimitOrderHeightExpiration) Reset()         { *m = LimitOrderHeightExpiration{} }
+func (m *LimitOrderHeightExpiration) String() string { return proto.CompactTextString(m) }
+func (*LimitOrderHeightExpiration) ProtoMessage()    {}
+func (*LimitOrderHeightExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_61264397cad6ae82, []int{1}
+}
+func (m *LimitOrderHeightExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LimitOrderHeightExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LimitOrderHeightExpiration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LimitOrderHeightExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LimitOrderHeightExpiration.Merge(m, src)
+}
+func (m *LimitOrderHeightExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *LimitOrderHeightExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_LimitOrderHeightExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LimitOrderHeightExpiration proto.InternalMessageInfo
+
+func (m *LimitOrderHeightExpiration) GetExpirationHeight() uint64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *LimitOrderHeightExpiration) GetTrancheRef() []byte {
+	if m != nil {
+		return m.TrancheRef
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LimitOrderExpiration)(nil), "neutron.dex.LimitOrderExpiration")
+	proto.RegisterType((*LimitOrderHeightExpiration)(nil), "neutron.dex.LimitOrderHeightExpiration")
 }
 
 func init() {
@@ -89,24 +143,26 @@ func init() {
 }
 
 var fileDescriptor_61264397cad6ae82 = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc8, 0x4b, 0x2d, 0x2d,
-	0x29, 0xca, 0xcf, 0xd3, 0x4f, 0x49, 0xad, 0xd0, 0xcf, 0xc9, 0xcc, 0xcd, 0x2c, 0x89, 0xcf, 0x2f,
-	0x4a, 0x49, 0x2d, 0x8a, 0x4f, 0xad, 0x28, 0xc8, 0x2c, 0x4a, 0x2c, 0xc9, 0xcc, 0xcf, 0xd3, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0xaa, 0xd4, 0x4b, 0x49, 0xad, 0x90, 0x12, 0x49, 0xcf,
-	0x4f, 0xcf, 0x07, 0x8b, 0xeb, 0x83, 0x58, 0x10, 0x25, 0x52, 0xf2, 0xe9, 0xf9, 0xf9, 0xe9, 0x39,
-	0xa9, 0xfa, 0x60, 0x5e, 0x52, 0x69, 0x9a, 0x7e, 0x49, 0x66, 0x6e, 0x6a, 0x71, 0x49, 0x62, 0x6e,
-	0x01, 0x44, 0x81, 0x52, 0x1b, 0x23, 0x97, 0x88, 0x0f, 0xc8, 0x12, 0x7f, 0x90, 0x1d, 0xae, 0x70,
-	0x2b, 0x84, 0x7c, 0xb9, 0xf8, 0x11, 0x16, 0xc6, 0x83, 0xb4, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70,
-	0x1b, 0x49, 0xe9, 0x41, 0xcc, 0xd4, 0x83, 0x99, 0xa9, 0x17, 0x02, 0x33, 0xd3, 0x89, 0xe3, 0xc4,
-	0x3d, 0x79, 0x86, 0x09, 0xf7, 0xe5, 0x19, 0x83, 0xf8, 0x10, 0x9a, 0x41, 0xd2, 0x42, 0xf2, 0x5c,
-	0xdc, 0x25, 0x45, 0x89, 0x79, 0xc9, 0x19, 0xa9, 0xf1, 0x45, 0xa9, 0x69, 0x12, 0x4c, 0x0a, 0x8c,
-	0x1a, 0x3c, 0x41, 0x5c, 0x50, 0xa1, 0xa0, 0xd4, 0x34, 0x27, 0xf7, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x87, 0xfa, 0x58, 0x37, 0xbf, 0x28, 0x1d, 0xc6, 0xd6, 0x2f, 0x33, 0xd5, 0xaf, 0x00, 0x07,
-	0x56, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x5d, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xb0, 0xba, 0xc8, 0x12, 0x48, 0x01, 0x00, 0x00,
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xcf, 0x4a, 0xc3, 0x30,
+	0x18, 0x6f, 0x44, 0x44, 0x32, 0xf1, 0xcf, 0xd8, 0x61, 0xf4, 0x90, 0x8e, 0x9d, 0x06, 0xb2, 0x04,
+	0x14, 0x5f, 0x60, 0x20, 0x7a, 0x50, 0x84, 0xe2, 0xc9, 0x4b, 0x69, 0xd7, 0xaf, 0x69, 0x64, 0x6d,
+	0x4a, 0x96, 0x4a, 0x7d, 0x01, 0xcf, 0x7b, 0xac, 0x1d, 0x77, 0xf4, 0xa4, 0xd2, 0xbe, 0x88, 0x34,
+	0x6d, 0xed, 0x6e, 0xde, 0xbe, 0x7c, 0xbf, 0xbf, 0x7c, 0xc1, 0xb3, 0x14, 0x72, 0xad, 0x64, 0xca,
+	0x42, 0x28, 0xd8, 0x4a, 0x24, 0x42, 0x7b, 0x52, 0x85, 0xa0, 0x3c, 0x28, 0x32, 0xa1, 0x7c, 0x2d,
+	0x64, 0x4a, 0x33, 0x25, 0xb5, 0x1c, 0x0e, 0x5a, 0x26, 0x0d, 0xa1, 0xb0, 0x47, 0x5c, 0x72, 0x69,
+	0xf6, 0xac, 0x9e, 0x1a, 0x8a, 0xed, 0x70, 0x29, 0xf9, 0x0a, 0x98, 0x79, 0x05, 0x79, 0xc4, 0xb4,
+	0x48, 0x60, 0xad, 0xfd, 0x24, 0x6b, 0x08, 0xd3, 0x0f, 0x84, 0x47, 0x0f, 0x75, 0xc8, 0x53, 0x9d,
+	0x71, 0xfb, 0x17, 0x31, 0x7c, 0xc4, 0x67, 0x7d, 0xa0, 0x57, 0xcb, 0xc6, 0x68, 0x82, 0x66, 0x83,
+	0x2b, 0x9b, 0x36, 0x9e, 0xb4, 0xf3, 0xa4, 0xcf, 0x9d, 0xe7, 0xe2, 0x78, 0xfb, 0xe5, 0x58, 0x9b,
+	0x6f, 0x07, 0xb9, 0xa7, 0xbd, 0xb8, 0x86, 0x87, 0x0e, 0x1e, 0x68, 0xe5, 0xa7, 0xcb, 0x18, 0x3c,
+	0x05, 0xd1, 0xf8, 0x60, 0x82, 0x66, 0x27, 0x2e, 0x6e, 0x57, 0x2e, 0x44, 0xd3, 0x57, 0x6c, 0xf7,
+	0x3d, 0xee, 0x41, 0xf0, 0x58, 0xef, 0xb5, 0xb9, 0xc4, 0x17, 0x7b, 0x6d, 0x62, 0x03, 0x9b, 0x3e,
+	0x87, 0xee, 0x79, 0x0f, 0x34, 0xb2, 0x7f, 0xb3, 0x16, 0x77, 0xdb, 0x92, 0xa0, 0x5d, 0x49, 0xd0,
+	0x4f, 0x49, 0xd0, 0xa6, 0x22, 0xd6, 0xae, 0x22, 0xd6, 0x67, 0x45, 0xac, 0x97, 0x39, 0x17, 0x3a,
+	0xce, 0x03, 0xba, 0x94, 0x09, 0x6b, 0xaf, 0x3b, 0x97, 0x8a, 0x77, 0x33, 0x7b, 0xbb, 0x61, 0x85,
+	0xf9, 0x18, 0xfd, 0x9e, 0xc1, 0x3a, 0x38, 0x32, 0x37, 0xb8, 0xfe, 0x1d, 0x00, 0xcc, 0xfc, 0x36,
+	0x3a, 0xb4, 0x01, 0x00, 0x00,
 }
 
 func (m *LimitOrderExpiration) Marshal() (dAtA []byte, err error) {
@@ -147,6 +203,41 @@ func (m *LimitOrderExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LimitOrderHeightExpiration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LimitOrderHeightExpiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LimitOrderHeightExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrancheRef) > 0 {
+		i -= len(m.TrancheRef)
+		copy(dAtA[i:], m.TrancheRef)
+		i = encodeVarintLimitOrderExpiration(dAtA, i, uint64(len(m.TrancheRef)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintLimitOrderExpiration(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLimitOrderExpiration(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLimitOrderExpiration(v)
 	base := offset
@@ -173,6 +264,22 @@ func (m *LimitOrderExpiration) Size() (n int) {
 	return n
 }
 
+func (m *LimitOrderHeightExpiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovLimitOrderExpiration(uint64(m.ExpirationHeight))
+	}
+	l = len(m.TrancheRef)
+	if l > 0 {
+		n += 1 + l + sovLimitOrderExpiration(uint64(l))
+	}
+	return n
+}
+
 func sovLimitOrderExpiration(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -296,6 +403,109 @@ func (m *LimitOrderExpiration) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *LimitOrderHeightExpiration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLimitOrderExpiration
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LimitOrderHeightExpiration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LimitOrderHeightExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrderExpiration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheRef", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrderExpiration
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLimitOrderExpiration
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrderExpiration
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheRef = append(m.TrancheRef[:0], dAtA[iNdEx:postIndex]...)
+			if m.TrancheRef == nil {
+				m.TrancheRef = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLimitOrderExpiration(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLimitOrderExpiration
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLimitOrderExpiration(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -88,7 +88,7 @@ func (t LimitOrderTranche) IsFilled() bool {
 }
 
 func (t LimitOrderTranche) HasExpiration() bool {
-	return t.ExpirationTime != nil
+	return t.ExpirationTime != nil || t.ExpirationHeight != 0
 }
 
 func (t LimitOrderTranche) IsJIT() bool {
@@ -96,6 +96,9 @@ func (t LimitOrderTranche) IsJIT() bool {
 }
 
 func (t LimitOrderTranche) IsExpired(ctx sdk.Context) bool {
+	if t.ExpirationHeight != 0 {
+		return t.ExpirationHeight <= uint64(ctx.BlockHeight()) //nolint:gosec
+	}
 	return t.ExpirationTime != nil && !t.IsJIT() && !t.ExpirationTime.After(ctx.BlockTime())
 }
 
@@ -166,6 +169,39 @@ func (t *LimitOrderTranche) Withdraw(trancheUser *LimitOrderTrancheUser) (shares
 	return amountOutTokenIn, amountOutTokenOut
 }
 
+// AccruedMakerRebate returns the maker rebate owed to the tranche's makers that has not yet been withdrawn
+func (t LimitOrderTranche) AccruedMakerRebate() math.Int {
+	if t.MakerRebate == nil {
+		return math.ZeroInt()
+	}
+
+	return *t.MakerRebate
+}
+
+func (t *LimitOrderTranche) AccrueMakerRebate(amount math.Int) {
+	if !amount.IsPositive() {
+		return
+	}
+
+	rebate := t.AccruedMakerRebate().Add(amount)
+	t.MakerRebate = &rebate
+}
+
+// WithdrawMakerRebate removes the share of the accrued maker rebate earned by takerAmountOut, which must already have
+// been withdrawn from ReservesTakerDenom.
+func (t *LimitOrderTranche) WithdrawMakerRebate(takerAmountOut math.Int) math.Int {
+	accrued := t.AccruedMakerRebate()
+	if !accrued.IsPositive() || !takerAmountOut.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	rebate := accrued.Mul(takerAmountOut).Quo(t.ReservesTakerDenom.Add(takerAmountOut))
+	remaining := accrued.Sub(rebate)
+	t.MakerRebate = &remaining
+
+	return rebate
+}
+
 func (t *LimitOrderTranche) Swap(maxAmountTakerIn math.Int, maxAmountMakerOut *math.Int) (
 	inAmount math.Int,
 	outAmount math.Int,
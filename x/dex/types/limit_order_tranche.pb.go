@@ -103,6 +103,10 @@ type LimitOrderTranche struct {
 	PriceTakerToMaker github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,7,opt,name=price_taker_to_maker,json=priceTakerToMaker,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"price_taker_to_maker" yaml:"price_taker_to_maker"` // Deprecated: Do not use.
 	// This is the price of the LimitOrder denominated in the opposite token. (ie. 1 TokenA with a maker_price of 10 is worth 10 TokenB )
 	MakerPrice github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,8,opt,name=maker_price,json=makerPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"maker_price" yaml:"maker_price"`
+	// LimitOrders with expiration_height set are valid as long as blockHeight < expiration_height
+	ExpirationHeight uint64 `protobuf:"varint,9,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// Maker rebates, denominated in the taker denom, that have accrued from fills and not yet been withdrawn
+	MakerRebate *cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=maker_rebate,json=makerRebate,proto3,customtype=cosmossdk.io/math.Int" json:"maker_rebate" yaml:"maker_rebate"`
 }
 
 func (m *LimitOrderTranche) Reset()         { *m = LimitOrderTranche{} }
@@ -152,6 +156,13 @@ func (m *LimitOrderTranche) GetExpirationTime() *time.Time {
 	return nil
 }
 
+func (m *LimitOrderTranche) GetExpirationHeight() uint64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*LimitOrderTrancheKey)(nil), "neutron.dex.LimitOrderTrancheKey")
 	proto.RegisterType((*LimitOrderTranche)(nil), "neutron.dex.LimitOrderTranche")
@@ -162,45 +173,48 @@ func init() {
 }
 
 var fileDescriptor_8c2ded67c80756d1 = []byte{
-	// 601 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x4f, 0x8b, 0xd3, 0x4e,
-	0x18, 0xc7, 0x3b, 0xbf, 0xfe, 0xdc, 0x3f, 0x53, 0x74, 0xd9, 0xd0, 0x85, 0xec, 0x0a, 0xc9, 0x1a,
-	0x10, 0xf6, 0xb2, 0x09, 0xb8, 0x0a, 0x22, 0x9e, 0x96, 0x82, 0x14, 0xad, 0x96, 0x90, 0x93, 0x97,
-	0x90, 0x26, 0x63, 0x3a, 0xb4, 0xc9, 0x84, 0xc9, 0xd3, 0xa5, 0xf5, 0xe8, 0xc1, 0xf3, 0xbe, 0x0a,
-	0xaf, 0xbe, 0x8d, 0xde, 0xdc, 0xa3, 0x78, 0x88, 0xd2, 0xde, 0x3c, 0xf6, 0x15, 0xc8, 0x4c, 0xda,
-	0x6d, 0x62, 0xa3, 0xcb, 0xe2, 0xa9, 0x33, 0xcf, 0xf7, 0x3b, 0x79, 0x3e, 0xcf, 0x3c, 0xf3, 0x14,
-	0x3f, 0x8c, 0xc9, 0x08, 0x38, 0x8b, 0xad, 0x80, 0x8c, 0xad, 0x21, 0x8d, 0x28, 0xb8, 0x8c, 0x07,
-	0x84, 0xbb, 0xc0, 0xbd, 0xd8, 0xef, 0x13, 0x33, 0xe1, 0x0c, 0x98, 0xd2, 0x58, 0xda, 0xcc, 0x80,
-	0x8c, 0x8f, 0x9a, 0x21, 0x0b, 0x99, 0x8c, 0x5b, 0x62, 0x95, 0x5b, 0x8e, 0xf4, 0x90, 0xb1, 0x70,
-	0x48, 0x2c, 0xb9, 0xeb, 0x8d, 0xde, 0x59, 0x40, 0x23, 0x92, 0x82, 0x17, 0x25, 0x4b, 0xc3, 0x61,
-	0x31, 0x55, 0xe2, 0x51, 0xee, 0xd2, 0x60, 0x75, 0xb6, 0x28, 0x01, 0xf7, 0x02, 0xe2, 0x96, 0x0c,
-	0xc6, 0x67, 0x84, 0x9b, 0xaf, 0x04, 0xdd, 0x1b, 0x01, 0xe7, 0xe4, 0x6c, 0x2f, 0xc9, 0x44, 0x79,
-	0x8e, 0xef, 0x96, 0xfc, 0x2a, 0x3a, 0x46, 0x27, 0x8d, 0x47, 0xaa, 0x59, 0x00, 0x36, 0x1d, 0xe1,
-	0xe8, 0x7a, 0x94, 0xb7, 0x5b, 0x76, 0x03, 0xae, 0x37, 0x81, 0xf2, 0x14, 0x1f, 0x02, 0xf5, 0x07,
-	0x2e, 0x8d, 0x03, 0x32, 0x76, 0xc1, 0x1b, 0x88, 0xc2, 0x99, 0x1b, 0x89, 0x85, 0xfa, 0xdf, 0x31,
-	0x3a, 0xa9, 0xdb, 0x07, 0xc2, 0xd0, 0x16, 0xba, 0x23, 0xa2, 0x0e, 0xeb, 0x88, 0x1f, 0x45, 0xc7,
-	0x8d, 0xe5, 0x0d, 0xb9, 0x03, 0x32, 0x51, 0xeb, 0xc7, 0xe8, 0x64, 0xd7, 0xc6, 0x70, 0x0d, 0x66,
-	0x7c, 0xd9, 0xc6, 0xfb, 0x1b, 0xc4, 0xca, 0x19, 0xae, 0x0b, 0x7b, 0x0e, 0xf9, 0xa0, 0x04, 0x59,
-	0x55, 0x9e, 0x2d, 0xdc, 0xca, 0x47, 0x84, 0x9b, 0x9c, 0xa4, 0x84, 0x5f, 0x90, 0x34, 0x67, 0x73,
-	0x03, 0x12, 0xb3, 0x48, 0x12, 0xee, 0x9e, 0x3b, 0xd3, 0x4c, 0xaf, 0x7d, 0xcb, 0xf4, 0x03, 0x9f,
-	0xa5, 0x11, 0x4b, 0xd3, 0x60, 0x60, 0x52, 0x66, 0x45, 0x1e, 0xf4, 0xcd, 0x76, 0x0c, 0x3f, 0x33,
-	0xbd, 0xf2, 0xf0, 0x22, 0xd3, 0xef, 0x4f, 0xbc, 0x68, 0xf8, 0xcc, 0xa8, 0x52, 0x0d, 0x5b, 0x59,
-	0x85, 0x65, 0xbd, 0x2d, 0x11, 0x2c, 0x83, 0x40, 0x01, 0xa4, 0x7e, 0x5b, 0x10, 0xf8, 0x2b, 0x08,
-	0x54, 0x82, 0x38, 0x6b, 0x90, 0xf7, 0x78, 0x1f, 0x18, 0x78, 0xc3, 0xd2, 0x6d, 0xfc, 0x2f, 0x21,
-	0x5e, 0xdf, 0x04, 0xb1, 0x79, 0x72, 0x91, 0xe9, 0x6a, 0x4e, 0xb0, 0x21, 0x19, 0xf6, 0x9e, 0x8c,
-	0x75, 0x2a, 0x72, 0x17, 0x2f, 0xe0, 0xce, 0xad, 0x72, 0xc3, 0x9f, 0x73, 0xc3, 0x66, 0xee, 0x42,
-	0xdd, 0x1d, 0xbc, 0x47, 0xc6, 0x09, 0xe5, 0x1e, 0x50, 0x16, 0xbb, 0x62, 0xc0, 0xd4, 0x2d, 0xf9,
-	0x94, 0x8e, 0xcc, 0x7c, 0xfa, 0xcc, 0xd5, 0xf4, 0x99, 0xce, 0x6a, 0xfa, 0xce, 0x77, 0xa6, 0x99,
-	0x8e, 0x2e, 0xbf, 0xeb, 0xc8, 0xbe, 0xb7, 0x3e, 0x2c, 0x64, 0xe5, 0x13, 0xc2, 0xcd, 0x84, 0x53,
-	0x9f, 0xfc, 0xfe, 0xf4, 0xb7, 0x65, 0x39, 0xa3, 0x65, 0x39, 0x8f, 0x43, 0x0a, 0xfd, 0x51, 0xcf,
-	0xf4, 0x59, 0x64, 0x2d, 0x5f, 0xec, 0x29, 0xe3, 0xe1, 0x6a, 0x6d, 0x5d, 0x3c, 0xb1, 0x46, 0x40,
-	0x87, 0x69, 0x5e, 0x69, 0x97, 0x13, 0xbf, 0x45, 0x7c, 0xd1, 0xee, 0xaa, 0x6f, 0xaf, 0xdb, 0x5d,
-	0xa5, 0x1a, 0x2a, 0xb2, 0xf7, 0xa5, 0x50, 0x9a, 0xb6, 0x0f, 0x08, 0x37, 0xf2, 0xae, 0x48, 0x4d,
-	0xdd, 0x91, 0x7c, 0xde, 0x3f, 0xf2, 0x15, 0x3f, 0xb9, 0xc8, 0x74, 0x25, 0xc7, 0x2a, 0x04, 0x0d,
-	0x1b, 0xcb, 0x5d, 0x57, 0x6c, 0xce, 0x5f, 0x4c, 0x67, 0x1a, 0xba, 0x9a, 0x69, 0xe8, 0xc7, 0x4c,
-	0x43, 0x97, 0x73, 0xad, 0x76, 0x35, 0xd7, 0x6a, 0x5f, 0xe7, 0x5a, 0xed, 0xed, 0xe9, 0xcd, 0x00,
-	0xe3, 0xfc, 0xaf, 0x6d, 0x92, 0x90, 0xb4, 0xb7, 0x25, 0x9b, 0x74, 0xf6, 0x2b, 0x00, 0x00, 0xff,
-	0xff, 0xda, 0xfd, 0x1f, 0x44, 0x7c, 0x05, 0x00, 0x00,
+	// 652 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc0, 0xb3, 0x5f, 0xfa, 0x95, 0x76, 0x03, 0x94, 0x98, 0x54, 0x72, 0x8b, 0x14, 0x07, 0x4b,
+	0x48, 0x91, 0x50, 0x6d, 0x89, 0x82, 0x84, 0x10, 0xa7, 0x2a, 0x12, 0x54, 0x50, 0xa8, 0x2c, 0x9f,
+	0xb8, 0x58, 0x8e, 0xbd, 0x38, 0xab, 0xc4, 0xde, 0x68, 0x3d, 0xa9, 0x12, 0x8e, 0x1c, 0x38, 0xf7,
+	0x29, 0xb8, 0xf2, 0x1a, 0x3d, 0xf6, 0x88, 0x38, 0x18, 0xd4, 0x8a, 0x0b, 0xc7, 0x3c, 0x01, 0xda,
+	0xdd, 0xa4, 0xb1, 0x89, 0x69, 0x55, 0x71, 0xf2, 0xce, 0xbf, 0x9d, 0xdf, 0xcc, 0xce, 0x18, 0x3f,
+	0x48, 0xc8, 0x08, 0x38, 0x4b, 0xec, 0x90, 0x8c, 0xed, 0x01, 0x8d, 0x29, 0x78, 0x8c, 0x87, 0x84,
+	0x7b, 0xc0, 0xfd, 0x24, 0xe8, 0x11, 0x6b, 0xc8, 0x19, 0x30, 0xad, 0x36, 0x73, 0xb3, 0x42, 0x32,
+	0xde, 0x6e, 0x44, 0x2c, 0x62, 0x52, 0x6f, 0x8b, 0x93, 0x72, 0xd9, 0x36, 0x22, 0xc6, 0xa2, 0x01,
+	0xb1, 0xa5, 0xd4, 0x1d, 0xbd, 0xb7, 0x81, 0xc6, 0x24, 0x05, 0x3f, 0x1e, 0xce, 0x1c, 0xb6, 0xf2,
+	0xa9, 0x86, 0x3e, 0xe5, 0x1e, 0x0d, 0xe7, 0xb1, 0x79, 0x13, 0x70, 0x3f, 0x24, 0x5e, 0xc1, 0xc1,
+	0xfc, 0x82, 0x70, 0xe3, 0xb5, 0xa0, 0x7b, 0x2b, 0xe0, 0x5c, 0xc5, 0xf6, 0x8a, 0x4c, 0xb4, 0xe7,
+	0xf8, 0x56, 0xc1, 0x5f, 0x47, 0x2d, 0xd4, 0xae, 0x3d, 0xd2, 0xad, 0x1c, 0xb0, 0xe5, 0x0a, 0x8f,
+	0x43, 0x9f, 0xf2, 0xfd, 0x8e, 0x53, 0x83, 0x0b, 0x21, 0xd4, 0x9e, 0xe2, 0x2d, 0xa0, 0x41, 0xdf,
+	0xa3, 0x49, 0x48, 0xc6, 0x1e, 0xf8, 0x7d, 0x51, 0x38, 0xf3, 0x62, 0x71, 0xd0, 0xff, 0x6b, 0xa1,
+	0x76, 0xd5, 0xd9, 0x14, 0x0e, 0xfb, 0xc2, 0xee, 0x0a, 0xad, 0xcb, 0x0e, 0xc4, 0x47, 0x33, 0x70,
+	0x6d, 0xd6, 0x21, 0xaf, 0x4f, 0x26, 0x7a, 0xb5, 0x85, 0xda, 0xeb, 0x0e, 0x86, 0x0b, 0x30, 0xf3,
+	0xe7, 0x1a, 0xae, 0x2f, 0x11, 0x6b, 0xbb, 0xb8, 0x2a, 0xdc, 0x15, 0xe4, 0xfd, 0x02, 0x64, 0x59,
+	0x79, 0x8e, 0xf0, 0xd6, 0x3e, 0x21, 0xdc, 0xe0, 0x24, 0x25, 0xfc, 0x88, 0xa4, 0x8a, 0xcd, 0x0b,
+	0x49, 0xc2, 0x62, 0x49, 0xb8, 0xbe, 0xe7, 0x9e, 0x64, 0x46, 0xe5, 0x5b, 0x66, 0x6c, 0x06, 0x2c,
+	0x8d, 0x59, 0x9a, 0x86, 0x7d, 0x8b, 0x32, 0x3b, 0xf6, 0xa1, 0x67, 0xed, 0x27, 0xf0, 0x2b, 0x33,
+	0x4a, 0x83, 0xa7, 0x99, 0x71, 0x6f, 0xe2, 0xc7, 0x83, 0x67, 0x66, 0x99, 0xd5, 0x74, 0xb4, 0xb9,
+	0x5a, 0xd6, 0xdb, 0x11, 0xca, 0x22, 0x08, 0xe4, 0x40, 0xaa, 0xd7, 0x05, 0x81, 0x4b, 0x41, 0xa0,
+	0x14, 0xc4, 0x5d, 0x80, 0x7c, 0xc0, 0x75, 0x60, 0xe0, 0x0f, 0x0a, 0xdd, 0x58, 0x91, 0x10, 0x6f,
+	0xae, 0x82, 0x58, 0x8e, 0x9c, 0x66, 0x86, 0xae, 0x08, 0x96, 0x4c, 0xa6, 0xb3, 0x21, 0x75, 0x07,
+	0x25, 0xb9, 0xf3, 0x0d, 0xf8, 0xff, 0x5a, 0xb9, 0xe1, 0xef, 0xb9, 0x61, 0x39, 0x77, 0xae, 0xee,
+	0x03, 0xbc, 0x41, 0xc6, 0x43, 0xca, 0x7d, 0xa0, 0x2c, 0xf1, 0xc4, 0x82, 0xe9, 0xab, 0x72, 0x94,
+	0xb6, 0x2d, 0xb5, 0x7d, 0xd6, 0x7c, 0xfb, 0x2c, 0x77, 0xbe, 0x7d, 0x7b, 0x6b, 0x27, 0x99, 0x81,
+	0x8e, 0xbf, 0x1b, 0xc8, 0xb9, 0xbd, 0x08, 0x16, 0x66, 0xed, 0x33, 0xc2, 0x8d, 0x21, 0xa7, 0x01,
+	0xf9, 0x73, 0xf4, 0x6f, 0xc8, 0x72, 0x46, 0xb3, 0x72, 0x1e, 0x47, 0x14, 0x7a, 0xa3, 0xae, 0x15,
+	0xb0, 0xd8, 0x9e, 0x4d, 0xec, 0x0e, 0xe3, 0xd1, 0xfc, 0x6c, 0x1f, 0x3d, 0xb1, 0x47, 0x40, 0x07,
+	0xa9, 0xaa, 0xf4, 0x90, 0x93, 0xa0, 0x43, 0x02, 0xf1, 0xdc, 0x65, 0x77, 0x2f, 0x9e, 0xbb, 0xcc,
+	0x6a, 0xea, 0xc8, 0xa9, 0x4b, 0x43, 0x61, 0xdb, 0x3e, 0x22, 0x5c, 0x53, 0xaf, 0x22, 0x6d, 0xfa,
+	0x9a, 0xe4, 0xf3, 0xff, 0x91, 0x2f, 0x7f, 0xe5, 0x34, 0x33, 0x34, 0x85, 0x95, 0x53, 0x9a, 0x0e,
+	0x96, 0xd2, 0xa1, 0x10, 0xb4, 0x87, 0xb8, 0x9e, 0x6b, 0x7e, 0x8f, 0xd0, 0xa8, 0x07, 0xfa, 0x7a,
+	0x0b, 0xb5, 0x57, 0x9c, 0x3b, 0x0b, 0xc3, 0x4b, 0xa9, 0xd7, 0x22, 0x7c, 0x53, 0x5d, 0xc4, 0x49,
+	0xd7, 0x07, 0xa2, 0x63, 0x49, 0xdc, 0x11, 0x4f, 0x71, 0xd9, 0x80, 0x14, 0x82, 0xa6, 0x99, 0x71,
+	0x37, 0xcf, 0xa4, 0xb4, 0xa6, 0xa3, 0xb8, 0x1d, 0x29, 0xed, 0xbd, 0x38, 0x39, 0x6b, 0xa2, 0xd3,
+	0xb3, 0x26, 0xfa, 0x71, 0xd6, 0x44, 0xc7, 0xe7, 0xcd, 0xca, 0xe9, 0x79, 0xb3, 0xf2, 0xf5, 0xbc,
+	0x59, 0x79, 0xb7, 0x73, 0x75, 0x5b, 0xc6, 0xea, 0x87, 0x3b, 0x19, 0x92, 0xb4, 0xbb, 0x2a, 0x47,
+	0x67, 0xf7, 0xf7, 0x00, 0xb7, 0xfd, 0x00, 0x5b, 0x12, 0x06, 0x00, 0x00,
 }
 
 func (m *LimitOrderTrancheKey) Marshal() (dAtA []byte, err error) {
@@ -270,6 +284,23 @@ func (m *LimitOrderTranche) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MakerRebate != nil {
+		{
+			size := m.MakerRebate.Size()
+			i -= size
+			if _, err := m.MakerRebate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintLimitOrderTranche(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintLimitOrderTranche(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MakerPrice.Size()
 		i -= size
@@ -412,6 +443,13 @@ func (m *LimitOrderTranche) Size() (n int) {
 	n += 1 + l + sovLimitOrderTranche(uint64(l))
 	l = m.MakerPrice.Size()
 	n += 1 + l + sovLimitOrderTranche(uint64(l))
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovLimitOrderTranche(uint64(m.ExpirationHeight))
+	}
+	if m.MakerRebate != nil {
+		l = m.MakerRebate.Size()
+		n += 1 + l + sovLimitOrderTranche(uint64(l))
+	}
 	return n
 }
 
@@ -863,6 +901,61 @@ func (m *LimitOrderTranche) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrderTranche
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrderTranche
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLimitOrderTranche
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLimitOrderTranche
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MakerRebate = &v
+			if err := m.MakerRebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLimitOrderTranche(dAtA[iNdEx:])
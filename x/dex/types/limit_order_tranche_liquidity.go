@@ -0,0 +1,69 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+type LimitOrderTrancheLiquidity struct {
+	Tranche        *LimitOrderTranche
+	TakerFeeBps    uint64
+	MakerRebateBps uint64
+	// TakerFee is the amount of taker denom charged on top of the amount swapped by the last call to Swap
+	TakerFee math.Int
+	// MakerRebate is the part of TakerFee that was accrued to the tranche's makers by the last call to Swap
+	MakerRebate math.Int
+}
+
+// Swap swaps against the tranche, reserving enough of maxAmountTakerDenomIn to pay the taker fee.
+// The returned inAmount includes the taker fee.
+func (tl *LimitOrderTrancheLiquidity) Swap(
+	maxAmountTakerDenomIn math.Int,
+	maxAmountMakerDenomOut *math.Int,
+) (inAmount, outAmount math.Int) {
+	maxAmountTrancheIn := AmountInBeforeTakerFee(maxAmountTakerDenomIn, tl.TakerFeeBps)
+	inAmount, outAmount = tl.Tranche.Swap(maxAmountTrancheIn, maxAmountMakerDenomOut)
+
+	tl.TakerFee = CalcLimitOrderTakerFee(inAmount, tl.TakerFeeBps)
+	tl.MakerRebate = math.MinInt(CalcLimitOrderMakerRebate(inAmount, tl.MakerRebateBps), tl.TakerFee)
+	tl.Tranche.AccrueMakerRebate(tl.MakerRebate)
+
+	return inAmount.Add(tl.TakerFee), outAmount
+}
+
+func (tl *LimitOrderTrancheLiquidity) Price() math_utils.PrecDec {
+	return tl.Tranche.Price()
+}
+
+// ProtocolFee is the part of TakerFee taken by the protocol on the last call to Swap
+func (tl *LimitOrderTrancheLiquidity) ProtocolFee() math.Int {
+	return tl.TakerFee.Sub(tl.MakerRebate)
+}
+
+// CalcLimitOrderTakerFee returns the taker fee owed for swapping amountIn against a LimitOrderTranche.
+// The fee is rounded up in favour of the dex.
+func CalcLimitOrderTakerFee(amountIn math.Int, takerFeeBps uint64) math.Int {
+	if takerFeeBps == 0 {
+		return math.ZeroInt()
+	}
+
+	feeNumerator := amountIn.Mul(math.NewIntFromUint64(takerFeeBps))
+	return math_utils.NewPrecDecFromInt(feeNumerator).QuoInt64(10_000).Ceil().TruncateInt()
+}
+
+// CalcLimitOrderMakerRebate returns the maker rebate earned by amountIn being swapped against a LimitOrderTranche.
+// The rebate is rounded down in favour of the dex.
+func CalcLimitOrderMakerRebate(amountIn math.Int, makerRebateBps uint64) math.Int {
+	return amountIn.Mul(math.NewIntFromUint64(makerRebateBps)).QuoRaw(10_000)
+}
+
+// AmountInBeforeTakerFee returns the largest amount that can be swapped against a LimitOrderTranche such that
+// the amount swapped plus its taker fee does not exceed maxAmountIn
+func AmountInBeforeTakerFee(maxAmountIn math.Int, takerFeeBps uint64) math.Int {
+	if takerFeeBps == 0 {
+		return maxAmountIn
+	}
+
+	return maxAmountIn.MulRaw(10_000).Quo(math.NewIntFromUint64(10_000 + takerFeeBps))
+}
@@ -34,6 +34,8 @@ type LimitOrderTrancheUser struct {
 	// TODO: remove this in next release. It is no longer used
 	SharesCancelled cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=shares_cancelled,json=sharesCancelled,proto3,customtype=cosmossdk.io/math.Int" json:"shares_cancelled" yaml:"shares_cancelled"`
 	OrderType       LimitOrderType        `protobuf:"varint,8,opt,name=order_type,json=orderType,proto3,enum=neutron.dex.LimitOrderType" json:"order_type,omitempty"`
+	// If set, proceeds are sent to address once the LimitOrderTranche is filled or expires
+	AutoWithdraw bool `protobuf:"varint,9,opt,name=auto_withdraw,json=autoWithdraw,proto3" json:"auto_withdraw,omitempty"`
 }
 
 func (m *LimitOrderTrancheUser) Reset()         { *m = LimitOrderTrancheUser{} }
@@ -104,6 +106,13 @@ func (m *LimitOrderTrancheUser) GetOrderType() LimitOrderType {
 	return LimitOrderType_GOOD_TIL_CANCELLED
 }
 
+func (m *LimitOrderTrancheUser) GetAutoWithdraw() bool {
+	if m != nil {
+		return m.AutoWithdraw
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*LimitOrderTrancheUser)(nil), "neutron.dex.LimitOrderTrancheUser")
 }
@@ -113,37 +122,38 @@ func init() {
 }
 
 var fileDescriptor_67e5ffbd487ea05f = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4d, 0x6b, 0xdb, 0x4e,
-	0x10, 0xc6, 0xad, 0x7f, 0xf2, 0x4f, 0x9a, 0x55, 0xdf, 0x50, 0x63, 0xba, 0x4d, 0x41, 0x32, 0x3e,
-	0x99, 0x42, 0x24, 0x48, 0x29, 0x94, 0xd0, 0x53, 0x1a, 0x28, 0xa6, 0x29, 0x29, 0xc2, 0xa5, 0xd0,
-	0x8b, 0xd8, 0x68, 0x07, 0x69, 0xb1, 0xa5, 0x15, 0xbb, 0xeb, 0x5a, 0xfa, 0x16, 0xfd, 0x58, 0x39,
-	0xe6, 0x58, 0x7a, 0x10, 0xad, 0x7d, 0xeb, 0x31, 0x9f, 0xa0, 0xac, 0xf5, 0x82, 0x44, 0x0e, 0xa5,
-	0x27, 0xcd, 0x3c, 0xcf, 0x33, 0xf3, 0x93, 0xc4, 0xa0, 0x17, 0x29, 0x2c, 0x95, 0xe0, 0xa9, 0x47,
-	0x21, 0xf7, 0x16, 0x2c, 0x61, 0x2a, 0xe0, 0x82, 0x82, 0x08, 0x94, 0x20, 0x69, 0x18, 0x43, 0xb0,
-	0x94, 0x20, 0xdc, 0x4c, 0x70, 0xc5, 0x2d, 0xb3, 0xce, 0xba, 0x14, 0xf2, 0xa3, 0xc3, 0x88, 0x47,
-	0x7c, 0xab, 0x7b, 0xba, 0xaa, 0x22, 0x47, 0x4e, 0x77, 0x9d, 0x12, 0x84, 0x42, 0x90, 0x11, 0x26,
-	0x02, 0x46, 0xeb, 0xc0, 0x61, 0x2f, 0x90, 0x57, 0xea, 0xf8, 0xd7, 0x2e, 0x1a, 0x5e, 0x68, 0xf8,
-	0xa5, 0x66, 0xcf, 0x2a, 0xf4, 0x27, 0x09, 0xc2, 0x7a, 0x83, 0x1e, 0xf4, 0xd6, 0x60, 0x63, 0x64,
-	0x4c, 0xcc, 0x13, 0xec, 0x76, 0xde, 0xc5, 0x9d, 0xe9, 0xc4, 0x47, 0xc2, 0xc4, 0xf4, 0xdc, 0x37,
-	0x55, 0xdb, 0x50, 0xeb, 0x35, 0x7a, 0xa6, 0x58, 0x38, 0x0f, 0x58, 0x4a, 0x21, 0x0f, 0x14, 0x99,
-	0xeb, 0x0f, 0xe3, 0x41, 0xa2, 0x0b, 0xfc, 0xdf, 0xc8, 0x98, 0xec, 0xf8, 0x43, 0x1d, 0x98, 0x6a,
-	0x7f, 0xa6, 0xd5, 0x19, 0xff, 0xa0, 0x1f, 0x96, 0x83, 0xcc, 0xe6, 0x0f, 0xcc, 0xa1, 0xc0, 0x3b,
-	0x23, 0x63, 0x72, 0xe0, 0xa3, 0x5a, 0x7a, 0x0f, 0x85, 0x85, 0xd1, 0x3e, 0xa1, 0x54, 0x80, 0x94,
-	0x78, 0x77, 0x6b, 0x36, 0xad, 0x15, 0xa1, 0xfb, 0x32, 0x26, 0x02, 0x64, 0xc0, 0x57, 0x29, 0x50,
-	0xfc, 0xbf, 0xb6, 0xcf, 0xce, 0xaf, 0x4b, 0x67, 0xf0, 0xa3, 0x74, 0x86, 0x21, 0x97, 0x09, 0x97,
-	0x92, 0xce, 0x5d, 0xc6, 0xbd, 0x84, 0xa8, 0xd8, 0x9d, 0xa6, 0xea, 0x77, 0xe9, 0xf4, 0x86, 0x6e,
-	0x4b, 0xe7, 0x49, 0x41, 0x92, 0xc5, 0xe9, 0xb8, 0xab, 0x8e, 0x7d, 0xb3, 0x6a, 0x2f, 0x75, 0x67,
-	0xad, 0xd0, 0xe3, 0xda, 0x5d, 0x31, 0x15, 0x53, 0x41, 0x56, 0x29, 0xde, 0xdb, 0xc2, 0x2e, 0xfe,
-	0x06, 0xbb, 0x33, 0x78, 0x5b, 0x3a, 0x4f, 0x7b, 0xc0, 0xd6, 0x19, 0xfb, 0x8f, 0x2a, 0xe9, 0x73,
-	0xa3, 0x74, 0xc0, 0x21, 0x49, 0x43, 0x58, 0x2c, 0x80, 0xe2, 0xfd, 0x7f, 0x03, 0xb7, 0x83, 0x77,
-	0xc0, 0xad, 0xd3, 0x82, 0xdf, 0x36, 0x8a, 0x75, 0x8a, 0x50, 0x7d, 0x9d, 0x45, 0x06, 0xf8, 0xde,
-	0xc8, 0x98, 0x3c, 0x3c, 0x79, 0xde, 0x3b, 0x85, 0xce, 0x15, 0x15, 0x19, 0xf8, 0x07, 0xbc, 0x29,
-	0xcf, 0xde, 0x5d, 0xaf, 0x6d, 0xe3, 0x66, 0x6d, 0x1b, 0x3f, 0xd7, 0xb6, 0xf1, 0x6d, 0x63, 0x0f,
-	0x6e, 0x36, 0xf6, 0xe0, 0xfb, 0xc6, 0x1e, 0x7c, 0x39, 0x8e, 0x98, 0x8a, 0x97, 0x57, 0x6e, 0xc8,
-	0x13, 0xaf, 0xde, 0x75, 0xcc, 0x45, 0xd4, 0xd4, 0xde, 0xd7, 0x57, 0x5e, 0x5e, 0xdd, 0x6b, 0x91,
-	0x81, 0xbc, 0xda, 0xdb, 0xde, 0xec, 0xcb, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2d, 0xc2, 0x2d,
-	0x0c, 0x3b, 0x03, 0x00, 0x00,
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x37, 0xd6, 0xfe, 0xd9, 0x49, 0xab, 0x12, 0xbb, 0x38, 0x56, 0x48, 0xc2, 0x7a, 0x09,
+	0x42, 0x13, 0xa8, 0x08, 0x52, 0x3c, 0xd5, 0x82, 0x2c, 0x56, 0x2a, 0x61, 0x45, 0xf0, 0x12, 0xa6,
+	0x99, 0x21, 0x19, 0x76, 0x93, 0x09, 0x33, 0xb3, 0x6e, 0xf2, 0x05, 0x3c, 0xfb, 0xb1, 0x7a, 0xec,
+	0x51, 0x3c, 0x04, 0xd9, 0xbd, 0x79, 0xec, 0x27, 0x90, 0xd9, 0xfc, 0x21, 0xa1, 0x07, 0xe9, 0x69,
+	0xdf, 0xf7, 0x79, 0x9e, 0x77, 0x7e, 0xfb, 0x86, 0x17, 0xbc, 0x4a, 0xc9, 0x42, 0x72, 0x96, 0x7a,
+	0x98, 0xe4, 0xde, 0x9c, 0x26, 0x54, 0x06, 0x8c, 0x63, 0xc2, 0x03, 0xc9, 0x51, 0x1a, 0xc6, 0x24,
+	0x58, 0x08, 0xc2, 0xdd, 0x8c, 0x33, 0xc9, 0x0c, 0xbd, 0xce, 0xba, 0x98, 0xe4, 0x47, 0x87, 0x11,
+	0x8b, 0xd8, 0x46, 0xf7, 0x54, 0x55, 0x45, 0x8e, 0xac, 0xee, 0x73, 0x92, 0x23, 0x4c, 0x82, 0x0c,
+	0x51, 0x1e, 0x50, 0x5c, 0x07, 0x0e, 0x7b, 0x81, 0xbc, 0x52, 0xc7, 0x3f, 0xb6, 0xc1, 0xe8, 0x42,
+	0xc1, 0x2f, 0x15, 0x7b, 0x5a, 0xa1, 0xbf, 0x08, 0xc2, 0x8d, 0x77, 0xe0, 0xa0, 0xf7, 0x0c, 0xd4,
+	0x6c, 0xcd, 0xd1, 0x4f, 0xa0, 0xdb, 0xf9, 0x2f, 0xee, 0x54, 0x25, 0x3e, 0x23, 0xca, 0x27, 0xe7,
+	0xbe, 0x2e, 0xdb, 0x06, 0x1b, 0x6f, 0xc1, 0x73, 0x49, 0xc3, 0x59, 0x40, 0x53, 0x4c, 0xf2, 0x40,
+	0xa2, 0x99, 0x5a, 0x8c, 0x05, 0x89, 0x2a, 0xe0, 0x03, 0x5b, 0x73, 0xb6, 0xfc, 0x91, 0x0a, 0x4c,
+	0x94, 0x3f, 0x55, 0xea, 0x94, 0x7d, 0x52, 0x3f, 0x86, 0x05, 0xf4, 0xe6, 0x0b, 0xcc, 0x48, 0x01,
+	0xb7, 0x6c, 0xcd, 0x19, 0xfa, 0xa0, 0x96, 0x3e, 0x92, 0xc2, 0x80, 0x60, 0x17, 0x61, 0xcc, 0x89,
+	0x10, 0xf0, 0xe1, 0xc6, 0x6c, 0x5a, 0x23, 0x02, 0xfb, 0x22, 0x46, 0x9c, 0x88, 0x80, 0x2d, 0x53,
+	0x82, 0xe1, 0xb6, 0xb2, 0xcf, 0xce, 0xaf, 0x4b, 0x6b, 0xf0, 0xbb, 0xb4, 0x46, 0x21, 0x13, 0x09,
+	0x13, 0x02, 0xcf, 0x5c, 0xca, 0xbc, 0x04, 0xc9, 0xd8, 0x9d, 0xa4, 0xf2, 0x6f, 0x69, 0xf5, 0x86,
+	0x6e, 0x4b, 0xeb, 0x69, 0x81, 0x92, 0xf9, 0xe9, 0xb8, 0xab, 0x8e, 0x7d, 0xbd, 0x6a, 0x2f, 0x55,
+	0x67, 0x2c, 0xc1, 0x93, 0xda, 0x5d, 0x52, 0x19, 0x63, 0x8e, 0x96, 0x29, 0xdc, 0xd9, 0xc0, 0x2e,
+	0xfe, 0x07, 0xbb, 0x33, 0x78, 0x5b, 0x5a, 0xcf, 0x7a, 0xc0, 0xd6, 0x19, 0xfb, 0x8f, 0x2b, 0xe9,
+	0x6b, 0xa3, 0x74, 0xc0, 0x21, 0x4a, 0x43, 0x32, 0x9f, 0x13, 0x0c, 0x77, 0xef, 0x07, 0x6e, 0x07,
+	0xef, 0x80, 0x5b, 0xa7, 0x05, 0xbf, 0x6f, 0x14, 0xe3, 0x14, 0x80, 0xfa, 0x3a, 0x8b, 0x8c, 0xc0,
+	0x3d, 0x5b, 0x73, 0x1e, 0x9d, 0xbc, 0xe8, 0x9d, 0x42, 0xe7, 0x8a, 0x8a, 0x8c, 0xf8, 0x43, 0xd6,
+	0x94, 0xc6, 0x4b, 0x70, 0x80, 0x16, 0x92, 0xb5, 0x8b, 0xc1, 0xa1, 0xad, 0x39, 0x7b, 0xfe, 0xbe,
+	0x12, 0x9b, 0xd5, 0xce, 0x3e, 0x5c, 0xaf, 0x4c, 0xed, 0x66, 0x65, 0x6a, 0x7f, 0x56, 0xa6, 0xf6,
+	0x73, 0x6d, 0x0e, 0x6e, 0xd6, 0xe6, 0xe0, 0xd7, 0xda, 0x1c, 0x7c, 0x3b, 0x8e, 0xa8, 0x8c, 0x17,
+	0x57, 0x6e, 0xc8, 0x12, 0xaf, 0x06, 0x1e, 0x33, 0x1e, 0x35, 0xb5, 0xf7, 0xfd, 0x8d, 0x97, 0x57,
+	0x47, 0x5d, 0x64, 0x44, 0x5c, 0xed, 0x6c, 0x0e, 0xfb, 0xf5, 0xbf, 0x01, 0x00, 0x46, 0x19, 0x43,
+	0x92, 0x60, 0x03, 0x00, 0x00,
 }
 
 func (m *LimitOrderTrancheUser) Marshal() (dAtA []byte, err error) {
@@ -166,6 +176,16 @@ func (m *LimitOrderTrancheUser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoWithdraw {
+		i--
+		if m.AutoWithdraw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.OrderType != 0 {
 		i = encodeVarintLimitOrderTrancheUser(dAtA, i, uint64(m.OrderType))
 		i--
@@ -276,6 +296,9 @@ func (m *LimitOrderTrancheUser) Size() (n int) {
 	if m.OrderType != 0 {
 		n += 1 + sovLimitOrderTrancheUser(uint64(m.OrderType))
 	}
+	if m.AutoWithdraw {
+		n += 2
+	}
 	return n
 }
 
@@ -554,6 +577,26 @@ func (m *LimitOrderTrancheUser) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoWithdraw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLimitOrderTrancheUser
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoWithdraw = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLimitOrderTrancheUser(dAtA[iNdEx:])
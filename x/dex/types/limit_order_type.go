@@ -20,10 +20,34 @@ func (l LimitOrderType) IsGoodTil() bool {
 	return l == LimitOrderType_GOOD_TIL_TIME
 }
 
+func (l LimitOrderType) IsGoodTilBlock() bool {
+	return l == LimitOrderType_GOOD_TIL_BLOCK
+}
+
+func (l LimitOrderType) IsStopLoss() bool {
+	return l == LimitOrderType_STOP_LOSS
+}
+
+func (l LimitOrderType) IsTakeProfit() bool {
+	return l == LimitOrderType_TAKE_PROFIT
+}
+
+func (l LimitOrderType) IsPostOnly() bool {
+	return l == LimitOrderType_POST_ONLY || l == LimitOrderType_POST_ONLY_REPRICE
+}
+
+func (l LimitOrderType) IsPostOnlyReprice() bool {
+	return l == LimitOrderType_POST_ONLY_REPRICE
+}
+
+func (l LimitOrderType) IsTrigger() bool {
+	return l.IsStopLoss() || l.IsTakeProfit()
+}
+
 func (l LimitOrderType) IsTakerOnly() bool {
 	return l.IsIoC() || l.IsFoK()
 }
 
 func (l LimitOrderType) HasExpiration() bool {
-	return l.IsGoodTil() || l.IsJIT()
+	return l.IsGoodTil() || l.IsGoodTilBlock() || l.IsJIT()
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	math_utils "github.com/neutron-org/neutron/v5/utils/math"
 )
@@ -10,3 +11,19 @@ type Liquidity interface {
 	Swap(maxAmountTakerIn math.Int, maxAmountMakerOut *math.Int) (inAmount, outAmount math.Int)
 	Price() math_utils.PrecDec
 }
+
+// SwapResult is the outcome of swapping against the liquidity of a TradePairID. TotalIn includes the fees charged on
+// top of the amount swapped: TakerFee by LimitOrderTranches and DynamicFee by pools of the dynamic fee tier. Both
+// fees are reported separately and are excluded from every limit price check.
+type SwapResult struct {
+	TotalIn     sdk.Coin
+	TotalOut    sdk.Coin
+	TakerFee    sdk.Coin
+	DynamicFee  sdk.Coin
+	OrderFilled bool
+}
+
+// AmountSwapped returns the part of TotalIn that was swapped, excluding fees
+func (r SwapResult) AmountSwapped() math.Int {
+	return r.TotalIn.Amount.Sub(r.TakerFee.Amount).Sub(r.DynamicFee.Amount)
+}
@@ -0,0 +1,70 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+const TypeMsgAmendLimitOrder = "amend_limit_order"
+
+var _ sdk.Msg = &MsgAmendLimitOrder{}
+
+func NewMsgAmendLimitOrder(
+	creator,
+	trancheKey string,
+	amountIn *math.Int,
+	limitSellPrice *math_utils.PrecDec,
+) *MsgAmendLimitOrder {
+	return &MsgAmendLimitOrder{
+		Creator:        creator,
+		TrancheKey:     trancheKey,
+		AmountIn:       amountIn,
+		LimitSellPrice: limitSellPrice,
+	}
+}
+
+func (msg *MsgAmendLimitOrder) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgAmendLimitOrder) Type() string {
+	return TypeMsgAmendLimitOrder
+}
+
+func (msg *MsgAmendLimitOrder) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgAmendLimitOrder) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgAmendLimitOrder) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if msg.AmountIn == nil && msg.LimitSellPrice == nil {
+		return ErrEmptyAmendLimitOrder
+	}
+
+	if msg.AmountIn != nil && !msg.AmountIn.IsPositive() {
+		return ErrZeroLimitOrder
+	}
+
+	if msg.LimitSellPrice != nil && IsPriceOutOfRange(*msg.LimitSellPrice) {
+		return ErrPriceOutsideRange
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgBatchOps = "batch_ops"
+
+var _ sdk.Msg = &MsgBatchOps{}
+
+// BatchOpMsg is implemented by all messages that can be included in a MsgBatchOps
+type BatchOpMsg interface {
+	sdk.Msg
+	GetCreator() string
+	Validate() error
+}
+
+func NewMsgBatchOps(creator string, ops []*BatchOp) *MsgBatchOps {
+	return &MsgBatchOps{
+		Creator: creator,
+		Ops:     ops,
+	}
+}
+
+func (msg *MsgBatchOps) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgBatchOps) Type() string {
+	return TypeMsgBatchOps
+}
+
+func (msg *MsgBatchOps) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgBatchOps) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgBatchOps) Validate() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+
+	if len(msg.Ops) == 0 {
+		return ErrEmptyBatchOps
+	}
+
+	for i, op := range msg.Ops {
+		opMsg, err := op.GetMsg()
+		if err != nil {
+			return sdkerrors.Wrapf(err, "op %d", i)
+		}
+
+		if opMsg.GetCreator() != msg.Creator {
+			return sdkerrors.Wrapf(ErrBatchOpCreatorMismatch, "op %d", i)
+		}
+
+		if err := opMsg.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "op %d", i)
+		}
+	}
+
+	return nil
+}
+
+// GetMsg returns the single operation contained in the BatchOp
+func (op *BatchOp) GetMsg() (BatchOpMsg, error) {
+	if op == nil {
+		return nil, ErrInvalidBatchOp
+	}
+
+	var msgs []BatchOpMsg
+	if op.Deposit != nil {
+		msgs = append(msgs, op.Deposit)
+	}
+	if op.Withdrawal != nil {
+		msgs = append(msgs, op.Withdrawal)
+	}
+	if op.PlaceLimitOrder != nil {
+		msgs = append(msgs, op.PlaceLimitOrder)
+	}
+	if op.WithdrawFilledLimitOrder != nil {
+		msgs = append(msgs, op.WithdrawFilledLimitOrder)
+	}
+	if op.CancelLimitOrder != nil {
+		msgs = append(msgs, op.CancelLimitOrder)
+	}
+	if op.MultiHopSwap != nil {
+		msgs = append(msgs, op.MultiHopSwap)
+	}
+
+	if len(msgs) != 1 {
+		return nil, ErrInvalidBatchOp
+	}
+
+	return msgs[0], nil
+}
+
+// SetCreator sets the creator of the MsgBatchOps and of every operation it contains
+func (msg *MsgBatchOps) SetCreator(creator string) {
+	msg.Creator = creator
+	for _, op := range msg.Ops {
+		if op == nil {
+			continue
+		}
+		if op.Deposit != nil {
+			op.Deposit.Creator = creator
+		}
+		if op.Withdrawal != nil {
+			op.Withdrawal.Creator = creator
+		}
+		if op.PlaceLimitOrder != nil {
+			op.PlaceLimitOrder.Creator = creator
+		}
+		if op.WithdrawFilledLimitOrder != nil {
+			op.WithdrawFilledLimitOrder.Creator = creator
+		}
+		if op.CancelLimitOrder != nil {
+			op.CancelLimitOrder.Creator = creator
+		}
+		if op.MultiHopSwap != nil {
+			op.MultiHopSwap.Creator = creator
+		}
+	}
+}
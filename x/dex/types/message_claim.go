@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgClaim = "claim"
+
+var _ sdk.Msg = &MsgClaim{}
+
+func NewMsgClaim(creator string) *MsgClaim {
+	return &MsgClaim{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgClaim) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgClaim) Type() string {
+	return TypeMsgClaim
+}
+
+func (msg *MsgClaim) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgClaim) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgClaim) Validate() error {
+	return validateAddress(msg.Creator, "creator")
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgCreateGauge = "create_gauge"
+
+var _ sdk.Msg = &MsgCreateGauge{}
+
+func NewMsgCreateGauge(
+	creator string,
+	pairID *PairID,
+	startTick, endTick int64,
+	coins sdk.Coins,
+	numEpochs uint64,
+) *MsgCreateGauge {
+	return &MsgCreateGauge{
+		Creator:   creator,
+		PairId:    pairID,
+		StartTick: startTick,
+		EndTick:   endTick,
+		Coins:     coins,
+		NumEpochs: numEpochs,
+	}
+}
+
+func (msg *MsgCreateGauge) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateGauge) Type() string {
+	return TypeMsgCreateGauge
+}
+
+func (msg *MsgCreateGauge) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateGauge) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgCreateGauge) Validate() error {
+	if err := validateAddress(msg.Creator, "creator"); err != nil {
+		return err
+	}
+
+	if err := ValidateGaugeParams(msg.PairId, msg.StartTick, msg.EndTick, msg.NumEpochs); err != nil {
+		return err
+	}
+
+	if !msg.Coins.IsValid() || msg.Coins.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidGauge, "invalid coins %s", msg.Coins)
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgDepositRange = "deposit_range"
+
+var _ sdk.Msg = &MsgDepositRange{}
+
+func NewMsgDepositRange(
+	creator,
+	receiver,
+	tokenA,
+	tokenB string,
+	amountA,
+	amountB math.Int,
+	lowerTickIndex,
+	upperTickIndex int64,
+	fee uint64,
+	shape RangeShape,
+	options *DepositOptions,
+) *MsgDepositRange {
+	return &MsgDepositRange{
+		Creator:            creator,
+		Receiver:           receiver,
+		TokenA:             tokenA,
+		TokenB:             tokenB,
+		AmountA:            amountA,
+		AmountB:            amountB,
+		LowerTickIndexAToB: lowerTickIndex,
+		UpperTickIndexAToB: upperTickIndex,
+		Fee:                fee,
+		Shape:              shape,
+		Options:            options,
+	}
+}
+
+func (msg *MsgDepositRange) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDepositRange) Type() string {
+	return TypeMsgDepositRange
+}
+
+func (msg *MsgDepositRange) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDepositRange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgDepositRange) Validate() error {
+	if err := validateAddress(msg.Creator, "creator"); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.Receiver, "receiver"); err != nil {
+		return err
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenA); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenA denom (%s)", err)
+	}
+	if err := sdk.ValidateDenom(msg.TokenB); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "TokenB denom (%s)", err)
+	}
+	if msg.TokenA == msg.TokenB {
+		return sdkerrors.Wrapf(ErrInvalidDenom, "tokenA cannot equal tokenB")
+	}
+
+	if msg.AmountA.IsNil() || msg.AmountB.IsNil() || msg.AmountA.IsNegative() || msg.AmountB.IsNegative() {
+		return ErrZeroDeposit
+	}
+	if msg.AmountA.IsZero() && msg.AmountB.IsZero() {
+		return ErrZeroDeposit
+	}
+
+	if _, ok := RangeShape_name[int32(msg.Shape)]; !ok {
+		return sdkerrors.Wrapf(ErrInvalidRange, "invalid shape %d", msg.Shape)
+	}
+
+	if err := ValidateTickFee(msg.LowerTickIndexAToB, msg.Fee); err != nil {
+		return err
+	}
+	if err := ValidateTickFee(msg.UpperTickIndexAToB, msg.Fee); err != nil {
+		return err
+	}
+	if _, err := RangeTickIndexes(msg.LowerTickIndexAToB, msg.UpperTickIndexAToB, msg.Fee); err != nil {
+		return err
+	}
+
+	return nil
+}
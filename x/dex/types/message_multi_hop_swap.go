@@ -73,6 +73,9 @@ func (msg *MsgMultiHopSwap) Validate() error {
 	if err := validateExitLimitPrice(msg.ExitLimitPrice); err != nil {
 		return err
 	}
+	if msg.PickBestRoute && msg.SplitRoutes {
+		return ErrSplitRoutesWithPickBestRoute
+	}
 	return nil
 }
 
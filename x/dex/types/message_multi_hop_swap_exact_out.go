@@ -0,0 +1,74 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgMultiHopSwapExactOut = "multi_hop_swap_exact_out"
+
+var _ sdk.Msg = &MsgMultiHopSwapExactOut{}
+
+func NewMsgMultiHopSwapExactOut(
+	creator string,
+	receiver string,
+	routesArr [][]string,
+	amountOut math.Int,
+	maxAmountIn math.Int,
+	pickBestRoute bool,
+) *MsgMultiHopSwapExactOut {
+	routes := make([]*MultiHopRoute, len(routesArr))
+	for i, hops := range routesArr {
+		routes[i] = &MultiHopRoute{Hops: hops}
+	}
+
+	return &MsgMultiHopSwapExactOut{
+		Creator:       creator,
+		Receiver:      receiver,
+		Routes:        routes,
+		AmountOut:     amountOut,
+		MaxAmountIn:   maxAmountIn,
+		PickBestRoute: pickBestRoute,
+	}
+}
+
+func (msg *MsgMultiHopSwapExactOut) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgMultiHopSwapExactOut) Type() string {
+	return TypeMsgMultiHopSwapExactOut
+}
+
+func (msg *MsgMultiHopSwapExactOut) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgMultiHopSwapExactOut) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgMultiHopSwapExactOut) Validate() error {
+	if err := validateAddress(msg.Creator, "creator"); err != nil {
+		return err
+	}
+	if err := validateAddress(msg.Receiver, "receiver"); err != nil {
+		return err
+	}
+	if err := validateRoutes(msg.Routes); err != nil {
+		return err
+	}
+	if err := validateAmountIn(msg.AmountOut); err != nil {
+		return err
+	}
+	if err := validateAmountIn(msg.MaxAmountIn); err != nil {
+		return err
+	}
+	return nil
+}
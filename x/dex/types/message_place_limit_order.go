@@ -100,11 +100,19 @@ func (msg *MsgPlaceLimitOrder) Validate() error {
 		return ErrExpirationOnWrongOrderType
 	}
 
+	if msg.OrderType.IsGoodTilBlock() && msg.ExpirationHeight == 0 {
+		return ErrGoodTilBlockOrderWithoutExpirationHeight
+	}
+
+	if !msg.OrderType.IsGoodTilBlock() && msg.ExpirationHeight != 0 {
+		return ErrExpirationHeightOnWrongOrderType
+	}
+
 	if msg.MaxAmountOut != nil {
 		if !msg.MaxAmountOut.IsPositive() {
 			return ErrZeroMaxAmountOut
 		}
-		if !msg.OrderType.IsTakerOnly() {
+		if !msg.OrderType.IsTakerOnly() && !msg.OrderType.IsTrigger() {
 			return ErrInvalidMaxAmountOutForMaker
 		}
 	}
@@ -125,10 +133,40 @@ func (msg *MsgPlaceLimitOrder) Validate() error {
 		return ErrZeroMinAverageSellPrice
 	}
 
+	if msg.OrderType.IsTrigger() && msg.TriggerSellPrice == nil {
+		return ErrTriggerOrderWithoutTriggerPrice
+	}
+
+	if !msg.OrderType.IsTrigger() && msg.TriggerSellPrice != nil {
+		return ErrTriggerPriceOnWrongOrderType
+	}
+
+	if msg.TriggerSellPrice != nil && IsPriceOutOfRange(*msg.TriggerSellPrice) {
+		return ErrPriceOutsideRange
+	}
+
+	if msg.AutoWithdraw && (msg.OrderType.IsTakerOnly() || msg.OrderType.IsTrigger()) {
+		return sdkerrors.Wrapf(ErrAutoWithdrawOnWrongOrderType, "%s", msg.OrderType)
+	}
+
+	if msg.OraclePeg != nil {
+		if msg.OrderType != LimitOrderType_GOOD_TIL_CANCELLED {
+			return sdkerrors.Wrapf(ErrInvalidPeggedOrderType, "%s", msg.OrderType)
+		}
+
+		if msg.LimitSellPrice != nil || msg.TickIndexInToOut != 0 {
+			return sdkerrors.Wrap(ErrInvalidOraclePeg, "tick_index_in_to_out and limit_sell_price must not be set")
+		}
+
+		if err := msg.OraclePeg.Validate(msg.TokenIn, msg.TokenOut); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (msg *MsgPlaceLimitOrder) ValidateGoodTilExpiration(blockTime time.Time) error {
+func (msg *MsgPlaceLimitOrder) ValidateGoodTilExpiration(blockTime time.Time, blockHeight int64) error {
 	if msg.OrderType.IsGoodTil() && !msg.ExpirationTime.After(blockTime) {
 		return sdkerrors.Wrapf(ErrExpirationTimeInPast,
 			"Current BlockTime: %s; Provided ExpirationTime: %s",
@@ -137,5 +175,13 @@ func (msg *MsgPlaceLimitOrder) ValidateGoodTilExpiration(blockTime time.Time) er
 		)
 	}
 
+	if msg.OrderType.IsGoodTilBlock() && msg.ExpirationHeight <= uint64(blockHeight) { //nolint:gosec
+		return sdkerrors.Wrapf(ErrExpirationHeightInPast,
+			"Current BlockHeight: %d; Provided ExpirationHeight: %d",
+			blockHeight,
+			msg.ExpirationHeight,
+		)
+	}
+
 	return nil
 }
@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgResetCircuitBreaker = "reset-circuit-breaker"
+
+var _ sdk.Msg = &MsgResetCircuitBreaker{}
+
+func (msg *MsgResetCircuitBreaker) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResetCircuitBreaker) Type() string {
+	return TypeMsgResetCircuitBreaker
+}
+
+func (msg *MsgResetCircuitBreaker) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgResetCircuitBreaker) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgResetCircuitBreaker) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if msg.PairId == nil {
+		return errorsmod.Wrap(ErrInvalidTradingPair, "pair_id must be set")
+	}
+	_, err := NewPairID(msg.PairId.Token0, msg.PairId.Token1)
+
+	return err
+}
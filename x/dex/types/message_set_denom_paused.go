@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetDenomPaused = "set-denom-paused"
+
+var _ sdk.Msg = &MsgSetDenomPaused{}
+
+func (msg *MsgSetDenomPaused) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetDenomPaused) Type() string {
+	return TypeMsgSetDenomPaused
+}
+
+func (msg *MsgSetDenomPaused) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetDenomPaused) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgSetDenomPaused) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetPairBatchAuction = "set-pair-batch-auction"
+
+var _ sdk.Msg = &MsgSetPairBatchAuction{}
+
+func (msg *MsgSetPairBatchAuction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPairBatchAuction) Type() string {
+	return TypeMsgSetPairBatchAuction
+}
+
+func (msg *MsgSetPairBatchAuction) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetPairBatchAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgSetPairBatchAuction) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if msg.PairId == nil {
+		return errorsmod.Wrap(ErrInvalidTradingPair, "pair_id must be set")
+	}
+	_, err := NewPairID(msg.PairId.Token0, msg.PairId.Token1)
+
+	return err
+}
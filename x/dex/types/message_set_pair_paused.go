@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgSetPairPaused = "set-pair-paused"
+
+var _ sdk.Msg = &MsgSetPairPaused{}
+
+func (msg *MsgSetPairPaused) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgSetPairPaused) Type() string {
+	return TypeMsgSetPairPaused
+}
+
+func (msg *MsgSetPairPaused) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgSetPairPaused) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgSetPairPaused) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority is invalid")
+	}
+
+	if msg.PairId == nil {
+		return errorsmod.Wrap(ErrInvalidTradingPair, "pair_id must be set")
+	}
+	_, err := NewPairID(msg.PairId.Token0, msg.PairId.Token1)
+
+	return err
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgStake = "stake"
+
+var _ sdk.Msg = &MsgStake{}
+
+func NewMsgStake(creator string, shares sdk.Coins) *MsgStake {
+	return &MsgStake{
+		Creator: creator,
+		Shares:  shares,
+	}
+}
+
+func (msg *MsgStake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgStake) Type() string {
+	return TypeMsgStake
+}
+
+func (msg *MsgStake) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgStake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgStake) Validate() error {
+	if err := validateAddress(msg.Creator, "creator"); err != nil {
+		return err
+	}
+
+	if !msg.Shares.IsValid() || msg.Shares.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidStake, "invalid shares %s", msg.Shares)
+	}
+
+	for _, share := range msg.Shares {
+		if _, err := ParsePoolIDFromDenom(share.Denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidStake, "%s is not a pool share denom", share.Denom)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgUnstake = "unstake"
+
+var _ sdk.Msg = &MsgUnstake{}
+
+func NewMsgUnstake(creator string, stakeID uint64) *MsgUnstake {
+	return &MsgUnstake{
+		Creator: creator,
+		StakeId: stakeID,
+	}
+}
+
+func (msg *MsgUnstake) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUnstake) Type() string {
+	return TypeMsgUnstake
+}
+
+func (msg *MsgUnstake) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUnstake) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgUnstake) Validate() error {
+	return validateAddress(msg.Creator, "creator")
+}
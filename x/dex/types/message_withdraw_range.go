@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const TypeMsgWithdrawRange = "withdraw_range"
+
+var _ sdk.Msg = &MsgWithdrawRange{}
+
+func NewMsgWithdrawRange(creator, receiver string, positionID uint64) *MsgWithdrawRange {
+	return &MsgWithdrawRange{
+		Creator:    creator,
+		Receiver:   receiver,
+		PositionId: positionID,
+	}
+}
+
+func (msg *MsgWithdrawRange) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgWithdrawRange) Type() string {
+	return TypeMsgWithdrawRange
+}
+
+func (msg *MsgWithdrawRange) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgWithdrawRange) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return bz
+}
+
+func (msg *MsgWithdrawRange) Validate() error {
+	if err := validateAddress(msg.Creator, "creator"); err != nil {
+		return err
+	}
+
+	return validateAddress(msg.Receiver, "receiver")
+}
@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+const (
+	// MaxOraclePriceGuardDecimals bounds the decimals of an OraclePriceGuard so that price conversion cannot overflow
+	MaxOraclePriceGuardDecimals = 36
+	// MaxOraclePriceGuardDeviationBps is the largest max_deviation_bps of an OraclePriceGuard (100%)
+	MaxOraclePriceGuardDeviationBps = 10_000
+)
+
+func (g OraclePriceGuard) Validate() error {
+	pairID, err := NewPairIDFromCanonicalString(g.PairId)
+	if err != nil {
+		return err
+	}
+	if pairID.CanonicalString() != g.PairId {
+		return fmt.Errorf("pair_id %s is not in canonical form", g.PairId)
+	}
+
+	if _, err := slinkytypes.CurrencyPairFromString(g.CurrencyPair); err != nil {
+		return err
+	}
+
+	if g.BaseDenom != pairID.Token0 && g.BaseDenom != pairID.Token1 {
+		return fmt.Errorf("base_denom %s is not part of pair %s", g.BaseDenom, g.PairId)
+	}
+
+	if g.BaseDecimals > MaxOraclePriceGuardDecimals || g.QuoteDecimals > MaxOraclePriceGuardDecimals {
+		return fmt.Errorf("decimals cannot exceed %d", MaxOraclePriceGuardDecimals)
+	}
+
+	if g.MaxDeviationBps == 0 || g.MaxDeviationBps > MaxOraclePriceGuardDeviationBps {
+		return fmt.Errorf("max_deviation_bps must be between 1 and %d", MaxOraclePriceGuardDeviationBps)
+	}
+
+	if _, ok := OraclePriceGuardAction_name[int32(g.Action)]; !ok {
+		return fmt.Errorf("invalid action %d", g.Action)
+	}
+
+	return nil
+}
+
+// MustCurrencyPair returns the x/oracle CurrencyPair of the guard
+func (g OraclePriceGuard) MustCurrencyPair() slinkytypes.CurrencyPair {
+	cp, err := slinkytypes.CurrencyPairFromString(g.CurrencyPair)
+	if err != nil {
+		panic(err)
+	}
+
+	return cp
+}
+
+// MakerPrice converts an oracle price of one base token in quote tokens, with priceDecimals decimals, into the price
+// of one maker token of tradePairID in taker tokens. This is the same unit as the MakerPrice of the dex liquidity.
+func (g OraclePriceGuard) MakerPrice(tradePairID *TradePairID, oraclePrice math_utils.PrecDec, priceDecimals uint64) math_utils.PrecDec {
+	price := OracleBaseUnitPrice(oraclePrice, priceDecimals, g.BaseDecimals, g.QuoteDecimals)
+	if tradePairID.MakerDenom == g.BaseDenom {
+		return price
+	}
+
+	return math_utils.OnePrecDec().Quo(price)
+}
+
+// MaxMakerPrice is the worst MakerPrice at which a swap may execute given the oracle MakerPrice
+func (g OraclePriceGuard) MaxMakerPrice(oracleMakerPrice math_utils.PrecDec) math_utils.PrecDec {
+	deviation := math_utils.NewPrecDec(int64(g.MaxDeviationBps)).Quo(math_utils.NewPrecDec(10_000)) //nolint:gosec
+	return oracleMakerPrice.Mul(math_utils.OnePrecDec().Add(deviation))
+}
+
+// OracleBaseUnitPrice converts an oracle price of one base token in quote tokens, with priceDecimals decimals,
+// into the price of one base unit in quote units.
+func OracleBaseUnitPrice(oraclePrice math_utils.PrecDec, priceDecimals, baseDecimals, quoteDecimals uint64) math_utils.PrecDec {
+	return oraclePrice.
+		Quo(decimalsMultiplier(priceDecimals)).
+		Mul(decimalsMultiplier(quoteDecimals)).
+		Quo(decimalsMultiplier(baseDecimals))
+}
+
+func decimalsMultiplier(decimals uint64) math_utils.PrecDec {
+	return math_utils.NewPrecDecFromInt(math.NewIntWithDecimal(1, int(decimals))) //nolint:gosec
+}
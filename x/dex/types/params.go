@@ -2,42 +2,160 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"gopkg.in/yaml.v2"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeyFeeTiers                         = []byte("FeeTiers")
-	DefaultFeeTiers                     = []uint64{0, 1, 2, 3, 4, 5, 10, 20, 50, 100, 150, 200}
-	KeyPaused                           = []byte("Paused")
-	DefaultPaused                       = false
-	KeyMaxJITsPerBlock                  = []byte("MaxJITs")
-	DefaultMaxJITsPerBlock       uint64 = 25
-	KeyGoodTilPurgeAllowance            = []byte("PurgeAllowance")
-	DefaultGoodTilPurgeAllowance uint64 = 540_000
+	KeyFeeTiers                              = []byte("FeeTiers")
+	DefaultFeeTiers                          = []uint64{0, 1, 2, 3, 4, 5, 10, 20, 50, 100, 150, 200}
+	KeyPaused                                = []byte("Paused")
+	DefaultPaused                            = false
+	KeyMaxJITsPerBlock                       = []byte("MaxJITs")
+	DefaultMaxJITsPerBlock            uint64 = 25
+	KeyGoodTilPurgeAllowance                 = []byte("PurgeAllowance")
+	DefaultGoodTilPurgeAllowance      uint64 = 540_000
+	KeyTriggerOrderAllowance                 = []byte("TriggerOrderAllowance")
+	DefaultTriggerOrderAllowance      uint64 = 1_000_000
+	KeyHookContract                          = []byte("HookContract")
+	DefaultHookContract                      = ""
+	KeyCircuitBreakerMaxTickMove             = []byte("CircuitBreakerMaxTickMove")
+	DefaultCircuitBreakerMaxTickMove  uint64
+	KeyCircuitBreakerWindow                  = []byte("CircuitBreakerWindow")
+	DefaultCircuitBreakerWindow       uint64 = 10
+	KeyProtocolFees                          = []byte("ProtocolFees")
+	DefaultProtocolFees               []ProtocolFee
+	KeyOraclePriceGuards              = []byte("OraclePriceGuards")
+	DefaultOraclePriceGuards          []OraclePriceGuard
+	KeyPeggedOrderAllowance                  = []byte("PeggedOrderAllowance")
+	DefaultPeggedOrderAllowance       uint64 = 1_000_000
+	KeyLimitOrderTakerFeeBps                 = []byte("LimitOrderTakerFeeBps")
+	DefaultLimitOrderTakerFeeBps      uint64
+	KeyLimitOrderMakerRebateBps       = []byte("LimitOrderMakerRebateBps")
+	DefaultLimitOrderMakerRebateBps   uint64
+	KeyGaugeEpochBlocks                      = []byte("GaugeEpochBlocks")
+	DefaultGaugeEpochBlocks           uint64 = 3_600
+	KeyAutoWithdrawAllowance                 = []byte("AutoWithdrawAllowance")
+	DefaultAutoWithdrawAllowance      uint64 = 1_000_000
+	KeyDynamicFeeTier                        = []byte("DynamicFeeTier")
+	DefaultDynamicFeeTier             uint64
+	KeyDynamicFeeMinBps               = []byte("DynamicFeeMinBps")
+	DefaultDynamicFeeMinBps           uint64
+	KeyDynamicFeeMaxBps               = []byte("DynamicFeeMaxBps")
+	DefaultDynamicFeeMaxBps           uint64
+	KeyDynamicFeeWindow                      = []byte("DynamicFeeWindow")
+	DefaultDynamicFeeWindow           uint64 = 100
+	KeyBatchAuctionAllowance                 = []byte("BatchAuctionAllowance")
+	DefaultBatchAuctionAllowance      uint64 = 2_000_000
+	KeyGaugeDistributionAllowance            = []byte("GaugeDistributionAllowance")
+	DefaultGaugeDistributionAllowance uint64 = 2_000_000
+	KeyGaugeCreationFee                      = []byte("GaugeCreationFee")
+	DefaultGaugeCreationFee           sdk.Coins
+	KeyMinStakeShares                        = []byte("MinStakeShares")
+	DefaultMinStakeShares             uint64 = 1_000_000
 )
 
+// MaxLimitOrderTakerFeeBps is the largest LimitOrderTakerFeeBps that can be set
+const MaxLimitOrderTakerFeeBps uint64 = 1_000
+
+// MaxDynamicFeeBps is the largest DynamicFeeMaxBps that can be set
+const MaxDynamicFeeBps uint64 = 1_000
+
+// MaxDynamicFeeWindow is the largest DynamicFeeWindow that can be set, it bounds the size of each DynamicFee
+const MaxDynamicFeeWindow uint64 = 1_000
+
 // ParamKeyTable the param key table for launch module
 func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
 // NewParams creates a new Params instance
-func NewParams(feeTiers []uint64, paused bool, maxJITsPerBlock, goodTilPurgeAllowance uint64) Params {
+func NewParams(
+	feeTiers []uint64,
+	paused bool,
+	maxJITsPerBlock,
+	goodTilPurgeAllowance,
+	triggerOrderAllowance uint64,
+	hookContract string,
+	circuitBreakerMaxTickMove,
+	circuitBreakerWindow uint64,
+	protocolFees []ProtocolFee,
+	oraclePriceGuards []OraclePriceGuard,
+	peggedOrderAllowance,
+	limitOrderTakerFeeBps,
+	limitOrderMakerRebateBps,
+	gaugeEpochBlocks,
+	autoWithdrawAllowance,
+	dynamicFeeTier,
+	dynamicFeeMinBps,
+	dynamicFeeMaxBps,
+	dynamicFeeWindow,
+	batchAuctionAllowance,
+	gaugeDistributionAllowance uint64,
+	gaugeCreationFee sdk.Coins,
+	minStakeShares uint64,
+) Params {
 	return Params{
-		FeeTiers:              feeTiers,
-		Paused:                paused,
-		MaxJitsPerBlock:       maxJITsPerBlock,
-		GoodTilPurgeAllowance: goodTilPurgeAllowance,
+		FeeTiers:                   feeTiers,
+		Paused:                     paused,
+		MaxJitsPerBlock:            maxJITsPerBlock,
+		GoodTilPurgeAllowance:      goodTilPurgeAllowance,
+		TriggerOrderAllowance:      triggerOrderAllowance,
+		HookContract:               hookContract,
+		CircuitBreakerMaxTickMove:  circuitBreakerMaxTickMove,
+		CircuitBreakerWindow:       circuitBreakerWindow,
+		ProtocolFees:               protocolFees,
+		OraclePriceGuards:          oraclePriceGuards,
+		PeggedOrderAllowance:       peggedOrderAllowance,
+		LimitOrderTakerFeeBps:      limitOrderTakerFeeBps,
+		LimitOrderMakerRebateBps:   limitOrderMakerRebateBps,
+		GaugeEpochBlocks:           gaugeEpochBlocks,
+		AutoWithdrawAllowance:      autoWithdrawAllowance,
+		DynamicFeeTier:             dynamicFeeTier,
+		DynamicFeeMinBps:           dynamicFeeMinBps,
+		DynamicFeeMaxBps:           dynamicFeeMaxBps,
+		DynamicFeeWindow:           dynamicFeeWindow,
+		BatchAuctionAllowance:      batchAuctionAllowance,
+		GaugeDistributionAllowance: gaugeDistributionAllowance,
+		GaugeCreationFee:           gaugeCreationFee,
+		MinStakeShares:             minStakeShares,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultFeeTiers, DefaultPaused, DefaultMaxJITsPerBlock, DefaultGoodTilPurgeAllowance)
+	return NewParams(
+		DefaultFeeTiers,
+		DefaultPaused,
+		DefaultMaxJITsPerBlock,
+		DefaultGoodTilPurgeAllowance,
+		DefaultTriggerOrderAllowance,
+		DefaultHookContract,
+		DefaultCircuitBreakerMaxTickMove,
+		DefaultCircuitBreakerWindow,
+		DefaultProtocolFees,
+		DefaultOraclePriceGuards,
+		DefaultPeggedOrderAllowance,
+		DefaultLimitOrderTakerFeeBps,
+		DefaultLimitOrderMakerRebateBps,
+		DefaultGaugeEpochBlocks,
+		DefaultAutoWithdrawAllowance,
+		DefaultDynamicFeeTier,
+		DefaultDynamicFeeMinBps,
+		DefaultDynamicFeeMaxBps,
+		DefaultDynamicFeeWindow,
+		DefaultBatchAuctionAllowance,
+		DefaultGaugeDistributionAllowance,
+		DefaultGaugeCreationFee,
+		DefaultMinStakeShares,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -47,7 +165,64 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyPaused, &p.Paused, validatePaused),
 		paramtypes.NewParamSetPair(KeyMaxJITsPerBlock, &p.MaxJitsPerBlock, validateMaxJITsPerBlock),
 		paramtypes.NewParamSetPair(KeyGoodTilPurgeAllowance, &p.GoodTilPurgeAllowance, validatePurgeAllowance),
+		paramtypes.NewParamSetPair(KeyTriggerOrderAllowance, &p.TriggerOrderAllowance, validateTriggerOrderAllowance),
+		paramtypes.NewParamSetPair(KeyHookContract, &p.HookContract, validateHookContract),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerMaxTickMove, &p.CircuitBreakerMaxTickMove, validateCircuitBreakerMaxTickMove),
+		paramtypes.NewParamSetPair(KeyCircuitBreakerWindow, &p.CircuitBreakerWindow, validateCircuitBreakerWindow),
+		paramtypes.NewParamSetPair(KeyProtocolFees, &p.ProtocolFees, validateProtocolFees),
+		paramtypes.NewParamSetPair(KeyOraclePriceGuards, &p.OraclePriceGuards, validateOraclePriceGuards),
+		paramtypes.NewParamSetPair(KeyPeggedOrderAllowance, &p.PeggedOrderAllowance, validatePeggedOrderAllowance),
+		paramtypes.NewParamSetPair(KeyLimitOrderTakerFeeBps, &p.LimitOrderTakerFeeBps, validateLimitOrderTakerFeeBps),
+		paramtypes.NewParamSetPair(KeyLimitOrderMakerRebateBps, &p.LimitOrderMakerRebateBps, validateLimitOrderMakerRebateBps),
+		paramtypes.NewParamSetPair(KeyGaugeEpochBlocks, &p.GaugeEpochBlocks, validateGaugeEpochBlocks),
+		paramtypes.NewParamSetPair(KeyAutoWithdrawAllowance, &p.AutoWithdrawAllowance, validateAutoWithdrawAllowance),
+		paramtypes.NewParamSetPair(KeyDynamicFeeTier, &p.DynamicFeeTier, validateDynamicFeeTier),
+		paramtypes.NewParamSetPair(KeyDynamicFeeMinBps, &p.DynamicFeeMinBps, validateDynamicFeeBps),
+		paramtypes.NewParamSetPair(KeyDynamicFeeMaxBps, &p.DynamicFeeMaxBps, validateDynamicFeeBps),
+		paramtypes.NewParamSetPair(KeyDynamicFeeWindow, &p.DynamicFeeWindow, validateDynamicFeeWindow),
+		paramtypes.NewParamSetPair(KeyBatchAuctionAllowance, &p.BatchAuctionAllowance, validateBatchAuctionAllowance),
+		paramtypes.NewParamSetPair(KeyGaugeDistributionAllowance, &p.GaugeDistributionAllowance, validateGaugeDistributionAllowance),
+		paramtypes.NewParamSetPair(KeyGaugeCreationFee, &p.GaugeCreationFee, validateGaugeCreationFee),
+		paramtypes.NewParamSetPair(KeyMinStakeShares, &p.MinStakeShares, validateMinStakeShares),
+	}
+}
+
+// ProtocolFeeFraction returns the fraction of the swap fees of feeTier that is taken by the protocol
+func (p Params) ProtocolFeeFraction(feeTier uint64) math_utils.PrecDec {
+	for _, protocolFee := range p.ProtocolFees {
+		if protocolFee.FeeTier == feeTier {
+			return protocolFee.Fraction
+		}
+	}
+
+	return math_utils.ZeroPrecDec()
+}
+
+// DynamicFeeEnabled returns true if pools of DynamicFeeTier charge a dynamic fee
+func (p Params) DynamicFeeEnabled() bool {
+	return p.DynamicFeeMaxBps > 0
+}
+
+// IsDynamicFeeTier returns true if pools of feeTier charge a dynamic fee
+func (p Params) IsDynamicFeeTier(feeTier uint64) bool {
+	return p.DynamicFeeEnabled() && feeTier == p.DynamicFeeTier
+}
+
+// BoundDynamicFee bounds feeBps by DynamicFeeMinBps and DynamicFeeMaxBps
+func (p Params) BoundDynamicFee(feeBps uint64) uint64 {
+	return min(max(feeBps, p.DynamicFeeMinBps), p.DynamicFeeMaxBps)
+}
+
+// OraclePriceGuard returns the OraclePriceGuard of pairID if the pair is guarded
+func (p Params) OraclePriceGuard(pairID *PairID) (OraclePriceGuard, bool) {
+	pairIDStr := pairID.CanonicalString()
+	for _, guard := range p.OraclePriceGuards {
+		if guard.PairId == pairIDStr {
+			return guard, true
+		}
 	}
+
+	return OraclePriceGuard{}, false
 }
 
 // String implements the Stringer interface.
@@ -73,6 +248,88 @@ func (p Params) Validate() error {
 	if err := validatePurgeAllowance(p.GoodTilPurgeAllowance); err != nil {
 		return err
 	}
+	if err := validateTriggerOrderAllowance(p.TriggerOrderAllowance); err != nil {
+		return err
+	}
+	if err := validateHookContract(p.HookContract); err != nil {
+		return fmt.Errorf("invalid hook contract: %w", err)
+	}
+	if err := validateCircuitBreakerMaxTickMove(p.CircuitBreakerMaxTickMove); err != nil {
+		return err
+	}
+	if err := validateCircuitBreakerWindow(p.CircuitBreakerWindow); err != nil {
+		return err
+	}
+	if p.CircuitBreakerMaxTickMove != 0 && p.CircuitBreakerWindow == 0 {
+		return fmt.Errorf("circuit breaker window must be greater than 0 when the circuit breaker is enabled")
+	}
+	if err := validateProtocolFees(p.ProtocolFees); err != nil {
+		return fmt.Errorf("invalid protocol fees: %w", err)
+	}
+	for _, protocolFee := range p.ProtocolFees {
+		if !slices.Contains(p.FeeTiers, protocolFee.FeeTier) {
+			return fmt.Errorf("invalid protocol fees: %d is not a fee tier", protocolFee.FeeTier)
+		}
+	}
+	if err := validateOraclePriceGuards(p.OraclePriceGuards); err != nil {
+		return fmt.Errorf("invalid oracle price guards: %w", err)
+	}
+	if err := validatePeggedOrderAllowance(p.PeggedOrderAllowance); err != nil {
+		return err
+	}
+	if err := validateLimitOrderTakerFeeBps(p.LimitOrderTakerFeeBps); err != nil {
+		return err
+	}
+	if err := validateLimitOrderMakerRebateBps(p.LimitOrderMakerRebateBps); err != nil {
+		return err
+	}
+	if p.LimitOrderMakerRebateBps > p.LimitOrderTakerFeeBps {
+		return fmt.Errorf("limit order maker rebate cannot exceed the limit order taker fee")
+	}
+	if err := validateGaugeEpochBlocks(p.GaugeEpochBlocks); err != nil {
+		return err
+	}
+	if err := validateAutoWithdrawAllowance(p.AutoWithdrawAllowance); err != nil {
+		return err
+	}
+	if err := validateDynamicFeeTier(p.DynamicFeeTier); err != nil {
+		return err
+	}
+	if err := validateDynamicFeeBps(p.DynamicFeeMinBps); err != nil {
+		return err
+	}
+	if err := validateDynamicFeeBps(p.DynamicFeeMaxBps); err != nil {
+		return err
+	}
+	if err := validateDynamicFeeWindow(p.DynamicFeeWindow); err != nil {
+		return err
+	}
+	if p.DynamicFeeEnabled() {
+		if !slices.Contains(p.FeeTiers, p.DynamicFeeTier) {
+			return fmt.Errorf("dynamic fee tier %d is not a fee tier", p.DynamicFeeTier)
+		}
+		if p.DynamicFeeMinBps < p.DynamicFeeTier {
+			return fmt.Errorf("dynamic fee min cannot be less than the dynamic fee tier")
+		}
+		if p.DynamicFeeMinBps > p.DynamicFeeMaxBps {
+			return fmt.Errorf("dynamic fee min cannot exceed the dynamic fee max")
+		}
+		if p.DynamicFeeWindow == 0 {
+			return fmt.Errorf("dynamic fee window must be greater than 0 when the dynamic fee is enabled")
+		}
+	}
+	if err := validateBatchAuctionAllowance(p.BatchAuctionAllowance); err != nil {
+		return err
+	}
+	if err := validateGaugeDistributionAllowance(p.GaugeDistributionAllowance); err != nil {
+		return err
+	}
+	if err := validateGaugeCreationFee(p.GaugeCreationFee); err != nil {
+		return err
+	}
+	if err := validateMinStakeShares(p.MinStakeShares); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -118,3 +375,214 @@ func validatePurgeAllowance(v interface{}) error {
 
 	return nil
 }
+
+func validateTriggerOrderAllowance(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateHookContract(v interface{}) error {
+	hookContract, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if hookContract == "" {
+		return nil
+	}
+
+	_, err := sdk.AccAddressFromBech32(hookContract)
+	return err
+}
+
+func validateCircuitBreakerMaxTickMove(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateCircuitBreakerWindow(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateProtocolFees(v interface{}) error {
+	protocolFees, ok := v.([]ProtocolFee)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	feeTierMap := make(map[uint64]bool)
+	for _, protocolFee := range protocolFees {
+		if _, ok := feeTierMap[protocolFee.FeeTier]; ok {
+			return fmt.Errorf("duplicate protocol fee for fee tier %d", protocolFee.FeeTier)
+		}
+		feeTierMap[protocolFee.FeeTier] = true
+
+		if protocolFee.Fraction.IsNil() || protocolFee.Fraction.IsNegative() || protocolFee.Fraction.GT(math_utils.OnePrecDec()) {
+			return fmt.Errorf("protocol fee fraction must be between 0 and 1")
+		}
+	}
+
+	return nil
+}
+
+func validateOraclePriceGuards(v interface{}) error {
+	guards, ok := v.([]OraclePriceGuard)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	pairMap := make(map[string]bool)
+	for _, guard := range guards {
+		if _, ok := pairMap[guard.PairId]; ok {
+			return fmt.Errorf("duplicate oracle price guard for pair %s", guard.PairId)
+		}
+		pairMap[guard.PairId] = true
+
+		if err := guard.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validatePeggedOrderAllowance(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateLimitOrderTakerFeeBps(v interface{}) error {
+	takerFeeBps, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if takerFeeBps > MaxLimitOrderTakerFeeBps {
+		return fmt.Errorf("limit order taker fee cannot exceed %d bps", MaxLimitOrderTakerFeeBps)
+	}
+
+	return nil
+}
+
+func validateLimitOrderMakerRebateBps(v interface{}) error {
+	makerRebateBps, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if makerRebateBps > MaxLimitOrderTakerFeeBps {
+		return fmt.Errorf("limit order maker rebate cannot exceed %d bps", MaxLimitOrderTakerFeeBps)
+	}
+
+	return nil
+}
+
+func validateGaugeEpochBlocks(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateAutoWithdrawAllowance(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateDynamicFeeTier(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateDynamicFeeBps(v interface{}) error {
+	feeBps, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if feeBps > MaxDynamicFeeBps {
+		return fmt.Errorf("dynamic fee cannot exceed %d bps", MaxDynamicFeeBps)
+	}
+
+	return nil
+}
+
+func validateDynamicFeeWindow(v interface{}) error {
+	window, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if window > MaxDynamicFeeWindow {
+		return fmt.Errorf("dynamic fee window cannot exceed %d blocks", MaxDynamicFeeWindow)
+	}
+
+	return nil
+}
+
+func validateBatchAuctionAllowance(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateGaugeDistributionAllowance(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateGaugeCreationFee(v interface{}) error {
+	fee, ok := v.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if err := fee.Validate(); err != nil {
+		return fmt.Errorf("invalid gauge creation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateMinStakeShares(v interface{}) error {
+	_, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
@@ -5,8 +5,11 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_neutron_org_neutron_v5_utils_math "github.com/neutron-org/neutron/v5/utils/math"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -23,12 +26,89 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// OraclePriceGuardAction is the action taken when a swap would execute at a price that is worse than the oracle bound
+type OraclePriceGuardAction int32
+
+const (
+	// The swap fails
+	OraclePriceGuardAction_REJECT OraclePriceGuardAction = 0
+	// The swap stops at the oracle bound. Any unswapped amount is treated like liquidity running out.
+	OraclePriceGuardAction_CAP OraclePriceGuardAction = 1
+)
+
+var OraclePriceGuardAction_name = map[int32]string{
+	0: "REJECT",
+	1: "CAP",
+}
+
+var OraclePriceGuardAction_value = map[string]int32{
+	"REJECT": 0,
+	"CAP":    1,
+}
+
+func (x OraclePriceGuardAction) String() string {
+	return proto.EnumName(OraclePriceGuardAction_name, int32(x))
+}
+
+func (OraclePriceGuardAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_84a6bffcfc21009c, []int{0}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	FeeTiers              []uint64 `protobuf:"varint,1,rep,packed,name=fee_tiers,json=feeTiers,proto3" json:"fee_tiers,omitempty"`
 	Paused                bool     `protobuf:"varint,3,opt,name=paused,proto3" json:"paused"`
 	MaxJitsPerBlock       uint64   `protobuf:"varint,4,opt,name=max_jits_per_block,json=maxJitsPerBlock,proto3" json:"max_jits_per_block,omitempty"`
 	GoodTilPurgeAllowance uint64   `protobuf:"varint,5,opt,name=good_til_purge_allowance,json=goodTilPurgeAllowance,proto3" json:"good_til_purge_allowance,omitempty"`
+	TriggerOrderAllowance uint64   `protobuf:"varint,6,opt,name=trigger_order_allowance,json=triggerOrderAllowance,proto3" json:"trigger_order_allowance,omitempty"`
+	// Address of an optional contract that receives a sudo call for every dex hook.
+	// Set via governance; an empty string disables the wasm hook. The contract is no longer called after
+	// 10 consecutive failed calls until it is set again.
+	HookContract string `protobuf:"bytes,7,opt,name=hook_contract,json=hookContract,proto3" json:"hook_contract,omitempty"`
+	// Maximum number of ticks the best price of a TradePairID may move within circuit_breaker_window
+	// blocks. Swaps that would move it further are rejected. Zero disables the circuit breaker.
+	CircuitBreakerMaxTickMove uint64 `protobuf:"varint,8,opt,name=circuit_breaker_max_tick_move,json=circuitBreakerMaxTickMove,proto3" json:"circuit_breaker_max_tick_move,omitempty"`
+	// Length of the circuit breaker window in blocks
+	CircuitBreakerWindow uint64 `protobuf:"varint,9,opt,name=circuit_breaker_window,json=circuitBreakerWindow,proto3" json:"circuit_breaker_window,omitempty"`
+	// Fraction of the swap fees of each fee tier that is taken by the protocol instead of going to LPs.
+	// Fee tiers that are not listed have no protocol fee.
+	ProtocolFees []ProtocolFee `protobuf:"bytes,10,rep,name=protocol_fees,json=protocolFees,proto3" json:"protocol_fees"`
+	// Pairs whose swaps are bounded by an x/oracle price. Pairs that are not listed are not guarded.
+	OraclePriceGuards []OraclePriceGuard `protobuf:"bytes,11,rep,name=oracle_price_guards,json=oraclePriceGuards,proto3" json:"oracle_price_guards"`
+	// Gas budget for moving PeggedOrders to their oracle price in BeginBlock
+	PeggedOrderAllowance uint64 `protobuf:"varint,12,opt,name=pegged_order_allowance,json=peggedOrderAllowance,proto3" json:"pegged_order_allowance,omitempty"`
+	// Fee in basis points charged to takers on top of the amount swapped against LimitOrderTranches
+	LimitOrderTakerFeeBps uint64 `protobuf:"varint,13,opt,name=limit_order_taker_fee_bps,json=limitOrderTakerFeeBps,proto3" json:"limit_order_taker_fee_bps,omitempty"`
+	// Portion of limit_order_taker_fee_bps, in basis points of the amount swapped, that is paid to the makers of the
+	// filled LimitOrderTranche. The remainder of the taker fee is taken by the protocol.
+	LimitOrderMakerRebateBps uint64 `protobuf:"varint,14,opt,name=limit_order_maker_rebate_bps,json=limitOrderMakerRebateBps,proto3" json:"limit_order_maker_rebate_bps,omitempty"`
+	// Number of blocks in each Gauge reward epoch. Gauges distribute rewards in the BeginBlock of every block whose
+	// height is a multiple of gauge_epoch_blocks.
+	GaugeEpochBlocks uint64 `protobuf:"varint,15,opt,name=gauge_epoch_blocks,json=gaugeEpochBlocks,proto3" json:"gauge_epoch_blocks,omitempty"`
+	// Gas budget for sending the proceeds of filled and expired auto_withdraw limit orders in EndBlock
+	AutoWithdrawAllowance uint64 `protobuf:"varint,16,opt,name=auto_withdraw_allowance,json=autoWithdrawAllowance,proto3" json:"auto_withdraw_allowance,omitempty"`
+	// Fee tier whose pools charge a dynamic fee. The tier sets the tick spacing of the pools while the fee they
+	// charge is recomputed every block from the realised tick movement of the pair. The dynamic fee is disabled
+	// if dynamic_fee_max_bps is zero.
+	DynamicFeeTier uint64 `protobuf:"varint,17,opt,name=dynamic_fee_tier,json=dynamicFeeTier,proto3" json:"dynamic_fee_tier,omitempty"`
+	// Lower bound of the dynamic fee in basis points, it cannot be less than dynamic_fee_tier
+	DynamicFeeMinBps uint64 `protobuf:"varint,18,opt,name=dynamic_fee_min_bps,json=dynamicFeeMinBps,proto3" json:"dynamic_fee_min_bps,omitempty"`
+	// Upper bound of the dynamic fee in basis points
+	DynamicFeeMaxBps uint64 `protobuf:"varint,19,opt,name=dynamic_fee_max_bps,json=dynamicFeeMaxBps,proto3" json:"dynamic_fee_max_bps,omitempty"`
+	// Number of blocks of tick movement used to compute the dynamic fee
+	DynamicFeeWindow uint64 `protobuf:"varint,20,opt,name=dynamic_fee_window,json=dynamicFeeWindow,proto3" json:"dynamic_fee_window,omitempty"`
+	// Gas budget for clearing batch auctions in EndBlock, including solving each auction. Pairs that are not cleared
+	// once it is spent stay queued and are cleared in the following blocks. The orders of a pair that cannot be solved
+	// with the whole budget are refunded.
+	BatchAuctionAllowance uint64 `protobuf:"varint,21,opt,name=batch_auction_allowance,json=batchAuctionAllowance,proto3" json:"batch_auction_allowance,omitempty"`
+	// Gas budget for distributing Gauge rewards in BeginBlock. Gauges that have not distributed the current epoch once
+	// it is spent distribute it in the following blocks.
+	GaugeDistributionAllowance uint64 `protobuf:"varint,22,opt,name=gauge_distribution_allowance,json=gaugeDistributionAllowance,proto3" json:"gauge_distribution_allowance,omitempty"`
+	// Fee charged for creating a Gauge on top of the coins it distributes. It is sent to the protocol fee collector.
+	GaugeCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,23,rep,name=gauge_creation_fee,json=gaugeCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"gauge_creation_fee"`
+	// Minimum amount of each pool share in a Stake. It bounds the number of Stakes that Gauges page through
+	// for a given amount of staked liquidity.
+	MinStakeShares uint64 `protobuf:"varint,24,opt,name=min_stake_shares,json=minStakeShares,proto3" json:"min_stake_shares,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -91,33 +171,371 @@ func (m *Params) GetGoodTilPurgeAllowance() uint64 {
 	return 0
 }
 
+func (m *Params) GetTriggerOrderAllowance() uint64 {
+	if m != nil {
+		return m.TriggerOrderAllowance
+	}
+	return 0
+}
+
+func (m *Params) GetHookContract() string {
+	if m != nil {
+		return m.HookContract
+	}
+	return ""
+}
+
+func (m *Params) GetCircuitBreakerMaxTickMove() uint64 {
+	if m != nil {
+		return m.CircuitBreakerMaxTickMove
+	}
+	return 0
+}
+
+func (m *Params) GetCircuitBreakerWindow() uint64 {
+	if m != nil {
+		return m.CircuitBreakerWindow
+	}
+	return 0
+}
+
+func (m *Params) GetProtocolFees() []ProtocolFee {
+	if m != nil {
+		return m.ProtocolFees
+	}
+	return nil
+}
+
+func (m *Params) GetOraclePriceGuards() []OraclePriceGuard {
+	if m != nil {
+		return m.OraclePriceGuards
+	}
+	return nil
+}
+
+func (m *Params) GetPeggedOrderAllowance() uint64 {
+	if m != nil {
+		return m.PeggedOrderAllowance
+	}
+	return 0
+}
+
+func (m *Params) GetLimitOrderTakerFeeBps() uint64 {
+	if m != nil {
+		return m.LimitOrderTakerFeeBps
+	}
+	return 0
+}
+
+func (m *Params) GetLimitOrderMakerRebateBps() uint64 {
+	if m != nil {
+		return m.LimitOrderMakerRebateBps
+	}
+	return 0
+}
+
+func (m *Params) GetGaugeEpochBlocks() uint64 {
+	if m != nil {
+		return m.GaugeEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetAutoWithdrawAllowance() uint64 {
+	if m != nil {
+		return m.AutoWithdrawAllowance
+	}
+	return 0
+}
+
+func (m *Params) GetDynamicFeeTier() uint64 {
+	if m != nil {
+		return m.DynamicFeeTier
+	}
+	return 0
+}
+
+func (m *Params) GetDynamicFeeMinBps() uint64 {
+	if m != nil {
+		return m.DynamicFeeMinBps
+	}
+	return 0
+}
+
+func (m *Params) GetDynamicFeeMaxBps() uint64 {
+	if m != nil {
+		return m.DynamicFeeMaxBps
+	}
+	return 0
+}
+
+func (m *Params) GetDynamicFeeWindow() uint64 {
+	if m != nil {
+		return m.DynamicFeeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetBatchAuctionAllowance() uint64 {
+	if m != nil {
+		return m.BatchAuctionAllowance
+	}
+	return 0
+}
+
+func (m *Params) GetGaugeDistributionAllowance() uint64 {
+	if m != nil {
+		return m.GaugeDistributionAllowance
+	}
+	return 0
+}
+
+func (m *Params) GetGaugeCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.GaugeCreationFee
+	}
+	return nil
+}
+
+func (m *Params) GetMinStakeShares() uint64 {
+	if m != nil {
+		return m.MinStakeShares
+	}
+	return 0
+}
+
+// ProtocolFee is the fraction of the swap fees earned by pools of fee_tier that is taken by the protocol
+type ProtocolFee struct {
+	FeeTier  uint64                                               `protobuf:"varint,1,opt,name=fee_tier,json=feeTier,proto3" json:"fee_tier,omitempty"`
+	Fraction github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,2,opt,name=fraction,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"fraction" yaml:"fraction"`
+}
+
+func (m *ProtocolFee) Reset()         { *m = ProtocolFee{} }
+func (m *ProtocolFee) String() string { return proto.CompactTextString(m) }
+func (*ProtocolFee) ProtoMessage()    {}
+func (*ProtocolFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a6bffcfc21009c, []int{1}
+}
+func (m *ProtocolFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtocolFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtocolFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtocolFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtocolFee.Merge(m, src)
+}
+func (m *ProtocolFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtocolFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtocolFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtocolFee proto.InternalMessageInfo
+
+func (m *ProtocolFee) GetFeeTier() uint64 {
+	if m != nil {
+		return m.FeeTier
+	}
+	return 0
+}
+
+// OraclePriceGuard bounds the execution price of swaps on a pair to within max_deviation_bps of an x/oracle price.
+// Only prices that are worse for the taker than the oracle price are bounded so that arbitrage towards the oracle
+// price is always possible. If the oracle has no price, or the price is older than max_price_age blocks, the
+// guard is not applied.
+type OraclePriceGuard struct {
+	// Canonical PairID of the guarded pair, ie. "tokenA<>tokenB"
+	PairId string `protobuf:"bytes,1,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// x/oracle CurrencyPair in "BASE/QUOTE" form
+	CurrencyPair string `protobuf:"bytes,2,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// Denom of the pair that corresponds to the base of currency_pair; the other denom of the pair is the quote
+	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// Number of decimals of base_denom and the quote denom, used to convert the oracle price into a price between base units
+	BaseDecimals    uint64                 `protobuf:"varint,4,opt,name=base_decimals,json=baseDecimals,proto3" json:"base_decimals,omitempty"`
+	QuoteDecimals   uint64                 `protobuf:"varint,5,opt,name=quote_decimals,json=quoteDecimals,proto3" json:"quote_decimals,omitempty"`
+	MaxDeviationBps uint64                 `protobuf:"varint,6,opt,name=max_deviation_bps,json=maxDeviationBps,proto3" json:"max_deviation_bps,omitempty"`
+	Action          OraclePriceGuardAction `protobuf:"varint,7,opt,name=action,proto3,enum=neutron.dex.OraclePriceGuardAction" json:"action,omitempty"`
+	// Maximum age of the oracle price in blocks. Zero means the price never goes stale.
+	MaxPriceAge uint64 `protobuf:"varint,8,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+}
+
+func (m *OraclePriceGuard) Reset()         { *m = OraclePriceGuard{} }
+func (m *OraclePriceGuard) String() string { return proto.CompactTextString(m) }
+func (*OraclePriceGuard) ProtoMessage()    {}
+func (*OraclePriceGuard) Descriptor() ([]byte, []int) {
+	return fileDescriptor_84a6bffcfc21009c, []int{2}
+}
+func (m *OraclePriceGuard) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePriceGuard) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePriceGuard.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OraclePriceGuard) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePriceGuard.Merge(m, src)
+}
+func (m *OraclePriceGuard) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePriceGuard) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePriceGuard.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePriceGuard proto.InternalMessageInfo
+
+func (m *OraclePriceGuard) GetPairId() string {
+	if m != nil {
+		return m.PairId
+	}
+	return ""
+}
+
+func (m *OraclePriceGuard) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *OraclePriceGuard) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *OraclePriceGuard) GetBaseDecimals() uint64 {
+	if m != nil {
+		return m.BaseDecimals
+	}
+	return 0
+}
+
+func (m *OraclePriceGuard) GetQuoteDecimals() uint64 {
+	if m != nil {
+		return m.QuoteDecimals
+	}
+	return 0
+}
+
+func (m *OraclePriceGuard) GetMaxDeviationBps() uint64 {
+	if m != nil {
+		return m.MaxDeviationBps
+	}
+	return 0
+}
+
+func (m *OraclePriceGuard) GetAction() OraclePriceGuardAction {
+	if m != nil {
+		return m.Action
+	}
+	return OraclePriceGuardAction_REJECT
+}
+
+func (m *OraclePriceGuard) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("neutron.dex.OraclePriceGuardAction", OraclePriceGuardAction_name, OraclePriceGuardAction_value)
 	proto.RegisterType((*Params)(nil), "neutron.dex.Params")
+	proto.RegisterType((*ProtocolFee)(nil), "neutron.dex.ProtocolFee")
+	proto.RegisterType((*OraclePriceGuard)(nil), "neutron.dex.OraclePriceGuard")
 }
 
 func init() { proto.RegisterFile("neutron/dex/params.proto", fileDescriptor_84a6bffcfc21009c) }
 
 var fileDescriptor_84a6bffcfc21009c = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x90, 0x31, 0x4b, 0x03, 0x31,
-	0x14, 0xc7, 0x2f, 0xf4, 0x2c, 0x35, 0x0e, 0x42, 0x50, 0x08, 0x0a, 0x69, 0xe9, 0x54, 0x90, 0x36,
-	0x83, 0x88, 0xe0, 0x66, 0x17, 0xc1, 0xa9, 0x94, 0x4e, 0x2e, 0x21, 0xed, 0xbd, 0xc6, 0xe8, 0x5d,
-	0x13, 0x92, 0x9c, 0x9e, 0xdf, 0xc2, 0xd1, 0xd1, 0x2f, 0xe1, 0x77, 0x70, 0xec, 0xe8, 0x24, 0x72,
-	0xb7, 0xf9, 0x29, 0xe4, 0xce, 0xeb, 0xf4, 0xfe, 0xef, 0xff, 0x7b, 0xff, 0xe1, 0xfd, 0x31, 0xdd,
-	0x40, 0x1e, 0x9c, 0xd9, 0xf0, 0x04, 0x0a, 0x6e, 0xa5, 0x93, 0x99, 0x9f, 0x58, 0x67, 0x82, 0x21,
-	0x07, 0x2d, 0x99, 0x24, 0x50, 0x9c, 0x1c, 0x29, 0xa3, 0x4c, 0xe3, 0xf3, 0x5a, 0xfd, 0x9f, 0x0c,
-	0x3f, 0x10, 0xee, 0xce, 0x9a, 0x0c, 0x39, 0xc5, 0xfb, 0x6b, 0x00, 0x11, 0x34, 0x38, 0x4f, 0xd1,
-	0xa0, 0x33, 0x8a, 0xe7, 0xbd, 0x35, 0xc0, 0xa2, 0xde, 0xc9, 0x10, 0x77, 0xad, 0xcc, 0x3d, 0x24,
-	0xb4, 0x33, 0x40, 0xa3, 0xde, 0x14, 0xff, 0x7e, 0xf7, 0x5b, 0x67, 0xde, 0x4e, 0x72, 0x86, 0x49,
-	0x26, 0x0b, 0xf1, 0xa0, 0x83, 0x17, 0x16, 0x9c, 0x58, 0xa6, 0x66, 0xf5, 0x48, 0xe3, 0x01, 0x1a,
-	0xc5, 0xf3, 0xc3, 0x4c, 0x16, 0xb7, 0x3a, 0xf8, 0x19, 0xb8, 0x69, 0x6d, 0x93, 0x4b, 0x4c, 0x95,
-	0x31, 0x89, 0x08, 0x3a, 0x15, 0x36, 0x77, 0x0a, 0x84, 0x4c, 0x53, 0xf3, 0x2c, 0x37, 0x2b, 0xa0,
-	0x7b, 0x4d, 0xe4, 0xb8, 0xe6, 0x0b, 0x9d, 0xce, 0x6a, 0x7a, 0xbd, 0x83, 0x57, 0xf1, 0xdb, 0x7b,
-	0x3f, 0x9a, 0xde, 0x7c, 0x96, 0x0c, 0x6d, 0x4b, 0x86, 0x7e, 0x4a, 0x86, 0x5e, 0x2b, 0x16, 0x6d,
-	0x2b, 0x16, 0x7d, 0x55, 0x2c, 0xba, 0x1b, 0x2b, 0x1d, 0xee, 0xf3, 0xe5, 0x64, 0x65, 0x32, 0xde,
-	0xfe, 0x3f, 0x36, 0x4e, 0xed, 0x34, 0x7f, 0xba, 0xe0, 0x45, 0x53, 0x55, 0x78, 0xb1, 0xe0, 0x97,
-	0xdd, 0xa6, 0x87, 0xf3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc6, 0xda, 0x12, 0xf5, 0x46, 0x01,
-	0x00, 0x00,
+	// 1055 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x55, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xb6, 0x9b, 0xe0, 0xc4, 0x93, 0x8f, 0xba, 0xd3, 0x34, 0xd9, 0x84, 0xc6, 0x8e, 0x5c, 0x21,
+	0x59, 0x85, 0xd8, 0xb4, 0x94, 0x82, 0x8a, 0x84, 0x1a, 0x3b, 0x49, 0x45, 0xa5, 0xa8, 0x96, 0x13,
+	0xa9, 0x12, 0x1c, 0x46, 0xe3, 0xd9, 0x37, 0xeb, 0xc1, 0xde, 0x9d, 0x65, 0x66, 0x36, 0x76, 0x7e,
+	0x05, 0x1c, 0x39, 0xc2, 0x95, 0x5f, 0x92, 0x63, 0x8f, 0x88, 0x43, 0x40, 0xc9, 0xad, 0x47, 0x7e,
+	0x01, 0x9a, 0x0f, 0xc7, 0xae, 0x8b, 0xe0, 0xe4, 0xf5, 0xf3, 0x31, 0x3b, 0xef, 0xbc, 0xef, 0x3c,
+	0x8b, 0x82, 0x04, 0x32, 0x2d, 0x45, 0xd2, 0x08, 0x61, 0xd4, 0x48, 0xa9, 0xa4, 0xb1, 0xaa, 0xa7,
+	0x52, 0x68, 0x81, 0x97, 0x3c, 0x53, 0x0f, 0x61, 0xb4, 0x55, 0x66, 0x42, 0xc5, 0x42, 0x35, 0xba,
+	0x54, 0x41, 0xe3, 0xec, 0x51, 0x17, 0x34, 0x7d, 0xd4, 0x60, 0x82, 0x27, 0x4e, 0xbc, 0xb5, 0x16,
+	0x89, 0x48, 0xd8, 0xc7, 0x86, 0x79, 0x72, 0x68, 0xf5, 0x47, 0x84, 0x0a, 0x6d, 0xbb, 0x26, 0xfe,
+	0x10, 0x15, 0x4f, 0x01, 0x88, 0xe6, 0x20, 0x55, 0x90, 0xdf, 0x99, 0xab, 0xcd, 0x77, 0x16, 0x4f,
+	0x01, 0x4e, 0xcc, 0x7f, 0x5c, 0x45, 0x85, 0x94, 0x66, 0x0a, 0xc2, 0x60, 0x6e, 0x27, 0x5f, 0x5b,
+	0x6c, 0xa2, 0xb7, 0x97, 0x15, 0x8f, 0x74, 0xfc, 0x2f, 0xfe, 0x18, 0xe1, 0x98, 0x8e, 0xc8, 0xf7,
+	0x5c, 0x2b, 0x92, 0x82, 0x24, 0xdd, 0x81, 0x60, 0xfd, 0x60, 0x7e, 0x27, 0x5f, 0x9b, 0xef, 0xdc,
+	0x8e, 0xe9, 0xe8, 0x25, 0xd7, 0xaa, 0x0d, 0xb2, 0x69, 0x60, 0xfc, 0x05, 0x0a, 0x22, 0x21, 0x42,
+	0xa2, 0xf9, 0x80, 0xa4, 0x99, 0x8c, 0x80, 0xd0, 0xc1, 0x40, 0x0c, 0x69, 0xc2, 0x20, 0xf8, 0xc0,
+	0x5a, 0xee, 0x19, 0xfe, 0x84, 0x0f, 0xda, 0x86, 0xdd, 0x1b, 0x93, 0xf8, 0x29, 0xda, 0xd0, 0x92,
+	0x47, 0x11, 0x48, 0x22, 0x64, 0x08, 0x72, 0xca, 0x57, 0x70, 0x3e, 0x4f, 0xbf, 0x32, 0xec, 0xc4,
+	0xf7, 0x00, 0xad, 0xf4, 0x84, 0xe8, 0x13, 0x26, 0x12, 0x2d, 0x29, 0xd3, 0xc1, 0xc2, 0x4e, 0xbe,
+	0x56, 0xec, 0x2c, 0x1b, 0xb0, 0xe5, 0x31, 0xfc, 0x1c, 0x6d, 0x33, 0x2e, 0x59, 0xc6, 0x35, 0xe9,
+	0x4a, 0xa0, 0x7d, 0x90, 0xc4, 0x94, 0xa4, 0x39, 0xeb, 0x93, 0x58, 0x9c, 0x41, 0xb0, 0x68, 0x5f,
+	0xb1, 0xe9, 0x45, 0x4d, 0xa7, 0x39, 0xa2, 0xa3, 0x13, 0xce, 0xfa, 0x47, 0xe2, 0x0c, 0xf0, 0x13,
+	0xb4, 0x3e, 0xbb, 0xc2, 0x90, 0x27, 0xa1, 0x18, 0x06, 0x45, 0x6b, 0x5d, 0x7b, 0xd7, 0xfa, 0xda,
+	0x72, 0xb8, 0x85, 0x56, 0x6c, 0x3f, 0x98, 0x18, 0x90, 0x53, 0x00, 0x15, 0xa0, 0x9d, 0xb9, 0xda,
+	0xd2, 0xe3, 0xa0, 0x3e, 0xd5, 0xe1, 0x7a, 0xdb, 0x2b, 0x0e, 0x01, 0x9a, 0xf3, 0x17, 0x97, 0x95,
+	0x5c, 0x67, 0x39, 0x9d, 0x40, 0x0a, 0x1f, 0xa3, 0xbb, 0x42, 0x52, 0x36, 0x00, 0x92, 0x4a, 0xce,
+	0x80, 0x44, 0x19, 0x95, 0xa1, 0x0a, 0x96, 0xec, 0x52, 0xdb, 0xef, 0x2c, 0xf5, 0xca, 0xea, 0xda,
+	0x46, 0xf6, 0xc2, 0xa8, 0xfc, 0x7a, 0x77, 0xc4, 0x0c, 0xae, 0x4c, 0x3d, 0x29, 0x44, 0x11, 0x84,
+	0xef, 0x9d, 0xf6, 0xb2, 0xab, 0xc7, 0xb1, 0x33, 0x87, 0xfd, 0x25, 0xda, 0x1c, 0xf0, 0x98, 0x6b,
+	0x6f, 0xd2, 0xf6, 0x1c, 0xcc, 0x74, 0x75, 0x53, 0x15, 0xac, 0xb8, 0x36, 0x59, 0x81, 0xf5, 0x9d,
+	0x18, 0xda, 0x54, 0x95, 0x2a, 0xfc, 0x35, 0xba, 0x3f, 0xed, 0x8c, 0xad, 0x53, 0x42, 0x97, 0x6a,
+	0x67, 0x5e, 0xb5, 0xe6, 0x60, 0x62, 0x3e, 0x32, 0x8a, 0x8e, 0x15, 0x18, 0xff, 0x27, 0x08, 0x47,
+	0x34, 0x8b, 0x80, 0x40, 0x2a, 0x58, 0xcf, 0xcd, 0xa0, 0x0a, 0x6e, 0x5b, 0x57, 0xc9, 0x32, 0x07,
+	0x86, 0xb0, 0x43, 0xa8, 0xcc, 0x30, 0xd1, 0x4c, 0x0b, 0x32, 0xe4, 0xba, 0x17, 0x4a, 0x3a, 0x9c,
+	0x2a, 0xaf, 0xe4, 0x76, 0x69, 0xe8, 0xd7, 0x9e, 0x9d, 0xd4, 0x57, 0x43, 0xa5, 0xf0, 0x3c, 0xa1,
+	0x31, 0x67, 0x64, 0x7c, 0x67, 0x82, 0x3b, 0xd6, 0xb0, 0xea, 0xf1, 0x43, 0x77, 0x73, 0xf0, 0x2e,
+	0xba, 0x3b, 0xad, 0x8c, 0x79, 0x62, 0xcb, 0xc0, 0x6e, 0x43, 0x13, 0xf1, 0x11, 0x4f, 0xcc, 0xf6,
+	0x67, 0xe5, 0x74, 0x64, 0xe5, 0x77, 0xdf, 0x93, 0xd3, 0x91, 0xaf, 0x76, 0x5a, 0xee, 0x27, 0x6d,
+	0x6d, 0x56, 0xed, 0xa7, 0xec, 0x29, 0xda, 0xe8, 0x52, 0xcd, 0x7a, 0x84, 0x66, 0x4c, 0x73, 0x91,
+	0x4c, 0x55, 0x7b, 0xcf, 0x55, 0x6b, 0xe9, 0x3d, 0xc7, 0x4e, 0xaa, 0x7d, 0x8e, 0xee, 0xbb, 0x33,
+	0x0d, 0xb9, 0xd2, 0x92, 0x77, 0xb3, 0x19, 0xf3, 0xba, 0x35, 0x6f, 0x59, 0xcd, 0xfe, 0x94, 0x64,
+	0xb2, 0xc2, 0xf9, 0xb8, 0x2b, 0x4c, 0x02, 0xb5, 0xee, 0x53, 0x80, 0x60, 0xc3, 0x4e, 0xe6, 0x66,
+	0xdd, 0x25, 0x57, 0xdd, 0x24, 0x57, 0xdd, 0x27, 0x57, 0xbd, 0x25, 0x78, 0xd2, 0xfc, 0xd4, 0x4c,
+	0xe5, 0x6f, 0x7f, 0x56, 0x6a, 0x11, 0xd7, 0xbd, 0xac, 0x5b, 0x67, 0x22, 0x6e, 0xf8, 0x98, 0x73,
+	0x3f, 0xbb, 0x2a, 0xec, 0x37, 0xf4, 0x79, 0x0a, 0xca, 0x1a, 0x94, 0x6f, 0x71, 0xcb, 0xbf, 0xe5,
+	0x10, 0x6c, 0xab, 0xcc, 0xa1, 0x2b, 0x33, 0x83, 0x44, 0xf5, 0xa8, 0x04, 0x15, 0x04, 0xae, 0x55,
+	0x31, 0x4f, 0x8e, 0x0d, 0x7c, 0x6c, 0xd1, 0x67, 0xf3, 0x3f, 0xff, 0x52, 0xc9, 0x55, 0x7f, 0xcd,
+	0xa3, 0xa5, 0xa9, 0x9b, 0x86, 0x37, 0xd1, 0xe2, 0x4d, 0x8b, 0xf3, 0xd6, 0xb7, 0xe0, 0x53, 0x11,
+	0x0f, 0xd1, 0xe2, 0xa9, 0x89, 0x0d, 0x2e, 0x92, 0xe0, 0x96, 0x49, 0x93, 0xe6, 0x77, 0x66, 0xc3,
+	0x7f, 0x5c, 0x56, 0x9e, 0x4c, 0x6d, 0xd8, 0xdf, 0xbb, 0x5d, 0x21, 0xa3, 0xf1, 0x73, 0xe3, 0xec,
+	0xf3, 0x46, 0xa6, 0xf9, 0x40, 0x35, 0x62, 0xaa, 0x7b, 0xf5, 0xb6, 0x04, 0xb6, 0x0f, 0xec, 0xed,
+	0x65, 0xe5, 0x66, 0xbd, 0xbf, 0x2f, 0x2b, 0xb7, 0xcf, 0x69, 0x3c, 0x78, 0x56, 0x1d, 0x23, 0xd5,
+	0xce, 0x0d, 0x59, 0xbd, 0xb8, 0x85, 0x4a, 0xb3, 0x57, 0x18, 0x6f, 0xa0, 0x85, 0x94, 0x72, 0x49,
+	0x78, 0x68, 0xf7, 0x59, 0x34, 0xb9, 0xcc, 0xe5, 0x37, 0xa1, 0x49, 0x3e, 0x96, 0x49, 0x09, 0x09,
+	0x3b, 0x27, 0x06, 0x72, 0x7b, 0xed, 0x2c, 0x8f, 0xc1, 0x36, 0xe5, 0x12, 0x6f, 0x23, 0x64, 0xce,
+	0x9f, 0x84, 0x90, 0x88, 0xd8, 0x86, 0x7c, 0xb1, 0x53, 0x34, 0xc8, 0xbe, 0x01, 0xcc, 0x1a, 0x9e,
+	0x66, 0x3c, 0xa6, 0x03, 0xe5, 0x63, 0x7d, 0xd9, 0x29, 0x1c, 0x86, 0x3f, 0x42, 0xab, 0x3f, 0x64,
+	0x42, 0x4f, 0xa9, 0x5c, 0x92, 0xaf, 0x58, 0xf4, 0x46, 0xf6, 0x10, 0xdd, 0x31, 0x73, 0x1d, 0xc2,
+	0x19, 0x77, 0xb3, 0x60, 0x26, 0xbc, 0x70, 0xf3, 0x99, 0xd8, 0x1f, 0xe3, 0x66, 0xc0, 0xbf, 0x42,
+	0x05, 0x7f, 0xc0, 0x26, 0xae, 0x57, 0x1f, 0x3f, 0xf8, 0xcf, 0x18, 0xdb, 0xb3, 0xd2, 0x8e, 0xb7,
+	0xe0, 0x2a, 0x5a, 0x31, 0x2f, 0x72, 0x69, 0x48, 0xa3, 0x71, 0x7a, 0x2f, 0xc5, 0x74, 0x64, 0x3d,
+	0x7b, 0x11, 0x3c, 0xdc, 0x45, 0xeb, 0xff, 0xbe, 0x0a, 0x46, 0xa8, 0xd0, 0x39, 0x78, 0x79, 0xd0,
+	0x3a, 0x29, 0xe5, 0xf0, 0x02, 0x9a, 0x6b, 0xed, 0xb5, 0x4b, 0xf9, 0xe6, 0x8b, 0x8b, 0xab, 0x72,
+	0xfe, 0xcd, 0x55, 0x39, 0xff, 0xd7, 0x55, 0x39, 0xff, 0xd3, 0x75, 0x39, 0xf7, 0xe6, 0xba, 0x9c,
+	0xfb, 0xfd, 0xba, 0x9c, 0xfb, 0x76, 0xf7, 0xff, 0x5b, 0x3e, 0xb2, 0x9f, 0x70, 0x3b, 0xae, 0xdd,
+	0x82, 0x8d, 0xee, 0xcf, 0xfe, 0x19, 0x00, 0x8c, 0xed, 0xfb, 0x79, 0xde, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -140,6 +558,148 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinStakeShares != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinStakeShares))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if len(m.GaugeCreationFee) > 0 {
+		for iNdEx := len(m.GaugeCreationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GaugeCreationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if m.GaugeDistributionAllowance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GaugeDistributionAllowance))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if m.BatchAuctionAllowance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BatchAuctionAllowance))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
+	if m.DynamicFeeWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DynamicFeeWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DynamicFeeMaxBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DynamicFeeMaxBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DynamicFeeMinBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DynamicFeeMinBps))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.DynamicFeeTier != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DynamicFeeTier))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.AutoWithdrawAllowance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoWithdrawAllowance))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.GaugeEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.GaugeEpochBlocks))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.LimitOrderMakerRebateBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LimitOrderMakerRebateBps))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LimitOrderTakerFeeBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LimitOrderTakerFeeBps))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.PeggedOrderAllowance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PeggedOrderAllowance))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.OraclePriceGuards) > 0 {
+		for iNdEx := len(m.OraclePriceGuards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OraclePriceGuards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ProtocolFees) > 0 {
+		for iNdEx := len(m.ProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.CircuitBreakerWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CircuitBreakerWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CircuitBreakerMaxTickMove != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CircuitBreakerMaxTickMove))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.HookContract) > 0 {
+		i -= len(m.HookContract)
+		copy(dAtA[i:], m.HookContract)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.HookContract)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.TriggerOrderAllowance != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TriggerOrderAllowance))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.GoodTilPurgeAllowance != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.GoodTilPurgeAllowance))
 		i--
@@ -181,16 +741,123 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProtocolFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *ProtocolFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtocolFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.FeeTier != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FeeTier))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OraclePriceGuard) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePriceGuard) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePriceGuard) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Action != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxDeviationBps != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDeviationBps))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.QuoteDecimals != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.QuoteDecimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BaseDecimals != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseDecimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PairId) > 0 {
+		i -= len(m.PairId)
+		copy(dAtA[i:], m.PairId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PairId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *Params) Size() (n int) {
 	if m == nil {
@@ -214,6 +881,123 @@ func (m *Params) Size() (n int) {
 	if m.GoodTilPurgeAllowance != 0 {
 		n += 1 + sovParams(uint64(m.GoodTilPurgeAllowance))
 	}
+	if m.TriggerOrderAllowance != 0 {
+		n += 1 + sovParams(uint64(m.TriggerOrderAllowance))
+	}
+	l = len(m.HookContract)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.CircuitBreakerMaxTickMove != 0 {
+		n += 1 + sovParams(uint64(m.CircuitBreakerMaxTickMove))
+	}
+	if m.CircuitBreakerWindow != 0 {
+		n += 1 + sovParams(uint64(m.CircuitBreakerWindow))
+	}
+	if len(m.ProtocolFees) > 0 {
+		for _, e := range m.ProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.OraclePriceGuards) > 0 {
+		for _, e := range m.OraclePriceGuards {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.PeggedOrderAllowance != 0 {
+		n += 1 + sovParams(uint64(m.PeggedOrderAllowance))
+	}
+	if m.LimitOrderTakerFeeBps != 0 {
+		n += 1 + sovParams(uint64(m.LimitOrderTakerFeeBps))
+	}
+	if m.LimitOrderMakerRebateBps != 0 {
+		n += 1 + sovParams(uint64(m.LimitOrderMakerRebateBps))
+	}
+	if m.GaugeEpochBlocks != 0 {
+		n += 1 + sovParams(uint64(m.GaugeEpochBlocks))
+	}
+	if m.AutoWithdrawAllowance != 0 {
+		n += 2 + sovParams(uint64(m.AutoWithdrawAllowance))
+	}
+	if m.DynamicFeeTier != 0 {
+		n += 2 + sovParams(uint64(m.DynamicFeeTier))
+	}
+	if m.DynamicFeeMinBps != 0 {
+		n += 2 + sovParams(uint64(m.DynamicFeeMinBps))
+	}
+	if m.DynamicFeeMaxBps != 0 {
+		n += 2 + sovParams(uint64(m.DynamicFeeMaxBps))
+	}
+	if m.DynamicFeeWindow != 0 {
+		n += 2 + sovParams(uint64(m.DynamicFeeWindow))
+	}
+	if m.BatchAuctionAllowance != 0 {
+		n += 2 + sovParams(uint64(m.BatchAuctionAllowance))
+	}
+	if m.GaugeDistributionAllowance != 0 {
+		n += 2 + sovParams(uint64(m.GaugeDistributionAllowance))
+	}
+	if len(m.GaugeCreationFee) > 0 {
+		for _, e := range m.GaugeCreationFee {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MinStakeShares != 0 {
+		n += 2 + sovParams(uint64(m.MinStakeShares))
+	}
+	return n
+}
+
+func (m *ProtocolFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FeeTier != 0 {
+		n += 1 + sovParams(uint64(m.FeeTier))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *OraclePriceGuard) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PairId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.BaseDecimals != 0 {
+		n += 1 + sovParams(uint64(m.BaseDecimals))
+	}
+	if m.QuoteDecimals != 0 {
+		n += 1 + sovParams(uint64(m.QuoteDecimals))
+	}
+	if m.MaxDeviationBps != 0 {
+		n += 1 + sovParams(uint64(m.MaxDeviationBps))
+	}
+	if m.Action != 0 {
+		n += 1 + sovParams(uint64(m.Action))
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceAge))
+	}
 	return n
 }
 
@@ -386,6 +1170,769 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerOrderAllowance", wireType)
+			}
+			m.TriggerOrderAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerOrderAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HookContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HookContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerMaxTickMove", wireType)
+			}
+			m.CircuitBreakerMaxTickMove = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerMaxTickMove |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CircuitBreakerWindow", wireType)
+			}
+			m.CircuitBreakerWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CircuitBreakerWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFees = append(m.ProtocolFees, ProtocolFee{})
+			if err := m.ProtocolFees[len(m.ProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePriceGuards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OraclePriceGuards = append(m.OraclePriceGuards, OraclePriceGuard{})
+			if err := m.OraclePriceGuards[len(m.OraclePriceGuards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeggedOrderAllowance", wireType)
+			}
+			m.PeggedOrderAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeggedOrderAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderTakerFeeBps", wireType)
+			}
+			m.LimitOrderTakerFeeBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitOrderTakerFeeBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitOrderMakerRebateBps", wireType)
+			}
+			m.LimitOrderMakerRebateBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LimitOrderMakerRebateBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeEpochBlocks", wireType)
+			}
+			m.GaugeEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoWithdrawAllowance", wireType)
+			}
+			m.AutoWithdrawAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoWithdrawAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeTier", wireType)
+			}
+			m.DynamicFeeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DynamicFeeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeMinBps", wireType)
+			}
+			m.DynamicFeeMinBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DynamicFeeMinBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeMaxBps", wireType)
+			}
+			m.DynamicFeeMaxBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DynamicFeeMaxBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFeeWindow", wireType)
+			}
+			m.DynamicFeeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DynamicFeeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchAuctionAllowance", wireType)
+			}
+			m.BatchAuctionAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchAuctionAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeDistributionAllowance", wireType)
+			}
+			m.GaugeDistributionAllowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeDistributionAllowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeCreationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GaugeCreationFee = append(m.GaugeCreationFee, types.Coin{})
+			if err := m.GaugeCreationFee[len(m.GaugeCreationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStakeShares", wireType)
+			}
+			m.MinStakeShares = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinStakeShares |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtocolFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtocolFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtocolFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTier", wireType)
+			}
+			m.FeeTier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FeeTier |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OraclePriceGuard) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OraclePriceGuard: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OraclePriceGuard: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PairId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDecimals", wireType)
+			}
+			m.BaseDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDecimals", wireType)
+			}
+			m.QuoteDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviationBps", wireType)
+			}
+			m.MaxDeviationBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDeviationBps |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= OraclePriceGuardAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
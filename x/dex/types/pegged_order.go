@@ -0,0 +1,82 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slinkytypes "github.com/skip-mev/slinky/pkg/types"
+
+	math_utils "github.com/neutron-org/neutron/v5/utils/math"
+)
+
+// MaxOraclePegOffsetBps bounds the offset of an OraclePeg so that the pegged price is always positive
+const MaxOraclePegOffsetBps = 9_999
+
+func (p OraclePeg) Validate(tokenIn, tokenOut string) error {
+	if _, err := slinkytypes.CurrencyPairFromString(p.CurrencyPair); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidOraclePeg, "%s", err)
+	}
+
+	if p.BaseDenom != tokenIn && p.BaseDenom != tokenOut {
+		return sdkerrors.Wrapf(ErrInvalidOraclePeg, "base_denom %s must be token_in or token_out", p.BaseDenom)
+	}
+
+	if p.BaseDecimals > MaxOraclePriceGuardDecimals || p.QuoteDecimals > MaxOraclePriceGuardDecimals {
+		return sdkerrors.Wrapf(ErrInvalidOraclePeg, "decimals cannot exceed %d", MaxOraclePriceGuardDecimals)
+	}
+
+	if p.OffsetBps > MaxOraclePegOffsetBps || p.OffsetBps < -MaxOraclePegOffsetBps {
+		return sdkerrors.Wrapf(ErrInvalidOraclePeg, "offset_bps must be between -%d and %d", MaxOraclePegOffsetBps, MaxOraclePegOffsetBps)
+	}
+
+	return nil
+}
+
+// MustCurrencyPair returns the x/oracle CurrencyPair of the peg
+func (p OraclePeg) MustCurrencyPair() slinkytypes.CurrencyPair {
+	cp, err := slinkytypes.CurrencyPairFromString(p.CurrencyPair)
+	if err != nil {
+		panic(err)
+	}
+
+	return cp
+}
+
+// LimitSellPrice converts an oracle price of one base token in quote tokens, with priceDecimals decimals, into the
+// price of one tokenIn in tokenOut and applies the peg's offset.
+func (p OraclePeg) LimitSellPrice(tokenIn string, oraclePrice math_utils.PrecDec, priceDecimals uint64) math_utils.PrecDec {
+	price := OracleBaseUnitPrice(oraclePrice, priceDecimals, p.BaseDecimals, p.QuoteDecimals)
+	if tokenIn != p.BaseDenom {
+		price = math_utils.OnePrecDec().Quo(price)
+	}
+
+	offset := math_utils.NewPrecDec(p.OffsetBps).Quo(math_utils.NewPrecDec(10_000))
+	return price.Mul(math_utils.OnePrecDec().Add(offset))
+}
+
+// TickIndexInToOut returns the tick at which the order is placed given the oracle price
+func (p OraclePeg) TickIndexInToOut(tokenIn string, oraclePrice math_utils.PrecDec, priceDecimals uint64) (int64, error) {
+	limitSellPrice := p.LimitSellPrice(tokenIn, oraclePrice, priceDecimals)
+	if IsPriceOutOfRange(limitSellPrice) {
+		return 0, sdkerrors.Wrapf(ErrPriceOutsideRange, "pegged price %s", limitSellPrice)
+	}
+
+	limitBuyPrice := math_utils.OnePrecDec().Quo(limitSellPrice)
+	return CalcTickIndexFromPrice(limitBuyPrice)
+}
+
+func (o PeggedOrder) Validate() error {
+	if o.TradePairId == nil {
+		return sdkerrors.Wrap(ErrInvalidTradingPair, "missing TradePairID")
+	}
+	if _, err := o.TradePairId.PairID(); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(o.Address); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid address (%s)", err)
+	}
+	if IsTickOutOfRange(o.TickIndexInToOut) {
+		return ErrTickOutsideRange
+	}
+
+	return o.Peg.Validate(o.TradePairId.TakerDenom, o.TradePairId.MakerDenom)
+}
@@ -0,0 +1,904 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/pegged_order.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OraclePeg pegs the sell price of a limit order to an x/oracle price
+type OraclePeg struct {
+	// x/oracle CurrencyPair in "BASE/QUOTE" form
+	CurrencyPair string `protobuf:"bytes,1,opt,name=currency_pair,json=currencyPair,proto3" json:"currency_pair,omitempty"`
+	// Denom of the order (token_in or token_out) that corresponds to the base of currency_pair
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// Number of decimals of base_denom and the quote denom, used to convert the oracle price into a price between base units
+	BaseDecimals  uint64 `protobuf:"varint,3,opt,name=base_decimals,json=baseDecimals,proto3" json:"base_decimals,omitempty"`
+	QuoteDecimals uint64 `protobuf:"varint,4,opt,name=quote_decimals,json=quoteDecimals,proto3" json:"quote_decimals,omitempty"`
+	// Offset of the sell price from the oracle price in basis points. Positive offsets sell above the oracle price.
+	OffsetBps int64 `protobuf:"varint,5,opt,name=offset_bps,json=offsetBps,proto3" json:"offset_bps,omitempty"`
+}
+
+func (m *OraclePeg) Reset()         { *m = OraclePeg{} }
+func (m *OraclePeg) String() string { return proto.CompactTextString(m) }
+func (*OraclePeg) ProtoMessage()    {}
+func (*OraclePeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0012f2fe75818ba8, []int{0}
+}
+func (m *OraclePeg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OraclePeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OraclePeg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OraclePeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OraclePeg.Merge(m, src)
+}
+func (m *OraclePeg) XXX_Size() int {
+	return m.Size()
+}
+func (m *OraclePeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_OraclePeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OraclePeg proto.InternalMessageInfo
+
+func (m *OraclePeg) GetCurrencyPair() string {
+	if m != nil {
+		return m.CurrencyPair
+	}
+	return ""
+}
+
+func (m *OraclePeg) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func (m *OraclePeg) GetBaseDecimals() uint64 {
+	if m != nil {
+		return m.BaseDecimals
+	}
+	return 0
+}
+
+func (m *OraclePeg) GetQuoteDecimals() uint64 {
+	if m != nil {
+		return m.QuoteDecimals
+	}
+	return 0
+}
+
+func (m *OraclePeg) GetOffsetBps() int64 {
+	if m != nil {
+		return m.OffsetBps
+	}
+	return 0
+}
+
+// PeggedOrder is a GOOD_TIL_CANCELLED limit order that is moved to the tick of its OraclePeg whenever the oracle price changes
+type PeggedOrder struct {
+	// Owner of the LimitOrderTrancheUser
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// Key of the tranche the order currently rests in
+	TrancheKey string `protobuf:"bytes,2,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	// TradePairID of the taker side of the order (ie. TakerDenom == token_in)
+	TradePairId *TradePairID `protobuf:"bytes,3,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	Peg         OraclePeg    `protobuf:"bytes,4,opt,name=peg,proto3" json:"peg"`
+	// Tick the order currently rests at, denominated the same way as tick_index_in_to_out
+	TickIndexInToOut int64 `protobuf:"varint,5,opt,name=tick_index_in_to_out,json=tickIndexInToOut,proto3" json:"tick_index_in_to_out,omitempty"`
+	// Block height of the oracle price the order was last placed with
+	OraclePriceHeight uint64 `protobuf:"varint,6,opt,name=oracle_price_height,json=oraclePriceHeight,proto3" json:"oracle_price_height,omitempty"`
+}
+
+func (m *PeggedOrder) Reset()         { *m = PeggedOrder{} }
+func (m *PeggedOrder) String() string { return proto.CompactTextString(m) }
+func (*PeggedOrder) ProtoMessage()    {}
+func (*PeggedOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0012f2fe75818ba8, []int{1}
+}
+func (m *PeggedOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeggedOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeggedOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeggedOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeggedOrder.Merge(m, src)
+}
+func (m *PeggedOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeggedOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeggedOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeggedOrder proto.InternalMessageInfo
+
+func (m *PeggedOrder) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PeggedOrder) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
+}
+
+func (m *PeggedOrder) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *PeggedOrder) GetPeg() OraclePeg {
+	if m != nil {
+		return m.Peg
+	}
+	return OraclePeg{}
+}
+
+func (m *PeggedOrder) GetTickIndexInToOut() int64 {
+	if m != nil {
+		return m.TickIndexInToOut
+	}
+	return 0
+}
+
+func (m *PeggedOrder) GetOraclePriceHeight() uint64 {
+	if m != nil {
+		return m.OraclePriceHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*OraclePeg)(nil), "neutron.dex.OraclePeg")
+	proto.RegisterType((*PeggedOrder)(nil), "neutron.dex.PeggedOrder")
+}
+
+func init() { proto.RegisterFile("neutron/dex/pegged_order.proto", fileDescriptor_0012f2fe75818ba8) }
+
+var fileDescriptor_0012f2fe75818ba8 = []byte{
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x92, 0x41, 0x6b, 0xdb, 0x30,
+	0x18, 0x86, 0xe3, 0x26, 0xeb, 0xc8, 0xe7, 0x66, 0x6c, 0x5a, 0x19, 0xa6, 0x50, 0x27, 0x74, 0x0c,
+	0x72, 0xa9, 0x0d, 0x1d, 0xbb, 0xed, 0x14, 0x0a, 0x5b, 0xd8, 0x21, 0xc1, 0xf4, 0xb4, 0x8b, 0x70,
+	0xac, 0xaf, 0x8a, 0x68, 0x63, 0x69, 0x92, 0x3c, 0xe2, 0x7f, 0xb1, 0xd3, 0x7e, 0xcc, 0x7e, 0x41,
+	0x8f, 0x3d, 0xee, 0x34, 0x46, 0xf2, 0x47, 0x8a, 0x64, 0x27, 0xa4, 0x37, 0xe9, 0x7d, 0x1f, 0x7d,
+	0xbc, 0x7a, 0xf9, 0x20, 0x2e, 0xb1, 0xb2, 0x5a, 0x96, 0x29, 0xc3, 0x75, 0xaa, 0x90, 0x73, 0x64,
+	0x54, 0x6a, 0x86, 0x3a, 0x51, 0x5a, 0x5a, 0x49, 0xc2, 0xd6, 0x4f, 0x18, 0xae, 0xcf, 0x4e, 0xb9,
+	0xe4, 0xd2, 0xeb, 0xa9, 0x3b, 0x35, 0xc8, 0xd9, 0xf0, 0x70, 0x84, 0xd5, 0x39, 0x43, 0xaa, 0x72,
+	0xa1, 0xa9, 0x60, 0x0d, 0x70, 0xf1, 0x27, 0x80, 0xfe, 0x4c, 0xe7, 0xc5, 0x3d, 0xce, 0x91, 0x93,
+	0xf7, 0x30, 0x28, 0x2a, 0xad, 0xb1, 0x2c, 0x6a, 0xcf, 0x45, 0xc1, 0x28, 0x18, 0xf7, 0xb3, 0x93,
+	0x9d, 0x38, 0xcf, 0x85, 0x26, 0xe7, 0x00, 0x8b, 0xdc, 0x20, 0x65, 0x58, 0xca, 0x55, 0x74, 0xe4,
+	0x89, 0xbe, 0x53, 0xae, 0x9d, 0xe0, 0x66, 0xb4, 0x76, 0x21, 0x56, 0xf9, 0xbd, 0x89, 0xba, 0xa3,
+	0x60, 0xdc, 0xcb, 0x4e, 0x1a, 0xa2, 0xd1, 0xc8, 0x07, 0x78, 0xf5, 0xa3, 0x92, 0xf6, 0x80, 0xea,
+	0x79, 0x6a, 0xe0, 0xd5, 0x3d, 0x76, 0x0e, 0x20, 0x6f, 0x6f, 0x0d, 0x5a, 0xba, 0x50, 0x26, 0x7a,
+	0x31, 0x0a, 0xc6, 0xdd, 0xac, 0xdf, 0x28, 0x13, 0x65, 0x2e, 0x7e, 0x1f, 0x41, 0x38, 0xf7, 0xbd,
+	0xcc, 0x5c, 0x2d, 0x24, 0x82, 0x97, 0x39, 0x63, 0x1a, 0x8d, 0x69, 0x83, 0xef, 0xae, 0x64, 0x08,
+	0xa1, 0xd5, 0x79, 0x59, 0x2c, 0x91, 0xde, 0x61, 0xdd, 0x86, 0x86, 0x56, 0xfa, 0x86, 0x35, 0xf9,
+	0x0c, 0x83, 0x67, 0xf5, 0xf8, 0xd4, 0xe1, 0x55, 0x94, 0x1c, 0x74, 0x9c, 0xdc, 0x38, 0xc2, 0x75,
+	0x30, 0xbd, 0xce, 0x42, 0xbb, 0xbf, 0x30, 0x92, 0x40, 0x57, 0x21, 0xf7, 0x7f, 0x08, 0xaf, 0xde,
+	0x3d, 0x7b, 0xb3, 0x2f, 0x77, 0xd2, 0x7b, 0xf8, 0x37, 0xec, 0x64, 0x0e, 0x24, 0x09, 0x9c, 0x5a,
+	0x51, 0xdc, 0x51, 0x51, 0x32, 0x5c, 0x53, 0x51, 0x52, 0x2b, 0xa9, 0xac, 0x6c, 0xfb, 0xc3, 0xd7,
+	0xce, 0x9b, 0x3a, 0x6b, 0x5a, 0xde, 0xc8, 0x59, 0x65, 0x49, 0x02, 0x6f, 0xa5, 0x9f, 0x43, 0x95,
+	0x16, 0x05, 0xd2, 0x25, 0x0a, 0xbe, 0xb4, 0xd1, 0xb1, 0xef, 0xec, 0x4d, 0x63, 0xcd, 0x9d, 0xf3,
+	0xd5, 0x1b, 0x93, 0x2f, 0x0f, 0x9b, 0x38, 0x78, 0xdc, 0xc4, 0xc1, 0xff, 0x4d, 0x1c, 0xfc, 0xda,
+	0xc6, 0x9d, 0xc7, 0x6d, 0xdc, 0xf9, 0xbb, 0x8d, 0x3b, 0xdf, 0x2f, 0xb9, 0xb0, 0xcb, 0x6a, 0x91,
+	0x14, 0x72, 0x95, 0xb6, 0x31, 0x2f, 0xa5, 0xe6, 0xbb, 0x73, 0xfa, 0xf3, 0x53, 0xba, 0x6e, 0x96,
+	0xa5, 0x56, 0x68, 0x16, 0xc7, 0x7e, 0x4b, 0x3e, 0x3e, 0x0d, 0x00, 0xd1, 0x69, 0x7f, 0xc8, 0x8b,
+	0x02, 0x00, 0x00,
+}
+
+func (m *OraclePeg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OraclePeg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OraclePeg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OffsetBps != 0 {
+		i = encodeVarintPeggedOrder(dAtA, i, uint64(m.OffsetBps))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.QuoteDecimals != 0 {
+		i = encodeVarintPeggedOrder(dAtA, i, uint64(m.QuoteDecimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BaseDecimals != 0 {
+		i = encodeVarintPeggedOrder(dAtA, i, uint64(m.BaseDecimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintPeggedOrder(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrencyPair) > 0 {
+		i -= len(m.CurrencyPair)
+		copy(dAtA[i:], m.CurrencyPair)
+		i = encodeVarintPeggedOrder(dAtA, i, uint64(len(m.CurrencyPair)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeggedOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeggedOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeggedOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OraclePriceHeight != 0 {
+		i = encodeVarintPeggedOrder(dAtA, i, uint64(m.OraclePriceHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TickIndexInToOut != 0 {
+		i = encodeVarintPeggedOrder(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Peg.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPeggedOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPeggedOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintPeggedOrder(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPeggedOrder(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPeggedOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPeggedOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OraclePeg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrencyPair)
+	if l > 0 {
+		n += 1 + l + sovPeggedOrder(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovPeggedOrder(uint64(l))
+	}
+	if m.BaseDecimals != 0 {
+		n += 1 + sovPeggedOrder(uint64(m.BaseDecimals))
+	}
+	if m.QuoteDecimals != 0 {
+		n += 1 + sovPeggedOrder(uint64(m.QuoteDecimals))
+	}
+	if m.OffsetBps != 0 {
+		n += 1 + sovPeggedOrder(uint64(m.OffsetBps))
+	}
+	return n
+}
+
+func (m *PeggedOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPeggedOrder(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovPeggedOrder(uint64(l))
+	}
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovPeggedOrder(uint64(l))
+	}
+	l = m.Peg.Size()
+	n += 1 + l + sovPeggedOrder(uint64(l))
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovPeggedOrder(uint64(m.TickIndexInToOut))
+	}
+	if m.OraclePriceHeight != 0 {
+		n += 1 + sovPeggedOrder(uint64(m.OraclePriceHeight))
+	}
+	return n
+}
+
+func sovPeggedOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPeggedOrder(x uint64) (n int) {
+	return sovPeggedOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OraclePeg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeggedOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OraclePeg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OraclePeg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrencyPair", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrencyPair = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDecimals", wireType)
+			}
+			m.BaseDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDecimals", wireType)
+			}
+			m.QuoteDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QuoteDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffsetBps", wireType)
+			}
+			m.OffsetBps = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffsetBps |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeggedOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeggedOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeggedOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeggedOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeggedOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Peg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Peg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
+			}
+			m.TickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePriceHeight", wireType)
+			}
+			m.OraclePriceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OraclePriceHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeggedOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeggedOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPeggedOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPeggedOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeggedOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPeggedOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPeggedOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPeggedOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPeggedOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPeggedOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPeggedOrder = fmt.Errorf("proto: unexpected end of group")
+)
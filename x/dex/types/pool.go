@@ -71,11 +71,14 @@ func (p *Pool) GetUpperReserve1() math.Int {
 	return p.UpperTick1.ReservesMakerDenom
 }
 
+// Swap swaps against the pool's reserves. protocolFeeFraction is the share of the swap fee that is
+// skimmed for the protocol; the skimmed amount is returned as protocolFee and is not added to the pool's reserves.
 func (p *Pool) Swap(
 	tradePairID *TradePairID,
 	maxAmountTakerIn math.Int,
 	maxAmountMakerOut *math.Int,
-) (amountTakerIn, amountMakerOut math.Int) {
+	protocolFeeFraction math_utils.PrecDec,
+) (amountTakerIn, amountMakerOut, protocolFee math.Int) {
 	var takerReserves, makerReserves *PoolReserves
 	if tradePairID.IsMakerDenomToken0() {
 		makerReserves = p.LowerTick0
@@ -87,7 +90,7 @@ func (p *Pool) Swap(
 
 	if maxAmountTakerIn.Equal(math.ZeroInt()) ||
 		makerReserves.ReservesMakerDenom.Equal(math.ZeroInt()) {
-		return math.ZeroInt(), math.ZeroInt()
+		return math.ZeroInt(), math.ZeroInt(), math.ZeroInt()
 	}
 
 	maxOutGivenTakerIn := math_utils.NewPrecDecFromInt(maxAmountTakerIn).Quo(makerReserves.MakerPrice).TruncateInt()
@@ -103,10 +106,53 @@ func (p *Pool) Swap(
 	amountMakerOut = utils.MinIntArr(possibleAmountsMakerOut)
 
 	amountTakerIn = makerReserves.MakerPrice.MulInt(amountMakerOut).Ceil().TruncateInt()
-	takerReserves.ReservesMakerDenom = takerReserves.ReservesMakerDenom.Add(amountTakerIn)
+	protocolFee = p.CalcProtocolFee(makerReserves, amountTakerIn, amountMakerOut, protocolFeeFraction)
+	takerReserves.ReservesMakerDenom = takerReserves.ReservesMakerDenom.Add(amountTakerIn.Sub(protocolFee))
 	makerReserves.ReservesMakerDenom = makerReserves.ReservesMakerDenom.Sub(amountMakerOut)
 
-	return amountTakerIn, amountMakerOut
+	return amountTakerIn, amountMakerOut, protocolFee
+}
+
+// CalcProtocolFee returns the protocol's share of the swap fee paid for a swap against makerReserves.
+// The swap fee is the difference between amountTakerIn and the value of amountMakerOut at the pool's center price.
+func (p *Pool) CalcProtocolFee(
+	makerReserves *PoolReserves,
+	amountTakerIn, amountMakerOut math.Int,
+	protocolFeeFraction math_utils.PrecDec,
+) math.Int {
+	if protocolFeeFraction.IsNil() || !protocolFeeFraction.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	feeInt64 := utils.MustSafeUint64ToInt64(p.Fee())
+	centerPrice := MustCalcPrice(makerReserves.Key.TickIndexTakerToMaker - feeInt64)
+	feeRevenue := math_utils.NewPrecDecFromInt(amountTakerIn).Sub(centerPrice.MulInt(amountMakerOut))
+	if !feeRevenue.IsPositive() {
+		return math.ZeroInt()
+	}
+
+	return feeRevenue.Mul(protocolFeeFraction).TruncateInt()
+}
+
+// AccrueDynamicFee adds a dynamic fee paid in the taker denom of tradePairID to the pool's reserves. Like the swap fee,
+// protocolFeeFraction of the dynamic fee is taken by the protocol; the protocol's share is returned.
+func (p *Pool) AccrueDynamicFee(
+	tradePairID *TradePairID,
+	dynamicFee math.Int,
+	protocolFeeFraction math_utils.PrecDec,
+) (protocolFee math.Int) {
+	takerReserves := p.LowerTick0
+	if tradePairID.IsMakerDenomToken0() {
+		takerReserves = p.UpperTick1
+	}
+
+	protocolFee = math.ZeroInt()
+	if !protocolFeeFraction.IsNil() && protocolFeeFraction.IsPositive() {
+		protocolFee = protocolFeeFraction.MulInt(dynamicFee).TruncateInt()
+	}
+	takerReserves.ReservesMakerDenom = takerReserves.ReservesMakerDenom.Add(dynamicFee.Sub(protocolFee))
+
+	return protocolFee
 }
 
 // Mutates the Pool object and returns relevant change variables. Deposit is not committed until
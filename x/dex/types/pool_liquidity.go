@@ -7,19 +7,39 @@ import (
 )
 
 type PoolLiquidity struct {
-	TradePairID *TradePairID
-	Pool        *Pool
+	TradePairID         *TradePairID
+	Pool                *Pool
+	ProtocolFeeFraction math_utils.PrecDec
+	// DynamicFeeBps is charged on top of the fee tier by pools of the dynamic fee tier
+	DynamicFeeBps uint64
+	// ProtocolFee is the amount of taker denom skimmed for the protocol by the last call to Swap
+	ProtocolFee math.Int
+	// DynamicFee is the amount of taker denom charged on top of the amount swapped by the last call to Swap
+	DynamicFee math.Int
 }
 
+// Swap swaps against the pool, reserving enough of maxAmountTakerDenomIn to pay the dynamic fee.
+// The returned inAmount includes the dynamic fee.
 func (pl *PoolLiquidity) Swap(
 	maxAmountTakerDenomIn math.Int,
 	maxAmountMakerDenomOut *math.Int,
 ) (inAmount, outAmount math.Int) {
-	return pl.Pool.Swap(
+	// The dynamic fee is charged the same way as the limit order taker fee
+	maxAmountPoolIn := AmountInBeforeTakerFee(maxAmountTakerDenomIn, pl.DynamicFeeBps)
+	inAmount, outAmount, pl.ProtocolFee = pl.Pool.Swap(
 		pl.TradePairID,
-		maxAmountTakerDenomIn,
+		maxAmountPoolIn,
 		maxAmountMakerDenomOut,
+		pl.ProtocolFeeFraction,
 	)
+
+	pl.DynamicFee = CalcLimitOrderTakerFee(inAmount, pl.DynamicFeeBps)
+	if pl.DynamicFee.IsPositive() {
+		dynamicProtocolFee := pl.Pool.AccrueDynamicFee(pl.TradePairID, pl.DynamicFee, pl.ProtocolFeeFraction)
+		pl.ProtocolFee = pl.ProtocolFee.Add(dynamicProtocolFee)
+	}
+
+	return inAmount.Add(pl.DynamicFee), outAmount
 }
 
 func (pl *PoolLiquidity) Price() math_utils.PrecDec {
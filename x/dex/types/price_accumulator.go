@@ -0,0 +1,13 @@
+package types
+
+import "time"
+
+// TickCumulativeAt extrapolates the accumulator's TickCumulative to time t assuming the tick stayed constant since the snapshot
+func (p PriceAccumulator) TickCumulativeAt(t time.Time) int64 {
+	if p.NoLiquidity {
+		return p.TickCumulative
+	}
+
+	elapsed := t.Unix() - p.Timestamp.Unix()
+	return p.TickCumulative + p.TickIndexTakerToMaker*elapsed
+}
@@ -0,0 +1,512 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/price_accumulator.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PriceAccumulator is a snapshot of the cumulative tick of a TradePairID. A snapshot is written
+// at the end of every block in which the TradePairID's liquidity was updated.
+type PriceAccumulator struct {
+	TradePairId *TradePairID `protobuf:"bytes,1,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	Timestamp   time.Time    `protobuf:"bytes,2,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	// Sum of tick_index_taker_to_maker * seconds elapsed, from the first accumulator of the TradePairID up to timestamp.
+	TickCumulative int64 `protobuf:"varint,3,opt,name=tick_cumulative,json=tickCumulative,proto3" json:"tick_cumulative,omitempty"`
+	// The best tick_index_taker_to_maker of the TradePairID at the end of the block, it is used
+	// to advance tick_cumulative until the next snapshot.
+	TickIndexTakerToMaker int64 `protobuf:"varint,4,opt,name=tick_index_taker_to_maker,json=tickIndexTakerToMaker,proto3" json:"tick_index_taker_to_maker,omitempty"`
+	// True if the TradePairID had no liquidity at the end of the block. tick_cumulative is not advanced
+	// until liquidity returns and TWAP windows overlapping the gap are unavailable.
+	NoLiquidity bool `protobuf:"varint,5,opt,name=no_liquidity,json=noLiquidity,proto3" json:"no_liquidity,omitempty"`
+}
+
+func (m *PriceAccumulator) Reset()         { *m = PriceAccumulator{} }
+func (m *PriceAccumulator) String() string { return proto.CompactTextString(m) }
+func (*PriceAccumulator) ProtoMessage()    {}
+func (*PriceAccumulator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8a4b6cb920789a4a, []int{0}
+}
+func (m *PriceAccumulator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceAccumulator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceAccumulator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceAccumulator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAccumulator.Merge(m, src)
+}
+func (m *PriceAccumulator) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceAccumulator) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAccumulator.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAccumulator proto.InternalMessageInfo
+
+func (m *PriceAccumulator) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *PriceAccumulator) GetTimestamp() time.Time {
+	if m != nil {
+		return m.Timestamp
+	}
+	return time.Time{}
+}
+
+func (m *PriceAccumulator) GetTickCumulative() int64 {
+	if m != nil {
+		return m.TickCumulative
+	}
+	return 0
+}
+
+func (m *PriceAccumulator) GetTickIndexTakerToMaker() int64 {
+	if m != nil {
+		return m.TickIndexTakerToMaker
+	}
+	return 0
+}
+
+func (m *PriceAccumulator) GetNoLiquidity() bool {
+	if m != nil {
+		return m.NoLiquidity
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*PriceAccumulator)(nil), "neutron.dex.PriceAccumulator")
+}
+
+func init() {
+	proto.RegisterFile("neutron/dex/price_accumulator.proto", fileDescriptor_8a4b6cb920789a4a)
+}
+
+var fileDescriptor_8a4b6cb920789a4a = []byte{
+	// 354 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xcf, 0x4e, 0xea, 0x40,
+	0x14, 0x87, 0x3b, 0x70, 0xef, 0x0d, 0xb7, 0xf5, 0x5f, 0x1a, 0x4d, 0x2a, 0x8b, 0x82, 0xba, 0x90,
+	0x0d, 0x33, 0x89, 0xc6, 0xc4, 0x85, 0x1b, 0xd1, 0xc4, 0x90, 0x68, 0x42, 0x9a, 0xae, 0xdc, 0x4c,
+	0x4a, 0x3b, 0xd6, 0x09, 0xb4, 0xa7, 0x0e, 0x53, 0x52, 0xde, 0x82, 0xb5, 0x4f, 0xc4, 0x92, 0xa5,
+	0x2b, 0x35, 0xf0, 0x22, 0x66, 0x5a, 0x0a, 0xb8, 0xea, 0x39, 0xbf, 0x7e, 0x27, 0xe7, 0x9b, 0xa3,
+	0x9f, 0xc5, 0x2c, 0x95, 0x02, 0x62, 0x12, 0xb0, 0x8c, 0x24, 0x82, 0xfb, 0x8c, 0x7a, 0xbe, 0x9f,
+	0x46, 0xe9, 0xd0, 0x93, 0x20, 0x70, 0x22, 0x40, 0x82, 0x69, 0xac, 0x20, 0x1c, 0xb0, 0xac, 0x7e,
+	0x18, 0x42, 0x08, 0x79, 0x4e, 0x54, 0x55, 0x20, 0xf5, 0x46, 0x08, 0x10, 0x0e, 0x19, 0xc9, 0xbb,
+	0x7e, 0xfa, 0x42, 0x24, 0x8f, 0xd8, 0x48, 0x7a, 0x51, 0x52, 0x02, 0xdb, 0x8b, 0xa4, 0xf0, 0x02,
+	0x46, 0x13, 0x8f, 0x0b, 0xca, 0x83, 0x02, 0x38, 0x7d, 0xaf, 0xe8, 0x07, 0x3d, 0x25, 0x70, 0xbb,
+	0xd9, 0x6f, 0xde, 0xe8, 0xbb, 0xbf, 0x58, 0x0b, 0x35, 0x51, 0xcb, 0xb8, 0xb0, 0xf0, 0x96, 0x11,
+	0x76, 0x15, 0xd1, 0xf3, 0xb8, 0xe8, 0xde, 0x3b, 0x86, 0x5c, 0x37, 0x81, 0xd9, 0xd1, 0xff, 0xaf,
+	0x35, 0xac, 0x4a, 0x3e, 0x59, 0xc7, 0x85, 0x28, 0x2e, 0x45, 0xb1, 0x5b, 0x12, 0x9d, 0xda, 0xec,
+	0xb3, 0xa1, 0x4d, 0xbf, 0x1a, 0xc8, 0xd9, 0x8c, 0x99, 0xe7, 0xfa, 0xbe, 0xe4, 0xfe, 0x80, 0xae,
+	0x9c, 0xf8, 0x98, 0x59, 0xd5, 0x26, 0x6a, 0x55, 0x9d, 0x3d, 0x15, 0xdf, 0xad, 0x53, 0xf3, 0x5a,
+	0x3f, 0xce, 0x41, 0x1e, 0x07, 0x2c, 0xa3, 0xd2, 0x1b, 0x30, 0x41, 0x25, 0xd0, 0x48, 0x15, 0xd6,
+	0x9f, 0x7c, 0xe4, 0x48, 0x01, 0x5d, 0xf5, 0xdf, 0x55, 0xa9, 0x0b, 0x4f, 0xea, 0x63, 0x9e, 0xe8,
+	0x3b, 0x31, 0xd0, 0x21, 0x7f, 0x4b, 0x79, 0xc0, 0xe5, 0xc4, 0xfa, 0xdb, 0x44, 0xad, 0x9a, 0x63,
+	0xc4, 0xf0, 0x58, 0x46, 0x9d, 0x87, 0xd9, 0xc2, 0x46, 0xf3, 0x85, 0x8d, 0xbe, 0x17, 0x36, 0x9a,
+	0x2e, 0x6d, 0x6d, 0xbe, 0xb4, 0xb5, 0x8f, 0xa5, 0xad, 0x3d, 0xb7, 0x43, 0x2e, 0x5f, 0xd3, 0x3e,
+	0xf6, 0x21, 0x22, 0xab, 0xa3, 0xb4, 0x41, 0x84, 0x65, 0x4d, 0xc6, 0x57, 0x24, 0x2b, 0x6e, 0x3e,
+	0x49, 0xd8, 0xa8, 0xff, 0x2f, 0x7f, 0xf7, 0xe5, 0xcf, 0x00, 0x04, 0xc3, 0x63, 0xaf, 0xf8, 0x01,
+	0x00, 0x00,
+}
+
+func (m *PriceAccumulator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceAccumulator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceAccumulator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NoLiquidity {
+		i--
+		if m.NoLiquidity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TickIndexTakerToMaker != 0 {
+		i = encodeVarintPriceAccumulator(dAtA, i, uint64(m.TickIndexTakerToMaker))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TickCumulative != 0 {
+		i = encodeVarintPriceAccumulator(dAtA, i, uint64(m.TickCumulative))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPriceAccumulator(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPriceAccumulator(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPriceAccumulator(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPriceAccumulator(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PriceAccumulator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovPriceAccumulator(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Timestamp)
+	n += 1 + l + sovPriceAccumulator(uint64(l))
+	if m.TickCumulative != 0 {
+		n += 1 + sovPriceAccumulator(uint64(m.TickCumulative))
+	}
+	if m.TickIndexTakerToMaker != 0 {
+		n += 1 + sovPriceAccumulator(uint64(m.TickIndexTakerToMaker))
+	}
+	if m.NoLiquidity {
+		n += 2
+	}
+	return n
+}
+
+func sovPriceAccumulator(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPriceAccumulator(x uint64) (n int) {
+	return sovPriceAccumulator(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PriceAccumulator) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPriceAccumulator
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceAccumulator: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceAccumulator: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceAccumulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceAccumulator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceAccumulator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceAccumulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPriceAccumulator
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPriceAccumulator
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Timestamp, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickCumulative", wireType)
+			}
+			m.TickCumulative = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceAccumulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickCumulative |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexTakerToMaker", wireType)
+			}
+			m.TickIndexTakerToMaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceAccumulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexTakerToMaker |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoLiquidity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPriceAccumulator
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoLiquidity = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPriceAccumulator(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPriceAccumulator
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPriceAccumulator(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPriceAccumulator
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceAccumulator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPriceAccumulator
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPriceAccumulator
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPriceAccumulator
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPriceAccumulator
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPriceAccumulator        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPriceAccumulator          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPriceAccumulator = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,92 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+)
+
+// RangeTickIndexes returns the center tick of every pool in a range. Pools are spaced 2*fee ticks apart
+// so that neighbouring pools share a boundary tick. Pools with a zero fee are placed on every tick.
+func RangeTickIndexes(lowerTickIndex, upperTickIndex int64, fee uint64) ([]int64, error) {
+	if lowerTickIndex > upperTickIndex {
+		return nil, ErrInvalidRange
+	}
+
+	spacing := int64(2 * fee) //nolint:gosec
+	if spacing == 0 {
+		spacing = 1
+	}
+
+	if (upperTickIndex-lowerTickIndex)/spacing >= MaxRangePositionPools {
+		return nil, ErrRangeTooWide
+	}
+
+	tickIndexes := make([]int64, 0, (upperTickIndex-lowerTickIndex)/spacing+1)
+	for tick := lowerTickIndex; tick <= upperTickIndex; tick += spacing {
+		tickIndexes = append(tickIndexes, tick)
+	}
+
+	return tickIndexes, nil
+}
+
+// Weights returns the relative weight of each of the n pools of a range.
+// BELL uses the binomial coefficients C(n-1, i), a discrete approximation of a normal distribution.
+func (s RangeShape) Weights(n int) []math.Int {
+	weights := make([]math.Int, n)
+	switch s {
+	case RangeShape_BELL:
+		weights[0] = math.OneInt()
+		for i := 1; i < n; i++ {
+			weights[i] = weights[i-1].MulRaw(int64(n - i)).QuoRaw(int64(i))
+		}
+	default:
+		for i := range weights {
+			weights[i] = math.OneInt()
+		}
+	}
+
+	return weights
+}
+
+// DistributeAmount splits amount proportionally to weights. Rounding dust is added to the heaviest weight
+// so that the returned amounts always sum up to amount. Nothing is distributed if every weight is zero.
+func DistributeAmount(amount math.Int, weights []math.Int) []math.Int {
+	totalWeight := math.ZeroInt()
+	heaviestIdx := 0
+	for i, w := range weights {
+		totalWeight = totalWeight.Add(w)
+		if w.GT(weights[heaviestIdx]) {
+			heaviestIdx = i
+		}
+	}
+
+	amounts := make([]math.Int, len(weights))
+	if !totalWeight.IsPositive() {
+		for i := range amounts {
+			amounts[i] = math.ZeroInt()
+		}
+		return amounts
+	}
+
+	distributed := math.ZeroInt()
+	for i, w := range weights {
+		amounts[i] = amount.Mul(w).Quo(totalWeight)
+		distributed = distributed.Add(amounts[i])
+	}
+	amounts[heaviestIdx] = amounts[heaviestIdx].Add(amount.Sub(distributed))
+
+	return amounts
+}
+
+func (p RangePosition) Validate() error {
+	if _, err := NewPairID(p.PairId.GetToken0(), p.PairId.GetToken1()); err != nil {
+		return err
+	}
+	if err := validateAddress(p.Owner, "owner"); err != nil {
+		return err
+	}
+	if _, err := RangeTickIndexes(p.LowerTickIndex, p.UpperTickIndex, p.Fee); err != nil {
+		return err
+	}
+
+	return p.Shares.Validate()
+}
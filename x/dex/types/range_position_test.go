@@ -0,0 +1,44 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func TestRangeTickIndexes(t *testing.T) {
+	tickIndexes, err := types.RangeTickIndexes(-4, 4, 2)
+	require.NoError(t, err)
+	require.Equal(t, []int64{-4, 0, 4}, tickIndexes)
+
+	tickIndexes, err = types.RangeTickIndexes(0, 2, 0)
+	require.NoError(t, err)
+	require.Equal(t, []int64{0, 1, 2}, tickIndexes)
+
+	_, err = types.RangeTickIndexes(4, -4, 2)
+	require.ErrorIs(t, err, types.ErrInvalidRange)
+
+	_, err = types.RangeTickIndexes(0, types.MaxRangePositionPools, 0)
+	require.ErrorIs(t, err, types.ErrRangeTooWide)
+}
+
+func TestRangeShapeWeights(t *testing.T) {
+	require.Equal(t, []math.Int{math.NewInt(1), math.NewInt(1), math.NewInt(1)}, types.RangeShape_FLAT.Weights(3))
+	require.Equal(
+		t,
+		[]math.Int{math.NewInt(1), math.NewInt(3), math.NewInt(3), math.NewInt(1)},
+		types.RangeShape_BELL.Weights(4),
+	)
+}
+
+func TestDistributeAmount(t *testing.T) {
+	amounts := types.DistributeAmount(math.NewInt(10), types.RangeShape_BELL.Weights(3))
+	// 10 * 1/4 = 2, 10 * 2/4 = 5 + 1 dust, 10 * 1/4 = 2
+	require.Equal(t, []math.Int{math.NewInt(2), math.NewInt(6), math.NewInt(2)}, amounts)
+
+	amounts = types.DistributeAmount(math.ZeroInt(), types.RangeShape_FLAT.Weights(2))
+	require.Equal(t, []math.Int{math.ZeroInt(), math.ZeroInt()}, amounts)
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+func (s Stake) Validate() error {
+	if err := validateAddress(s.Owner, "owner"); err != nil {
+		return err
+	}
+
+	if !s.Shares.IsValid() || s.Shares.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidStake, "invalid shares %s", s.Shares)
+	}
+
+	for _, share := range s.Shares {
+		if _, err := ParsePoolIDFromDenom(share.Denom); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidStake, "%s is not a pool share denom", share.Denom)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,214 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BlockTxIndex is the DexDelta.TxIndex of deltas produced by BeginBlock and EndBlock
+const BlockTxIndex = -1
+
+// DexDeltasFromEvents converts the TickUpdate and DepositLP events emitted by the tx at txIndex into DexDeltas.
+// All other events are ignored.
+func DexDeltasFromEvents(txIndex int32, events []abci.Event) ([]*DexDelta, error) {
+	var deltas []*DexDelta
+	for _, event := range events {
+		attrs := eventAttributes(event)
+		var (
+			eventDeltas []isDexDelta_Delta
+			err         error
+		)
+		switch {
+		case event.Type == EventTypeTickUpdate:
+			eventDeltas, err = tickUpdateDeltas(attrs)
+		case event.Type == sdk.EventTypeMessage && attrs[sdk.AttributeKeyModule] == ModuleName &&
+			attrs[sdk.AttributeKeyAction] == DepositEventKey:
+			eventDeltas, err = depositDeltas(attrs)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s event: %w", event.Type, err)
+		}
+
+		for _, delta := range eventDeltas {
+			deltas = append(deltas, &DexDelta{TxIndex: txIndex, Delta: delta})
+		}
+	}
+
+	return deltas, nil
+}
+
+func eventAttributes(event abci.Event) map[string]string {
+	attrs := make(map[string]string, len(event.Attributes))
+	for _, attr := range event.Attributes {
+		attrs[attr.Key] = attr.Value
+	}
+
+	return attrs
+}
+
+func tickUpdateDeltas(attrs map[string]string) ([]isDexDelta_Delta, error) {
+	pairID, err := NewPairID(attrs[AttributeToken0], attrs[AttributeToken1])
+	if err != nil {
+		return nil, err
+	}
+	tradePairID := NewTradePairIDFromMaker(pairID, attrs[AttributeTokenIn])
+
+	tickIndex, err := strconv.ParseInt(attrs[AttributeTickIndex], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	var fee uint64
+	if feeStr, ok := attrs[AttributeFee]; ok {
+		if fee, err = strconv.ParseUint(feeStr, 10, 64); err != nil {
+			return nil, err
+		}
+	}
+	trancheKey := attrs[AttributeTrancheKey]
+
+	reserves, err := parseEventInt(attrs, AttributeReserves)
+	if err != nil {
+		return nil, err
+	}
+
+	deltas := []isDexDelta_Delta{&DexDelta_Tick{Tick: &TickDelta{
+		TradePairId:           tradePairID,
+		TickIndexTakerToMaker: tickIndex,
+		Fee:                   fee,
+		TrancheKey:            trancheKey,
+		ReservesMakerDenom:    reserves,
+	}}}
+
+	if _, ok := attrs[AttributeSwapAmountIn]; ok {
+		fill := &FillDelta{
+			TradePairId:           tradePairID,
+			TickIndexTakerToMaker: tickIndex,
+			Fee:                   fee,
+			TrancheKey:            trancheKey,
+		}
+		for key, amount := range map[string]*math.Int{
+			AttributeSwapAmountIn:  &fill.AmountIn,
+			AttributeSwapAmountOut: &fill.AmountOut,
+			AttributeTakerFee:      &fill.TakerFee,
+			AttributeMakerRebate:   &fill.MakerRebate,
+			AttributeDynamicFee:    &fill.DynamicFee,
+		} {
+			if *amount, err = parseEventInt(attrs, key); err != nil {
+				return nil, err
+			}
+		}
+		deltas = append(deltas, &DexDelta_Fill{Fill: fill})
+	}
+
+	if attrs[AttributeExpired] == strconv.FormatBool(true) {
+		deltas = append(deltas, &DexDelta_Expiration{Expiration: &ExpirationDelta{
+			TradePairId:           tradePairID,
+			TickIndexTakerToMaker: tickIndex,
+			TrancheKey:            trancheKey,
+		}})
+	}
+
+	return deltas, nil
+}
+
+func depositDeltas(attrs map[string]string) ([]isDexDelta_Delta, error) {
+	pairID, err := NewPairID(attrs[AttributeToken0], attrs[AttributeToken1])
+	if err != nil {
+		return nil, err
+	}
+
+	tickIndex, err := strconv.ParseInt(attrs[AttributeTickIndex], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	fee, err := strconv.ParseUint(attrs[AttributeFee], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit := &DepositDelta{
+		Creator:   attrs[AttributeCreator],
+		Receiver:  attrs[AttributeReceiver],
+		PairId:    pairID,
+		TickIndex: tickIndex,
+		Fee:       fee,
+	}
+	for key, amount := range map[string]*math.Int{
+		AttributeReserves0Deposited: &deposit.Reserves0Deposited,
+		AttributeReserves1Deposited: &deposit.Reserves1Deposited,
+		AttributeSharesMinted:       &deposit.SharesMinted,
+	} {
+		if *amount, err = parseEventInt(attrs, key); err != nil {
+			return nil, err
+		}
+	}
+
+	return []isDexDelta_Delta{&DexDelta_Deposit{Deposit: deposit}}, nil
+}
+
+// parseEventInt parses the math.Int attribute key. Missing attributes are treated as zero.
+func parseEventInt(attrs map[string]string, key string) (math.Int, error) {
+	value, ok := attrs[key]
+	if !ok {
+		return math.ZeroInt(), nil
+	}
+
+	amount, ok := math.NewIntFromString(value)
+	if !ok {
+		return math.Int{}, fmt.Errorf("invalid %s: %s", key, value)
+	}
+
+	return amount, nil
+}
+
+// DexStreamBlockFromFinalizeBlock collects the DexDeltas of every successful tx of a block along with the
+// deltas produced by BeginBlock and EndBlock.
+func DexStreamBlockFromFinalizeBlock(req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) (*DexStreamBlock, error) {
+	block := &DexStreamBlock{
+		BlockHeight: req.Height,
+		BlockTime:   req.Time,
+	}
+
+	var beginBlockEvents, endBlockEvents []abci.Event
+	for _, event := range res.Events {
+		// Events of PreBlock and BeginBlock are all executed before the txs
+		if eventAttributes(event)["mode"] == "EndBlock" {
+			endBlockEvents = append(endBlockEvents, event)
+		} else {
+			beginBlockEvents = append(beginBlockEvents, event)
+		}
+	}
+
+	deltas, err := DexDeltasFromEvents(BlockTxIndex, beginBlockEvents)
+	if err != nil {
+		return nil, err
+	}
+	block.Deltas = append(block.Deltas, deltas...)
+
+	for i, txResult := range res.TxResults {
+		if txResult.Code != 0 {
+			continue
+		}
+
+		deltas, err := DexDeltasFromEvents(int32(i), txResult.Events) //nolint:gosec
+		if err != nil {
+			return nil, err
+		}
+		block.Deltas = append(block.Deltas, deltas...)
+	}
+
+	deltas, err = DexDeltasFromEvents(BlockTxIndex, endBlockEvents)
+	if err != nil {
+		return nil, err
+	}
+	block.Deltas = append(block.Deltas, deltas...)
+
+	return block, nil
+}
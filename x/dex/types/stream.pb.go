@@ -0,0 +1,2640 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/stream.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DexStreamBlock holds the dex deltas of a single committed block. Nodes that enable the [dex-stream]
+// section of app.toml write one DexStreamBlock per block, encoded as proto3 JSON on a single line (JSON lines).
+// Blocks without any deltas are written too so that consumers can detect gaps from block_height.
+type DexStreamBlock struct {
+	BlockHeight int64     `protobuf:"varint,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockTime   time.Time `protobuf:"bytes,2,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// Deltas in execution order: BeginBlock, then every successful tx, then EndBlock
+	Deltas []*DexDelta `protobuf:"bytes,3,rep,name=deltas,proto3" json:"deltas,omitempty"`
+}
+
+func (m *DexStreamBlock) Reset()         { *m = DexStreamBlock{} }
+func (m *DexStreamBlock) String() string { return proto.CompactTextString(m) }
+func (*DexStreamBlock) ProtoMessage()    {}
+func (*DexStreamBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b03bf824a43a5284, []int{0}
+}
+func (m *DexStreamBlock) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DexStreamBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DexStreamBlock.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DexStreamBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexStreamBlock.Merge(m, src)
+}
+func (m *DexStreamBlock) XXX_Size() int {
+	return m.Size()
+}
+func (m *DexStreamBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexStreamBlock.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DexStreamBlock proto.InternalMessageInfo
+
+func (m *DexStreamBlock) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *DexStreamBlock) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func (m *DexStreamBlock) GetDeltas() []*DexDelta {
+	if m != nil {
+		return m.Deltas
+	}
+	return nil
+}
+
+// DexDelta is a single change to the dex
+type DexDelta struct {
+	// Index of the tx within the block that produced the delta or -1 for BeginBlock and EndBlock
+	TxIndex int32 `protobuf:"varint,1,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// Types that are valid to be assigned to Delta:
+	//	*DexDelta_Tick
+	//	*DexDelta_Fill
+	//	*DexDelta_Deposit
+	//	*DexDelta_Expiration
+	Delta isDexDelta_Delta `protobuf_oneof:"delta"`
+}
+
+func (m *DexDelta) Reset()         { *m = DexDelta{} }
+func (m *DexDelta) String() string { return proto.CompactTextString(m) }
+func (*DexDelta) ProtoMessage()    {}
+func (*DexDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b03bf824a43a5284, []int{1}
+}
+func (m *DexDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DexDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DexDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DexDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DexDelta.Merge(m, src)
+}
+func (m *DexDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *DexDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_DexDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DexDelta proto.InternalMessageInfo
+
+type isDexDelta_Delta interface {
+	isDexDelta_Delta()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type DexDelta_Tick struct {
+	Tick *TickDelta `protobuf:"bytes,2,opt,name=tick,proto3,oneof" json:"tick,omitempty"`
+}
+type DexDelta_Fill struct {
+	Fill *FillDelta `protobuf:"bytes,3,opt,name=fill,proto3,oneof" json:"fill,omitempty"`
+}
+type DexDelta_Deposit struct {
+	Deposit *DepositDelta `protobuf:"bytes,4,opt,name=deposit,proto3,oneof" json:"deposit,omitempty"`
+}
+type DexDelta_Expiration struct {
+	Expiration *ExpirationDelta `protobuf:"bytes,5,opt,name=expiration,proto3,oneof" json:"expiration,omitempty"`
+}
+
+func (*DexDelta_Tick) isDexDelta_Delta()       {}
+func (*DexDelta_Fill) isDexDelta_Delta()       {}
+func (*DexDelta_Deposit) isDexDelta_Delta()    {}
+func (*DexDelta_Expiration) isDexDelta_Delta() {}
+
+func (m *DexDelta) GetDelta() isDexDelta_Delta {
+	if m != nil {
+		return m.Delta
+	}
+	return nil
+}
+
+func (m *DexDelta) GetTxIndex() int32 {
+	if m != nil {
+		return m.TxIndex
+	}
+	return 0
+}
+
+func (m *DexDelta) GetTick() *TickDelta {
+	if x, ok := m.GetDelta().(*DexDelta_Tick); ok {
+		return x.Tick
+	}
+	return nil
+}
+
+func (m *DexDelta) GetFill() *FillDelta {
+	if x, ok := m.GetDelta().(*DexDelta_Fill); ok {
+		return x.Fill
+	}
+	return nil
+}
+
+func (m *DexDelta) GetDeposit() *DepositDelta {
+	if x, ok := m.GetDelta().(*DexDelta_Deposit); ok {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (m *DexDelta) GetExpiration() *ExpirationDelta {
+	if x, ok := m.GetDelta().(*DexDelta_Expiration); ok {
+		return x.Expiration
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*DexDelta) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*DexDelta_Tick)(nil),
+		(*DexDelta_Fill)(nil),
+		(*DexDelta_Deposit)(nil),
+		(*DexDelta_Expiration)(nil),
+	}
+}
+
+// TickDelta is the new state of a single PoolReserves or LimitOrderTranche. Zero reserves mean that the
+// liquidity has been removed from the tick.
+type TickDelta struct {
+	TradePairId           *TradePairID `protobuf:"bytes,1,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	TickIndexTakerToMaker int64        `protobuf:"varint,2,opt,name=tick_index_taker_to_maker,json=tickIndexTakerToMaker,proto3" json:"tick_index_taker_to_maker,omitempty"`
+	// Only set for PoolReserves
+	Fee uint64 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// Only set for LimitOrderTranches
+	TrancheKey         string                `protobuf:"bytes,4,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	ReservesMakerDenom cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=reserves_maker_denom,json=reservesMakerDenom,proto3,customtype=cosmossdk.io/math.Int" json:"reserves_maker_denom" yaml:"reserves_maker_denom"`
+}
+
+func (m *TickDelta) Reset()         { *m = TickDelta{} }
+func (m *TickDelta) String() string { return proto.CompactTextString(m) }
+func (*TickDelta) ProtoMessage()    {}
+func (*TickDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b03bf824a43a5284, []int{2}
+}
+func (m *TickDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickDelta.Merge(m, src)
+}
+func (m *TickDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TickDelta proto.InternalMessageInfo
+
+func (m *TickDelta) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *TickDelta) GetTickIndexTakerToMaker() int64 {
+	if m != nil {
+		return m.TickIndexTakerToMaker
+	}
+	return 0
+}
+
+func (m *TickDelta) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *TickDelta) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
+}
+
+// FillDelta is a swap against a single PoolReserves or LimitOrderTranche. It always follows the TickDelta
+// with the resulting reserves.
+type FillDelta struct {
+	TradePairId           *TradePairID `protobuf:"bytes,1,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	TickIndexTakerToMaker int64        `protobuf:"varint,2,opt,name=tick_index_taker_to_maker,json=tickIndexTakerToMaker,proto3" json:"tick_index_taker_to_maker,omitempty"`
+	// Only set for PoolReserves
+	Fee uint64 `protobuf:"varint,3,opt,name=fee,proto3" json:"fee,omitempty"`
+	// Only set for LimitOrderTranches
+	TrancheKey string `protobuf:"bytes,4,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	// Amount of the taker denom swapped in
+	AmountIn cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	// Amount of the maker denom swapped out
+	AmountOut cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount_out,json=amountOut,proto3,customtype=cosmossdk.io/math.Int" json:"amount_out" yaml:"amount_out"`
+	// Only non-zero for fills of LimitOrderTranches
+	TakerFee cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=taker_fee,json=takerFee,proto3,customtype=cosmossdk.io/math.Int" json:"taker_fee" yaml:"taker_fee"`
+	// Only non-zero for fills of LimitOrderTranches
+	MakerRebate cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=maker_rebate,json=makerRebate,proto3,customtype=cosmossdk.io/math.Int" json:"maker_rebate" yaml:"maker_rebate"`
+	// Only non-zero for fills of pools of the dynamic fee tier
+	DynamicFee cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=dynamic_fee,json=dynamicFee,proto3,customtype=cosmossdk.io/math.Int" json:"dynamic_fee" yaml:"dynamic_fee"`
+}
+
+func (m *FillDelta) Reset()         { *m = FillDelta{} }
+func (m *FillDelta) String() string { return proto.CompactTextString(m) }
+func (*FillDelta) ProtoMessage()    {}
+func (*FillDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b03bf824a43a5284, []int{3}
+}
+func (m *FillDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FillDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FillDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FillDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FillDelta.Merge(m, src)
+}
+func (m *FillDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *FillDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_FillDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FillDelta proto.InternalMessageInfo
+
+func (m *FillDelta) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *FillDelta) GetTickIndexTakerToMaker() int64 {
+	if m != nil {
+		return m.TickIndexTakerToMaker
+	}
+	return 0
+}
+
+func (m *FillDelta) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *FillDelta) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
+}
+
+// DepositDelta is a deposit of liquidity into a single pool. The resulting reserves are reported by the
+// TickDeltas that precede it.
+type DepositDelta struct {
+	Creator            string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver           string                `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	PairId             *PairID               `protobuf:"bytes,3,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	TickIndex          int64                 `protobuf:"varint,4,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty"`
+	Fee                uint64                `protobuf:"varint,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	Reserves0Deposited cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=reserves0_deposited,json=reserves0Deposited,proto3,customtype=cosmossdk.io/math.Int" json:"reserves0_deposited" yaml:"reserves0_deposited"`
+	Reserves1Deposited cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=reserves1_deposited,json=reserves1Deposited,proto3,customtype=cosmossdk.io/math.Int" json:"reserves1_deposited" yaml:"reserves1_deposited"`
+	SharesMinted       cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=shares_minted,json=sharesMinted,proto3,customtype=cosmossdk.io/math.Int" json:"shares_minted" yaml:"shares_minted"`
+}
+
+func (m *DepositDelta) Reset()         { *m = DepositDelta{} }
+func (m *DepositDelta) String() string { return proto.CompactTextString(m) }
+func (*DepositDelta) ProtoMessage()    {}
+func (*DepositDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b03bf824a43a5284, []int{4}
+}
+func (m *DepositDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DepositDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositDelta.Merge(m, src)
+}
+func (m *DepositDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositDelta proto.InternalMessageInfo
+
+func (m *DepositDelta) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *DepositDelta) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *DepositDelta) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+func (m *DepositDelta) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
+	}
+	return 0
+}
+
+func (m *DepositDelta) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+// ExpirationDelta is the expiration of a LimitOrderTranche. The tranche is removed from the book and its
+// remaining reserves can be withdrawn by its owners.
+type ExpirationDelta struct {
+	TradePairId           *TradePairID `protobuf:"bytes,1,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	TickIndexTakerToMaker int64        `protobuf:"varint,2,opt,name=tick_index_taker_to_maker,json=tickIndexTakerToMaker,proto3" json:"tick_index_taker_to_maker,omitempty"`
+	TrancheKey            string       `protobuf:"bytes,3,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+}
+
+func (m *ExpirationDelta) Reset()         { *m = ExpirationDelta{} }
+func (m *ExpirationDelta) String() string { return proto.CompactTextString(m) }
+func (*ExpirationDelta) ProtoMessage()    {}
+func (*ExpirationDelta) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b03bf824a43a5284, []int{5}
+}
+func (m *ExpirationDelta) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExpirationDelta) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExpirationDelta.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExpirationDelta) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExpirationDelta.Merge(m, src)
+}
+func (m *ExpirationDelta) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExpirationDelta) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExpirationDelta.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExpirationDelta proto.InternalMessageInfo
+
+func (m *ExpirationDelta) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *ExpirationDelta) GetTickIndexTakerToMaker() int64 {
+	if m != nil {
+		return m.TickIndexTakerToMaker
+	}
+	return 0
+}
+
+func (m *ExpirationDelta) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DexStreamBlock)(nil), "neutron.dex.DexStreamBlock")
+	proto.RegisterType((*DexDelta)(nil), "neutron.dex.DexDelta")
+	proto.RegisterType((*TickDelta)(nil), "neutron.dex.TickDelta")
+	proto.RegisterType((*FillDelta)(nil), "neutron.dex.FillDelta")
+	proto.RegisterType((*DepositDelta)(nil), "neutron.dex.DepositDelta")
+	proto.RegisterType((*ExpirationDelta)(nil), "neutron.dex.ExpirationDelta")
+}
+
+func init() { proto.RegisterFile("neutron/dex/stream.proto", fileDescriptor_b03bf824a43a5284) }
+
+var fileDescriptor_b03bf824a43a5284 = []byte{
+	// 913 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0x8e, 0x9b, 0xa6, 0x89, 0x5f, 0xba, 0xb0, 0x4c, 0x5b, 0xe4, 0x06, 0x88, 0x8b, 0x4f, 0x3d,
+	0x6c, 0x9d, 0x6d, 0xd1, 0x4a, 0x08, 0xa1, 0x15, 0x0a, 0xa1, 0x6c, 0x85, 0x56, 0xa0, 0x21, 0x27,
+	0x38, 0x58, 0x8e, 0x3d, 0x4d, 0x46, 0x89, 0x3d, 0x91, 0x3d, 0xa9, 0x9c, 0x23, 0x42, 0xe2, 0xbc,
+	0x3f, 0x00, 0x89, 0x23, 0x12, 0x7f, 0x82, 0xeb, 0x1e, 0xf7, 0x88, 0x38, 0x18, 0xd4, 0xde, 0x38,
+	0xe6, 0x17, 0xa0, 0x99, 0xb1, 0x1d, 0xbb, 0x8a, 0x08, 0x9c, 0xd0, 0x9e, 0x3a, 0xef, 0x7b, 0xef,
+	0x7b, 0xef, 0xf3, 0xe7, 0xe7, 0x69, 0xc0, 0x08, 0xc9, 0x82, 0x47, 0x2c, 0xec, 0xf9, 0x24, 0xe9,
+	0xc5, 0x3c, 0x22, 0x6e, 0x60, 0xcf, 0x23, 0xc6, 0x19, 0x6a, 0x67, 0x19, 0xdb, 0x27, 0x49, 0xe7,
+	0x70, 0xcc, 0xc6, 0x4c, 0xe2, 0x3d, 0x71, 0x52, 0x25, 0x1d, 0x73, 0xcc, 0xd8, 0x78, 0x46, 0x7a,
+	0x32, 0x1a, 0x2d, 0xae, 0x7b, 0x9c, 0x06, 0x24, 0xe6, 0x6e, 0x30, 0xcf, 0x0a, 0x8e, 0xcb, 0xdd,
+	0xe7, 0x2e, 0x8d, 0x1c, 0xea, 0xe7, 0xdc, 0x72, 0x8a, 0x47, 0xae, 0x4f, 0x9c, 0x4a, 0x81, 0xf5,
+	0xb3, 0x06, 0x6f, 0x0c, 0x48, 0xf2, 0xb5, 0xd4, 0xd4, 0x9f, 0x31, 0x6f, 0x8a, 0xde, 0x87, 0xfd,
+	0x91, 0x38, 0x38, 0x13, 0x42, 0xc7, 0x13, 0x6e, 0x68, 0x27, 0xda, 0x69, 0x1d, 0xb7, 0x25, 0xf6,
+	0x4c, 0x42, 0xe8, 0x53, 0x00, 0x55, 0x22, 0xa4, 0x18, 0x3b, 0x27, 0xda, 0x69, 0xfb, 0xa2, 0x63,
+	0x2b, 0x9d, 0x76, 0xae, 0xd3, 0x1e, 0xe6, 0x3a, 0xfb, 0xad, 0x97, 0xa9, 0x59, 0x7b, 0xf1, 0x87,
+	0xa9, 0x61, 0x5d, 0xf2, 0x44, 0x06, 0x9d, 0xc1, 0x9e, 0x4f, 0x66, 0xdc, 0x8d, 0x8d, 0xfa, 0x49,
+	0xfd, 0xb4, 0x7d, 0x71, 0x64, 0x97, 0xbc, 0xb0, 0x07, 0x24, 0x19, 0x88, 0x2c, 0xce, 0x8a, 0xac,
+	0xef, 0x76, 0xa0, 0x95, 0x83, 0xe8, 0x18, 0x5a, 0x3c, 0x71, 0x68, 0xe8, 0x93, 0x44, 0xea, 0x6b,
+	0xe0, 0x26, 0x4f, 0xae, 0x44, 0x88, 0x1e, 0xc1, 0x2e, 0xa7, 0xde, 0x34, 0x53, 0xf5, 0x76, 0xa5,
+	0xe9, 0x90, 0x7a, 0x53, 0xd9, 0xe0, 0x59, 0x0d, 0xcb, 0x2a, 0x51, 0x7d, 0x4d, 0x67, 0x33, 0xa3,
+	0xbe, 0xa1, 0xfa, 0x92, 0xce, 0x66, 0x45, 0xb5, 0xa8, 0x42, 0x4f, 0xa0, 0xe9, 0x93, 0x39, 0x8b,
+	0x29, 0x37, 0x76, 0x25, 0xe1, 0xf8, 0x9e, 0x66, 0x99, 0xcb, 0x39, 0x79, 0x2d, 0x7a, 0x0a, 0x40,
+	0x92, 0x39, 0x8d, 0x5c, 0x4e, 0x59, 0x68, 0x34, 0x24, 0xf3, 0xdd, 0x0a, 0xf3, 0xb3, 0x22, 0x9d,
+	0x93, 0x4b, 0x8c, 0x7e, 0x13, 0x1a, 0xd2, 0x04, 0xeb, 0xd7, 0x1d, 0xd0, 0x8b, 0x67, 0x40, 0x1f,
+	0xc3, 0x83, 0xca, 0x2b, 0x95, 0x4e, 0xb4, 0x2f, 0x8c, 0xea, 0x23, 0x8b, 0x8a, 0xaf, 0x5c, 0x1a,
+	0x5d, 0x0d, 0x70, 0x9b, 0x17, 0x81, 0x8f, 0x3e, 0x84, 0x63, 0xe1, 0x80, 0x32, 0xd1, 0xe1, 0xee,
+	0x94, 0x44, 0x0e, 0x67, 0x4e, 0x20, 0x0e, 0xd2, 0xbc, 0x3a, 0x3e, 0x12, 0x05, 0xd2, 0xd5, 0xa1,
+	0x40, 0x87, 0xec, 0xb9, 0xf8, 0x83, 0x1e, 0x42, 0xfd, 0x9a, 0x10, 0x69, 0xd9, 0x2e, 0x16, 0x47,
+	0x64, 0x82, 0x68, 0x1d, 0x7a, 0x13, 0xe2, 0x4c, 0xc9, 0x52, 0x7a, 0xa3, 0x63, 0xc8, 0xa0, 0x2f,
+	0xc8, 0x12, 0xfd, 0xa0, 0xc1, 0x61, 0x44, 0x62, 0x12, 0xdd, 0x90, 0x58, 0x8d, 0x70, 0x7c, 0x12,
+	0xb2, 0x40, 0x9a, 0xa1, 0xf7, 0x87, 0x62, 0x3f, 0x7e, 0x4f, 0xcd, 0x23, 0x8f, 0xc5, 0x01, 0x8b,
+	0x63, 0x7f, 0x6a, 0x53, 0xd6, 0x0b, 0x5c, 0x3e, 0xb1, 0xaf, 0x42, 0xfe, 0x57, 0x6a, 0x6e, 0x24,
+	0xaf, 0x52, 0xf3, 0x9d, 0xa5, 0x1b, 0xcc, 0x3e, 0xb2, 0x36, 0x65, 0x2d, 0x8c, 0x72, 0x58, 0xca,
+	0x1e, 0x48, 0xf0, 0xa7, 0x06, 0xe8, 0xc5, 0x7b, 0x7d, 0x9d, 0x1c, 0xfc, 0x16, 0x74, 0x37, 0x60,
+	0x8b, 0x90, 0x3b, 0x34, 0xcc, 0x5c, 0x7b, 0xba, 0xcd, 0xb5, 0x35, 0x63, 0x95, 0x9a, 0x0f, 0x95,
+	0x55, 0x05, 0x64, 0xe1, 0x96, 0x3a, 0x5f, 0x85, 0xc8, 0x01, 0xc8, 0x70, 0xb6, 0xe0, 0xc6, 0x9e,
+	0xec, 0xfe, 0xc9, 0xb6, 0xee, 0x25, 0xca, 0x2a, 0x35, 0xdf, 0xaa, 0xb4, 0x67, 0x0b, 0x6e, 0xe1,
+	0x6c, 0xfc, 0x97, 0x0b, 0x2e, 0xd4, 0x2b, 0x7f, 0xc4, 0x63, 0x37, 0xff, 0xa5, 0xfa, 0x82, 0xb1,
+	0x56, 0x5f, 0x40, 0x16, 0x6e, 0xc9, 0xf3, 0x25, 0x21, 0x68, 0x0c, 0xfb, 0xea, 0xbd, 0x47, 0x64,
+	0xe4, 0x72, 0x62, 0xb4, 0x64, 0xff, 0xc1, 0xb6, 0xfe, 0x15, 0xd2, 0x2a, 0x35, 0x0f, 0xd4, 0x88,
+	0x32, 0x6a, 0xe1, 0xb6, 0x0c, 0xb1, 0x8c, 0x90, 0x07, 0x6d, 0x7f, 0x19, 0xba, 0x01, 0xf5, 0xe4,
+	0x73, 0xe8, 0x72, 0x4e, 0x7f, 0xdb, 0x9c, 0x32, 0x67, 0x95, 0x9a, 0x48, 0x8d, 0x29, 0x81, 0x16,
+	0x86, 0x2c, 0xba, 0x24, 0xc4, 0xfa, 0x71, 0x17, 0xf6, 0xcb, 0x17, 0x09, 0x32, 0xa0, 0xe9, 0x45,
+	0xc4, 0xe5, 0x2c, 0x92, 0xeb, 0xa9, 0xe3, 0x3c, 0x44, 0x1d, 0x68, 0x45, 0xc4, 0x23, 0xf4, 0x26,
+	0xdb, 0x37, 0x1d, 0x17, 0x31, 0x7a, 0x04, 0xcd, 0x7c, 0xa9, 0xd5, 0xdd, 0x76, 0x50, 0x59, 0xea,
+	0x6c, 0x9f, 0xf7, 0xe6, 0x6a, 0x95, 0xdf, 0x03, 0x58, 0xaf, 0xb2, 0xdc, 0xbe, 0x3a, 0xd6, 0x8b,
+	0xdd, 0xcd, 0xf7, 0xb5, 0xb1, 0xde, 0xd7, 0xef, 0x35, 0x38, 0xc8, 0x3f, 0xaf, 0xc7, 0x4e, 0x76,
+	0xd1, 0x11, 0x3f, 0xdb, 0x1d, 0xbc, 0xcd, 0x93, 0x4d, 0xdc, 0x55, 0x6a, 0x76, 0xaa, 0x9f, 0x73,
+	0x29, 0x59, 0xfa, 0x9a, 0x1f, 0x0f, 0x72, 0xb0, 0xa2, 0xe2, 0xbc, 0xa4, 0xa2, 0xf9, 0x1f, 0x55,
+	0x9c, 0xff, 0x93, 0x8a, 0xf3, 0x8d, 0x2a, 0xce, 0xd7, 0x2a, 0xa6, 0xf0, 0x20, 0x9e, 0xb8, 0x91,
+	0xb8, 0x7e, 0x68, 0x28, 0xc6, 0xab, 0x05, 0xbc, 0xdc, 0x36, 0xbe, 0xca, 0x5a, 0xa5, 0xe6, 0xa1,
+	0x1a, 0x5c, 0x81, 0x2d, 0xbc, 0xaf, 0xe2, 0xe7, 0x2a, 0xfc, 0x45, 0x83, 0x37, 0xef, 0xfd, 0xb7,
+	0xf8, 0xdf, 0xae, 0xb1, 0x7b, 0x97, 0x56, 0xfd, 0xfe, 0xa5, 0xd5, 0xff, 0xfc, 0xe5, 0x6d, 0x57,
+	0x7b, 0x75, 0xdb, 0xd5, 0xfe, 0xbc, 0xed, 0x6a, 0x2f, 0xee, 0xba, 0xb5, 0x57, 0x77, 0xdd, 0xda,
+	0x6f, 0x77, 0xdd, 0xda, 0x37, 0x67, 0x63, 0xca, 0x27, 0x8b, 0x91, 0xed, 0xb1, 0xa0, 0x97, 0xa9,
+	0x3c, 0x63, 0xd1, 0x38, 0x3f, 0xf7, 0x6e, 0x9e, 0xf4, 0x12, 0xf5, 0xa3, 0x65, 0x39, 0x27, 0xf1,
+	0x68, 0x4f, 0xfe, 0xa8, 0xf8, 0xe0, 0xef, 0x01, 0x00, 0x6e, 0xcc, 0x0b, 0x09, 0x49, 0x09, 0x00,
+	0x00,
+}
+
+func (m *DexStreamBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexStreamBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexStreamBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deltas) > 0 {
+		for iNdEx := len(m.Deltas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deltas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStream(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintStream(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.BlockHeight != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DexDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Delta != nil {
+		{
+			size := m.Delta.Size()
+			i -= size
+			if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if m.TxIndex != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.TxIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DexDelta_Tick) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexDelta_Tick) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Tick != nil {
+		{
+			size, err := m.Tick.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DexDelta_Fill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexDelta_Fill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Fill != nil {
+		{
+			size, err := m.Fill.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DexDelta_Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexDelta_Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *DexDelta_Expiration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexDelta_Expiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Expiration != nil {
+		{
+			size, err := m.Expiration.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TickDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReservesMakerDenom.Size()
+		i -= size
+		if _, err := m.ReservesMakerDenom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Fee != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TickIndexTakerToMaker != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.TickIndexTakerToMaker))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FillDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FillDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FillDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DynamicFee.Size()
+		i -= size
+		if _, err := m.DynamicFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.MakerRebate.Size()
+		i -= size
+		if _, err := m.MakerRebate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.AmountOut.Size()
+		i -= size
+		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Fee != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TickIndexTakerToMaker != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.TickIndexTakerToMaker))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SharesMinted.Size()
+		i -= size
+		if _, err := m.SharesMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.Reserves1Deposited.Size()
+		i -= size
+		if _, err := m.Reserves1Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Reserves0Deposited.Size()
+		i -= size
+		if _, err := m.Reserves0Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStream(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Fee != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.TickIndex != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExpirationDelta) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExpirationDelta) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExpirationDelta) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintStream(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TickIndexTakerToMaker != 0 {
+		i = encodeVarintStream(dAtA, i, uint64(m.TickIndexTakerToMaker))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStream(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStream(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStream(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DexStreamBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockHeight != 0 {
+		n += 1 + sovStream(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovStream(uint64(l))
+	if len(m.Deltas) > 0 {
+		for _, e := range m.Deltas {
+			l = e.Size()
+			n += 1 + l + sovStream(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *DexDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxIndex != 0 {
+		n += 1 + sovStream(uint64(m.TxIndex))
+	}
+	if m.Delta != nil {
+		n += m.Delta.Size()
+	}
+	return n
+}
+
+func (m *DexDelta_Tick) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tick != nil {
+		l = m.Tick.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	return n
+}
+func (m *DexDelta_Fill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fill != nil {
+		l = m.Fill.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	return n
+}
+func (m *DexDelta_Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	return n
+}
+func (m *DexDelta_Expiration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Expiration != nil {
+		l = m.Expiration.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	return n
+}
+func (m *TickDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if m.TickIndexTakerToMaker != 0 {
+		n += 1 + sovStream(uint64(m.TickIndexTakerToMaker))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovStream(uint64(m.Fee))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = m.ReservesMakerDenom.Size()
+	n += 1 + l + sovStream(uint64(l))
+	return n
+}
+
+func (m *FillDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if m.TickIndexTakerToMaker != 0 {
+		n += 1 + sovStream(uint64(m.TickIndexTakerToMaker))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovStream(uint64(m.Fee))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovStream(uint64(l))
+	l = m.AmountOut.Size()
+	n += 1 + l + sovStream(uint64(l))
+	l = m.TakerFee.Size()
+	n += 1 + l + sovStream(uint64(l))
+	l = m.MakerRebate.Size()
+	n += 1 + l + sovStream(uint64(l))
+	l = m.DynamicFee.Size()
+	n += 1 + l + sovStream(uint64(l))
+	return n
+}
+
+func (m *DepositDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if m.TickIndex != 0 {
+		n += 1 + sovStream(uint64(m.TickIndex))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovStream(uint64(m.Fee))
+	}
+	l = m.Reserves0Deposited.Size()
+	n += 1 + l + sovStream(uint64(l))
+	l = m.Reserves1Deposited.Size()
+	n += 1 + l + sovStream(uint64(l))
+	l = m.SharesMinted.Size()
+	n += 1 + l + sovStream(uint64(l))
+	return n
+}
+
+func (m *ExpirationDelta) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovStream(uint64(l))
+	}
+	if m.TickIndexTakerToMaker != 0 {
+		n += 1 + sovStream(uint64(m.TickIndexTakerToMaker))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovStream(uint64(l))
+	}
+	return n
+}
+
+func sovStream(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStream(x uint64) (n int) {
+	return sovStream(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DexStreamBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DexStreamBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DexStreamBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deltas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deltas = append(m.Deltas, &DexDelta{})
+			if err := m.Deltas[len(m.Deltas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DexDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DexDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DexDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxIndex", wireType)
+			}
+			m.TxIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxIndex |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &TickDelta{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Delta = &DexDelta_Tick{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fill", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &FillDelta{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Delta = &DexDelta_Fill{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &DepositDelta{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Delta = &DexDelta_Deposit{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &ExpirationDelta{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Delta = &DexDelta_Expiration{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexTakerToMaker", wireType)
+			}
+			m.TickIndexTakerToMaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexTakerToMaker |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservesMakerDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReservesMakerDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FillDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FillDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FillDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexTakerToMaker", wireType)
+			}
+			m.TickIndexTakerToMaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexTakerToMaker |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerRebate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerRebate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DynamicFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DepositDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves0Deposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserves0Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves1Deposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserves1Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharesMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExpirationDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExpirationDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExpirationDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexTakerToMaker", wireType)
+			}
+			m.TickIndexTakerToMaker = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexTakerToMaker |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStream
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStream
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStream(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStream
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipStream(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowStream
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowStream
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthStream
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupStream
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthStream
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthStream        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowStream          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupStream = fmt.Errorf("proto: unexpected end of group")
+)
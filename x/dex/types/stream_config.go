@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+)
+
+const (
+	flagStreamEnabled       = "dex-stream.enabled"
+	flagStreamOutput        = "dex-stream.output"
+	flagStreamStopNodeOnErr = "dex-stream.stop-node-on-err"
+
+	// StreamUnixSocketPrefix marks a stream output as a unix socket rather than a file
+	StreamUnixSocketPrefix = "unix://"
+)
+
+// DefaultStreamConfigTemplate should be appended to the app.toml template.
+const DefaultStreamConfigTemplate = `
+
+###############################################################################
+###                                Dex Stream                               ###
+###############################################################################
+[dex-stream]
+# Enabled indicates whether this node streams the dex deltas of every committed block (tick changes, fills,
+# deposits and expirations). Each block is written as a neutron.dex.DexStreamBlock encoded as proto3 JSON
+# on a single line. The stream does not affect consensus and can be replayed with 'neutrond debug dex-stream-replay'.
+enabled = "{{ .DexStream.Enabled }}"
+
+# Output is the file the stream is appended to, relative to the node home directory if not absolute.
+# Use unix:///path/to/socket to write to a unix socket that is listened on by the consumer instead.
+output = "{{ .DexStream.Output }}"
+
+# StopNodeOnErr halts the node when the stream cannot be written to so that no blocks are missed.
+stop-node-on-err = "{{ .DexStream.StopNodeOnErr }}"
+`
+
+// StreamConfig configures the node-local dex stream
+type StreamConfig struct {
+	Enabled       bool   `mapstructure:"enabled" toml:"enabled"`
+	Output        string `mapstructure:"output" toml:"output"`
+	StopNodeOnErr bool   `mapstructure:"stop-node-on-err" toml:"stop-node-on-err"`
+}
+
+func DefaultStreamConfig() StreamConfig {
+	return StreamConfig{
+		Enabled:       false,
+		Output:        "data/dex_stream.jsonl",
+		StopNodeOnErr: false,
+	}
+}
+
+func (c StreamConfig) Validate() error {
+	if strings.TrimPrefix(c.Output, StreamUnixSocketPrefix) == "" {
+		return fmt.Errorf("dex stream output must not be empty")
+	}
+
+	return nil
+}
+
+// IsUnixSocket returns true if the stream is written to a unix socket
+func (c StreamConfig) IsUnixSocket() bool {
+	return strings.HasPrefix(c.Output, StreamUnixSocketPrefix)
+}
+
+// ReadStreamConfigFromAppOpts reads the stream config from the [dex-stream] section of app.toml
+func ReadStreamConfigFromAppOpts(opts servertypes.AppOptions) (StreamConfig, error) {
+	var (
+		cfg = DefaultStreamConfig()
+		err error
+	)
+
+	if v := opts.Get(flagStreamEnabled); v != nil {
+		if cfg.Enabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if !cfg.Enabled {
+		return cfg, nil
+	}
+
+	if v := opts.Get(flagStreamOutput); v != nil {
+		if cfg.Output, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	if v := opts.Get(flagStreamStopNodeOnErr); v != nil {
+		if cfg.StopNodeOnErr, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+
+	return cfg, cfg.Validate()
+}
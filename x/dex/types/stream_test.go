@@ -0,0 +1,136 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/neutron-org/neutron/v5/testutil/common/sample"
+	"github.com/neutron-org/neutron/v5/x/dex/types"
+)
+
+func TestDexDeltasFromEvents(t *testing.T) {
+	tradePairID := types.MustNewTradePairID("TokenA", "TokenB")
+	poolReserves := types.PoolReserves{
+		Key: &types.PoolReservesKey{
+			TradePairId:           tradePairID,
+			TickIndexTakerToMaker: 5,
+			Fee:                   5,
+		},
+		ReservesMakerDenom: math.NewInt(90),
+	}
+	tranche := &types.LimitOrderTranche{
+		Key: &types.LimitOrderTrancheKey{
+			TradePairId:           tradePairID,
+			TickIndexTakerToMaker: 10,
+			TrancheKey:            "tranche",
+		},
+	}
+	creator := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	events := sdk.Events{
+		types.CreateDepositEvent(creator, creator, "TokenA", "TokenB", 0, 5, math.ZeroInt(), math.NewInt(100), math.NewInt(100)),
+		types.CreateTickUpdatePoolReserves(poolReserves, types.SwapMetadata{
+			AmountIn:  math.NewInt(10),
+			AmountOut: math.NewInt(10),
+			TokenIn:   "TokenA",
+		}),
+		types.CreateTickUpdateLimitOrderTranchePurge(tranche),
+		sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyModule, "bank")),
+	}.ToABCIEvents()
+
+	deltas, err := types.DexDeltasFromEvents(2, events)
+	require.NoError(t, err)
+	require.Len(t, deltas, 5)
+	for _, delta := range deltas {
+		require.Equal(t, int32(2), delta.TxIndex)
+	}
+
+	deposit := deltas[0].GetDeposit()
+	require.NotNil(t, deposit)
+	require.Equal(t, creator.String(), deposit.Creator)
+	require.Equal(t, &types.PairID{Token0: "TokenA", Token1: "TokenB"}, deposit.PairId)
+	require.Equal(t, uint64(5), deposit.Fee)
+	require.Equal(t, math.NewInt(100), deposit.Reserves1Deposited)
+	require.Equal(t, math.NewInt(100), deposit.SharesMinted)
+
+	tick := deltas[1].GetTick()
+	require.NotNil(t, tick)
+	require.Equal(t, tradePairID, tick.TradePairId)
+	require.Equal(t, int64(5), tick.TickIndexTakerToMaker)
+	require.Equal(t, uint64(5), tick.Fee)
+	require.Equal(t, math.NewInt(90), tick.ReservesMakerDenom)
+
+	fill := deltas[2].GetFill()
+	require.NotNil(t, fill)
+	require.Equal(t, math.NewInt(10), fill.AmountIn)
+	require.Equal(t, math.NewInt(10), fill.AmountOut)
+	require.True(t, fill.TakerFee.IsZero())
+
+	tick = deltas[3].GetTick()
+	require.NotNil(t, tick)
+	require.Equal(t, "tranche", tick.TrancheKey)
+	require.True(t, tick.ReservesMakerDenom.IsZero())
+
+	expiration := deltas[4].GetExpiration()
+	require.NotNil(t, expiration)
+	require.Equal(t, &types.ExpirationDelta{
+		TradePairId:           tradePairID,
+		TickIndexTakerToMaker: 10,
+		TrancheKey:            "tranche",
+	}, expiration)
+}
+
+func TestDexDeltasFromEventsInvalid(t *testing.T) {
+	events := []abci.Event{{
+		Type: types.EventTypeTickUpdate,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeToken0, Value: "TokenA"},
+			{Key: types.AttributeToken1, Value: "TokenB"},
+			{Key: types.AttributeTokenIn, Value: "TokenB"},
+			{Key: types.AttributeTickIndex, Value: "0"},
+			{Key: types.AttributeReserves, Value: "abc"},
+		},
+	}}
+
+	_, err := types.DexDeltasFromEvents(0, events)
+	require.ErrorContains(t, err, "invalid TickUpdate event")
+}
+
+func TestDexStreamBlockFromFinalizeBlock(t *testing.T) {
+	tickUpdate := func(tickIndex int64) abci.Event {
+		return abci.Event(types.TickUpdateEvent("TokenA", "TokenB", "TokenB", tickIndex, math.NewInt(10)))
+	}
+	withMode := func(event abci.Event, mode string) abci.Event {
+		event.Attributes = append(event.Attributes, abci.EventAttribute{Key: "mode", Value: mode})
+		return event
+	}
+	blockTime := time.Unix(1_000, 0).UTC()
+
+	block, err := types.DexStreamBlockFromFinalizeBlock(
+		abci.RequestFinalizeBlock{Height: 10, Time: blockTime},
+		abci.ResponseFinalizeBlock{
+			Events: []abci.Event{withMode(tickUpdate(3), "EndBlock"), withMode(tickUpdate(0), "BeginBlock")},
+			TxResults: []*abci.ExecTxResult{
+				{Events: []abci.Event{tickUpdate(1)}},
+				// Failed txs are ignored
+				{Code: 1, Events: []abci.Event{tickUpdate(100)}},
+				{Events: []abci.Event{tickUpdate(2)}},
+			},
+		},
+	)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), block.BlockHeight)
+	require.Equal(t, blockTime, block.BlockTime)
+
+	// Deltas are ordered by execution
+	require.Len(t, block.Deltas, 4)
+	for i, txIndex := range []int32{types.BlockTxIndex, 0, 2, types.BlockTxIndex} {
+		require.Equal(t, txIndex, block.Deltas[i].TxIndex)
+		require.Equal(t, int64(i), block.Deltas[i].GetTick().TickIndexTakerToMaker)
+	}
+}
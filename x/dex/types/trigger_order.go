@@ -0,0 +1,54 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (t TriggerOrder) KeyMarshal() []byte {
+	return TriggerOrderKey(t.TradePairId, t.OrderType, t.TriggerTickIndexInToOut, t.OrderKey)
+}
+
+func (t TriggerOrder) CoinIn() sdk.Coin {
+	return sdk.NewCoin(t.TradePairId.TakerDenom, t.AmountIn)
+}
+
+// IsTriggered returns true if the order should be executed given the current tickIndexTakerToMaker
+// of its TradePairID. A higher tick corresponds to a lower sell price.
+func (t TriggerOrder) IsTriggered(tickIndexTakerToMaker int64) bool {
+	switch t.OrderType {
+	case LimitOrderType_STOP_LOSS:
+		return tickIndexTakerToMaker >= t.TriggerTickIndexInToOut
+	case LimitOrderType_TAKE_PROFIT:
+		return tickIndexTakerToMaker <= t.TriggerTickIndexInToOut
+	default:
+		return false
+	}
+}
+
+func (t TriggerOrder) Validate() error {
+	if t.TradePairId == nil {
+		return sdkerrors.Wrap(ErrInvalidTradingPair, "missing TradePairID")
+	}
+	if _, err := t.TradePairId.PairID(); err != nil {
+		return err
+	}
+	if !t.OrderType.IsTrigger() {
+		return sdkerrors.Wrapf(ErrInvalidOrderType, "%s", t.OrderType)
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Creator); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if _, err := sdk.AccAddressFromBech32(t.Receiver); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid receiver address (%s)", err)
+	}
+	if t.AmountIn.IsNil() || t.AmountIn.LTE(math.ZeroInt()) {
+		return ErrZeroLimitOrder
+	}
+	if IsTickOutOfRange(t.TickIndexInToOut) || IsTickOutOfRange(t.TriggerTickIndexInToOut) {
+		return ErrTickOutsideRange
+	}
+
+	return nil
+}
@@ -0,0 +1,765 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: neutron/dex/trigger_order.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_neutron_org_neutron_v5_utils_math "github.com/neutron-org/neutron/v5/utils/math"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TriggerOrder is a STOP_LOSS or TAKE_PROFIT limit order waiting for its trigger price to be reached.
+// Once triggered it is executed as an IMMEDIATE_OR_CANCEL limit order.
+type TriggerOrder struct {
+	// TradePairID of the taker side of the order (ie. TakerDenom == token_in)
+	TradePairId *TradePairID `protobuf:"bytes,1,opt,name=trade_pair_id,json=tradePairId,proto3" json:"trade_pair_id,omitempty"`
+	// Tick at which the order is triggered, denominated the same way as tick_index_in_to_out
+	TriggerTickIndexInToOut int64                 `protobuf:"varint,2,opt,name=trigger_tick_index_in_to_out,json=triggerTickIndexInToOut,proto3" json:"trigger_tick_index_in_to_out,omitempty"`
+	OrderType               LimitOrderType        `protobuf:"varint,3,opt,name=order_type,json=orderType,proto3,enum=neutron.dex.LimitOrderType" json:"order_type,omitempty"`
+	OrderKey                string                `protobuf:"bytes,4,opt,name=order_key,json=orderKey,proto3" json:"order_key,omitempty"`
+	Creator                 string                `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver                string                `protobuf:"bytes,6,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	AmountIn                cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	// Limit tick used when the order is executed
+	TickIndexInToOut    int64                                                 `protobuf:"varint,8,opt,name=tick_index_in_to_out,json=tickIndexInToOut,proto3" json:"tick_index_in_to_out,omitempty"`
+	MaxAmountOut        *cosmossdk_io_math.Int                                `protobuf:"bytes,9,opt,name=max_amount_out,json=maxAmountOut,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_out" yaml:"max_amount_out"`
+	MinAverageSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,10,opt,name=min_average_sell_price,json=minAverageSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"min_average_sell_price" yaml:"min_average_sell_price"`
+}
+
+func (m *TriggerOrder) Reset()         { *m = TriggerOrder{} }
+func (m *TriggerOrder) String() string { return proto.CompactTextString(m) }
+func (*TriggerOrder) ProtoMessage()    {}
+func (*TriggerOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_89e20823ab38e6f8, []int{0}
+}
+func (m *TriggerOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TriggerOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TriggerOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TriggerOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TriggerOrder.Merge(m, src)
+}
+func (m *TriggerOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *TriggerOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_TriggerOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TriggerOrder proto.InternalMessageInfo
+
+func (m *TriggerOrder) GetTradePairId() *TradePairID {
+	if m != nil {
+		return m.TradePairId
+	}
+	return nil
+}
+
+func (m *TriggerOrder) GetTriggerTickIndexInToOut() int64 {
+	if m != nil {
+		return m.TriggerTickIndexInToOut
+	}
+	return 0
+}
+
+func (m *TriggerOrder) GetOrderType() LimitOrderType {
+	if m != nil {
+		return m.OrderType
+	}
+	return LimitOrderType_GOOD_TIL_CANCELLED
+}
+
+func (m *TriggerOrder) GetOrderKey() string {
+	if m != nil {
+		return m.OrderKey
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *TriggerOrder) GetTickIndexInToOut() int64 {
+	if m != nil {
+		return m.TickIndexInToOut
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*TriggerOrder)(nil), "neutron.dex.TriggerOrder")
+}
+
+func init() { proto.RegisterFile("neutron/dex/trigger_order.proto", fileDescriptor_89e20823ab38e6f8) }
+
+var fileDescriptor_89e20823ab38e6f8 = []byte{
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x8d, 0x29, 0x6d, 0x93, 0x6d, 0xa9, 0x2a, 0x93, 0x82, 0x95, 0x82, 0x1d, 0xe5, 0x94, 0x4b,
+	0x6d, 0xa9, 0xc0, 0xa5, 0x02, 0xa4, 0x56, 0x95, 0x20, 0x02, 0xa9, 0x91, 0xc9, 0x09, 0x0e, 0xab,
+	0xad, 0x3d, 0x72, 0x57, 0xb1, 0x77, 0xad, 0xf5, 0x3a, 0xd8, 0x7f, 0xc1, 0x87, 0xf0, 0x1d, 0x28,
+	0xc7, 0x1e, 0x11, 0x07, 0x0b, 0x25, 0xb7, 0x1e, 0xf3, 0x05, 0xc8, 0x5e, 0xa7, 0x90, 0xa8, 0xc0,
+	0x6d, 0xde, 0x9b, 0x37, 0xf3, 0x9e, 0xc7, 0x5a, 0x64, 0x31, 0x48, 0xa5, 0xe0, 0xcc, 0xf1, 0x21,
+	0x73, 0xa4, 0xa0, 0x41, 0x00, 0x02, 0x73, 0xe1, 0x83, 0xb0, 0x63, 0xc1, 0x25, 0xd7, 0x77, 0x6a,
+	0x81, 0xed, 0x43, 0xd6, 0x69, 0x07, 0x3c, 0xe0, 0x15, 0xef, 0x94, 0x95, 0x92, 0x74, 0xd6, 0x76,
+	0x10, 0x1f, 0x70, 0x4c, 0xa8, 0xc0, 0xd4, 0xaf, 0x05, 0xed, 0x15, 0x41, 0xa6, 0xd8, 0xde, 0xb7,
+	0x4d, 0xb4, 0x3b, 0x52, 0x8e, 0x17, 0xa5, 0xa1, 0xfe, 0x12, 0x3d, 0x58, 0x99, 0x36, 0xb4, 0xae,
+	0xd6, 0xdf, 0x39, 0x36, 0xec, 0x3f, 0x22, 0xd8, 0xa3, 0x52, 0x31, 0x24, 0x54, 0x0c, 0xce, 0xdd,
+	0x1d, 0x79, 0x0b, 0x7c, 0xfd, 0x15, 0x7a, 0xb2, 0xcc, 0x2f, 0xa9, 0x37, 0xc6, 0x94, 0xf9, 0x90,
+	0x61, 0xca, 0xb0, 0xe4, 0x98, 0xa7, 0xd2, 0xb8, 0xd7, 0xd5, 0xfa, 0x1b, 0xee, 0xe3, 0x5a, 0x33,
+	0xa2, 0xde, 0x78, 0x50, 0x2a, 0x06, 0x6c, 0xc4, 0x2f, 0x52, 0xa9, 0x9f, 0x20, 0x54, 0x7d, 0x36,
+	0x96, 0x79, 0x0c, 0xc6, 0x46, 0x57, 0xeb, 0xef, 0x1d, 0x1f, 0xae, 0x38, 0xbf, 0xa7, 0x11, 0x95,
+	0x55, 0xd2, 0x51, 0x1e, 0x83, 0xdb, 0xe2, 0xcb, 0x52, 0x3f, 0x44, 0x0a, 0xe0, 0x31, 0xe4, 0xc6,
+	0xfd, 0xae, 0xd6, 0x6f, 0xb9, 0xcd, 0x8a, 0x78, 0x07, 0xb9, 0x6e, 0xa0, 0x6d, 0x4f, 0x00, 0x91,
+	0x5c, 0x18, 0x9b, 0x55, 0x6b, 0x09, 0xf5, 0x0e, 0x6a, 0x0a, 0xf0, 0x80, 0x4e, 0x40, 0x18, 0x5b,
+	0x6a, 0x6a, 0x89, 0xf5, 0x4f, 0xa8, 0x45, 0x22, 0x9e, 0x32, 0x89, 0x29, 0x33, 0xb6, 0xcb, 0xe6,
+	0xd9, 0xeb, 0x69, 0x61, 0x35, 0x7e, 0x14, 0xd6, 0x81, 0xc7, 0x93, 0x88, 0x27, 0x89, 0x3f, 0xb6,
+	0x29, 0x77, 0x22, 0x22, 0xaf, 0xec, 0x01, 0x93, 0x37, 0x85, 0xf5, 0x7b, 0x62, 0x51, 0x58, 0xfb,
+	0x39, 0x89, 0xc2, 0x93, 0xde, 0x2d, 0xd5, 0x73, 0x9b, 0xaa, 0x1e, 0x30, 0xdd, 0x46, 0xed, 0x3b,
+	0x4f, 0xd4, 0xac, 0x4e, 0xb4, 0x2f, 0xd7, 0x6f, 0xc3, 0xd0, 0x5e, 0x44, 0x32, 0x5c, 0xef, 0x2a,
+	0x95, 0xad, 0x2a, 0xd1, 0xdb, 0x69, 0x61, 0x69, 0xff, 0x4a, 0xb4, 0x36, 0xb6, 0x28, 0xac, 0x03,
+	0x15, 0x6b, 0x95, 0xef, 0xb9, 0xbb, 0x11, 0xc9, 0x4e, 0x2b, 0x5c, 0xfa, 0x7d, 0xd5, 0xd0, 0xa3,
+	0x88, 0x32, 0x4c, 0x26, 0x20, 0x48, 0x00, 0x38, 0x81, 0x30, 0xc4, 0xb1, 0xa0, 0x1e, 0x18, 0xa8,
+	0x32, 0xfe, 0x5c, 0x1b, 0x3f, 0x0f, 0xa8, 0xbc, 0x4a, 0x2f, 0x6d, 0x8f, 0x47, 0x4e, 0xfd, 0xab,
+	0x8e, 0xb8, 0x08, 0x96, 0xb5, 0x33, 0x79, 0xe1, 0xa4, 0x92, 0x86, 0x89, 0xca, 0x34, 0x14, 0xe0,
+	0x9d, 0x83, 0x77, 0x53, 0x58, 0x7f, 0xd9, 0xbe, 0x28, 0xac, 0xa7, 0x75, 0xbe, 0x3b, 0xfb, 0x3d,
+	0xf7, 0x61, 0x44, 0xd9, 0xa9, 0xe2, 0x3f, 0x40, 0x18, 0x0e, 0x4b, 0xf6, 0xec, 0xcd, 0x74, 0x66,
+	0x6a, 0xd7, 0x33, 0x53, 0xfb, 0x39, 0x33, 0xb5, 0x2f, 0x73, 0xb3, 0x71, 0x3d, 0x37, 0x1b, 0xdf,
+	0xe7, 0x66, 0xe3, 0xe3, 0xd1, 0xff, 0xf3, 0x65, 0xea, 0x51, 0xe4, 0x31, 0x24, 0x97, 0x5b, 0xd5,
+	0xc3, 0x78, 0xf6, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x7c, 0xd0, 0xdc, 0x43, 0x95, 0x03, 0x00, 0x00,
+}
+
+func (m *TriggerOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TriggerOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TriggerOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinAverageSellPrice != nil {
+		{
+			size := m.MinAverageSellPrice.Size()
+			i -= size
+			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTriggerOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.MaxAmountOut != nil {
+		{
+			size := m.MaxAmountOut.Size()
+			i -= size
+			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTriggerOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.TickIndexInToOut != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OrderKey) > 0 {
+		i -= len(m.OrderKey)
+		copy(dAtA[i:], m.OrderKey)
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(len(m.OrderKey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TriggerTickIndexInToOut != 0 {
+		i = encodeVarintTriggerOrder(dAtA, i, uint64(m.TriggerTickIndexInToOut))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TradePairId != nil {
+		{
+			size, err := m.TradePairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTriggerOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTriggerOrder(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTriggerOrder(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TriggerOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TradePairId != nil {
+		l = m.TradePairId.Size()
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	if m.TriggerTickIndexInToOut != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.TriggerTickIndexInToOut))
+	}
+	if m.OrderType != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.OrderType))
+	}
+	l = len(m.OrderKey)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTriggerOrder(uint64(l))
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovTriggerOrder(uint64(m.TickIndexInToOut))
+	}
+	if m.MaxAmountOut != nil {
+		l = m.MaxAmountOut.Size()
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	if m.MinAverageSellPrice != nil {
+		l = m.MinAverageSellPrice.Size()
+		n += 1 + l + sovTriggerOrder(uint64(l))
+	}
+	return n
+}
+
+func sovTriggerOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTriggerOrder(x uint64) (n int) {
+	return sovTriggerOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TriggerOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTriggerOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradePairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.TradePairId == nil {
+				m.TradePairId = &TradePairID{}
+			}
+			if err := m.TradePairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerTickIndexInToOut", wireType)
+			}
+			m.TriggerTickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TriggerTickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
+			}
+			m.TickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxAmountOut = &v
+			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.MinAverageSellPrice = &v
+			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTriggerOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTriggerOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTriggerOrder(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTriggerOrder
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTriggerOrder
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTriggerOrder
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTriggerOrder
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTriggerOrder
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTriggerOrder        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTriggerOrder          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTriggerOrder = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,8 +8,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -47,6 +47,21 @@ const (
 	LimitOrderType_IMMEDIATE_OR_CANCEL LimitOrderType = 2
 	LimitOrderType_JUST_IN_TIME        LimitOrderType = 3
 	LimitOrderType_GOOD_TIL_TIME       LimitOrderType = 4
+	// STOP_LOSS orders are held until the best available sell price falls to or below trigger_sell_price,
+	// they are then executed as IMMEDIATE_OR_CANCEL.
+	LimitOrderType_STOP_LOSS LimitOrderType = 5
+	// TAKE_PROFIT orders are held until the best available sell price rises to or above trigger_sell_price,
+	// they are then executed as IMMEDIATE_OR_CANCEL.
+	LimitOrderType_TAKE_PROFIT LimitOrderType = 6
+	// POST_ONLY orders are only ever added to the book as makers, they are rejected if they would fill
+	// against any existing liquidity. Once placed they behave like GOOD_TIL_CANCELLED orders.
+	LimitOrderType_POST_ONLY LimitOrderType = 7
+	// POST_ONLY_REPRICE orders behave like POST_ONLY orders, except that an order that would fill against
+	// existing liquidity is moved one tick behind the best opposing price instead of being rejected.
+	LimitOrderType_POST_ONLY_REPRICE LimitOrderType = 8
+	// GOOD_TIL_BLOCK orders behave like GOOD_TIL_TIME orders, except that they expire once the block height reaches
+	// expiration_height rather than at a block time.
+	LimitOrderType_GOOD_TIL_BLOCK LimitOrderType = 9
 )
 
 var LimitOrderType_name = map[int32]string{
@@ -55,6 +70,11 @@ var LimitOrderType_name = map[int32]string{
 	2: "IMMEDIATE_OR_CANCEL",
 	3: "JUST_IN_TIME",
 	4: "GOOD_TIL_TIME",
+	5: "STOP_LOSS",
+	6: "TAKE_PROFIT",
+	7: "POST_ONLY",
+	8: "POST_ONLY_REPRICE",
+	9: "GOOD_TIL_BLOCK",
 }
 
 var LimitOrderType_value = map[string]int32{
@@ -63,6 +83,11 @@ var LimitOrderType_value = map[string]int32{
 	"IMMEDIATE_OR_CANCEL": 2,
 	"JUST_IN_TIME":        3,
 	"GOOD_TIL_TIME":       4,
+	"STOP_LOSS":           5,
+	"TAKE_PROFIT":         6,
+	"POST_ONLY":           7,
+	"POST_ONLY_REPRICE":   8,
+	"GOOD_TIL_BLOCK":      9,
 }
 
 func (x LimitOrderType) String() string {
@@ -457,8 +482,20 @@ type MsgPlaceLimitOrder struct {
 	LimitSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,11,opt,name=limit_sell_price,json=limitSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"limit_sell_price" yaml:"limit_sell_price"`
 	// min_average_sell_price is an optional parameter that sets a required minimum average price for the entire trade.
 	// if the min_average_sell_price is not met the trade will fail.
-	// If min_average_sell_price is omitted limit_sell_price will be used instead
+	// If min_average_sell_price is omitted limit_sell_price will be used instead.
+	// Fees charged on top of the trade, the limit order taker fee and the dynamic fee, are excluded from the average price.
 	MinAverageSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,12,opt,name=min_average_sell_price,json=minAverageSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"min_average_sell_price" yaml:"min_average_sell_price"`
+	// trigger_sell_price is only valid iff orderType == STOP_LOSS or TAKE_PROFIT.
+	TriggerSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,13,opt,name=trigger_sell_price,json=triggerSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"trigger_sell_price" yaml:"trigger_sell_price"`
+	// oracle_peg is only valid iff orderType == GOOD_TIL_CANCELLED. If set the order is placed at the oracle price plus
+	// the peg's offset and is moved whenever the oracle price changes. tick_index_in_to_out and limit_sell_price must not be set.
+	OraclePeg *OraclePeg `protobuf:"bytes,14,opt,name=oracle_peg,json=oraclePeg,proto3" json:"oracle_peg,omitempty"`
+	// expiration_height is only valid iff orderType == GOOD_TIL_BLOCK.
+	ExpirationHeight uint64 `protobuf:"varint,15,opt,name=expiration_height,json=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	// If auto_withdraw is set the proceeds of the maker portion of the order are sent to the receiver once the
+	// LimitOrderTranche is filled or expires, instead of waiting for a MsgWithdrawFilledLimitOrder.
+	// auto_withdraw is not valid for taker-only or trigger orders.
+	AutoWithdraw bool `protobuf:"varint,16,opt,name=auto_withdraw,json=autoWithdraw,proto3" json:"auto_withdraw,omitempty"`
 }
 
 func (m *MsgPlaceLimitOrder) Reset()         { *m = MsgPlaceLimitOrder{} }
@@ -544,6 +581,27 @@ func (m *MsgPlaceLimitOrder) GetExpirationTime() *time.Time {
 	return nil
 }
 
+func (m *MsgPlaceLimitOrder) GetOraclePeg() *OraclePeg {
+	if m != nil {
+		return m.OraclePeg
+	}
+	return nil
+}
+
+func (m *MsgPlaceLimitOrder) GetExpirationHeight() uint64 {
+	if m != nil {
+		return m.ExpirationHeight
+	}
+	return 0
+}
+
+func (m *MsgPlaceLimitOrder) GetAutoWithdraw() bool {
+	if m != nil {
+		return m.AutoWithdraw
+	}
+	return false
+}
+
 type MsgPlaceLimitOrderResponse struct {
 	TrancheKey string `protobuf:"bytes,1,opt,name=trancheKey,proto3" json:"trancheKey,omitempty"`
 	// Total amount of coin used for the limit order
@@ -555,6 +613,8 @@ type MsgPlaceLimitOrderResponse struct {
 	TakerCoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=taker_coin_out,json=takerCoinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taker_coin_out" yaml:"taker_coin_out"`
 	// Total amount of the token in that was immediately swapped for takerOutCoin
 	TakerCoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=taker_coin_in,json=takerCoinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taker_coin_in" yaml:"taker_coin_in"`
+	// Total taker fee paid on the amount immediately swapped; it is included in taker_coin_in
+	TakerFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taker_fee" yaml:"taker_fee"`
 }
 
 func (m *MsgPlaceLimitOrderResponse) Reset()         { *m = MsgPlaceLimitOrderResponse{} }
@@ -781,22 +841,30 @@ func (m *MsgCancelLimitOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelLimitOrderResponse proto.InternalMessageInfo
 
-type MultiHopRoute struct {
-	Hops []string `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
+// MsgAmendLimitOrder atomically cancels a resting GOOD_TIL_CANCELLED, GOOD_TIL_TIME, GOOD_TIL_BLOCK or POST_ONLY limit
+// order and re-places its unfilled amount. Any filled proceeds are withdrawn to the creator.
+type MsgAmendLimitOrder struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TrancheKey string `protobuf:"bytes,2,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	// amount_in is the new unfilled size of the order. It must not exceed the currently unfilled amount.
+	// If omitted the full unfilled amount is re-placed.
+	AmountIn *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	// limit_sell_price is the new price for the order. If omitted the order keeps its current price.
+	LimitSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,4,opt,name=limit_sell_price,json=limitSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"limit_sell_price" yaml:"limit_sell_price"`
 }
 
-func (m *MultiHopRoute) Reset()         { *m = MultiHopRoute{} }
-func (m *MultiHopRoute) String() string { return proto.CompactTextString(m) }
-func (*MultiHopRoute) ProtoMessage()    {}
-func (*MultiHopRoute) Descriptor() ([]byte, []int) {
+func (m *MsgAmendLimitOrder) Reset()         { *m = MsgAmendLimitOrder{} }
+func (m *MsgAmendLimitOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendLimitOrder) ProtoMessage()    {}
+func (*MsgAmendLimitOrder) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a489f6e187d5e074, []int{12}
 }
-func (m *MultiHopRoute) XXX_Unmarshal(b []byte) error {
+func (m *MsgAmendLimitOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MultiHopRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAmendLimitOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MultiHopRoute.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAmendLimitOrder.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -806,48 +874,55 @@ func (m *MultiHopRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MultiHopRoute) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MultiHopRoute.Merge(m, src)
+func (m *MsgAmendLimitOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendLimitOrder.Merge(m, src)
 }
-func (m *MultiHopRoute) XXX_Size() int {
+func (m *MsgAmendLimitOrder) XXX_Size() int {
 	return m.Size()
 }
-func (m *MultiHopRoute) XXX_DiscardUnknown() {
-	xxx_messageInfo_MultiHopRoute.DiscardUnknown(m)
+func (m *MsgAmendLimitOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendLimitOrder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MultiHopRoute proto.InternalMessageInfo
+var xxx_messageInfo_MsgAmendLimitOrder proto.InternalMessageInfo
 
-func (m *MultiHopRoute) GetHops() []string {
+func (m *MsgAmendLimitOrder) GetCreator() string {
 	if m != nil {
-		return m.Hops
+		return m.Creator
 	}
-	return nil
+	return ""
 }
 
-type MsgMultiHopSwap struct {
-	Creator        string                                               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Receiver       string                                               `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	Routes         []*MultiHopRoute                                     `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
-	AmountIn       cosmossdk_io_math.Int                                `protobuf:"bytes,4,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
-	ExitLimitPrice github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,5,opt,name=exit_limit_price,json=exitLimitPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"exit_limit_price" yaml:"exit_limit_price"`
-	// If pickBestRoute == true then all routes are run and the route with the
-	// best price is chosen otherwise, the first succesful route is used.
-	PickBestRoute bool `protobuf:"varint,6,opt,name=pick_best_route,json=pickBestRoute,proto3" json:"pick_best_route,omitempty"`
+func (m *MsgAmendLimitOrder) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
 }
 
-func (m *MsgMultiHopSwap) Reset()         { *m = MsgMultiHopSwap{} }
-func (m *MsgMultiHopSwap) String() string { return proto.CompactTextString(m) }
-func (*MsgMultiHopSwap) ProtoMessage()    {}
-func (*MsgMultiHopSwap) Descriptor() ([]byte, []int) {
+type MsgAmendLimitOrderResponse struct {
+	// TrancheKey of the re-placed order
+	TrancheKey string `protobuf:"bytes,1,opt,name=tranche_key,json=trancheKey,proto3" json:"tranche_key,omitempty"`
+	// Total amount of taker reserves that were withdrawn, including any output from crossing the book at the new price
+	TakerCoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=taker_coin_out,json=takerCoinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taker_coin_out" yaml:"taker_coin_out"`
+	// Total amount of maker reserves that were returned because the order size was reduced
+	MakerCoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=maker_coin_out,json=makerCoinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"maker_coin_out" yaml:"maker_coin_out"`
+	// Fee paid for the part of the re-placed order that was filled by crossing the book at the new price
+	TakerFee github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=taker_fee,json=takerFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"taker_fee" yaml:"taker_fee"`
+}
+
+func (m *MsgAmendLimitOrderResponse) Reset()         { *m = MsgAmendLimitOrderResponse{} }
+func (m *MsgAmendLimitOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAmendLimitOrderResponse) ProtoMessage()    {}
+func (*MsgAmendLimitOrderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a489f6e187d5e074, []int{13}
 }
-func (m *MsgMultiHopSwap) XXX_Unmarshal(b []byte) error {
+func (m *MsgAmendLimitOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMultiHopSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAmendLimitOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMultiHopSwap.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAmendLimitOrderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -857,64 +932,119 @@ func (m *MsgMultiHopSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgMultiHopSwap) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMultiHopSwap.Merge(m, src)
+func (m *MsgAmendLimitOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAmendLimitOrderResponse.Merge(m, src)
 }
-func (m *MsgMultiHopSwap) XXX_Size() int {
+func (m *MsgAmendLimitOrderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMultiHopSwap) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMultiHopSwap.DiscardUnknown(m)
+func (m *MsgAmendLimitOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAmendLimitOrderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMultiHopSwap proto.InternalMessageInfo
+var xxx_messageInfo_MsgAmendLimitOrderResponse proto.InternalMessageInfo
 
-func (m *MsgMultiHopSwap) GetCreator() string {
+func (m *MsgAmendLimitOrderResponse) GetTrancheKey() string {
+	if m != nil {
+		return m.TrancheKey
+	}
+	return ""
+}
+
+// MsgFlashSwap swaps amount_in of token_in for token_out without pre-funding the trade. The output is sent to the
+// creator, which must be a contract, and the contract is called with a FlashSwapCallback sudo msg. Once the callback
+// returns the swap's amount in is collected from the contract; if it cannot be paid the whole swap is reverted.
+type MsgFlashSwap struct {
+	Creator  string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TokenIn  string                `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty"`
+	TokenOut string                `protobuf:"bytes,3,opt,name=token_out,json=tokenOut,proto3" json:"token_out,omitempty"`
+	AmountIn cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	// Worst price in token_out per token_in that the swap may execute at. If omitted there is no price limit.
+	LimitSellPrice *github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,5,opt,name=limit_sell_price,json=limitSellPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"limit_sell_price" yaml:"limit_sell_price"`
+	// Opaque data passed back to the contract in the FlashSwapCallback
+	CallbackData []byte `protobuf:"bytes,6,opt,name=callback_data,json=callbackData,proto3" json:"callback_data,omitempty"`
+}
+
+func (m *MsgFlashSwap) Reset()         { *m = MsgFlashSwap{} }
+func (m *MsgFlashSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashSwap) ProtoMessage()    {}
+func (*MsgFlashSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{14}
+}
+func (m *MsgFlashSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFlashSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFlashSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFlashSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashSwap.Merge(m, src)
+}
+func (m *MsgFlashSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFlashSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFlashSwap proto.InternalMessageInfo
+
+func (m *MsgFlashSwap) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgMultiHopSwap) GetReceiver() string {
+func (m *MsgFlashSwap) GetTokenIn() string {
 	if m != nil {
-		return m.Receiver
+		return m.TokenIn
 	}
 	return ""
 }
 
-func (m *MsgMultiHopSwap) GetRoutes() []*MultiHopRoute {
+func (m *MsgFlashSwap) GetTokenOut() string {
 	if m != nil {
-		return m.Routes
+		return m.TokenOut
 	}
-	return nil
+	return ""
 }
 
-func (m *MsgMultiHopSwap) GetPickBestRoute() bool {
+func (m *MsgFlashSwap) GetCallbackData() []byte {
 	if m != nil {
-		return m.PickBestRoute
+		return m.CallbackData
 	}
-	return false
+	return nil
 }
 
-type MsgMultiHopSwapResponse struct {
-	CoinOut github_com_cosmos_cosmos_sdk_types.Coin   `protobuf:"bytes,1,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out" yaml:"coin_out"`
-	Route   *MultiHopRoute                            `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
-	Dust    []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,rep,name=dust,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"dust" yaml:"dust"`
+type MsgFlashSwapResponse struct {
+	// Amount of token_in that was repaid by the contract
+	CoinIn github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=coin_in,json=coinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_in" yaml:"coin_in"`
+	// Amount of token_out that was released to the contract
+	CoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out" yaml:"coin_out"`
 }
 
-func (m *MsgMultiHopSwapResponse) Reset()         { *m = MsgMultiHopSwapResponse{} }
-func (m *MsgMultiHopSwapResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgMultiHopSwapResponse) ProtoMessage()    {}
-func (*MsgMultiHopSwapResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a489f6e187d5e074, []int{14}
+func (m *MsgFlashSwapResponse) Reset()         { *m = MsgFlashSwapResponse{} }
+func (m *MsgFlashSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFlashSwapResponse) ProtoMessage()    {}
+func (*MsgFlashSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{15}
 }
-func (m *MsgMultiHopSwapResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgFlashSwapResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgMultiHopSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgFlashSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgMultiHopSwapResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgFlashSwapResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -924,44 +1054,42 @@ func (m *MsgMultiHopSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgMultiHopSwapResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgMultiHopSwapResponse.Merge(m, src)
+func (m *MsgFlashSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFlashSwapResponse.Merge(m, src)
 }
-func (m *MsgMultiHopSwapResponse) XXX_Size() int {
+func (m *MsgFlashSwapResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgMultiHopSwapResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgMultiHopSwapResponse.DiscardUnknown(m)
+func (m *MsgFlashSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFlashSwapResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgMultiHopSwapResponse proto.InternalMessageInfo
-
-func (m *MsgMultiHopSwapResponse) GetRoute() *MultiHopRoute {
-	if m != nil {
-		return m.Route
-	}
-	return nil
-}
+var xxx_messageInfo_MsgFlashSwapResponse proto.InternalMessageInfo
 
-type MsgUpdateParams struct {
-	// Authority is the address of the governance account.
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+type MsgCreateGauge struct {
+	Creator string  `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	PairId  *PairID `protobuf:"bytes,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	// Normalized (token0) tick of the lower bound of the rewarded range
+	StartTick int64 `protobuf:"varint,3,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty"`
+	// Normalized (token0) tick of the upper bound of the rewarded range
+	EndTick int64 `protobuf:"varint,4,opt,name=end_tick,json=endTick,proto3" json:"end_tick,omitempty"`
+	// Coins to distribute; they are sent from creator to the Gauge
+	Coins     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins" yaml:"coins"`
+	NumEpochs uint64                                   `protobuf:"varint,6,opt,name=num_epochs,json=numEpochs,proto3" json:"num_epochs,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a489f6e187d5e074, []int{15}
+func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
+func (m *MsgCreateGauge) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGauge) ProtoMessage()    {}
+func (*MsgCreateGauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{16}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateGauge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateGauge.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -971,51 +1099,76 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCreateGauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGauge.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCreateGauge) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCreateGauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGauge.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateGauge proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgCreateGauge) GetCreator() string {
 	if m != nil {
-		return m.Authority
+		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgCreateGauge) GetPairId() *PairID {
 	if m != nil {
-		return m.Params
+		return m.PairId
 	}
-	return Params{}
+	return nil
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-//
-// Since: 0.47
-type MsgUpdateParamsResponse struct {
+func (m *MsgCreateGauge) GetStartTick() int64 {
+	if m != nil {
+		return m.StartTick
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a489f6e187d5e074, []int{16}
+func (m *MsgCreateGauge) GetEndTick() int64 {
+	if m != nil {
+		return m.EndTick
+	}
+	return 0
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgCreateGauge) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
+func (m *MsgCreateGauge) GetNumEpochs() uint64 {
+	if m != nil {
+		return m.NumEpochs
+	}
+	return 0
+}
+
+type MsgCreateGaugeResponse struct {
+	GaugeId uint64 `protobuf:"varint,1,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *MsgCreateGaugeResponse) Reset()         { *m = MsgCreateGaugeResponse{} }
+func (m *MsgCreateGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateGaugeResponse) ProtoMessage()    {}
+func (*MsgCreateGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{17}
+}
+func (m *MsgCreateGaugeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateGaugeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1025,1902 +1178,11384 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgCreateGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateGaugeResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgCreateGaugeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgCreateGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateGaugeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateGaugeResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("neutron.dex.LimitOrderType", LimitOrderType_name, LimitOrderType_value)
-	proto.RegisterType((*DepositOptions)(nil), "neutron.dex.DepositOptions")
-	proto.RegisterType((*MsgDeposit)(nil), "neutron.dex.MsgDeposit")
-	proto.RegisterType((*FailedDeposit)(nil), "neutron.dex.FailedDeposit")
-	proto.RegisterType((*MsgDepositResponse)(nil), "neutron.dex.MsgDepositResponse")
-	proto.RegisterType((*MsgWithdrawal)(nil), "neutron.dex.MsgWithdrawal")
-	proto.RegisterType((*MsgWithdrawalResponse)(nil), "neutron.dex.MsgWithdrawalResponse")
-	proto.RegisterType((*MsgPlaceLimitOrder)(nil), "neutron.dex.MsgPlaceLimitOrder")
-	proto.RegisterType((*MsgPlaceLimitOrderResponse)(nil), "neutron.dex.MsgPlaceLimitOrderResponse")
-	proto.RegisterType((*MsgWithdrawFilledLimitOrder)(nil), "neutron.dex.MsgWithdrawFilledLimitOrder")
-	proto.RegisterType((*MsgWithdrawFilledLimitOrderResponse)(nil), "neutron.dex.MsgWithdrawFilledLimitOrderResponse")
-	proto.RegisterType((*MsgCancelLimitOrder)(nil), "neutron.dex.MsgCancelLimitOrder")
-	proto.RegisterType((*MsgCancelLimitOrderResponse)(nil), "neutron.dex.MsgCancelLimitOrderResponse")
-	proto.RegisterType((*MultiHopRoute)(nil), "neutron.dex.MultiHopRoute")
-	proto.RegisterType((*MsgMultiHopSwap)(nil), "neutron.dex.MsgMultiHopSwap")
-	proto.RegisterType((*MsgMultiHopSwapResponse)(nil), "neutron.dex.MsgMultiHopSwapResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.dex.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.dex.MsgUpdateParamsResponse")
+func (m *MsgCreateGaugeResponse) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("neutron/dex/tx.proto", fileDescriptor_a489f6e187d5e074) }
-
-var fileDescriptor_a489f6e187d5e074 = []byte{
-	// 1940 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcb, 0x6f, 0x23, 0x49,
-	0x19, 0x4f, 0xdb, 0x79, 0xd8, 0x95, 0xc4, 0xf1, 0x74, 0x32, 0x93, 0x1e, 0x0f, 0xa4, 0xad, 0x9e,
-	0xd5, 0x8e, 0x19, 0x31, 0xf6, 0x78, 0x60, 0xf7, 0x90, 0x03, 0x92, 0x9d, 0xc7, 0xae, 0x59, 0x7b,
-	0x12, 0xf5, 0x78, 0x05, 0xda, 0x95, 0x68, 0xda, 0xee, 0x8a, 0xd3, 0x4a, 0x77, 0x97, 0xd5, 0x55,
-	0x76, 0x1c, 0x2e, 0xac, 0x10, 0xa7, 0x3d, 0xed, 0x05, 0x81, 0xc4, 0x3f, 0x00, 0x12, 0x87, 0x39,
-	0xec, 0x99, 0xf3, 0x70, 0x5b, 0x21, 0x21, 0x01, 0x07, 0x03, 0x33, 0x87, 0x91, 0xf6, 0x98, 0x03,
-	0x5c, 0x10, 0x42, 0xf5, 0x70, 0xbf, 0xec, 0x24, 0x93, 0xdd, 0x59, 0xc4, 0x61, 0x2f, 0x71, 0xd7,
-	0xef, 0xfb, 0xea, 0xab, 0x5f, 0xd5, 0xf7, 0xa8, 0xaf, 0x3b, 0x60, 0xc3, 0x83, 0x03, 0xe2, 0x23,
-	0xaf, 0x62, 0xc1, 0x51, 0x85, 0x8c, 0xca, 0x7d, 0x1f, 0x11, 0x24, 0x2f, 0x0b, 0xb4, 0x6c, 0xc1,
-	0x51, 0xe1, 0x86, 0xe9, 0xda, 0x1e, 0xaa, 0xb0, 0xbf, 0x5c, 0x5e, 0xd8, 0xea, 0x22, 0xec, 0x22,
-	0x5c, 0xe9, 0x98, 0x18, 0x56, 0x86, 0xd5, 0x0e, 0x24, 0x66, 0xb5, 0xd2, 0x45, 0xb6, 0x27, 0xe4,
-	0x9b, 0x42, 0xee, 0xe2, 0x5e, 0x65, 0x58, 0xa5, 0x3f, 0x42, 0x70, 0x9b, 0x0b, 0x0c, 0x36, 0xaa,
-	0xf0, 0x81, 0x10, 0x6d, 0xf4, 0x50, 0x0f, 0x71, 0x9c, 0x3e, 0x09, 0x54, 0xed, 0x21, 0xd4, 0x73,
-	0x60, 0x85, 0x8d, 0x3a, 0x83, 0xa3, 0x0a, 0xb1, 0x5d, 0x88, 0x89, 0xe9, 0xf6, 0x85, 0x82, 0x12,
-	0xdd, 0x40, 0xdf, 0xf4, 0x4d, 0x57, 0x18, 0xd4, 0x7e, 0x0c, 0x72, 0xbb, 0xb0, 0x8f, 0xb0, 0x4d,
-	0x0e, 0xfa, 0xc4, 0x46, 0x1e, 0x96, 0xbf, 0x05, 0xf2, 0x96, 0x8d, 0xcd, 0x8e, 0x03, 0x0d, 0x73,
-	0x40, 0x10, 0x3e, 0x35, 0xfb, 0x8a, 0x54, 0x94, 0x4a, 0x19, 0x7d, 0x4d, 0xe0, 0x35, 0x01, 0xcb,
-	0x77, 0x41, 0xee, 0xc8, 0xb4, 0x1d, 0x83, 0x8c, 0x0c, 0xe4, 0x19, 0x1d, 0xe8, 0x28, 0x29, 0xa6,
-	0xb8, 0x4c, 0xd1, 0xf6, 0xe8, 0xc0, 0xab, 0x43, 0x47, 0x7b, 0x96, 0x06, 0xa0, 0x85, 0x7b, 0x62,
-	0x15, 0x59, 0x01, 0x4b, 0x5d, 0x1f, 0x9a, 0x04, 0xf9, 0xcc, 0x6a, 0x56, 0x9f, 0x0c, 0xe5, 0x02,
-	0xc8, 0xf8, 0xb0, 0x0b, 0xed, 0x21, 0xf4, 0x99, 0x9d, 0xac, 0x1e, 0x8c, 0xe5, 0x4d, 0xb0, 0x44,
-	0xd0, 0x09, 0xf4, 0x0c, 0x53, 0x49, 0x33, 0xd1, 0x22, 0x1b, 0xd6, 0x42, 0x41, 0x47, 0x99, 0x8f,
-	0x08, 0xea, 0xf2, 0x87, 0x20, 0x6b, 0xba, 0x68, 0xe0, 0x11, 0x6c, 0x98, 0xca, 0x42, 0x31, 0x5d,
-	0xca, 0xd6, 0xbf, 0xf7, 0x6c, 0xac, 0xce, 0xfd, 0x75, 0xac, 0xde, 0xe4, 0x47, 0x8a, 0xad, 0x93,
-	0xb2, 0x8d, 0x2a, 0xae, 0x49, 0x8e, 0xcb, 0x0d, 0x8f, 0x7c, 0x3e, 0x56, 0xc3, 0x19, 0xe7, 0x63,
-	0x35, 0x7f, 0x66, 0xba, 0xce, 0xb6, 0x16, 0x40, 0x9a, 0x9e, 0x11, 0xcf, 0xb5, 0xa8, 0xf1, 0x8e,
-	0xb2, 0x78, 0x4d, 0xe3, 0x9d, 0x69, 0xe3, 0x9d, 0xd0, 0x78, 0x5d, 0xfe, 0x36, 0x58, 0x27, 0x76,
-	0xf7, 0xc4, 0xb0, 0x3d, 0x0b, 0x8e, 0x20, 0x36, 0x4c, 0x83, 0x20, 0xa3, 0xa3, 0x2c, 0x15, 0xd3,
-	0xa5, 0xb4, 0xbe, 0x46, 0x45, 0x0d, 0x2e, 0xa9, 0xb5, 0x51, 0x5d, 0x96, 0xc1, 0xfc, 0x11, 0x84,
-	0x58, 0xc9, 0x14, 0xd3, 0xa5, 0x79, 0x9d, 0x3d, 0xcb, 0x6f, 0x81, 0x25, 0xc4, 0xbd, 0xa9, 0x64,
-	0x8b, 0xe9, 0xd2, 0xf2, 0xa3, 0x3b, 0xe5, 0x48, 0xac, 0x96, 0xe3, 0x0e, 0xd7, 0x27, 0xba, 0xdb,
-	0xea, 0xcf, 0x5e, 0x3e, 0xbd, 0x3f, 0x71, 0xc7, 0xc7, 0x2f, 0x9f, 0xde, 0xcf, 0xd1, 0x70, 0x09,
-	0x7d, 0xa7, 0xed, 0x83, 0xd5, 0x7d, 0xd3, 0x76, 0xa0, 0x35, 0x71, 0xa6, 0x0a, 0x96, 0x2d, 0xfe,
-	0x68, 0xd8, 0xd6, 0x88, 0x39, 0x74, 0x5e, 0x07, 0x02, 0x6a, 0x58, 0x23, 0x79, 0x03, 0x2c, 0x40,
-	0xdf, 0x47, 0x13, 0x87, 0xf2, 0x81, 0xf6, 0xcf, 0x34, 0x90, 0x43, 0xb3, 0x3a, 0xc4, 0x7d, 0xe4,
-	0x61, 0x28, 0xff, 0x14, 0xc8, 0x3e, 0xc4, 0xd0, 0x1f, 0xc2, 0x87, 0x86, 0xb0, 0x01, 0x2d, 0x45,
-	0x62, 0xc7, 0x7b, 0x78, 0xd5, 0xf1, 0xce, 0x98, 0x7a, 0x3e, 0x56, 0x6f, 0xf3, 0x73, 0x9e, 0x96,
-	0x69, 0xfa, 0x8d, 0x09, 0xb8, 0x3b, 0xc1, 0x22, 0x04, 0xaa, 0x11, 0x02, 0xa9, 0xeb, 0x11, 0xa8,
-	0x5e, 0x42, 0xa0, 0x3a, 0x8b, 0x40, 0x35, 0x24, 0xb0, 0x03, 0xd6, 0x8e, 0xd8, 0x01, 0x4f, 0xf4,
-	0xb0, 0x92, 0x66, 0x0e, 0x2c, 0xc4, 0x1c, 0x18, 0x73, 0x82, 0x9e, 0x3b, 0x8a, 0x0e, 0xb1, 0xfc,
-	0x2b, 0x09, 0xac, 0xe2, 0x63, 0xd3, 0x87, 0xd8, 0xb0, 0x31, 0x1e, 0x40, 0x4b, 0x99, 0x67, 0x36,
-	0x6e, 0x97, 0x45, 0x29, 0xa1, 0x05, 0xa9, 0x2c, 0x0a, 0x52, 0x79, 0x07, 0xd9, 0x5e, 0xfd, 0x87,
-	0x62, 0x73, 0xf7, 0x7a, 0x36, 0x39, 0x1e, 0x74, 0xca, 0x5d, 0xe4, 0x8a, 0xba, 0x23, 0x7e, 0x1e,
-	0x60, 0xeb, 0xa4, 0x42, 0xce, 0xfa, 0x10, 0xb3, 0x09, 0x9f, 0x8f, 0xd5, 0xf8, 0x12, 0xe7, 0x63,
-	0x75, 0x83, 0xef, 0x34, 0x06, 0x6b, 0xfa, 0x0a, 0x1f, 0x37, 0xf8, 0xf0, 0x4f, 0x29, 0xb0, 0xda,
-	0xc2, 0xbd, 0x1f, 0xd8, 0xe4, 0xd8, 0xf2, 0xcd, 0x53, 0xd3, 0xf9, 0x9f, 0x95, 0x83, 0x21, 0xc8,
-	0x0b, 0x66, 0x04, 0x19, 0x3e, 0x74, 0xd1, 0x10, 0x8a, 0xaa, 0xd0, 0xbc, 0xca, 0xb1, 0x53, 0x13,
-	0xcf, 0xc7, 0xea, 0x66, 0x6c, 0xb3, 0x81, 0x44, 0xd3, 0x73, 0x1c, 0x6a, 0x23, 0x9d, 0x01, 0x17,
-	0x25, 0xf3, 0xe2, 0xe5, 0xc9, 0xbc, 0x14, 0x26, 0xf3, 0xb6, 0x96, 0xcc, 0xca, 0x1b, 0x22, 0x2b,
-	0xc3, 0x53, 0xd4, 0x3e, 0x4d, 0x83, 0x9b, 0x31, 0x64, 0x66, 0x4e, 0x9d, 0x0a, 0xb1, 0xc7, 0x8f,
-	0xfa, 0x3a, 0x39, 0x15, 0x4c, 0x9d, 0x91, 0x53, 0x81, 0x2c, 0x92, 0x53, 0x13, 0x26, 0x5e, 0x2c,
-	0xa7, 0x42, 0x02, 0xa9, 0xeb, 0x11, 0xa8, 0x5e, 0x42, 0xa0, 0x3a, 0x8b, 0x40, 0x35, 0x24, 0x10,
-	0x49, 0x87, 0xce, 0xc0, 0xf7, 0xa0, 0x25, 0x52, 0xea, 0xab, 0x49, 0x07, 0xbe, 0xc4, 0x54, 0x3a,
-	0x70, 0x38, 0x48, 0x87, 0x3a, 0x1f, 0xfe, 0x67, 0x91, 0xd5, 0xc1, 0x43, 0xc7, 0xec, 0xc2, 0xa6,
-	0xed, 0xda, 0xe4, 0xc0, 0xb7, 0xa0, 0xff, 0x05, 0x73, 0xe2, 0x36, 0xc8, 0xf0, 0xd0, 0xb7, 0x3d,
-	0x91, 0x14, 0x3c, 0x15, 0x1a, 0x9e, 0x7c, 0x07, 0x64, 0xb9, 0x08, 0x0d, 0x88, 0xc8, 0x0b, 0xae,
-	0x7b, 0x30, 0x20, 0xf2, 0x23, 0xb0, 0x11, 0x46, 0xa8, 0x61, 0x7b, 0x34, 0x40, 0xa9, 0xde, 0x42,
-	0x51, 0x2a, 0xa5, 0xeb, 0x29, 0x45, 0xd2, 0xf3, 0x41, 0x98, 0x36, 0xbc, 0x36, 0xa2, 0x73, 0x82,
-	0xfb, 0x8f, 0x2e, 0xb6, 0xc4, 0x7c, 0xf9, 0xaa, 0xf7, 0x9f, 0x61, 0x7b, 0xc9, 0xfb, 0xcf, 0xb0,
-	0xbd, 0xe0, 0xfe, 0x6b, 0x78, 0xf2, 0x36, 0x00, 0x88, 0x9e, 0x83, 0x41, 0x0f, 0x58, 0xc9, 0x14,
-	0xa5, 0x52, 0x2e, 0x71, 0x81, 0x85, 0x67, 0xd5, 0x3e, 0xeb, 0x43, 0x3d, 0x8b, 0x26, 0x8f, 0x72,
-	0x0b, 0xac, 0xc1, 0x51, 0xdf, 0xf6, 0x4d, 0x7a, 0xa3, 0x19, 0xb4, 0x0d, 0x52, 0xb2, 0x45, 0x89,
-	0x15, 0x50, 0xde, 0x23, 0x95, 0x27, 0x3d, 0x52, 0xb9, 0x3d, 0xe9, 0x91, 0xea, 0x99, 0x67, 0x63,
-	0x55, 0xfa, 0xe4, 0x6f, 0xaa, 0xa4, 0xe7, 0xc2, 0xc9, 0x54, 0x2c, 0x7b, 0x20, 0xe7, 0x9a, 0x23,
-	0x43, 0xd0, 0xa4, 0xa7, 0x02, 0xd8, 0x66, 0xdf, 0xa5, 0x33, 0x2e, 0xdb, 0x6c, 0x62, 0xda, 0xf9,
-	0x58, 0xbd, 0xc9, 0x77, 0x1c, 0xc7, 0x35, 0x7d, 0xc5, 0x35, 0x47, 0x35, 0x36, 0xa6, 0xe7, 0xfa,
-	0x0b, 0x09, 0xe4, 0x1d, 0xba, 0x39, 0x03, 0x43, 0xc7, 0x31, 0xfa, 0xbe, 0xdd, 0x85, 0xca, 0x32,
-	0x5b, 0xf2, 0x44, 0x2c, 0xf9, 0xdd, 0x48, 0x4c, 0x8a, 0x33, 0x79, 0x80, 0xfc, 0xde, 0xe4, 0xb9,
-	0x32, 0x7c, 0xab, 0x32, 0x20, 0xb6, 0x83, 0x39, 0x9b, 0x43, 0x1f, 0x76, 0x77, 0x61, 0x97, 0x56,
-	0xb1, 0xa4, 0xdd, 0xb0, 0x8a, 0x25, 0x25, 0x9a, 0x9e, 0x63, 0xd0, 0x13, 0xe8, 0x38, 0x87, 0x14,
-	0x90, 0x7f, 0x27, 0x81, 0x5b, 0xae, 0xed, 0x19, 0xe6, 0x10, 0xfa, 0x66, 0x0f, 0x46, 0xd9, 0xad,
-	0x30, 0x76, 0xa7, 0x5f, 0x92, 0xdd, 0x05, 0xd6, 0xcf, 0xc7, 0xea, 0x37, 0xc5, 0xb9, 0xcd, 0x94,
-	0x6b, 0xfa, 0xba, 0x6b, 0x7b, 0x35, 0x8e, 0x07, 0x74, 0xb7, 0xef, 0x25, 0x4b, 0xe6, 0x2d, 0x51,
-	0x32, 0x13, 0x99, 0xa6, 0xfd, 0x2b, 0x0d, 0x0a, 0xd3, 0x70, 0x50, 0x3c, 0xb7, 0x00, 0x20, 0xbe,
-	0xe9, 0x75, 0x8f, 0xe1, 0x7b, 0xf0, 0x4c, 0xe4, 0x62, 0x04, 0x91, 0x3f, 0x92, 0xc0, 0x12, 0x6d,
-	0xe8, 0x69, 0x16, 0xa4, 0x58, 0x98, 0x5d, 0x52, 0x54, 0x9a, 0xd7, 0x2f, 0x2a, 0x13, 0xe3, 0xe7,
-	0x63, 0x35, 0xc7, 0x8f, 0x41, 0x00, 0x9a, 0xbe, 0x48, 0x9f, 0x1a, 0x9e, 0xfc, 0x6b, 0x09, 0xe4,
-	0x88, 0x79, 0x02, 0x7d, 0x83, 0x89, 0x68, 0x88, 0xa6, 0xaf, 0x62, 0xf2, 0xc1, 0xf5, 0x99, 0x24,
-	0xd6, 0x08, 0xe3, 0x39, 0x8e, 0x6b, 0xfa, 0x0a, 0x03, 0xe8, 0x2c, 0x1a, 0xcf, 0xbf, 0x94, 0xc0,
-	0x6a, 0x44, 0xc3, 0xf6, 0x58, 0xf5, 0x79, 0xed, 0xb5, 0x37, 0xb6, 0x44, 0x58, 0x7b, 0x63, 0xb0,
-	0xa6, 0x2f, 0x07, 0xd4, 0x1a, 0x9e, 0xf6, 0xb1, 0x04, 0xee, 0x44, 0x6e, 0xcc, 0x7d, 0xdb, 0x71,
-	0xa0, 0xf5, 0x4a, 0x35, 0x58, 0x05, 0xcb, 0x22, 0x04, 0x8c, 0x13, 0x78, 0x26, 0xca, 0x70, 0x24,
-	0x2a, 0xb6, 0x1f, 0x26, 0xa3, 0x4f, 0x4d, 0x5c, 0xd8, 0xc9, 0xc5, 0xb4, 0x7f, 0xa4, 0xc0, 0xdd,
-	0x4b, 0xe4, 0x41, 0x3c, 0xce, 0x70, 0xb6, 0xf4, 0xff, 0xe3, 0x6c, 0xca, 0xce, 0x8d, 0xb3, 0x4b,
-	0x7d, 0x15, 0xec, 0xdc, 0x0b, 0xd8, 0xb9, 0x49, 0x76, 0x6e, 0x84, 0x9d, 0xf6, 0x13, 0xb0, 0xde,
-	0xc2, 0xbd, 0x1d, 0xd3, 0xeb, 0x42, 0xe7, 0xf5, 0xf8, 0xb9, 0x94, 0xf4, 0xf3, 0xa6, 0xf0, 0x73,
-	0x72, 0x11, 0xed, 0x2f, 0x29, 0x16, 0x6c, 0x49, 0xfc, 0x6b, 0xbf, 0xbe, 0x06, 0xbf, 0xde, 0x05,
-	0xab, 0xad, 0x81, 0x43, 0xec, 0x77, 0x51, 0x5f, 0x47, 0x03, 0x02, 0x69, 0x0f, 0x7d, 0x8c, 0xfa,
-	0x98, 0xbf, 0x37, 0xea, 0xec, 0x59, 0xfb, 0x7d, 0x1a, 0xac, 0xb5, 0x70, 0x6f, 0xa2, 0xf8, 0xe4,
-	0xd4, 0xec, 0x7f, 0xc1, 0x2e, 0xeb, 0x11, 0x58, 0xf4, 0xe9, 0x32, 0xb3, 0x5f, 0xcc, 0x62, 0x4c,
-	0x74, 0xa1, 0x19, 0xef, 0x96, 0xe6, 0x5f, 0x73, 0xb7, 0x44, 0x5b, 0x06, 0x38, 0xb2, 0x89, 0xc1,
-	0x6f, 0x71, 0x7e, 0x29, 0x2f, 0x04, 0x2d, 0xc3, 0xdc, 0x97, 0x69, 0x19, 0x92, 0x76, 0xc3, 0x96,
-	0x21, 0x29, 0xd1, 0x68, 0xeb, 0x64, 0x13, 0x16, 0xdb, 0xbc, 0x65, 0x78, 0x13, 0xac, 0xf5, 0x69,
-	0x5b, 0xd9, 0x81, 0x98, 0x18, 0xec, 0x20, 0x94, 0x45, 0xf6, 0x71, 0x68, 0x95, 0xc2, 0x75, 0x88,
-	0x09, 0x3b, 0xa4, 0xed, 0x37, 0x92, 0x59, 0xb4, 0x2e, 0xb2, 0x28, 0xea, 0x2c, 0xed, 0x0f, 0x29,
-	0xb0, 0x99, 0xc0, 0x82, 0xec, 0xf9, 0xb9, 0x04, 0x32, 0xaf, 0x9e, 0x37, 0x8f, 0xaf, 0x1f, 0x99,
-	0x99, 0x48, 0x4c, 0xae, 0x45, 0xee, 0x61, 0x16, 0x8d, 0xec, 0x8e, 0xa6, 0x69, 0xf2, 0x10, 0x2c,
-	0xf0, 0x6d, 0xa6, 0x44, 0xc3, 0x79, 0x71, 0x60, 0x70, 0x45, 0x79, 0x00, 0xe6, 0xad, 0x01, 0x26,
-	0x57, 0xbf, 0x8f, 0xec, 0x5f, 0x9f, 0x33, 0xb3, 0x7c, 0x3e, 0x56, 0x97, 0x39, 0x5f, 0x3a, 0xd2,
-	0x74, 0x06, 0x6a, 0xbf, 0x95, 0x58, 0x32, 0xbc, 0xdf, 0xb7, 0x4c, 0x02, 0x0f, 0xd9, 0xc7, 0x40,
-	0xf9, 0x6d, 0x90, 0x35, 0x07, 0xe4, 0x18, 0xf9, 0x36, 0x11, 0x8d, 0x4e, 0x5d, 0xf9, 0xe3, 0xa7,
-	0x0f, 0x36, 0x04, 0xa5, 0x9a, 0x65, 0xf9, 0x10, 0xe3, 0x27, 0xc4, 0xb7, 0xbd, 0x9e, 0x1e, 0xaa,
-	0xca, 0x6f, 0x83, 0x45, 0xfe, 0x39, 0x51, 0xec, 0x7a, 0x3d, 0xb6, 0x6b, 0x6e, 0xbc, 0x9e, 0xa5,
-	0xf4, 0x7f, 0xf3, 0xf2, 0xe9, 0x7d, 0x49, 0x17, 0xda, 0xdb, 0x6f, 0x52, 0xaf, 0x87, 0x76, 0xa2,
-	0x7e, 0x8f, 0xf2, 0xd2, 0x6e, 0x33, 0xb7, 0x47, 0xa1, 0x89, 0xdb, 0xef, 0x8f, 0x40, 0x2e, 0xfe,
-	0x1e, 0x20, 0xdf, 0x02, 0xf2, 0x3b, 0x07, 0x07, 0xbb, 0x46, 0xbb, 0xd1, 0x34, 0x76, 0x6a, 0x8f,
-	0x77, 0xf6, 0x9a, 0xcd, 0xbd, 0xdd, 0xfc, 0x9c, 0x9c, 0x07, 0x2b, 0xfb, 0x8d, 0x66, 0xd3, 0x38,
-	0xd0, 0x8d, 0xf7, 0x1a, 0xcd, 0x66, 0x5e, 0x92, 0x37, 0xc1, 0x7a, 0xa3, 0xd5, 0xda, 0xdb, 0x6d,
-	0xd4, 0xda, 0x7b, 0x14, 0xe6, 0xda, 0xf9, 0x14, 0x55, 0xfd, 0xfe, 0xfb, 0x4f, 0xda, 0x46, 0xe3,
-	0xb1, 0xd1, 0x6e, 0xb4, 0xf6, 0xf2, 0x69, 0xf9, 0x06, 0x58, 0x0d, 0x8c, 0x32, 0x68, 0xfe, 0xd1,
-	0xbf, 0xe7, 0x41, 0xba, 0x85, 0x7b, 0xf2, 0x0e, 0x58, 0x9a, 0x7c, 0x08, 0xdb, 0x8c, 0x7b, 0x3b,
-	0xf8, 0xb6, 0x55, 0x50, 0x2f, 0x10, 0x04, 0xd1, 0xdb, 0x04, 0x20, 0xf2, 0x39, 0xa4, 0x90, 0x54,
-	0x0f, 0x65, 0x05, 0xed, 0x62, 0x59, 0x60, 0xed, 0x43, 0xb0, 0x96, 0x7c, 0x9b, 0x9c, 0x62, 0x90,
-	0x50, 0x28, 0xdc, 0xbb, 0x42, 0x21, 0x30, 0x3e, 0x04, 0xca, 0x85, 0xfd, 0x52, 0xe9, 0x22, 0x72,
-	0x49, 0xcd, 0xc2, 0xc3, 0x57, 0xd5, 0x0c, 0xd6, 0xfd, 0x11, 0xc8, 0x4f, 0xdd, 0xdb, 0xc5, 0xa4,
-	0x95, 0xa4, 0x46, 0xa1, 0x74, 0x95, 0x46, 0x60, 0x5f, 0x07, 0x2b, 0xb1, 0x9b, 0xe1, 0x1b, 0xc9,
-	0x99, 0x51, 0x69, 0xe1, 0x8d, 0xcb, 0xa4, 0x51, 0x9b, 0xb1, 0x04, 0x9b, 0xb2, 0x19, 0x95, 0x4e,
-	0xdb, 0x9c, 0x15, 0xf1, 0x85, 0x85, 0x8f, 0x68, 0x0e, 0xd5, 0xdf, 0x79, 0xf6, 0x7c, 0x4b, 0xfa,
-	0xec, 0xf9, 0x96, 0xf4, 0xf7, 0xe7, 0x5b, 0xd2, 0x27, 0x2f, 0xb6, 0xe6, 0x3e, 0x7b, 0xb1, 0x35,
-	0xf7, 0xe7, 0x17, 0x5b, 0x73, 0x1f, 0x3c, 0xb8, 0xba, 0xd0, 0x8f, 0xf8, 0xff, 0x30, 0x68, 0xa9,
-	0xe8, 0x2c, 0xb2, 0x77, 0xe1, 0xef, 0xfc, 0x37, 0x00, 0x00, 0xff, 0xff, 0x95, 0x04, 0x7c, 0xe0,
-	0xdf, 0x18, 0x00, 0x00,
+type MsgStake struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// Pool shares to stake
+	Shares github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=shares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shares" yaml:"shares"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
-	Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error)
-	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
-	WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error)
-	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
-	MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error)
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgStake) Reset()         { *m = MsgStake{} }
+func (m *MsgStake) String() string { return proto.CompactTextString(m) }
+func (*MsgStake) ProtoMessage()    {}
+func (*MsgStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{18}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStake.Merge(m, src)
+}
+func (m *MsgStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStake.DiscardUnknown(m)
 }
 
-func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
-	out := new(MsgDepositResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Deposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgStake proto.InternalMessageInfo
+
+func (m *MsgStake) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error) {
-	out := new(MsgWithdrawalResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Withdrawal", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgStake) GetShares() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shares
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
-	out := new(MsgPlaceLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/PlaceLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgStakeResponse struct {
+	StakeId uint64 `protobuf:"varint,1,opt,name=stake_id,json=stakeId,proto3" json:"stake_id,omitempty"`
 }
 
-func (c *msgClient) WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error) {
-	out := new(MsgWithdrawFilledLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/WithdrawFilledLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgStakeResponse) Reset()         { *m = MsgStakeResponse{} }
+func (m *MsgStakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStakeResponse) ProtoMessage()    {}
+func (*MsgStakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{19}
+}
+func (m *MsgStakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgStakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStakeResponse.Merge(m, src)
+}
+func (m *MsgStakeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStakeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
-	out := new(MsgCancelLimitOrderResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/CancelLimitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgStakeResponse proto.InternalMessageInfo
+
+func (m *MsgStakeResponse) GetStakeId() uint64 {
+	if m != nil {
+		return m.StakeId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error) {
-	out := new(MsgMultiHopSwapResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/MultiHopSwap", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgUnstake struct {
+	// Must be the owner of the Stake
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StakeId uint64 `protobuf:"varint,2,opt,name=stake_id,json=stakeId,proto3" json:"stake_id,omitempty"`
+}
+
+func (m *MsgUnstake) Reset()         { *m = MsgUnstake{} }
+func (m *MsgUnstake) String() string { return proto.CompactTextString(m) }
+func (*MsgUnstake) ProtoMessage()    {}
+func (*MsgUnstake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{20}
+}
+func (m *MsgUnstake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnstake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnstake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUnstake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnstake.Merge(m, src)
+}
+func (m *MsgUnstake) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnstake) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnstake.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUnstake proto.InternalMessageInfo
+
+func (m *MsgUnstake) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
-	Withdrawal(context.Context, *MsgWithdrawal) (*MsgWithdrawalResponse, error)
-	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
-	WithdrawFilledLimitOrder(context.Context, *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error)
-	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
-	MultiHopSwap(context.Context, *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error)
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgUnstake) GetStakeId() uint64 {
+	if m != nil {
+		return m.StakeId
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgUnstakeResponse struct {
+	Shares github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=shares,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"shares" yaml:"shares"`
 }
 
-func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+func (m *MsgUnstakeResponse) Reset()         { *m = MsgUnstakeResponse{} }
+func (m *MsgUnstakeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnstakeResponse) ProtoMessage()    {}
+func (*MsgUnstakeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{21}
 }
-func (*UnimplementedMsgServer) Withdrawal(ctx context.Context, req *MsgWithdrawal) (*MsgWithdrawalResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Withdrawal not implemented")
+func (m *MsgUnstakeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) PlaceLimitOrder(ctx context.Context, req *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+func (m *MsgUnstakeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnstakeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WithdrawFilledLimitOrder(ctx context.Context, req *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFilledLimitOrder not implemented")
+func (m *MsgUnstakeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnstakeResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelLimitOrder(ctx context.Context, req *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+func (m *MsgUnstakeResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) MultiHopSwap(ctx context.Context, req *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MultiHopSwap not implemented")
+func (m *MsgUnstakeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnstakeResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+
+var xxx_messageInfo_MsgUnstakeResponse proto.InternalMessageInfo
+
+func (m *MsgUnstakeResponse) GetShares() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+type MsgClaim struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 }
 
-func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Deposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/Deposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+func (m *MsgClaim) Reset()         { *m = MsgClaim{} }
+func (m *MsgClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgClaim) ProtoMessage()    {}
+func (*MsgClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{22}
+}
+func (m *MsgClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaim.Merge(m, src)
+}
+func (m *MsgClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaim.DiscardUnknown(m)
 }
 
-func _Msg_Withdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawal)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Withdrawal(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/Withdrawal",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Withdrawal(ctx, req.(*MsgWithdrawal))
+var xxx_messageInfo_MsgClaim proto.InternalMessageInfo
+
+func (m *MsgClaim) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPlaceLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/PlaceLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgClaimResponse struct {
+	Rewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=rewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"rewards" yaml:"rewards"`
 }
 
-func _Msg_WithdrawFilledLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawFilledLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/WithdrawFilledLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, req.(*MsgWithdrawFilledLimitOrder))
+func (m *MsgClaimResponse) Reset()         { *m = MsgClaimResponse{} }
+func (m *MsgClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimResponse) ProtoMessage()    {}
+func (*MsgClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{23}
+}
+func (m *MsgClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimResponse.Merge(m, src)
+}
+func (m *MsgClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimResponse.DiscardUnknown(m)
 }
 
-func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelLimitOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelLimitOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/CancelLimitOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+var xxx_messageInfo_MsgClaimResponse proto.InternalMessageInfo
+
+func (m *MsgClaimResponse) GetRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Rewards
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_MultiHopSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgMultiHopSwap)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).MultiHopSwap(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/MultiHopSwap",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).MultiHopSwap(ctx, req.(*MsgMultiHopSwap))
-	}
-	return interceptor(ctx, in, info, handler)
+type MultiHopRoute struct {
+	Hops []string `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/neutron.dex.Msg/UpdateParams",
+func (m *MultiHopRoute) Reset()         { *m = MultiHopRoute{} }
+func (m *MultiHopRoute) String() string { return proto.CompactTextString(m) }
+func (*MultiHopRoute) ProtoMessage()    {}
+func (*MultiHopRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{24}
+}
+func (m *MultiHopRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiHopRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiHopRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+}
+func (m *MultiHopRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiHopRoute.Merge(m, src)
+}
+func (m *MultiHopRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiHopRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiHopRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiHopRoute proto.InternalMessageInfo
+
+func (m *MultiHopRoute) GetHops() []string {
+	if m != nil {
+		return m.Hops
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "neutron.dex.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Deposit",
-			Handler:    _Msg_Deposit_Handler,
-		},
-		{
-			MethodName: "Withdrawal",
-			Handler:    _Msg_Withdrawal_Handler,
-		},
-		{
-			MethodName: "PlaceLimitOrder",
-			Handler:    _Msg_PlaceLimitOrder_Handler,
-		},
-		{
-			MethodName: "WithdrawFilledLimitOrder",
-			Handler:    _Msg_WithdrawFilledLimitOrder_Handler,
-		},
-		{
-			MethodName: "CancelLimitOrder",
-			Handler:    _Msg_CancelLimitOrder_Handler,
-		},
-		{
-			MethodName: "MultiHopSwap",
-			Handler:    _Msg_MultiHopSwap_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "neutron/dex/tx.proto",
+// MultiHopRouteAllocation describes the portion of a split MultiHopSwap that was executed through a single route.
+type MultiHopRouteAllocation struct {
+	Route    *MultiHopRoute                          `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	AmountIn cosmossdk_io_math.Int                   `protobuf:"bytes,2,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	CoinOut  github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out" yaml:"coin_out"`
 }
 
-func (m *DepositOptions) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MultiHopRouteAllocation) Reset()         { *m = MultiHopRouteAllocation{} }
+func (m *MultiHopRouteAllocation) String() string { return proto.CompactTextString(m) }
+func (*MultiHopRouteAllocation) ProtoMessage()    {}
+func (*MultiHopRouteAllocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{25}
+}
+func (m *MultiHopRouteAllocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiHopRouteAllocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiHopRouteAllocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MultiHopRouteAllocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiHopRouteAllocation.Merge(m, src)
+}
+func (m *MultiHopRouteAllocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiHopRouteAllocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiHopRouteAllocation.DiscardUnknown(m)
 }
 
-func (m *DepositOptions) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MultiHopRouteAllocation proto.InternalMessageInfo
+
+func (m *MultiHopRouteAllocation) GetRoute() *MultiHopRoute {
+	if m != nil {
+		return m.Route
+	}
+	return nil
 }
 
-func (m *DepositOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FailTxOnBel {
-		i--
-		if m.FailTxOnBel {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+type MsgMultiHopSwap struct {
+	Creator        string                                               `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver       string                                               `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Routes         []*MultiHopRoute                                     `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
+	AmountIn       cosmossdk_io_math.Int                                `protobuf:"bytes,4,opt,name=amount_in,json=amountIn,proto3,customtype=cosmossdk.io/math.Int" json:"amount_in" yaml:"amount_in"`
+	ExitLimitPrice github_com_neutron_org_neutron_v5_utils_math.PrecDec `protobuf:"bytes,5,opt,name=exit_limit_price,json=exitLimitPrice,proto3,customtype=github.com/neutron-org/neutron/v5/utils/math.PrecDec" json:"exit_limit_price" yaml:"exit_limit_price"`
+	// If pickBestRoute == true then all routes are run and the route with the
+	// best price is chosen otherwise, the first succesful route is used.
+	PickBestRoute bool `protobuf:"varint,6,opt,name=pick_best_route,json=pickBestRoute,proto3" json:"pick_best_route,omitempty"`
+	// If split_routes == true then amount_in is split across all routes so as to maximise
+	// the total amount out. Every portion of the trade must satisfy exit_limit_price.
+	// Cannot be used together with pick_best_route.
+	SplitRoutes bool `protobuf:"varint,7,opt,name=split_routes,json=splitRoutes,proto3" json:"split_routes,omitempty"`
+}
+
+func (m *MsgMultiHopSwap) Reset()         { *m = MsgMultiHopSwap{} }
+func (m *MsgMultiHopSwap) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiHopSwap) ProtoMessage()    {}
+func (*MsgMultiHopSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{26}
+}
+func (m *MsgMultiHopSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiHopSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiHopSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x10
+		return b[:n], nil
 	}
-	if m.DisableAutoswap {
-		i--
-		if m.DisableAutoswap {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgMultiHopSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiHopSwap.Merge(m, src)
+}
+func (m *MsgMultiHopSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiHopSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiHopSwap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiHopSwap proto.InternalMessageInfo
+
+func (m *MsgMultiHopSwap) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMultiHopSwap) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMultiHopSwap) GetRoutes() []*MultiHopRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
 }
 
-func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Options) > 0 {
-		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x4a
-		}
+func (m *MsgMultiHopSwap) GetPickBestRoute() bool {
+	if m != nil {
+		return m.PickBestRoute
 	}
-	if len(m.Fees) > 0 {
-		dAtA2 := make([]byte, len(m.Fees)*10)
-		var j1 int
-		for _, num := range m.Fees {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x42
+	return false
+}
+
+func (m *MsgMultiHopSwap) GetSplitRoutes() bool {
+	if m != nil {
+		return m.SplitRoutes
 	}
-	if len(m.TickIndexesAToB) > 0 {
-		dAtA4 := make([]byte, len(m.TickIndexesAToB)*10)
-		var j3 int
-		for _, num1 := range m.TickIndexesAToB {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.AmountsB) > 0 {
-		for iNdEx := len(m.AmountsB) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.AmountsB[iNdEx].Size()
-				i -= size
-				if _, err := m.AmountsB[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.AmountsA) > 0 {
-		for iNdEx := len(m.AmountsA) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.AmountsA[iNdEx].Size()
-				i -= size
-				if _, err := m.AmountsA[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.TokenB) > 0 {
-		i -= len(m.TokenB)
-		copy(dAtA[i:], m.TokenB)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenA) > 0 {
-		i -= len(m.TokenA)
-		copy(dAtA[i:], m.TokenA)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *FailedDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type MsgMultiHopSwapResponse struct {
+	CoinOut github_com_cosmos_cosmos_sdk_types.Coin   `protobuf:"bytes,1,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out" yaml:"coin_out"`
+	Route   *MultiHopRoute                            `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	Dust    []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,rep,name=dust,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"dust" yaml:"dust"`
+	// Amount in and out of every route that was used, only set if split_routes == true.
+	// In that case route is left empty.
+	RouteAllocations []*MultiHopRouteAllocation `protobuf:"bytes,4,rep,name=route_allocations,json=routeAllocations,proto3" json:"route_allocations,omitempty"`
 }
 
-func (m *FailedDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgMultiHopSwapResponse) Reset()         { *m = MsgMultiHopSwapResponse{} }
+func (m *MsgMultiHopSwapResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiHopSwapResponse) ProtoMessage()    {}
+func (*MsgMultiHopSwapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{27}
 }
-
-func (m *FailedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgMultiHopSwapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiHopSwapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiHopSwapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.DepositIdx != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.DepositIdx))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgMultiHopSwapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiHopSwapResponse.Merge(m, src)
+}
+func (m *MsgMultiHopSwapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiHopSwapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiHopSwapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiHopSwapResponse proto.InternalMessageInfo
+
+func (m *MsgMultiHopSwapResponse) GetRoute() *MultiHopRoute {
+	if m != nil {
+		return m.Route
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMultiHopSwapResponse) GetRouteAllocations() []*MultiHopRouteAllocation {
+	if m != nil {
+		return m.RouteAllocations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgMultiHopSwapExactOut struct {
+	Creator  string           `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver string           `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	Routes   []*MultiHopRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
+	// Exact amount of the last hop denom that the receiver will get
+	AmountOut cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount_out,json=amountOut,proto3,customtype=cosmossdk.io/math.Int" json:"amount_out" yaml:"amount_out"`
+	// Maximum amount of the first hop denom that can be spent to receive amount_out
+	MaxAmountIn cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_amount_in,json=maxAmountIn,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount_in" yaml:"max_amount_in"`
+	// If pickBestRoute == true then all routes are run and the route requiring the
+	// smallest amount in is chosen otherwise, the first succesful route is used.
+	PickBestRoute bool `protobuf:"varint,6,opt,name=pick_best_route,json=pickBestRoute,proto3" json:"pick_best_route,omitempty"`
 }
 
-func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SharesIssued) > 0 {
-		for iNdEx := len(m.SharesIssued) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.SharesIssued[iNdEx].Size()
-				i -= size
-				if _, err := m.SharesIssued[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
+func (m *MsgMultiHopSwapExactOut) Reset()         { *m = MsgMultiHopSwapExactOut{} }
+func (m *MsgMultiHopSwapExactOut) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiHopSwapExactOut) ProtoMessage()    {}
+func (*MsgMultiHopSwapExactOut) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{28}
+}
+func (m *MsgMultiHopSwapExactOut) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiHopSwapExactOut) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiHopSwapExactOut.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.FailedDeposits) > 0 {
-		for iNdEx := len(m.FailedDeposits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FailedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+}
+func (m *MsgMultiHopSwapExactOut) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiHopSwapExactOut.Merge(m, src)
+}
+func (m *MsgMultiHopSwapExactOut) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiHopSwapExactOut) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiHopSwapExactOut.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiHopSwapExactOut proto.InternalMessageInfo
+
+func (m *MsgMultiHopSwapExactOut) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Reserve1Deposited) > 0 {
-		for iNdEx := len(m.Reserve1Deposited) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Reserve1Deposited[iNdEx].Size()
-				i -= size
-				if _, err := m.Reserve1Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return ""
+}
+
+func (m *MsgMultiHopSwapExactOut) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if len(m.Reserve0Deposited) > 0 {
-		for iNdEx := len(m.Reserve0Deposited) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Reserve0Deposited[iNdEx].Size()
-				i -= size
-				if _, err := m.Reserve0Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *MsgMultiHopSwapExactOut) GetRoutes() []*MultiHopRoute {
+	if m != nil {
+		return m.Routes
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgWithdrawal) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgMultiHopSwapExactOut) GetPickBestRoute() bool {
+	if m != nil {
+		return m.PickBestRoute
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgWithdrawal) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgMultiHopSwapExactOutResponse struct {
+	CoinIn  github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,1,opt,name=coin_in,json=coinIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_in" yaml:"coin_in"`
+	CoinOut github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=coin_out,json=coinOut,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"coin_out" yaml:"coin_out"`
+	Route   *MultiHopRoute                          `protobuf:"bytes,3,opt,name=route,proto3" json:"route,omitempty"`
 }
 
-func (m *MsgWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fees) > 0 {
-		dAtA6 := make([]byte, len(m.Fees)*10)
-		var j5 int
-		for _, num := range m.Fees {
-			for num >= 1<<7 {
-				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA6[j5] = uint8(num)
-			j5++
+func (m *MsgMultiHopSwapExactOutResponse) Reset()         { *m = MsgMultiHopSwapExactOutResponse{} }
+func (m *MsgMultiHopSwapExactOutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiHopSwapExactOutResponse) ProtoMessage()    {}
+func (*MsgMultiHopSwapExactOutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{29}
+}
+func (m *MsgMultiHopSwapExactOutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiHopSwapExactOutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiHopSwapExactOutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j5
-		copy(dAtA[i:], dAtA6[:j5])
-		i = encodeVarintTx(dAtA, i, uint64(j5))
-		i--
-		dAtA[i] = 0x3a
+		return b[:n], nil
 	}
-	if len(m.TickIndexesAToB) > 0 {
-		dAtA8 := make([]byte, len(m.TickIndexesAToB)*10)
-		var j7 int
-		for _, num1 := range m.TickIndexesAToB {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA8[j7] = uint8(num)
-			j7++
-		}
-		i -= j7
-		copy(dAtA[i:], dAtA8[:j7])
-		i = encodeVarintTx(dAtA, i, uint64(j7))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *MsgMultiHopSwapExactOutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiHopSwapExactOutResponse.Merge(m, src)
+}
+func (m *MsgMultiHopSwapExactOutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiHopSwapExactOutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiHopSwapExactOutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiHopSwapExactOutResponse proto.InternalMessageInfo
+
+func (m *MsgMultiHopSwapExactOutResponse) GetRoute() *MultiHopRoute {
+	if m != nil {
+		return m.Route
 	}
-	if len(m.SharesToRemove) > 0 {
-		for iNdEx := len(m.SharesToRemove) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.SharesToRemove[iNdEx].Size()
-				i -= size
-				if _, err := m.SharesToRemove[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	return nil
+}
+
+type MsgDepositRange struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	TokenA   string `protobuf:"bytes,3,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
+	TokenB   string `protobuf:"bytes,4,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	// Total amount of token_a distributed across the range
+	AmountA cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount_a,json=amountA,proto3,customtype=cosmossdk.io/math.Int" json:"amount_a" yaml:"amount_a"`
+	// Total amount of token_b distributed across the range
+	AmountB cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=amount_b,json=amountB,proto3,customtype=cosmossdk.io/math.Int" json:"amount_b" yaml:"amount_b"`
+	// Pools are placed every 2*fee ticks (every tick if fee == 0) starting at lower_tick_index_a_to_b
+	LowerTickIndexAToB int64           `protobuf:"varint,7,opt,name=lower_tick_index_a_to_b,json=lowerTickIndexAToB,proto3" json:"lower_tick_index_a_to_b,omitempty"`
+	UpperTickIndexAToB int64           `protobuf:"varint,8,opt,name=upper_tick_index_a_to_b,json=upperTickIndexAToB,proto3" json:"upper_tick_index_a_to_b,omitempty"`
+	Fee                uint64          `protobuf:"varint,9,opt,name=fee,proto3" json:"fee,omitempty"`
+	Shape              RangeShape      `protobuf:"varint,10,opt,name=shape,proto3,enum=neutron.dex.RangeShape" json:"shape,omitempty"`
+	Options            *DepositOptions `protobuf:"bytes,11,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (m *MsgDepositRange) Reset()         { *m = MsgDepositRange{} }
+func (m *MsgDepositRange) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositRange) ProtoMessage()    {}
+func (*MsgDepositRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{30}
+}
+func (m *MsgDepositRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.TokenB) > 0 {
-		i -= len(m.TokenB)
-		copy(dAtA[i:], m.TokenB)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenA) > 0 {
-		i -= len(m.TokenA)
-		copy(dAtA[i:], m.TokenA)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgDepositRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositRange.Merge(m, src)
+}
+func (m *MsgDepositRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositRange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositRange proto.InternalMessageInfo
+
+func (m *MsgDepositRange) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgDepositRange) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgDepositRange) GetTokenA() string {
+	if m != nil {
+		return m.TokenA
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawalResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDepositRange) GetTokenB() string {
+	if m != nil {
+		return m.TokenB
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDepositRange) GetLowerTickIndexAToB() int64 {
+	if m != nil {
+		return m.LowerTickIndexAToB
+	}
+	return 0
 }
 
-func (m *MsgWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SharesBurned) > 0 {
-		for iNdEx := len(m.SharesBurned) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.SharesBurned[iNdEx].Size()
-				i -= size
-				if _, err := m.SharesBurned[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *MsgDepositRange) GetUpperTickIndexAToB() int64 {
+	if m != nil {
+		return m.UpperTickIndexAToB
 	}
-	{
-		size := m.Reserve1Withdrawn.Size()
-		i -= size
-		if _, err := m.Reserve1Withdrawn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *MsgDepositRange) GetFee() uint64 {
+	if m != nil {
+		return m.Fee
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Reserve0Withdrawn.Size()
-		i -= size
-		if _, err := m.Reserve0Withdrawn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *MsgDepositRange) GetShape() RangeShape {
+	if m != nil {
+		return m.Shape
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return RangeShape_FLAT
 }
 
-func (m *MsgPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDepositRange) GetOptions() *DepositOptions {
+	if m != nil {
+		return m.Options
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgDepositRangeResponse struct {
+	PositionId        uint64                                    `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
+	Reserve0Deposited cosmossdk_io_math.Int                     `protobuf:"bytes,2,opt,name=reserve0_deposited,json=reserve0Deposited,proto3,customtype=cosmossdk.io/math.Int" json:"reserve0_deposited" yaml:"reserve0_deposited"`
+	Reserve1Deposited cosmossdk_io_math.Int                     `protobuf:"bytes,3,opt,name=reserve1_deposited,json=reserve1Deposited,proto3,customtype=cosmossdk.io/math.Int" json:"reserve1_deposited" yaml:"reserve1_deposited"`
+	FailedDeposits    []*FailedDeposit                          `protobuf:"bytes,4,rep,name=failed_deposits,json=failedDeposits,proto3" json:"failed_deposits,omitempty"`
+	SharesIssued      []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,5,rep,name=shares_issued,json=sharesIssued,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"shares_issued" yaml:"shares_issued"`
 }
 
-func (m *MsgPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MinAverageSellPrice != nil {
-		{
-			size := m.MinAverageSellPrice.Size()
-			i -= size
-			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgDepositRangeResponse) Reset()         { *m = MsgDepositRangeResponse{} }
+func (m *MsgDepositRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositRangeResponse) ProtoMessage()    {}
+func (*MsgDepositRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{31}
+}
+func (m *MsgDepositRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x62
+		return b[:n], nil
 	}
-	if m.LimitSellPrice != nil {
-		{
-			size := m.LimitSellPrice.Size()
-			i -= size
-			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x5a
+}
+func (m *MsgDepositRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositRangeResponse.Merge(m, src)
+}
+func (m *MsgDepositRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositRangeResponse proto.InternalMessageInfo
+
+func (m *MsgDepositRangeResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	if m.MaxAmountOut != nil {
-		{
-			size := m.MaxAmountOut.Size()
-			i -= size
-			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.ExpirationTime != nil {
-		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
-		if err9 != nil {
-			return 0, err9
-		}
-		i -= n9
-		i = encodeVarintTx(dAtA, i, uint64(n9))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if m.OrderType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
-		i--
-		dAtA[i] = 0x40
-	}
-	{
-		size := m.AmountIn.Size()
-		i -= size
-		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	if m.TickIndexInToOut != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TickIndexInToOut))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.TokenOut) > 0 {
-		i -= len(m.TokenOut)
-		copy(dAtA[i:], m.TokenOut)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgPlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDepositRangeResponse) GetFailedDeposits() []*FailedDeposit {
+	if m != nil {
+		return m.FailedDeposits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgPlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdrawRange struct {
+	// Must be the owner of the RangePosition
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Receiver   string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	PositionId uint64 `protobuf:"varint,3,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty"`
 }
 
-func (m *MsgPlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TakerCoinIn.Size()
-		i -= size
-		if _, err := m.TakerCoinIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.TakerCoinOut.Size()
-		i -= size
-		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.CoinIn.Size()
-		i -= size
-		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgWithdrawRange) Reset()         { *m = MsgWithdrawRange{} }
+func (m *MsgWithdrawRange) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRange) ProtoMessage()    {}
+func (*MsgWithdrawRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{32}
+}
+func (m *MsgWithdrawRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgWithdrawFilledLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgWithdrawRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRange.Merge(m, src)
 }
-
-func (m *MsgWithdrawFilledLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRange.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawFilledLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgWithdrawRange proto.InternalMessageInfo
+
+func (m *MsgWithdrawRange) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgWithdrawRange) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawRange) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdrawRangeResponse struct {
+	Reserve0Withdrawn cosmossdk_io_math.Int                     `protobuf:"bytes,1,opt,name=reserve0_withdrawn,json=reserve0Withdrawn,proto3,customtype=cosmossdk.io/math.Int" json:"reserve0_withdrawn" yaml:"reserve0_withdrawn"`
+	Reserve1Withdrawn cosmossdk_io_math.Int                     `protobuf:"bytes,2,opt,name=reserve1_withdrawn,json=reserve1Withdrawn,proto3,customtype=cosmossdk.io/math.Int" json:"reserve1_withdrawn" yaml:"reserve1_withdrawn"`
+	SharesBurned      []github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,rep,name=shares_burned,json=sharesBurned,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"shares_burned" yaml:"shares_burned"`
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MakerCoinOut.Size()
-		i -= size
-		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TakerCoinOut.Size()
-		i -= size
-		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgWithdrawRangeResponse) Reset()         { *m = MsgWithdrawRangeResponse{} }
+func (m *MsgWithdrawRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRangeResponse) ProtoMessage()    {}
+func (*MsgWithdrawRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{33}
+}
+func (m *MsgWithdrawRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgWithdrawRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRangeResponse.Merge(m, src)
 }
-
-func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawRangeResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TrancheKey) > 0 {
-		i -= len(m.TrancheKey)
-		copy(dAtA[i:], m.TrancheKey)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgWithdrawRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRangeResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgWithdrawRangeResponse proto.InternalMessageInfo
 
-func (m *MsgCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgUpdateParams struct {
+	// Authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func (m *MsgCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.MakerCoinOut.Size()
-		i -= size
-		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{34}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.TakerCoinOut.Size()
-		i -= size
-		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MultiHopRoute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return Params{}
 }
 
-func (m *MultiHopRoute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+//
+// Since: 0.47
+type MsgUpdateParamsResponse struct {
 }
 
-func (m *MultiHopRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hops) > 0 {
-		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Hops[iNdEx])
-			copy(dAtA[i:], m.Hops[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Hops[iNdEx])))
-			i--
-			dAtA[i] = 0xa
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{35}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgMultiHopSwap) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgMultiHopSwap) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// MsgSetPairPaused pauses or unpauses deposits, limit orders and swaps for a single pair.
+// Withdrawals and limit order cancellations remain possible so that users can exit their positions.
+type MsgSetPairPaused struct {
+	// Authority is the address of the governance account.
+	Authority string  `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PairId    *PairID `protobuf:"bytes,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Paused    bool    `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
 }
 
-func (m *MsgMultiHopSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PickBestRoute {
-		i--
-		if m.PickBestRoute {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
-	}
-	{
-		size := m.ExitLimitPrice.Size()
-		i -= size
-		if _, err := m.ExitLimitPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.AmountIn.Size()
-		i -= size
-		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgSetPairPaused) Reset()         { *m = MsgSetPairPaused{} }
+func (m *MsgSetPairPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPairPaused) ProtoMessage()    {}
+func (*MsgSetPairPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{36}
+}
+func (m *MsgSetPairPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPairPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPairPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Receiver) > 0 {
-		i -= len(m.Receiver)
-		copy(dAtA[i:], m.Receiver)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgSetPairPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPairPaused.Merge(m, src)
+}
+func (m *MsgSetPairPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPairPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPairPaused.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPairPaused proto.InternalMessageInfo
+
+func (m *MsgSetPairPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgSetPairPaused) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetPairPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgMultiHopSwapResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetPairPausedResponse struct {
 }
 
-func (m *MsgMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Dust) > 0 {
-		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Dust[iNdEx].Size()
-				i -= size
-				if _, err := m.Dust[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.Route != nil {
-		{
-			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size := m.CoinOut.Size()
-		i -= size
-		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSetPairPausedResponse) Reset()         { *m = MsgSetPairPausedResponse{} }
+func (m *MsgSetPairPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPairPausedResponse) ProtoMessage()    {}
+func (*MsgSetPairPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{37}
+}
+func (m *MsgSetPairPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPairPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPairPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetPairPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPairPausedResponse.Merge(m, src)
+}
+func (m *MsgSetPairPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPairPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPairPausedResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetPairPausedResponse proto.InternalMessageInfo
+
+// MsgSetPairBatchAuction enables or disables batch auction mode for a single pair. In batch auction mode taker limit
+// orders are queued and cleared at a single uniform price at EndBlock. MultiHopSwaps, MultiHopSwapExactOuts and
+// FlashSwaps must complete within their tx so they are not queued; like maker limit orders that would cross the
+// orderbook, they fail if they would take liquidity from the pair.
+type MsgSetPairBatchAuction struct {
+	// Authority is the address of the governance account.
+	Authority string  `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PairId    *PairID `protobuf:"bytes,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+	Enabled   bool    `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetPairBatchAuction) Reset()         { *m = MsgSetPairBatchAuction{} }
+func (m *MsgSetPairBatchAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPairBatchAuction) ProtoMessage()    {}
+func (*MsgSetPairBatchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{38}
+}
+func (m *MsgSetPairBatchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPairBatchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPairBatchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetPairBatchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPairBatchAuction.Merge(m, src)
+}
+func (m *MsgSetPairBatchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPairBatchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPairBatchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPairBatchAuction proto.InternalMessageInfo
+
+func (m *MsgSetPairBatchAuction) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetPairBatchAuction) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetPairBatchAuction) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetPairBatchAuctionResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgSetPairBatchAuctionResponse) Reset()         { *m = MsgSetPairBatchAuctionResponse{} }
+func (m *MsgSetPairBatchAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPairBatchAuctionResponse) ProtoMessage()    {}
+func (*MsgSetPairBatchAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{39}
 }
-func (m *DepositOptions) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DisableAutoswap {
-		n += 2
-	}
-	if m.FailTxOnBel {
-		n += 2
-	}
-	return n
+func (m *MsgSetPairBatchAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgDeposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenA)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TokenB)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.AmountsA) > 0 {
-		for _, e := range m.AmountsA {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.AmountsB) > 0 {
-		for _, e := range m.AmountsB {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.TickIndexesAToB) > 0 {
-		l = 0
-		for _, e := range m.TickIndexesAToB {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	if len(m.Fees) > 0 {
-		l = 0
-		for _, e := range m.Fees {
-			l += sovTx(uint64(e))
+func (m *MsgSetPairBatchAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPairBatchAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	if len(m.Options) > 0 {
-		for _, e := range m.Options {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetPairBatchAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPairBatchAuctionResponse.Merge(m, src)
+}
+func (m *MsgSetPairBatchAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPairBatchAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPairBatchAuctionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPairBatchAuctionResponse proto.InternalMessageInfo
+
+// MsgSetDenomPaused pauses or unpauses deposits, limit orders and swaps for every pair containing denom.
+type MsgSetDenomPaused struct {
+	// Authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Paused    bool   `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *MsgSetDenomPaused) Reset()         { *m = MsgSetDenomPaused{} }
+func (m *MsgSetDenomPaused) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomPaused) ProtoMessage()    {}
+func (*MsgSetDenomPaused) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{40}
+}
+func (m *MsgSetDenomPaused) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomPaused) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomPaused.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgSetDenomPaused) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomPaused.Merge(m, src)
+}
+func (m *MsgSetDenomPaused) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomPaused) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomPaused.DiscardUnknown(m)
 }
 
-func (m *FailedDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetDenomPaused proto.InternalMessageInfo
+
+func (m *MsgSetDenomPaused) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	var l int
-	_ = l
-	if m.DepositIdx != 0 {
-		n += 1 + sovTx(uint64(m.DepositIdx))
+	return ""
+}
+
+func (m *MsgSetDenomPaused) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetDenomPaused) GetPaused() bool {
+	if m != nil {
+		return m.Paused
 	}
-	return n
+	return false
 }
 
-func (m *MsgDepositResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgSetDenomPausedResponse struct {
+}
+
+func (m *MsgSetDenomPausedResponse) Reset()         { *m = MsgSetDenomPausedResponse{} }
+func (m *MsgSetDenomPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomPausedResponse) ProtoMessage()    {}
+func (*MsgSetDenomPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{41}
+}
+func (m *MsgSetDenomPausedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomPausedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.Reserve0Deposited) > 0 {
-		for _, e := range m.Reserve0Deposited {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetDenomPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomPausedResponse.Merge(m, src)
+}
+func (m *MsgSetDenomPausedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomPausedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomPausedResponse proto.InternalMessageInfo
+
+// MsgResetCircuitBreaker resets the circuit breakers of both directions of a pair so that a new window starts
+// from the current price with the next swap.
+type MsgResetCircuitBreaker struct {
+	// Authority is the address of the governance account.
+	Authority string  `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PairId    *PairID `protobuf:"bytes,2,opt,name=pair_id,json=pairId,proto3" json:"pair_id,omitempty"`
+}
+
+func (m *MsgResetCircuitBreaker) Reset()         { *m = MsgResetCircuitBreaker{} }
+func (m *MsgResetCircuitBreaker) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreaker) ProtoMessage()    {}
+func (*MsgResetCircuitBreaker) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{42}
+}
+func (m *MsgResetCircuitBreaker) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreaker) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreaker.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Reserve1Deposited) > 0 {
-		for _, e := range m.Reserve1Deposited {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+}
+func (m *MsgResetCircuitBreaker) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreaker.Merge(m, src)
+}
+func (m *MsgResetCircuitBreaker) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreaker) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreaker.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreaker proto.InternalMessageInfo
+
+func (m *MsgResetCircuitBreaker) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	if len(m.FailedDeposits) > 0 {
-		for _, e := range m.FailedDeposits {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgResetCircuitBreaker) GetPairId() *PairID {
+	if m != nil {
+		return m.PairId
+	}
+	return nil
+}
+
+type MsgResetCircuitBreakerResponse struct {
+}
+
+func (m *MsgResetCircuitBreakerResponse) Reset()         { *m = MsgResetCircuitBreakerResponse{} }
+func (m *MsgResetCircuitBreakerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCircuitBreakerResponse) ProtoMessage()    {}
+func (*MsgResetCircuitBreakerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{43}
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCircuitBreakerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.SharesIssued) > 0 {
-		for _, e := range m.SharesIssued {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.Merge(m, src)
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCircuitBreakerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCircuitBreakerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCircuitBreakerResponse proto.InternalMessageInfo
+
+// BatchOp wraps a single operation of a MsgBatchOps. Exactly one operation must be set.
+type BatchOp struct {
+	// If allow_failure is true a failure of this operation is reported in MsgBatchOpsResponse.failed_ops
+	// and its state changes are discarded, otherwise the failure reverts the entire batch.
+	AllowFailure             bool                         `protobuf:"varint,1,opt,name=allow_failure,json=allowFailure,proto3" json:"allow_failure,omitempty"`
+	Deposit                  *MsgDeposit                  `protobuf:"bytes,2,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Withdrawal               *MsgWithdrawal               `protobuf:"bytes,3,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
+	PlaceLimitOrder          *MsgPlaceLimitOrder          `protobuf:"bytes,4,opt,name=place_limit_order,json=placeLimitOrder,proto3" json:"place_limit_order,omitempty"`
+	WithdrawFilledLimitOrder *MsgWithdrawFilledLimitOrder `protobuf:"bytes,5,opt,name=withdraw_filled_limit_order,json=withdrawFilledLimitOrder,proto3" json:"withdraw_filled_limit_order,omitempty"`
+	CancelLimitOrder         *MsgCancelLimitOrder         `protobuf:"bytes,6,opt,name=cancel_limit_order,json=cancelLimitOrder,proto3" json:"cancel_limit_order,omitempty"`
+	MultiHopSwap             *MsgMultiHopSwap             `protobuf:"bytes,7,opt,name=multi_hop_swap,json=multiHopSwap,proto3" json:"multi_hop_swap,omitempty"`
+}
+
+func (m *BatchOp) Reset()         { *m = BatchOp{} }
+func (m *BatchOp) String() string { return proto.CompactTextString(m) }
+func (*BatchOp) ProtoMessage()    {}
+func (*BatchOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{44}
+}
+func (m *BatchOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *BatchOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOp.Merge(m, src)
+}
+func (m *BatchOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOp.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawal) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_BatchOp proto.InternalMessageInfo
+
+func (m *BatchOp) GetAllowFailure() bool {
+	if m != nil {
+		return m.AllowFailure
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return false
+}
+
+func (m *BatchOp) GetDeposit() *MsgDeposit {
+	if m != nil {
+		return m.Deposit
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *BatchOp) GetWithdrawal() *MsgWithdrawal {
+	if m != nil {
+		return m.Withdrawal
 	}
-	l = len(m.TokenA)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *BatchOp) GetPlaceLimitOrder() *MsgPlaceLimitOrder {
+	if m != nil {
+		return m.PlaceLimitOrder
 	}
-	l = len(m.TokenB)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *BatchOp) GetWithdrawFilledLimitOrder() *MsgWithdrawFilledLimitOrder {
+	if m != nil {
+		return m.WithdrawFilledLimitOrder
 	}
-	if len(m.SharesToRemove) > 0 {
-		for _, e := range m.SharesToRemove {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return nil
+}
+
+func (m *BatchOp) GetCancelLimitOrder() *MsgCancelLimitOrder {
+	if m != nil {
+		return m.CancelLimitOrder
 	}
-	if len(m.TickIndexesAToB) > 0 {
-		l = 0
-		for _, e := range m.TickIndexesAToB {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return nil
+}
+
+func (m *BatchOp) GetMultiHopSwap() *MsgMultiHopSwap {
+	if m != nil {
+		return m.MultiHopSwap
 	}
-	if len(m.Fees) > 0 {
-		l = 0
-		for _, e := range m.Fees {
-			l += sovTx(uint64(e))
+	return nil
+}
+
+// MsgBatchOps executes a list of dex operations in order. The creator of every operation must match the creator of the batch.
+type MsgBatchOps struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Ops     []*BatchOp `protobuf:"bytes,2,rep,name=ops,proto3" json:"ops,omitempty"`
+}
+
+func (m *MsgBatchOps) Reset()         { *m = MsgBatchOps{} }
+func (m *MsgBatchOps) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchOps) ProtoMessage()    {}
+func (*MsgBatchOps) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{45}
+}
+func (m *MsgBatchOps) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchOps) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchOps.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgBatchOps) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchOps.Merge(m, src)
+}
+func (m *MsgBatchOps) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchOps) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchOps.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawalResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgBatchOps proto.InternalMessageInfo
+
+func (m *MsgBatchOps) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	l = m.Reserve0Withdrawn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Reserve1Withdrawn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.SharesBurned) > 0 {
-		for _, e := range m.SharesBurned {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return ""
+}
+
+func (m *MsgBatchOps) GetOps() []*BatchOp {
+	if m != nil {
+		return m.Ops
 	}
-	return n
+	return nil
 }
 
-func (m *MsgPlaceLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
+// BatchOpResponse holds the response of the operation at the same index in MsgBatchOps.ops.
+// All fields are empty if the operation failed.
+type BatchOpResponse struct {
+	Deposit                  *MsgDepositResponse                  `protobuf:"bytes,1,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	Withdrawal               *MsgWithdrawalResponse               `protobuf:"bytes,2,opt,name=withdrawal,proto3" json:"withdrawal,omitempty"`
+	PlaceLimitOrder          *MsgPlaceLimitOrderResponse          `protobuf:"bytes,3,opt,name=place_limit_order,json=placeLimitOrder,proto3" json:"place_limit_order,omitempty"`
+	WithdrawFilledLimitOrder *MsgWithdrawFilledLimitOrderResponse `protobuf:"bytes,4,opt,name=withdraw_filled_limit_order,json=withdrawFilledLimitOrder,proto3" json:"withdraw_filled_limit_order,omitempty"`
+	CancelLimitOrder         *MsgCancelLimitOrderResponse         `protobuf:"bytes,5,opt,name=cancel_limit_order,json=cancelLimitOrder,proto3" json:"cancel_limit_order,omitempty"`
+	MultiHopSwap             *MsgMultiHopSwapResponse             `protobuf:"bytes,6,opt,name=multi_hop_swap,json=multiHopSwap,proto3" json:"multi_hop_swap,omitempty"`
+}
+
+func (m *BatchOpResponse) Reset()         { *m = BatchOpResponse{} }
+func (m *BatchOpResponse) String() string { return proto.CompactTextString(m) }
+func (*BatchOpResponse) ProtoMessage()    {}
+func (*BatchOpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{46}
+}
+func (m *BatchOpResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchOpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchOpResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *BatchOpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchOpResponse.Merge(m, src)
+}
+func (m *BatchOpResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchOpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchOpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchOpResponse proto.InternalMessageInfo
+
+func (m *BatchOpResponse) GetDeposit() *MsgDepositResponse {
+	if m != nil {
+		return m.Deposit
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *BatchOpResponse) GetWithdrawal() *MsgWithdrawalResponse {
+	if m != nil {
+		return m.Withdrawal
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *BatchOpResponse) GetPlaceLimitOrder() *MsgPlaceLimitOrderResponse {
+	if m != nil {
+		return m.PlaceLimitOrder
 	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func (m *BatchOpResponse) GetWithdrawFilledLimitOrder() *MsgWithdrawFilledLimitOrderResponse {
+	if m != nil {
+		return m.WithdrawFilledLimitOrder
 	}
-	if m.TickIndexInToOut != 0 {
-		n += 1 + sovTx(uint64(m.TickIndexInToOut))
+	return nil
+}
+
+func (m *BatchOpResponse) GetCancelLimitOrder() *MsgCancelLimitOrderResponse {
+	if m != nil {
+		return m.CancelLimitOrder
 	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.OrderType != 0 {
-		n += 1 + sovTx(uint64(m.OrderType))
+	return nil
+}
+
+func (m *BatchOpResponse) GetMultiHopSwap() *MsgMultiHopSwapResponse {
+	if m != nil {
+		return m.MultiHopSwap
 	}
-	if m.ExpirationTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.MaxAmountOut != nil {
-		l = m.MaxAmountOut.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+type FailedBatchOp struct {
+	OpIdx uint64 `protobuf:"varint,1,opt,name=op_idx,json=opIdx,proto3" json:"op_idx,omitempty"`
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *FailedBatchOp) Reset()         { *m = FailedBatchOp{} }
+func (m *FailedBatchOp) String() string { return proto.CompactTextString(m) }
+func (*FailedBatchOp) ProtoMessage()    {}
+func (*FailedBatchOp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{47}
+}
+func (m *FailedBatchOp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FailedBatchOp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FailedBatchOp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if m.LimitSellPrice != nil {
-		l = m.LimitSellPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *FailedBatchOp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FailedBatchOp.Merge(m, src)
+}
+func (m *FailedBatchOp) XXX_Size() int {
+	return m.Size()
+}
+func (m *FailedBatchOp) XXX_DiscardUnknown() {
+	xxx_messageInfo_FailedBatchOp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FailedBatchOp proto.InternalMessageInfo
+
+func (m *FailedBatchOp) GetOpIdx() uint64 {
+	if m != nil {
+		return m.OpIdx
 	}
-	if m.MinAverageSellPrice != nil {
-		l = m.MinAverageSellPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return 0
+}
+
+func (m *FailedBatchOp) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return n
+	return ""
 }
 
-func (m *MsgPlaceLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgBatchOpsResponse struct {
+	Results   []*BatchOpResponse `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	FailedOps []*FailedBatchOp   `protobuf:"bytes,2,rep,name=failed_ops,json=failedOps,proto3" json:"failed_ops,omitempty"`
+}
+
+func (m *MsgBatchOpsResponse) Reset()         { *m = MsgBatchOpsResponse{} }
+func (m *MsgBatchOpsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchOpsResponse) ProtoMessage()    {}
+func (*MsgBatchOpsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a489f6e187d5e074, []int{48}
+}
+func (m *MsgBatchOpsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchOpsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchOpsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgBatchOpsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchOpsResponse.Merge(m, src)
+}
+func (m *MsgBatchOpsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchOpsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchOpsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchOpsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchOpsResponse) GetResults() []*BatchOpResponse {
+	if m != nil {
+		return m.Results
 	}
-	l = m.CoinIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TakerCoinIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
 
-func (m *MsgWithdrawFilledLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBatchOpsResponse) GetFailedOps() []*FailedBatchOp {
+	if m != nil {
+		return m.FailedOps
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("neutron.dex.LimitOrderType", LimitOrderType_name, LimitOrderType_value)
+	proto.RegisterType((*DepositOptions)(nil), "neutron.dex.DepositOptions")
+	proto.RegisterType((*MsgDeposit)(nil), "neutron.dex.MsgDeposit")
+	proto.RegisterType((*FailedDeposit)(nil), "neutron.dex.FailedDeposit")
+	proto.RegisterType((*MsgDepositResponse)(nil), "neutron.dex.MsgDepositResponse")
+	proto.RegisterType((*MsgWithdrawal)(nil), "neutron.dex.MsgWithdrawal")
+	proto.RegisterType((*MsgWithdrawalResponse)(nil), "neutron.dex.MsgWithdrawalResponse")
+	proto.RegisterType((*MsgPlaceLimitOrder)(nil), "neutron.dex.MsgPlaceLimitOrder")
+	proto.RegisterType((*MsgPlaceLimitOrderResponse)(nil), "neutron.dex.MsgPlaceLimitOrderResponse")
+	proto.RegisterType((*MsgWithdrawFilledLimitOrder)(nil), "neutron.dex.MsgWithdrawFilledLimitOrder")
+	proto.RegisterType((*MsgWithdrawFilledLimitOrderResponse)(nil), "neutron.dex.MsgWithdrawFilledLimitOrderResponse")
+	proto.RegisterType((*MsgCancelLimitOrder)(nil), "neutron.dex.MsgCancelLimitOrder")
+	proto.RegisterType((*MsgCancelLimitOrderResponse)(nil), "neutron.dex.MsgCancelLimitOrderResponse")
+	proto.RegisterType((*MsgAmendLimitOrder)(nil), "neutron.dex.MsgAmendLimitOrder")
+	proto.RegisterType((*MsgAmendLimitOrderResponse)(nil), "neutron.dex.MsgAmendLimitOrderResponse")
+	proto.RegisterType((*MsgFlashSwap)(nil), "neutron.dex.MsgFlashSwap")
+	proto.RegisterType((*MsgFlashSwapResponse)(nil), "neutron.dex.MsgFlashSwapResponse")
+	proto.RegisterType((*MsgCreateGauge)(nil), "neutron.dex.MsgCreateGauge")
+	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "neutron.dex.MsgCreateGaugeResponse")
+	proto.RegisterType((*MsgStake)(nil), "neutron.dex.MsgStake")
+	proto.RegisterType((*MsgStakeResponse)(nil), "neutron.dex.MsgStakeResponse")
+	proto.RegisterType((*MsgUnstake)(nil), "neutron.dex.MsgUnstake")
+	proto.RegisterType((*MsgUnstakeResponse)(nil), "neutron.dex.MsgUnstakeResponse")
+	proto.RegisterType((*MsgClaim)(nil), "neutron.dex.MsgClaim")
+	proto.RegisterType((*MsgClaimResponse)(nil), "neutron.dex.MsgClaimResponse")
+	proto.RegisterType((*MultiHopRoute)(nil), "neutron.dex.MultiHopRoute")
+	proto.RegisterType((*MultiHopRouteAllocation)(nil), "neutron.dex.MultiHopRouteAllocation")
+	proto.RegisterType((*MsgMultiHopSwap)(nil), "neutron.dex.MsgMultiHopSwap")
+	proto.RegisterType((*MsgMultiHopSwapResponse)(nil), "neutron.dex.MsgMultiHopSwapResponse")
+	proto.RegisterType((*MsgMultiHopSwapExactOut)(nil), "neutron.dex.MsgMultiHopSwapExactOut")
+	proto.RegisterType((*MsgMultiHopSwapExactOutResponse)(nil), "neutron.dex.MsgMultiHopSwapExactOutResponse")
+	proto.RegisterType((*MsgDepositRange)(nil), "neutron.dex.MsgDepositRange")
+	proto.RegisterType((*MsgDepositRangeResponse)(nil), "neutron.dex.MsgDepositRangeResponse")
+	proto.RegisterType((*MsgWithdrawRange)(nil), "neutron.dex.MsgWithdrawRange")
+	proto.RegisterType((*MsgWithdrawRangeResponse)(nil), "neutron.dex.MsgWithdrawRangeResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "neutron.dex.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "neutron.dex.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetPairPaused)(nil), "neutron.dex.MsgSetPairPaused")
+	proto.RegisterType((*MsgSetPairPausedResponse)(nil), "neutron.dex.MsgSetPairPausedResponse")
+	proto.RegisterType((*MsgSetPairBatchAuction)(nil), "neutron.dex.MsgSetPairBatchAuction")
+	proto.RegisterType((*MsgSetPairBatchAuctionResponse)(nil), "neutron.dex.MsgSetPairBatchAuctionResponse")
+	proto.RegisterType((*MsgSetDenomPaused)(nil), "neutron.dex.MsgSetDenomPaused")
+	proto.RegisterType((*MsgSetDenomPausedResponse)(nil), "neutron.dex.MsgSetDenomPausedResponse")
+	proto.RegisterType((*MsgResetCircuitBreaker)(nil), "neutron.dex.MsgResetCircuitBreaker")
+	proto.RegisterType((*MsgResetCircuitBreakerResponse)(nil), "neutron.dex.MsgResetCircuitBreakerResponse")
+	proto.RegisterType((*BatchOp)(nil), "neutron.dex.BatchOp")
+	proto.RegisterType((*MsgBatchOps)(nil), "neutron.dex.MsgBatchOps")
+	proto.RegisterType((*BatchOpResponse)(nil), "neutron.dex.BatchOpResponse")
+	proto.RegisterType((*FailedBatchOp)(nil), "neutron.dex.FailedBatchOp")
+	proto.RegisterType((*MsgBatchOpsResponse)(nil), "neutron.dex.MsgBatchOpsResponse")
+}
+
+func init() { proto.RegisterFile("neutron/dex/tx.proto", fileDescriptor_a489f6e187d5e074) }
+
+var fileDescriptor_a489f6e187d5e074 = []byte{
+	// 3716 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0x4b, 0x6c, 0x1b, 0xd7,
+	0x76, 0x1e, 0x92, 0x12, 0xc9, 0x23, 0x89, 0xa2, 0xc6, 0xb2, 0x35, 0xa2, 0x62, 0x91, 0x19, 0x39,
+	0xb6, 0xea, 0xd8, 0x92, 0x65, 0x37, 0x01, 0x9e, 0xf0, 0x5a, 0x54, 0xd4, 0x27, 0xe1, 0xb3, 0x64,
+	0xaa, 0x23, 0xba, 0xef, 0xf5, 0x3d, 0xa0, 0xd3, 0x21, 0xe7, 0x8a, 0x9a, 0x27, 0x72, 0x86, 0x9d,
+	0x19, 0x4a, 0xf2, 0x5b, 0xb4, 0x0f, 0x41, 0x16, 0x41, 0x56, 0x59, 0xb4, 0x48, 0xd1, 0x26, 0x40,
+	0x81, 0x02, 0xfd, 0xa1, 0x45, 0x03, 0x34, 0x40, 0xd1, 0x75, 0x17, 0xf5, 0x32, 0x08, 0x50, 0xa0,
+	0x2d, 0x50, 0xa6, 0x49, 0x16, 0x06, 0x02, 0x74, 0xa3, 0x45, 0x17, 0x5d, 0x15, 0xf7, 0x33, 0x5f,
+	0x0e, 0x7f, 0xb1, 0x62, 0xbb, 0x40, 0x36, 0xd2, 0xdc, 0x73, 0xee, 0x3d, 0xf7, 0x9c, 0x7b, 0x3e,
+	0xf7, 0xdc, 0x7b, 0x0f, 0x61, 0x56, 0x47, 0x6d, 0xdb, 0x34, 0xf4, 0x55, 0x15, 0x9d, 0xad, 0xda,
+	0x67, 0x2b, 0x2d, 0xd3, 0xb0, 0x0d, 0x7e, 0x82, 0x41, 0x57, 0x54, 0x74, 0x96, 0x9b, 0x51, 0x9a,
+	0x9a, 0x6e, 0xac, 0x92, 0xbf, 0x14, 0x9f, 0x5b, 0xac, 0x19, 0x56, 0xd3, 0xb0, 0x56, 0xab, 0x8a,
+	0x85, 0x56, 0x4f, 0xd6, 0xaa, 0xc8, 0x56, 0xd6, 0x56, 0x6b, 0x86, 0xa6, 0x33, 0xfc, 0x1c, 0xc3,
+	0x37, 0xad, 0xfa, 0xea, 0xc9, 0x1a, 0xfe, 0xc7, 0x10, 0xf3, 0x14, 0x21, 0x93, 0xd6, 0x2a, 0x6d,
+	0x30, 0xd4, 0x6c, 0xdd, 0xa8, 0x1b, 0x14, 0x8e, 0xbf, 0x18, 0x34, 0x5f, 0x37, 0x8c, 0x7a, 0x03,
+	0xad, 0x92, 0x56, 0xb5, 0x7d, 0xb8, 0x6a, 0x6b, 0x4d, 0x64, 0xd9, 0x4a, 0xb3, 0xe5, 0x50, 0xf4,
+	0x0b, 0xd0, 0x52, 0x34, 0x53, 0xd6, 0x54, 0x86, 0x12, 0x82, 0x28, 0x53, 0x69, 0x3a, 0x73, 0x2d,
+	0x06, 0x30, 0xa8, 0x5e, 0x47, 0xaa, 0x6c, 0x98, 0x2a, 0x32, 0x19, 0xbe, 0xe0, 0xc7, 0x9b, 0x8a,
+	0x5e, 0x47, 0x72, 0xcb, 0xb0, 0x34, 0x5b, 0x33, 0x98, 0x84, 0xe2, 0xef, 0x42, 0x66, 0x0b, 0x11,
+	0x58, 0xb9, 0x85, 0xc1, 0x16, 0xff, 0x2b, 0x90, 0x55, 0x35, 0x4b, 0xa9, 0x36, 0x90, 0xac, 0xb4,
+	0x6d, 0xc3, 0x3a, 0x55, 0x5a, 0x02, 0x57, 0xe0, 0x96, 0x53, 0xd2, 0x34, 0x83, 0x6f, 0x30, 0x30,
+	0xbf, 0x04, 0x99, 0x43, 0x45, 0x6b, 0xc8, 0xf6, 0x99, 0x6c, 0xe8, 0x72, 0x15, 0x35, 0x84, 0x18,
+	0xe9, 0x38, 0x81, 0xa1, 0x95, 0xb3, 0xb2, 0x5e, 0x44, 0x0d, 0xf1, 0x49, 0x1c, 0x60, 0xcf, 0xaa,
+	0xb3, 0x59, 0x78, 0x01, 0x92, 0x35, 0x13, 0x29, 0xb6, 0x61, 0x12, 0xaa, 0x69, 0xc9, 0x69, 0xf2,
+	0x39, 0x48, 0x99, 0xa8, 0x86, 0xb4, 0x13, 0x64, 0x12, 0x3a, 0x69, 0xc9, 0x6d, 0xf3, 0x73, 0x90,
+	0xb4, 0x8d, 0x63, 0xa4, 0xcb, 0x8a, 0x10, 0x27, 0xa8, 0x71, 0xd2, 0xdc, 0xf0, 0x10, 0x55, 0x21,
+	0xe1, 0x43, 0x14, 0xf9, 0x9f, 0x41, 0x5a, 0x69, 0x1a, 0x6d, 0xdd, 0xb6, 0x64, 0x45, 0x18, 0x2b,
+	0xc4, 0x97, 0xd3, 0xc5, 0x5f, 0x7f, 0xd2, 0xc9, 0x5f, 0xfa, 0x8f, 0x4e, 0xfe, 0x0a, 0xd5, 0x97,
+	0xa5, 0x1e, 0xaf, 0x68, 0xc6, 0x6a, 0x53, 0xb1, 0x8f, 0x56, 0x4a, 0xba, 0xfd, 0x4d, 0x27, 0xef,
+	0x8d, 0x38, 0xef, 0xe4, 0xb3, 0x8f, 0x95, 0x66, 0x63, 0x5d, 0x74, 0x41, 0xa2, 0x94, 0x62, 0xdf,
+	0x1b, 0x7e, 0xe2, 0x55, 0x61, 0x7c, 0x44, 0xe2, 0xd5, 0x6e, 0xe2, 0x55, 0x8f, 0x78, 0x91, 0xbf,
+	0x0d, 0x97, 0x6d, 0xad, 0x76, 0x2c, 0x6b, 0xba, 0x8a, 0xce, 0x90, 0x25, 0x2b, 0xb2, 0x6d, 0xc8,
+	0x55, 0x21, 0x59, 0x88, 0x2f, 0xc7, 0xa5, 0x69, 0x8c, 0x2a, 0x51, 0xcc, 0x46, 0xc5, 0x28, 0xf2,
+	0x3c, 0x24, 0x0e, 0x11, 0xb2, 0x84, 0x54, 0x21, 0xbe, 0x9c, 0x90, 0xc8, 0x37, 0xff, 0x06, 0x24,
+	0x0d, 0xaa, 0x4d, 0x21, 0x5d, 0x88, 0x2f, 0x4f, 0xdc, 0x5b, 0x58, 0xf1, 0x39, 0xc2, 0x4a, 0x50,
+	0xe1, 0x92, 0xd3, 0x77, 0x3d, 0xff, 0xce, 0xd3, 0x4f, 0x6e, 0x39, 0xea, 0x78, 0xff, 0xe9, 0x27,
+	0xb7, 0x32, 0xd8, 0x6c, 0x3c, 0xdd, 0x89, 0x3b, 0x30, 0xb5, 0xa3, 0x68, 0x0d, 0xa4, 0x3a, 0xca,
+	0xcc, 0xc3, 0x84, 0x4a, 0x3f, 0x65, 0x4d, 0x3d, 0x23, 0x0a, 0x4d, 0x48, 0xc0, 0x40, 0x25, 0xf5,
+	0x8c, 0x9f, 0x85, 0x31, 0x64, 0x9a, 0x86, 0xa3, 0x50, 0xda, 0x10, 0xff, 0x27, 0x0e, 0xbc, 0x47,
+	0x56, 0x42, 0x56, 0xcb, 0xd0, 0x2d, 0xc4, 0xff, 0x01, 0xf0, 0x26, 0xb2, 0x90, 0x79, 0x82, 0xee,
+	0xca, 0x8c, 0x06, 0x52, 0x05, 0x8e, 0x2c, 0xef, 0xfe, 0xa0, 0xe5, 0x8d, 0x18, 0x7a, 0xde, 0xc9,
+	0xcf, 0xd3, 0x75, 0xee, 0xc6, 0x89, 0xd2, 0x8c, 0x03, 0xdc, 0x72, 0x60, 0x3e, 0x06, 0xd6, 0x7c,
+	0x0c, 0xc4, 0x46, 0x63, 0x60, 0xad, 0x0f, 0x03, 0x6b, 0x51, 0x0c, 0xac, 0x79, 0x0c, 0x6c, 0xc2,
+	0xf4, 0x21, 0x59, 0x60, 0xa7, 0x9f, 0x25, 0xc4, 0x89, 0x02, 0x73, 0x01, 0x05, 0x06, 0x94, 0x20,
+	0x65, 0x0e, 0xfd, 0x4d, 0x8b, 0xff, 0x63, 0x0e, 0xa6, 0xac, 0x23, 0xc5, 0x44, 0x96, 0xac, 0x59,
+	0x56, 0x1b, 0xa9, 0x42, 0x82, 0xd0, 0x98, 0x5f, 0x61, 0x71, 0x0a, 0x47, 0xbb, 0x15, 0x16, 0xed,
+	0x56, 0x36, 0x0d, 0x4d, 0x2f, 0xfe, 0x84, 0x09, 0x77, 0xb3, 0xae, 0xd9, 0x47, 0xed, 0xea, 0x4a,
+	0xcd, 0x68, 0xb2, 0xa0, 0xc6, 0xfe, 0xdd, 0xb1, 0xd4, 0xe3, 0x55, 0xfb, 0x71, 0x0b, 0x59, 0x64,
+	0xc0, 0x37, 0x9d, 0x7c, 0x70, 0x8a, 0xf3, 0x4e, 0x7e, 0x96, 0x4a, 0x1a, 0x00, 0x8b, 0xd2, 0x24,
+	0x6d, 0x97, 0x68, 0xf3, 0x5f, 0x63, 0x30, 0xb5, 0x67, 0xd5, 0x7f, 0xac, 0xd9, 0x47, 0xaa, 0xa9,
+	0x9c, 0x2a, 0x8d, 0xe7, 0x16, 0x0e, 0x4e, 0x20, 0xcb, 0x38, 0xb3, 0x0d, 0xd9, 0x44, 0x4d, 0xe3,
+	0x04, 0xb1, 0xa8, 0xb0, 0x3b, 0x48, 0xb1, 0x5d, 0x03, 0xcf, 0x3b, 0xf9, 0xb9, 0x80, 0xb0, 0x2e,
+	0x46, 0x94, 0x32, 0x14, 0x54, 0x31, 0x24, 0x02, 0xe8, 0xe5, 0xcc, 0xe3, 0xfd, 0x9d, 0x39, 0xe9,
+	0x39, 0xf3, 0xba, 0x18, 0xf6, 0xca, 0x19, 0xe6, 0x95, 0xde, 0x2a, 0x8a, 0x9f, 0xc6, 0xe1, 0x4a,
+	0x00, 0x12, 0xe9, 0x53, 0xa7, 0x0c, 0xad, 0xd3, 0xa5, 0x1e, 0xc5, 0xa7, 0xdc, 0xa1, 0x11, 0x3e,
+	0xe5, 0xe2, 0x7c, 0x3e, 0xe5, 0x70, 0xa2, 0x07, 0x7c, 0xca, 0x63, 0x20, 0x36, 0x1a, 0x03, 0x6b,
+	0x7d, 0x18, 0x58, 0x8b, 0x62, 0x60, 0xcd, 0x63, 0xc0, 0xe7, 0x0e, 0xd5, 0xb6, 0xa9, 0x23, 0x55,
+	0x88, 0x7f, 0x87, 0xee, 0x40, 0xa7, 0xe8, 0x72, 0x07, 0x0a, 0x76, 0xdd, 0xa1, 0x48, 0x9b, 0x7f,
+	0x99, 0x26, 0x71, 0x70, 0xbf, 0xa1, 0xd4, 0xd0, 0xae, 0xd6, 0xd4, 0xec, 0x32, 0xde, 0xbb, 0xbf,
+	0xa5, 0x4f, 0xcc, 0x43, 0x8a, 0x9a, 0xbe, 0xa6, 0x33, 0xa7, 0xa0, 0xae, 0x50, 0xd2, 0xf9, 0x05,
+	0x48, 0x53, 0x94, 0xd1, 0xb6, 0x99, 0x5f, 0xd0, 0xbe, 0xe5, 0xb6, 0xcd, 0xdf, 0x83, 0x59, 0xcf,
+	0x42, 0x65, 0x4d, 0xc7, 0x06, 0x8a, 0xfb, 0x8d, 0x15, 0xb8, 0xe5, 0x78, 0x31, 0x26, 0x70, 0x52,
+	0xd6, 0x35, 0xd3, 0x92, 0x5e, 0x31, 0xf0, 0x18, 0x77, 0xff, 0xc3, 0x93, 0x25, 0x0b, 0xdc, 0x08,
+	0xfb, 0x9f, 0xac, 0xe9, 0xe1, 0xfd, 0x4f, 0xd6, 0x74, 0x77, 0xff, 0x2b, 0xe9, 0xfc, 0x3a, 0x00,
+	0xc9, 0x61, 0x64, 0xbc, 0xc0, 0x42, 0xaa, 0xc0, 0x2d, 0x67, 0x42, 0x1b, 0x98, 0xb7, 0x56, 0x95,
+	0xc7, 0x2d, 0x24, 0xa5, 0x0d, 0xe7, 0x93, 0xdf, 0x83, 0x69, 0x74, 0xd6, 0xd2, 0x4c, 0x05, 0xef,
+	0x68, 0x32, 0xce, 0xb1, 0x84, 0x74, 0x81, 0x23, 0x01, 0x94, 0x26, 0x60, 0x2b, 0x4e, 0x02, 0xb6,
+	0x52, 0x71, 0x12, 0xb0, 0x62, 0xea, 0x49, 0x27, 0xcf, 0x7d, 0xf0, 0x45, 0x9e, 0x93, 0x32, 0xde,
+	0x60, 0x8c, 0xe6, 0x75, 0xc8, 0x34, 0x95, 0x33, 0x99, 0xb1, 0x89, 0x57, 0x05, 0x88, 0xb0, 0x6f,
+	0xe3, 0x11, 0xfd, 0x84, 0x0d, 0x0d, 0x3b, 0xef, 0xe4, 0xaf, 0x50, 0x89, 0x83, 0x70, 0x51, 0x9a,
+	0x6c, 0x2a, 0x67, 0x1b, 0xa4, 0x8d, 0xd7, 0xf5, 0x8f, 0x38, 0xc8, 0x36, 0xb0, 0x70, 0xb2, 0x85,
+	0x1a, 0x0d, 0xb9, 0x65, 0x6a, 0x35, 0x24, 0x4c, 0x90, 0x29, 0x8f, 0xd9, 0x94, 0xbf, 0xea, 0xb3,
+	0x49, 0xb6, 0x26, 0x77, 0x0c, 0xb3, 0xee, 0x7c, 0xaf, 0x9e, 0xbc, 0xb1, 0xda, 0xb6, 0xb5, 0x86,
+	0x45, 0xb9, 0xd9, 0x37, 0x51, 0x6d, 0x0b, 0xd5, 0x70, 0x14, 0x0b, 0xd3, 0xf5, 0xa2, 0x58, 0x18,
+	0x23, 0x4a, 0x19, 0x02, 0x3a, 0x40, 0x8d, 0xc6, 0x3e, 0x06, 0xf0, 0x7f, 0xcb, 0xc1, 0xd5, 0xa6,
+	0xa6, 0xcb, 0xca, 0x09, 0x32, 0x95, 0x3a, 0xf2, 0x73, 0x37, 0x49, 0xb8, 0x3b, 0x7d, 0x46, 0xee,
+	0x7a, 0x50, 0x3f, 0xef, 0xe4, 0xaf, 0xb1, 0x75, 0x8b, 0xc4, 0x8b, 0xd2, 0xe5, 0xa6, 0xa6, 0x6f,
+	0x50, 0xb8, 0xc7, 0xee, 0xc7, 0x1c, 0xf0, 0xb6, 0xa9, 0xd5, 0xeb, 0xc8, 0xf4, 0xb3, 0x3a, 0x45,
+	0x58, 0x35, 0x9e, 0x91, 0xd5, 0x08, 0xca, 0x5e, 0x4c, 0xea, 0xc6, 0x89, 0x52, 0x96, 0x01, 0x3d,
+	0xfe, 0xde, 0xc0, 0x16, 0xae, 0xd4, 0x1a, 0x48, 0x6e, 0xa1, 0xba, 0x90, 0x21, 0x06, 0x7a, 0x35,
+	0x60, 0xe1, 0x65, 0x82, 0xde, 0x47, 0x75, 0x6c, 0xdc, 0xec, 0x93, 0x7f, 0x1d, 0x66, 0x7c, 0xc6,
+	0x7d, 0x84, 0xb4, 0xfa, 0x91, 0x2d, 0x4c, 0x93, 0x9c, 0x2b, 0xeb, 0x21, 0xde, 0x26, 0x70, 0x7e,
+	0x09, 0xa6, 0x70, 0xfa, 0xee, 0x06, 0x47, 0x21, 0x4b, 0x52, 0xf3, 0x49, 0x0c, 0x74, 0x82, 0xe3,
+	0xfa, 0xcd, 0xf0, 0xde, 0x72, 0x95, 0xed, 0x2d, 0xa1, 0x90, 0x24, 0xbe, 0x33, 0x06, 0xb9, 0x6e,
+	0xb0, 0xbb, 0xcb, 0x2c, 0x02, 0xd8, 0xa6, 0xa2, 0xd7, 0x8e, 0xd0, 0x03, 0xf4, 0x98, 0x05, 0x2d,
+	0x1f, 0x84, 0xff, 0x25, 0x07, 0x49, 0x7c, 0xac, 0xc2, 0xe1, 0x22, 0x56, 0xe0, 0xfa, 0x47, 0xdf,
+	0xdd, 0xd1, 0xa3, 0xaf, 0x43, 0xfc, 0xbc, 0x93, 0xcf, 0x50, 0x45, 0x30, 0x80, 0x28, 0x8d, 0xe3,
+	0xaf, 0x92, 0xce, 0xff, 0x29, 0x07, 0x19, 0x5b, 0x39, 0x46, 0xa6, 0x4c, 0x50, 0xd8, 0x97, 0xe3,
+	0x83, 0x38, 0xf9, 0xe9, 0xe8, 0x9c, 0x84, 0xe6, 0xf0, 0x1c, 0x3f, 0x08, 0x17, 0xa5, 0x49, 0x02,
+	0xc0, 0xa3, 0xb0, 0xe3, 0x7f, 0xc8, 0xc1, 0x94, 0xaf, 0x87, 0xa6, 0x0b, 0x89, 0x41, 0xcc, 0x7d,
+	0x9b, 0x4d, 0x2a, 0x30, 0x85, 0xb7, 0x49, 0x05, 0xc0, 0xa2, 0x34, 0xe1, 0xb2, 0x56, 0xd2, 0xf9,
+	0xf7, 0x38, 0x48, 0x53, 0xfc, 0x21, 0x42, 0xc2, 0xd8, 0x20, 0xae, 0xf6, 0x47, 0xe7, 0xca, 0x23,
+	0xef, 0x6d, 0x0c, 0x2e, 0x48, 0x94, 0x52, 0xe4, 0x7b, 0x07, 0x21, 0xf1, 0x7d, 0x0e, 0x16, 0x7c,
+	0x59, 0xce, 0x8e, 0xd6, 0x68, 0x20, 0x75, 0xa8, 0x7d, 0x33, 0x0f, 0x13, 0xcc, 0x1a, 0xe5, 0x63,
+	0xf4, 0x58, 0x88, 0x85, 0x0d, 0x74, 0xfd, 0x6e, 0xd8, 0x11, 0xf2, 0xa1, 0x24, 0x2b, 0x3c, 0x99,
+	0xf8, 0x65, 0x0c, 0x96, 0xfa, 0xe0, 0x5d, 0xd7, 0x88, 0xb0, 0x3b, 0xee, 0xe5, 0xb1, 0x3b, 0xcc,
+	0x5d, 0x33, 0xc8, 0x5d, 0xec, 0xbb, 0xe0, 0xae, 0xd9, 0x83, 0xbb, 0x66, 0x98, 0xbb, 0xa6, 0x8f,
+	0x3b, 0xf1, 0x17, 0x70, 0x79, 0xcf, 0xaa, 0x6f, 0x2a, 0x7a, 0x0d, 0x35, 0x2e, 0x46, 0xcf, 0xcb,
+	0x61, 0x3d, 0xcf, 0x31, 0x3d, 0x87, 0x27, 0x11, 0xff, 0x3d, 0x06, 0x0b, 0x11, 0xf0, 0xef, 0xf5,
+	0x7a, 0x01, 0x7a, 0xfd, 0xef, 0x18, 0xc9, 0x7b, 0x37, 0x9a, 0x48, 0xbf, 0x18, 0xff, 0x0d, 0x26,
+	0xa4, 0x71, 0x37, 0x21, 0xe5, 0x2e, 0x24, 0x21, 0x8d, 0xcc, 0xca, 0x12, 0x2f, 0x3c, 0x2b, 0xeb,
+	0xbd, 0x7b, 0x87, 0x16, 0x56, 0x7c, 0x37, 0x01, 0xb9, 0x6e, 0xb0, 0x6b, 0xca, 0xa1, 0xd5, 0xed,
+	0xde, 0xbe, 0x23, 0x6c, 0x3d, 0xf6, 0x52, 0xdb, 0x7a, 0xfc, 0xa5, 0xb1, 0xf5, 0xd0, 0xfe, 0x99,
+	0x78, 0x91, 0xfb, 0xe7, 0xc7, 0x71, 0x98, 0xdc, 0xb3, 0xea, 0x3b, 0x0d, 0xc5, 0x3a, 0x3a, 0xc0,
+	0xf7, 0xb7, 0xbd, 0x1d, 0xce, 0x7f, 0x98, 0x8c, 0xf5, 0x39, 0x4c, 0xc6, 0x43, 0x87, 0xc9, 0x80,
+	0x1f, 0x26, 0x2e, 0xf8, 0x60, 0x18, 0xe9, 0x87, 0x63, 0x2f, 0xfe, 0x74, 0xb4, 0x04, 0x53, 0x35,
+	0xa5, 0xd1, 0xa8, 0x2a, 0xb5, 0x63, 0x59, 0x55, 0x6c, 0x45, 0x18, 0x2f, 0x70, 0xcb, 0x93, 0xd2,
+	0xa4, 0x03, 0xdc, 0x52, 0x6c, 0x65, 0xfd, 0xd5, 0xb0, 0xb3, 0x66, 0x99, 0xb3, 0xba, 0xea, 0x10,
+	0xff, 0x2c, 0x06, 0xb3, 0x7e, 0x80, 0xeb, 0xa0, 0xfe, 0xf4, 0x99, 0x7b, 0x31, 0xe9, 0xf3, 0xbb,
+	0x1c, 0xa4, 0x86, 0x77, 0xfe, 0x87, 0xa3, 0xf3, 0x90, 0xf2, 0x39, 0xd6, 0xb4, 0x8f, 0x09, 0xe2,
+	0x52, 0xc9, 0x1a, 0xdb, 0x39, 0x3e, 0x8f, 0x41, 0x06, 0xef, 0xca, 0x78, 0x19, 0xd1, 0x5b, 0x4a,
+	0xbb, 0x8e, 0xfa, 0x18, 0xf1, 0x6d, 0x48, 0xb2, 0x87, 0x14, 0xc6, 0xf1, 0xe5, 0xc0, 0x19, 0x6b,
+	0x5f, 0xd1, 0xcc, 0xd2, 0x96, 0x34, 0x8e, 0xfb, 0x94, 0x54, 0xfe, 0x1a, 0x80, 0x65, 0x2b, 0xa6,
+	0x2d, 0xe3, 0xdb, 0x0e, 0x62, 0xd8, 0x71, 0x29, 0x4d, 0x20, 0x15, 0xad, 0x76, 0x8c, 0x3d, 0x02,
+	0xe9, 0x2a, 0x45, 0x26, 0x08, 0x32, 0x89, 0x74, 0x95, 0xa0, 0x7e, 0x01, 0x63, 0x98, 0x3f, 0x8b,
+	0x5c, 0x28, 0xf6, 0x5d, 0x97, 0x12, 0x5e, 0x97, 0x6f, 0x3a, 0x79, 0xda, 0xff, 0xbc, 0x93, 0x9f,
+	0xf4, 0x24, 0xb5, 0xc4, 0xbf, 0xf9, 0x22, 0xbf, 0x3c, 0xe4, 0x82, 0x59, 0x12, 0x25, 0x81, 0xb9,
+	0xd6, 0xdb, 0x4d, 0x19, 0xb5, 0x8c, 0xda, 0x91, 0x45, 0x0c, 0x2f, 0x21, 0xa5, 0xf5, 0x76, 0x73,
+	0x9b, 0x00, 0xd6, 0x97, 0xc2, 0x56, 0xc7, 0x3b, 0xf9, 0x8e, 0xb7, 0x82, 0xe2, 0x7d, 0xb8, 0x1a,
+	0x84, 0xb8, 0x86, 0x37, 0x0f, 0xa9, 0x3a, 0x06, 0xe0, 0x25, 0xa4, 0x97, 0xfb, 0x49, 0xd2, 0x2e,
+	0xa9, 0xe2, 0x3f, 0x73, 0x90, 0xda, 0xb3, 0xea, 0x07, 0x38, 0xba, 0xf4, 0xd1, 0xc1, 0xef, 0xc3,
+	0x38, 0xbd, 0xf2, 0x12, 0x62, 0x83, 0x16, 0xe7, 0x01, 0x5b, 0x1c, 0x36, 0xe0, 0xbc, 0x93, 0x9f,
+	0xf2, 0xdf, 0xa1, 0x8d, 0xb6, 0x3c, 0x8c, 0xc8, 0xfa, 0xb5, 0xf0, 0x02, 0x4c, 0xb2, 0x05, 0x20,
+	0x8c, 0x8b, 0x77, 0x20, 0xeb, 0x7c, 0xfb, 0x85, 0xb6, 0x30, 0xc0, 0x27, 0x34, 0x69, 0x97, 0x54,
+	0xb1, 0x4a, 0x9e, 0xb2, 0x1e, 0xe9, 0xd6, 0x00, 0xa9, 0xfd, 0x24, 0x62, 0x01, 0x12, 0xbd, 0x1f,
+	0x59, 0x18, 0x55, 0xf1, 0x0f, 0x39, 0xe0, 0xbd, 0xa6, 0xcb, 0x95, 0xb7, 0x90, 0xdc, 0x8b, 0x58,
+	0x48, 0x71, 0x93, 0xa8, 0x7b, 0xb3, 0xa1, 0x68, 0xcd, 0xde, 0x82, 0xf7, 0x5e, 0x6e, 0x32, 0x50,
+	0xfc, 0x90, 0x83, 0xac, 0xd3, 0x70, 0x25, 0x7b, 0x87, 0x83, 0xa4, 0x89, 0x4e, 0x15, 0x53, 0x1d,
+	0x42, 0xb6, 0x3d, 0x26, 0x9b, 0x33, 0xc2, 0x0b, 0x59, 0x0c, 0x30, 0x9a, 0x74, 0x0e, 0x19, 0x71,
+	0x09, 0xa6, 0xf6, 0xda, 0x0d, 0x5b, 0x7b, 0xdb, 0x68, 0x49, 0x46, 0xdb, 0x46, 0xf8, 0x2a, 0xfe,
+	0xc8, 0x68, 0x51, 0x8e, 0xd2, 0x12, 0xf9, 0x16, 0xff, 0x3e, 0x06, 0x73, 0x81, 0x5e, 0x1b, 0x8d,
+	0x86, 0x51, 0x23, 0xb7, 0x2e, 0xfc, 0x5d, 0x18, 0x33, 0x31, 0x88, 0x05, 0xe8, 0xe0, 0x83, 0x4d,
+	0x60, 0x90, 0x44, 0x3b, 0x06, 0xf7, 0xca, 0xd8, 0x05, 0xef, 0x95, 0x81, 0x78, 0x1d, 0x7f, 0x61,
+	0xf1, 0xfa, 0xcb, 0x38, 0x4c, 0xef, 0x59, 0x75, 0x47, 0xfe, 0x01, 0x59, 0x47, 0xbf, 0xeb, 0xed,
+	0x7b, 0x30, 0x4e, 0x96, 0x2d, 0xfa, 0x45, 0x2c, 0xb8, 0xc0, 0xac, 0xe7, 0x77, 0x9f, 0x8d, 0xa0,
+	0x33, 0xcd, 0x96, 0x69, 0x82, 0x10, 0xce, 0x46, 0x2e, 0x3d, 0x4b, 0x36, 0x12, 0xa6, 0xeb, 0x65,
+	0x23, 0x61, 0x8c, 0x88, 0xef, 0xac, 0x35, 0x9b, 0x64, 0xf5, 0x34, 0x1b, 0xb9, 0x01, 0xd3, 0x2d,
+	0x7c, 0x9f, 0x5f, 0x45, 0x96, 0x2d, 0x53, 0x93, 0x1c, 0x27, 0x57, 0x7f, 0x53, 0x18, 0x5c, 0x44,
+	0x96, 0x4d, 0x0d, 0xfc, 0x55, 0x98, 0xb4, 0x5a, 0x0d, 0x8d, 0xf5, 0xb1, 0xc8, 0x35, 0x7e, 0x4a,
+	0x9a, 0x20, 0x30, 0xd2, 0xc3, 0x5a, 0xbf, 0x1e, 0xf6, 0xe6, 0xcb, 0xcc, 0x9b, 0xfd, 0xfa, 0x14,
+	0x3f, 0x8a, 0xc3, 0x5c, 0x08, 0xe6, 0xfa, 0x76, 0xc0, 0x0c, 0xb9, 0x17, 0x65, 0x86, 0x9e, 0x73,
+	0xc6, 0x86, 0x75, 0xce, 0x36, 0x24, 0xd4, 0xb6, 0x65, 0x0f, 0x7e, 0x2b, 0xda, 0x19, 0x9d, 0x67,
+	0x42, 0xf9, 0xbc, 0x93, 0x9f, 0xa0, 0xfc, 0xe2, 0x96, 0x28, 0x11, 0x20, 0xff, 0x9b, 0x30, 0x43,
+	0xe6, 0x97, 0x15, 0x37, 0xb2, 0x58, 0xec, 0xf9, 0xf6, 0x7a, 0x6f, 0xa6, 0xbd, 0x30, 0x24, 0x65,
+	0xcd, 0x20, 0xc0, 0x12, 0xff, 0xa2, 0x5b, 0x3d, 0xdb, 0x67, 0x4a, 0x8d, 0xbc, 0x37, 0x3c, 0x3f,
+	0x57, 0x94, 0x01, 0x7c, 0xaf, 0x28, 0xd4, 0x17, 0x7f, 0x63, 0x90, 0x2f, 0x42, 0xe0, 0x05, 0x65,
+	0x26, 0xe0, 0x8c, 0x44, 0xc1, 0xcc, 0x59, 0xb1, 0x28, 0x3f, 0x87, 0x29, 0xdf, 0xdb, 0x8a, 0xa6,
+	0x33, 0x57, 0xdc, 0x19, 0x34, 0x47, 0x70, 0x94, 0x77, 0x27, 0x1a, 0x00, 0x8b, 0xd2, 0x84, 0xfb,
+	0x4e, 0x53, 0xd2, 0x87, 0x75, 0xb1, 0xf5, 0xdb, 0x61, 0xff, 0x59, 0x88, 0xf0, 0x1f, 0x47, 0x19,
+	0xe2, 0x7f, 0xc6, 0x20, 0xdf, 0x03, 0xf7, 0xfd, 0x49, 0xa0, 0xb7, 0x4b, 0xc7, 0x87, 0x74, 0x69,
+	0xf1, 0x1f, 0x12, 0x64, 0x2f, 0x72, 0xca, 0x26, 0x70, 0x35, 0xd4, 0x73, 0x2b, 0x3f, 0xf8, 0x31,
+	0xb0, 0x8d, 0x43, 0x56, 0x98, 0x61, 0xfe, 0x70, 0x90, 0x61, 0xba, 0x03, 0xbc, 0x65, 0x70, 0x20,
+	0xa2, 0x94, 0xa4, 0x9f, 0x1b, 0x3e, 0xc2, 0x55, 0x61, 0x7c, 0x34, 0xc2, 0xd5, 0x2e, 0xc2, 0x55,
+	0x97, 0x70, 0x91, 0xbf, 0x0f, 0x73, 0x0d, 0xe3, 0x14, 0xbf, 0xc2, 0x7a, 0x8f, 0xc3, 0x6e, 0x25,
+	0x12, 0x3e, 0xfe, 0xf0, 0x04, 0x5d, 0x71, 0x9e, 0x86, 0x49, 0xfd, 0xc2, 0x7d, 0x98, 0x6b, 0xb7,
+	0x5a, 0x91, 0x83, 0x52, 0x74, 0x10, 0x41, 0x07, 0x07, 0x65, 0x21, 0x7e, 0x88, 0xe8, 0x3b, 0x6d,
+	0x42, 0xc2, 0x9f, 0xfc, 0x1d, 0x18, 0xb3, 0x8e, 0x94, 0x16, 0x22, 0xaf, 0xad, 0x99, 0x7b, 0x73,
+	0x01, 0xdd, 0x12, 0xc5, 0x1d, 0x60, 0xb4, 0x44, 0x7b, 0xf9, 0xcb, 0x9d, 0x26, 0x88, 0x31, 0x0c,
+	0x57, 0xee, 0xd4, 0x73, 0x77, 0xf3, 0x5b, 0x88, 0xf8, 0x51, 0x02, 0xe6, 0x42, 0x30, 0xff, 0xc5,
+	0x99, 0x53, 0x4e, 0xe7, 0x1d, 0x16, 0xc0, 0x01, 0x95, 0xd4, 0x1e, 0x15, 0x4d, 0xb1, 0x51, 0xab,
+	0x2f, 0x2e, 0xba, 0xa2, 0x29, 0x3e, 0x6a, 0xf5, 0xc5, 0x85, 0x56, 0x34, 0x25, 0x2e, 0xa0, 0xa2,
+	0x69, 0xec, 0x65, 0xa9, 0x68, 0xfa, 0x80, 0x9e, 0x68, 0x9c, 0x67, 0xa0, 0x67, 0x89, 0x2a, 0x21,
+	0x6b, 0x8a, 0x87, 0xad, 0x69, 0xfd, 0xb5, 0xb0, 0xc1, 0xce, 0x86, 0x1e, 0xa9, 0xa8, 0xc5, 0xfe,
+	0x63, 0x1c, 0x84, 0x30, 0xf0, 0xfb, 0x7a, 0xa0, 0xff, 0x0f, 0xf5, 0x40, 0x7f, 0xcd, 0x91, 0x1d,
+	0xea, 0x51, 0x4b, 0x55, 0x6c, 0xb4, 0x4f, 0x0a, 0x7d, 0xf9, 0x37, 0x21, 0xad, 0xb4, 0xed, 0x23,
+	0xc3, 0xd4, 0x6c, 0x76, 0x35, 0x5f, 0x14, 0x3e, 0xff, 0xf4, 0xce, 0x2c, 0x63, 0x76, 0x43, 0x55,
+	0x4d, 0x64, 0x59, 0x07, 0xb6, 0xa9, 0xe9, 0x75, 0xc9, 0xeb, 0xca, 0xbf, 0x09, 0xe3, 0xb4, 0x54,
+	0xb8, 0xc7, 0xdd, 0x17, 0x46, 0x15, 0xd3, 0x58, 0xb0, 0xbf, 0x7a, 0xfa, 0xc9, 0x2d, 0x4e, 0x62,
+	0xbd, 0xd7, 0x6f, 0x60, 0x23, 0xf3, 0xe8, 0xf8, 0xe3, 0xa2, 0x9f, 0x2f, 0x71, 0x1e, 0xe6, 0x42,
+	0x20, 0xc7, 0xc6, 0xc4, 0x7f, 0xa2, 0x3e, 0x71, 0x80, 0x6c, 0x7c, 0xc5, 0xb6, 0xaf, 0xb4, 0x2d,
+	0xa4, 0x7e, 0x6b, 0x39, 0x46, 0xbb, 0xc4, 0xbb, 0x8a, 0xa5, 0xc6, 0xf3, 0x11, 0xf7, 0x49, 0x49,
+	0xac, 0x45, 0x9f, 0x4a, 0x82, 0x52, 0x39, 0xce, 0x13, 0x60, 0x53, 0xcc, 0x81, 0x10, 0x86, 0xb9,
+	0x72, 0xfd, 0x0b, 0x07, 0x57, 0x3d, 0x64, 0x51, 0xb1, 0x6b, 0x47, 0x1b, 0xed, 0x1a, 0x39, 0xfd,
+	0x3f, 0x1f, 0xe9, 0x04, 0x48, 0x22, 0x1d, 0x57, 0x60, 0x3b, 0xe2, 0x39, 0xcd, 0xf5, 0x3b, 0xdd,
+	0xf2, 0xe5, 0x82, 0xf2, 0xf9, 0xd9, 0x15, 0x0b, 0xb0, 0x18, 0x8d, 0x71, 0x65, 0xfd, 0x73, 0x0e,
+	0x66, 0x68, 0x97, 0x2d, 0xa4, 0x1b, 0xcd, 0x67, 0x54, 0xe2, 0x2c, 0x8c, 0xa9, 0x98, 0x8c, 0x53,
+	0x06, 0x4c, 0x1a, 0x3d, 0x95, 0xb5, 0xdc, 0x2d, 0xcc, 0x15, 0x4f, 0x18, 0x1f, 0x3f, 0xe2, 0x02,
+	0xcc, 0x77, 0x01, 0x5d, 0x11, 0xfe, 0x8e, 0xaa, 0x4b, 0x42, 0x16, 0xb2, 0x37, 0x35, 0xb3, 0xd6,
+	0xd6, 0xec, 0xa2, 0x89, 0xf0, 0x63, 0xc8, 0xf3, 0x51, 0x57, 0x3f, 0xa5, 0x44, 0x30, 0xc5, 0x94,
+	0x12, 0x81, 0x71, 0x25, 0x7a, 0x1a, 0x87, 0x24, 0xd1, 0x56, 0xb9, 0x45, 0xea, 0x7b, 0x1a, 0x0d,
+	0xe3, 0x54, 0xc6, 0x7b, 0x65, 0xdb, 0x44, 0xac, 0x46, 0x7f, 0x92, 0x00, 0x77, 0x28, 0x8c, 0x5f,
+	0x83, 0x24, 0xdb, 0x76, 0x19, 0xbf, 0xc1, 0x54, 0xca, 0x97, 0xd7, 0x38, 0xfd, 0x70, 0xf5, 0xdd,
+	0xa9, 0x5b, 0x46, 0x1a, 0x9d, 0x5c, 0x07, 0x0a, 0x4d, 0x7d, 0xbd, 0xf9, 0x07, 0x30, 0xd3, 0xc2,
+	0x15, 0x42, 0xec, 0x82, 0x82, 0x94, 0xe5, 0xb1, 0x27, 0xaf, 0x7c, 0x98, 0x44, 0xb8, 0x94, 0x68,
+	0xba, 0x15, 0x04, 0xf0, 0x75, 0x58, 0x70, 0x48, 0xcb, 0x87, 0xa4, 0xba, 0x22, 0x40, 0x96, 0x56,
+	0xa2, 0x2c, 0xf7, 0xe2, 0xac, 0xab, 0x1e, 0x43, 0x38, 0xed, 0x81, 0xe1, 0x1f, 0x02, 0x5f, 0x23,
+	0xaf, 0xfc, 0x01, 0xfa, 0xe3, 0x84, 0x7e, 0x21, 0x4c, 0xbf, 0xab, 0x1e, 0x20, 0x5b, 0x0b, 0x41,
+	0xf8, 0x22, 0x64, 0x9a, 0xf8, 0xfc, 0x21, 0x1f, 0x19, 0x2d, 0x99, 0xfc, 0x7c, 0x22, 0x49, 0x68,
+	0xbd, 0x12, 0xa6, 0x15, 0xb8, 0x31, 0x99, 0x6c, 0xfa, 0x5a, 0xe2, 0xef, 0xc1, 0xc4, 0x9e, 0x55,
+	0x67, 0xba, 0xb6, 0xfa, 0x24, 0x14, 0x37, 0x20, 0x6e, 0xb4, 0x9c, 0xcb, 0xf5, 0xd9, 0xc0, 0x0c,
+	0x6c, 0xb4, 0x84, 0x3b, 0xac, 0x17, 0xc2, 0xb9, 0xc3, 0x34, 0xb3, 0x44, 0x67, 0x0e, 0xf1, 0x7f,
+	0xe3, 0x30, 0xed, 0x0c, 0x71, 0xb2, 0x85, 0x1f, 0x78, 0xf6, 0xc3, 0x45, 0xab, 0x31, 0x54, 0xc3,
+	0xef, 0xd9, 0x51, 0x31, 0x60, 0x47, 0xd4, 0xfa, 0xc4, 0x3e, 0x76, 0xe4, 0x10, 0xf0, 0xdb, 0xd3,
+	0x41, 0x94, 0x3d, 0x51, 0x93, 0xbc, 0x39, 0xc8, 0x9e, 0x1c, 0x7a, 0x5d, 0x76, 0x65, 0xf4, 0xb7,
+	0x2b, 0x6a, 0xae, 0x77, 0x87, 0xb6, 0x2b, 0x67, 0x9e, 0xde, 0xf6, 0xf5, 0x5b, 0x91, 0xf6, 0xd5,
+	0xc3, 0x7e, 0x7b, 0xd5, 0x9b, 0x44, 0xd8, 0xd9, 0x8f, 0xba, 0xec, 0x8c, 0xda, 0xec, 0xf5, 0xbe,
+	0x76, 0xe6, 0xd0, 0x0b, 0xda, 0xdb, 0x0f, 0x9d, 0x5f, 0x76, 0x38, 0xe1, 0xe5, 0x0a, 0x8c, 0x1b,
+	0x2d, 0xdf, 0x8f, 0x3a, 0xc6, 0x8c, 0x56, 0xef, 0xdf, 0x73, 0xbc, 0xc7, 0x91, 0x42, 0x1d, 0x36,
+	0xd6, 0x4d, 0x04, 0xf8, 0x37, 0xf1, 0xc5, 0xbe, 0xd5, 0x6e, 0xd8, 0xce, 0xc5, 0xfe, 0x2b, 0x91,
+	0x06, 0xea, 0xda, 0x0e, 0xeb, 0xcc, 0xff, 0x00, 0x80, 0x1d, 0x1a, 0x3c, 0xdb, 0x8e, 0x3a, 0x2f,
+	0x38, 0x04, 0xd2, 0xb4, 0x77, 0xb9, 0x65, 0xdd, 0xfa, 0x9c, 0x83, 0x4c, 0xb0, 0x3c, 0x98, 0xbf,
+	0x0a, 0xfc, 0x5b, 0xe5, 0xf2, 0x96, 0x5c, 0x29, 0xed, 0xca, 0x9b, 0x1b, 0x0f, 0x37, 0xb7, 0x77,
+	0x77, 0xb7, 0xb7, 0xb2, 0x97, 0xf8, 0x2c, 0x4c, 0xee, 0x94, 0x76, 0x77, 0xe5, 0xb2, 0x24, 0x3f,
+	0x28, 0xed, 0xee, 0x66, 0x39, 0x7e, 0x0e, 0x2e, 0x97, 0xf6, 0xf6, 0xb6, 0xb7, 0x4a, 0x1b, 0x95,
+	0x6d, 0x0c, 0xa6, 0xbd, 0xb3, 0x31, 0xdc, 0xf5, 0x47, 0x8f, 0x0e, 0x2a, 0x72, 0xe9, 0xa1, 0x5c,
+	0x29, 0xed, 0x6d, 0x67, 0xe3, 0xfc, 0x0c, 0x4c, 0xb9, 0x44, 0x09, 0x28, 0xc1, 0x4f, 0x41, 0xfa,
+	0xa0, 0x52, 0xde, 0x97, 0x77, 0xcb, 0x07, 0x07, 0xd9, 0x31, 0x7e, 0x1a, 0x26, 0x2a, 0x1b, 0x0f,
+	0xb6, 0xe5, 0x7d, 0xa9, 0xbc, 0x53, 0xaa, 0x64, 0xc7, 0x31, 0x7e, 0xbf, 0x7c, 0x50, 0x91, 0xcb,
+	0x0f, 0x77, 0x7f, 0x3b, 0x9b, 0xe4, 0xaf, 0xc0, 0x8c, 0xdb, 0x94, 0xa5, 0xed, 0x7d, 0xa9, 0xb4,
+	0xb9, 0x9d, 0x4d, 0xf1, 0x3c, 0x64, 0x5c, 0xc2, 0xc5, 0xdd, 0xf2, 0xe6, 0x83, 0x6c, 0xfa, 0xde,
+	0x9f, 0x64, 0x20, 0xbe, 0x67, 0xd5, 0xf9, 0x4d, 0x48, 0x3a, 0xbf, 0xbc, 0xe9, 0x15, 0xc8, 0x73,
+	0x83, 0x3c, 0x94, 0xdf, 0x05, 0xf0, 0xfd, 0xfe, 0xa2, 0x4f, 0x68, 0xcf, 0x0d, 0xe1, 0xae, 0xfc,
+	0xcf, 0x60, 0x3a, 0x5c, 0xbe, 0x3e, 0x28, 0xd4, 0xe7, 0x86, 0xf5, 0x5d, 0xfe, 0x04, 0x84, 0x9e,
+	0xc5, 0x7e, 0x43, 0x47, 0xfe, 0xdc, 0xc8, 0xbe, 0xcc, 0xff, 0x0e, 0x64, 0xbb, 0x8a, 0xce, 0x06,
+	0xee, 0x04, 0xb9, 0xa1, 0x7d, 0x99, 0x97, 0x60, 0x32, 0xf0, 0x22, 0xd2, 0x77, 0x67, 0xc8, 0x0d,
+	0xe5, 0xcf, 0xfc, 0xcf, 0x61, 0x36, 0xf2, 0x8a, 0xb7, 0xef, 0x68, 0xa7, 0x57, 0xee, 0xf6, 0x30,
+	0xbd, 0xfc, 0xfc, 0x07, 0xce, 0x28, 0x5d, 0xfc, 0xfb, 0xb1, 0xb9, 0xeb, 0xfd, 0xb0, 0x2e, 0xcd,
+	0x1d, 0x48, 0x79, 0xdb, 0x5d, 0x78, 0x84, 0x83, 0xc9, 0x15, 0x7a, 0x61, 0xfc, 0xbc, 0x05, 0x6e,
+	0xf8, 0x5e, 0xe9, 0xe5, 0x0f, 0x18, 0x9b, 0xbb, 0xde, 0x0f, 0xeb, 0xd2, 0x7c, 0x04, 0x53, 0xc1,
+	0x03, 0xfe, 0xb5, 0x5e, 0x26, 0x45, 0xa9, 0xbe, 0xd6, 0x17, 0xed, 0x27, 0x1b, 0x3c, 0x23, 0x75,
+	0x91, 0x0d, 0xa0, 0x73, 0xaf, 0xf5, 0x45, 0xbb, 0x64, 0x7f, 0x02, 0x99, 0x50, 0xda, 0xbe, 0x18,
+	0x31, 0xd0, 0x87, 0xcf, 0xdd, 0xe8, 0x8f, 0x77, 0x29, 0xd7, 0xe1, 0x72, 0x54, 0x36, 0xbd, 0x14,
+	0x1e, 0x1e, 0xd1, 0x29, 0xf7, 0xfa, 0x10, 0x9d, 0xfc, 0x51, 0x25, 0x5c, 0x1c, 0xd8, 0x15, 0x55,
+	0x42, 0x1d, 0x72, 0x37, 0x07, 0x74, 0x70, 0x89, 0x97, 0x20, 0xed, 0x95, 0x40, 0xcd, 0x87, 0x47,
+	0xb9, 0xa8, 0xdc, 0xab, 0x3d, 0x51, 0x2e, 0xa9, 0x32, 0x4c, 0xf8, 0x4b, 0x51, 0x16, 0xba, 0x22,
+	0x80, 0x87, 0xcc, 0x2d, 0xf5, 0x41, 0xba, 0x04, 0x7f, 0x0d, 0xc6, 0x68, 0x45, 0xc5, 0x95, 0x2e,
+	0x95, 0x60, 0x70, 0xee, 0x5a, 0x24, 0xd8, 0x1d, 0xbe, 0x09, 0x49, 0xa7, 0x38, 0xa1, 0x6b, 0x83,
+	0x60, 0x88, 0x5c, 0xbe, 0x07, 0xc2, 0xcf, 0x03, 0x7d, 0xe6, 0xef, 0xe2, 0x81, 0x80, 0x73, 0xd7,
+	0x22, 0xc1, 0x7e, 0x23, 0x89, 0x3a, 0x21, 0x2f, 0xf5, 0x30, 0x5e, 0x7f, 0xa7, 0xdc, 0xeb, 0x43,
+	0x74, 0x72, 0x26, 0xca, 0x8d, 0xfd, 0x12, 0x5f, 0x5c, 0x14, 0xdf, 0x7a, 0xf2, 0xd5, 0x22, 0xf7,
+	0xd9, 0x57, 0x8b, 0xdc, 0x7f, 0x7d, 0xb5, 0xc8, 0x7d, 0xf0, 0xf5, 0xe2, 0xa5, 0xcf, 0xbe, 0x5e,
+	0xbc, 0xf4, 0x6f, 0x5f, 0x2f, 0x5e, 0xfa, 0xe9, 0x9d, 0xc1, 0xcf, 0xaf, 0x67, 0xf4, 0xf7, 0xe2,
+	0xf8, 0xe6, 0xa6, 0x3a, 0x4e, 0x7e, 0x1a, 0x74, 0xff, 0xff, 0x06, 0x00, 0x0d, 0xca, 0x76, 0x9a,
+	0x4b, 0x3e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error)
+	Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error)
+	PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error)
+	WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error)
+	CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error)
+	MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error)
+	MultiHopSwapExactOut(ctx context.Context, in *MsgMultiHopSwapExactOut, opts ...grpc.CallOption) (*MsgMultiHopSwapExactOutResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	BatchOps(ctx context.Context, in *MsgBatchOps, opts ...grpc.CallOption) (*MsgBatchOpsResponse, error)
+	DepositRange(ctx context.Context, in *MsgDepositRange, opts ...grpc.CallOption) (*MsgDepositRangeResponse, error)
+	WithdrawRange(ctx context.Context, in *MsgWithdrawRange, opts ...grpc.CallOption) (*MsgWithdrawRangeResponse, error)
+	SetPairPaused(ctx context.Context, in *MsgSetPairPaused, opts ...grpc.CallOption) (*MsgSetPairPausedResponse, error)
+	SetDenomPaused(ctx context.Context, in *MsgSetDenomPaused, opts ...grpc.CallOption) (*MsgSetDenomPausedResponse, error)
+	ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error)
+	AmendLimitOrder(ctx context.Context, in *MsgAmendLimitOrder, opts ...grpc.CallOption) (*MsgAmendLimitOrderResponse, error)
+	FlashSwap(ctx context.Context, in *MsgFlashSwap, opts ...grpc.CallOption) (*MsgFlashSwapResponse, error)
+	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
+	Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error)
+	Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error)
+	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
+	SetPairBatchAuction(ctx context.Context, in *MsgSetPairBatchAuction, opts ...grpc.CallOption) (*MsgSetPairBatchAuctionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) Deposit(ctx context.Context, in *MsgDeposit, opts ...grpc.CallOption) (*MsgDepositResponse, error) {
+	out := new(MsgDepositResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Deposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) Withdrawal(ctx context.Context, in *MsgWithdrawal, opts ...grpc.CallOption) (*MsgWithdrawalResponse, error) {
+	out := new(MsgWithdrawalResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Withdrawal", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgWithdrawFilledLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) PlaceLimitOrder(ctx context.Context, in *MsgPlaceLimitOrder, opts ...grpc.CallOption) (*MsgPlaceLimitOrderResponse, error) {
+	out := new(MsgPlaceLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/PlaceLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelLimitOrder) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) WithdrawFilledLimitOrder(ctx context.Context, in *MsgWithdrawFilledLimitOrder, opts ...grpc.CallOption) (*MsgWithdrawFilledLimitOrderResponse, error) {
+	out := new(MsgWithdrawFilledLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/WithdrawFilledLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelLimitOrder(ctx context.Context, in *MsgCancelLimitOrder, opts ...grpc.CallOption) (*MsgCancelLimitOrderResponse, error) {
+	out := new(MsgCancelLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/CancelLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TrancheKey)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) MultiHopSwap(ctx context.Context, in *MsgMultiHopSwap, opts ...grpc.CallOption) (*MsgMultiHopSwapResponse, error) {
+	out := new(MsgMultiHopSwapResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/MultiHopSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelLimitOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) MultiHopSwapExactOut(ctx context.Context, in *MsgMultiHopSwapExactOut, opts ...grpc.CallOption) (*MsgMultiHopSwapExactOutResponse, error) {
+	out := new(MsgMultiHopSwapExactOutResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/MultiHopSwapExactOut", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.TakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.MakerCoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MultiHopRoute) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Hops) > 0 {
-		for _, s := range m.Hops {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) BatchOps(ctx context.Context, in *MsgBatchOps, opts ...grpc.CallOption) (*MsgBatchOpsResponse, error) {
+	out := new(MsgBatchOpsResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/BatchOps", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMultiHopSwap) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) DepositRange(ctx context.Context, in *MsgDepositRange, opts ...grpc.CallOption) (*MsgDepositRangeResponse, error) {
+	out := new(MsgDepositRangeResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/DepositRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) WithdrawRange(ctx context.Context, in *MsgWithdrawRange, opts ...grpc.CallOption) (*MsgWithdrawRangeResponse, error) {
+	out := new(MsgWithdrawRangeResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/WithdrawRange", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetPairPaused(ctx context.Context, in *MsgSetPairPaused, opts ...grpc.CallOption) (*MsgSetPairPausedResponse, error) {
+	out := new(MsgSetPairPausedResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/SetPairPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomPaused(ctx context.Context, in *MsgSetDenomPaused, opts ...grpc.CallOption) (*MsgSetDenomPausedResponse, error) {
+	out := new(MsgSetDenomPausedResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/SetDenomPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.AmountIn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.ExitLimitPrice.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.PickBestRoute {
-		n += 2
+	return out, nil
+}
+
+func (c *msgClient) ResetCircuitBreaker(ctx context.Context, in *MsgResetCircuitBreaker, opts ...grpc.CallOption) (*MsgResetCircuitBreakerResponse, error) {
+	out := new(MsgResetCircuitBreakerResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/ResetCircuitBreaker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgMultiHopSwapResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AmendLimitOrder(ctx context.Context, in *MsgAmendLimitOrder, opts ...grpc.CallOption) (*MsgAmendLimitOrderResponse, error) {
+	out := new(MsgAmendLimitOrderResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/AmendLimitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.CoinOut.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Route != nil {
-		l = m.Route.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) FlashSwap(ctx context.Context, in *MsgFlashSwap, opts ...grpc.CallOption) (*MsgFlashSwapResponse, error) {
+	out := new(MsgFlashSwapResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/FlashSwap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Dust) > 0 {
-		for _, e := range m.Dust {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error) {
+	out := new(MsgCreateGaugeResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/CreateGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Stake(ctx context.Context, in *MsgStake, opts ...grpc.CallOption) (*MsgStakeResponse, error) {
+	out := new(MsgStakeResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Stake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Unstake(ctx context.Context, in *MsgUnstake, opts ...grpc.CallOption) (*MsgUnstakeResponse, error) {
+	out := new(MsgUnstakeResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Unstake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error) {
+	out := new(MsgClaimResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/Claim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPairBatchAuction(ctx context.Context, in *MsgSetPairBatchAuction, opts ...grpc.CallOption) (*MsgSetPairBatchAuctionResponse, error) {
+	out := new(MsgSetPairBatchAuctionResponse)
+	err := c.cc.Invoke(ctx, "/neutron.dex.Msg/SetPairBatchAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	Deposit(context.Context, *MsgDeposit) (*MsgDepositResponse, error)
+	Withdrawal(context.Context, *MsgWithdrawal) (*MsgWithdrawalResponse, error)
+	PlaceLimitOrder(context.Context, *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error)
+	WithdrawFilledLimitOrder(context.Context, *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error)
+	CancelLimitOrder(context.Context, *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error)
+	MultiHopSwap(context.Context, *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error)
+	MultiHopSwapExactOut(context.Context, *MsgMultiHopSwapExactOut) (*MsgMultiHopSwapExactOutResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	BatchOps(context.Context, *MsgBatchOps) (*MsgBatchOpsResponse, error)
+	DepositRange(context.Context, *MsgDepositRange) (*MsgDepositRangeResponse, error)
+	WithdrawRange(context.Context, *MsgWithdrawRange) (*MsgWithdrawRangeResponse, error)
+	SetPairPaused(context.Context, *MsgSetPairPaused) (*MsgSetPairPausedResponse, error)
+	SetDenomPaused(context.Context, *MsgSetDenomPaused) (*MsgSetDenomPausedResponse, error)
+	ResetCircuitBreaker(context.Context, *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error)
+	AmendLimitOrder(context.Context, *MsgAmendLimitOrder) (*MsgAmendLimitOrderResponse, error)
+	FlashSwap(context.Context, *MsgFlashSwap) (*MsgFlashSwapResponse, error)
+	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
+	Stake(context.Context, *MsgStake) (*MsgStakeResponse, error)
+	Unstake(context.Context, *MsgUnstake) (*MsgUnstakeResponse, error)
+	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
+	SetPairBatchAuction(context.Context, *MsgSetPairBatchAuction) (*MsgSetPairBatchAuctionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) Deposit(ctx context.Context, req *MsgDeposit) (*MsgDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Deposit not implemented")
+}
+func (*UnimplementedMsgServer) Withdrawal(ctx context.Context, req *MsgWithdrawal) (*MsgWithdrawalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Withdrawal not implemented")
+}
+func (*UnimplementedMsgServer) PlaceLimitOrder(ctx context.Context, req *MsgPlaceLimitOrder) (*MsgPlaceLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawFilledLimitOrder(ctx context.Context, req *MsgWithdrawFilledLimitOrder) (*MsgWithdrawFilledLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFilledLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelLimitOrder(ctx context.Context, req *MsgCancelLimitOrder) (*MsgCancelLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) MultiHopSwap(ctx context.Context, req *MsgMultiHopSwap) (*MsgMultiHopSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiHopSwap not implemented")
+}
+func (*UnimplementedMsgServer) MultiHopSwapExactOut(ctx context.Context, req *MsgMultiHopSwapExactOut) (*MsgMultiHopSwapExactOutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiHopSwapExactOut not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) BatchOps(ctx context.Context, req *MsgBatchOps) (*MsgBatchOpsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchOps not implemented")
+}
+func (*UnimplementedMsgServer) DepositRange(ctx context.Context, req *MsgDepositRange) (*MsgDepositRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositRange not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawRange(ctx context.Context, req *MsgWithdrawRange) (*MsgWithdrawRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRange not implemented")
+}
+func (*UnimplementedMsgServer) SetPairPaused(ctx context.Context, req *MsgSetPairPaused) (*MsgSetPairPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPairPaused not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomPaused(ctx context.Context, req *MsgSetDenomPaused) (*MsgSetDenomPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomPaused not implemented")
+}
+func (*UnimplementedMsgServer) ResetCircuitBreaker(ctx context.Context, req *MsgResetCircuitBreaker) (*MsgResetCircuitBreakerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCircuitBreaker not implemented")
+}
+func (*UnimplementedMsgServer) AmendLimitOrder(ctx context.Context, req *MsgAmendLimitOrder) (*MsgAmendLimitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AmendLimitOrder not implemented")
+}
+func (*UnimplementedMsgServer) FlashSwap(ctx context.Context, req *MsgFlashSwap) (*MsgFlashSwapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlashSwap not implemented")
+}
+func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGauge) (*MsgCreateGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGauge not implemented")
+}
+func (*UnimplementedMsgServer) Stake(ctx context.Context, req *MsgStake) (*MsgStakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stake not implemented")
+}
+func (*UnimplementedMsgServer) Unstake(ctx context.Context, req *MsgUnstake) (*MsgUnstakeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unstake not implemented")
+}
+func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Claim not implemented")
+}
+func (*UnimplementedMsgServer) SetPairBatchAuction(ctx context.Context, req *MsgSetPairBatchAuction) (*MsgSetPairBatchAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPairBatchAuction not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Deposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Deposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/Deposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Deposit(ctx, req.(*MsgDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Withdrawal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawal)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Withdrawal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/Withdrawal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Withdrawal(ctx, req.(*MsgWithdrawal))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/PlaceLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceLimitOrder(ctx, req.(*MsgPlaceLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawFilledLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawFilledLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/WithdrawFilledLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawFilledLimitOrder(ctx, req.(*MsgWithdrawFilledLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/CancelLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelLimitOrder(ctx, req.(*MsgCancelLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiHopSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiHopSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiHopSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/MultiHopSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiHopSwap(ctx, req.(*MsgMultiHopSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiHopSwapExactOut_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiHopSwapExactOut)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiHopSwapExactOut(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/MultiHopSwapExactOut",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiHopSwapExactOut(ctx, req.(*MsgMultiHopSwapExactOut))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchOps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchOps)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchOps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/BatchOps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchOps(ctx, req.(*MsgBatchOps))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/DepositRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositRange(ctx, req.(*MsgDepositRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/WithdrawRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRange(ctx, req.(*MsgWithdrawRange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPairPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPairPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPairPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/SetPairPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPairPaused(ctx, req.(*MsgSetPairPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomPaused)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/SetDenomPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomPaused(ctx, req.(*MsgSetDenomPaused))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResetCircuitBreaker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCircuitBreaker)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/ResetCircuitBreaker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCircuitBreaker(ctx, req.(*MsgResetCircuitBreaker))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AmendLimitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAmendLimitOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AmendLimitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/AmendLimitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AmendLimitOrder(ctx, req.(*MsgAmendLimitOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FlashSwap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFlashSwap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FlashSwap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/FlashSwap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FlashSwap(ctx, req.(*MsgFlashSwap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/CreateGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateGauge(ctx, req.(*MsgCreateGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Stake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Stake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/Stake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Stake(ctx, req.(*MsgStake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Unstake_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnstake)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Unstake(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/Unstake",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Unstake(ctx, req.(*MsgUnstake))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_Claim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Claim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/Claim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Claim(ctx, req.(*MsgClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPairBatchAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPairBatchAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPairBatchAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/neutron.dex.Msg/SetPairBatchAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPairBatchAuction(ctx, req.(*MsgSetPairBatchAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "neutron.dex.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Deposit",
+			Handler:    _Msg_Deposit_Handler,
+		},
+		{
+			MethodName: "Withdrawal",
+			Handler:    _Msg_Withdrawal_Handler,
+		},
+		{
+			MethodName: "PlaceLimitOrder",
+			Handler:    _Msg_PlaceLimitOrder_Handler,
+		},
+		{
+			MethodName: "WithdrawFilledLimitOrder",
+			Handler:    _Msg_WithdrawFilledLimitOrder_Handler,
+		},
+		{
+			MethodName: "CancelLimitOrder",
+			Handler:    _Msg_CancelLimitOrder_Handler,
+		},
+		{
+			MethodName: "MultiHopSwap",
+			Handler:    _Msg_MultiHopSwap_Handler,
+		},
+		{
+			MethodName: "MultiHopSwapExactOut",
+			Handler:    _Msg_MultiHopSwapExactOut_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "BatchOps",
+			Handler:    _Msg_BatchOps_Handler,
+		},
+		{
+			MethodName: "DepositRange",
+			Handler:    _Msg_DepositRange_Handler,
+		},
+		{
+			MethodName: "WithdrawRange",
+			Handler:    _Msg_WithdrawRange_Handler,
+		},
+		{
+			MethodName: "SetPairPaused",
+			Handler:    _Msg_SetPairPaused_Handler,
+		},
+		{
+			MethodName: "SetDenomPaused",
+			Handler:    _Msg_SetDenomPaused_Handler,
+		},
+		{
+			MethodName: "ResetCircuitBreaker",
+			Handler:    _Msg_ResetCircuitBreaker_Handler,
+		},
+		{
+			MethodName: "AmendLimitOrder",
+			Handler:    _Msg_AmendLimitOrder_Handler,
+		},
+		{
+			MethodName: "FlashSwap",
+			Handler:    _Msg_FlashSwap_Handler,
+		},
+		{
+			MethodName: "CreateGauge",
+			Handler:    _Msg_CreateGauge_Handler,
+		},
+		{
+			MethodName: "Stake",
+			Handler:    _Msg_Stake_Handler,
+		},
+		{
+			MethodName: "Unstake",
+			Handler:    _Msg_Unstake_Handler,
+		},
+		{
+			MethodName: "Claim",
+			Handler:    _Msg_Claim_Handler,
+		},
+		{
+			MethodName: "SetPairBatchAuction",
+			Handler:    _Msg_SetPairBatchAuction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "neutron/dex/tx.proto",
+}
+
+func (m *DepositOptions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositOptions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositOptions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailTxOnBel {
+		i--
+		if m.FailTxOnBel {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DisableAutoswap {
+		i--
+		if m.DisableAutoswap {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Options) > 0 {
+		for iNdEx := len(m.Options) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Options[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Fees) > 0 {
+		dAtA2 := make([]byte, len(m.Fees)*10)
+		var j1 int
+		for _, num := range m.Fees {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintTx(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		dAtA4 := make([]byte, len(m.TickIndexesAToB)*10)
+		var j3 int
+		for _, num1 := range m.TickIndexesAToB {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintTx(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.AmountsB) > 0 {
+		for iNdEx := len(m.AmountsB) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.AmountsB[iNdEx].Size()
+				i -= size
+				if _, err := m.AmountsB[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AmountsA) > 0 {
+		for iNdEx := len(m.AmountsA) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.AmountsA[iNdEx].Size()
+				i -= size
+				if _, err := m.AmountsA[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DepositIdx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DepositIdx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SharesIssued) > 0 {
+		for iNdEx := len(m.SharesIssued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesIssued[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesIssued[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FailedDeposits) > 0 {
+		for iNdEx := len(m.FailedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Reserve1Deposited) > 0 {
+		for iNdEx := len(m.Reserve1Deposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Reserve1Deposited[iNdEx].Size()
+				i -= size
+				if _, err := m.Reserve1Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Reserve0Deposited) > 0 {
+		for iNdEx := len(m.Reserve0Deposited) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Reserve0Deposited[iNdEx].Size()
+				i -= size
+				if _, err := m.Reserve0Deposited[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		dAtA6 := make([]byte, len(m.Fees)*10)
+		var j5 int
+		for _, num := range m.Fees {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		dAtA8 := make([]byte, len(m.TickIndexesAToB)*10)
+		var j7 int
+		for _, num1 := range m.TickIndexesAToB {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA8[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA8[j7] = uint8(num)
+			j7++
+		}
+		i -= j7
+		copy(dAtA[i:], dAtA8[:j7])
+		i = encodeVarintTx(dAtA, i, uint64(j7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SharesToRemove) > 0 {
+		for iNdEx := len(m.SharesToRemove) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesToRemove[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesToRemove[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SharesBurned) > 0 {
+		for iNdEx := len(m.SharesBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesBurned[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesBurned[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Reserve1Withdrawn.Size()
+		i -= size
+		if _, err := m.Reserve1Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Reserve0Withdrawn.Size()
+		i -= size
+		if _, err := m.Reserve0Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoWithdraw {
+		i--
+		if m.AutoWithdraw {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.OraclePeg != nil {
+		{
+			size, err := m.OraclePeg.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.TriggerSellPrice != nil {
+		{
+			size := m.TriggerSellPrice.Size()
+			i -= size
+			if _, err := m.TriggerSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MinAverageSellPrice != nil {
+		{
+			size := m.MinAverageSellPrice.Size()
+			i -= size
+			if _, err := m.MinAverageSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.LimitSellPrice != nil {
+		{
+			size := m.LimitSellPrice.Size()
+			i -= size
+			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.MaxAmountOut != nil {
+		{
+			size := m.MaxAmountOut.Size()
+			i -= size
+			if _, err := m.MaxAmountOut.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.ExpirationTime != nil {
+		n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpirationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime):])
+		if err10 != nil {
+			return 0, err10
+		}
+		i -= n10
+		i = encodeVarintTx(dAtA, i, uint64(n10))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.OrderType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OrderType))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.TickIndexInToOut != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TickIndexInToOut))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.TakerCoinIn.Size()
+		i -= size
+		if _, err := m.TakerCoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFilledLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFilledLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFilledLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MakerCoinOut.Size()
+		i -= size
+		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MakerCoinOut.Size()
+		i -= size
+		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendLimitOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendLimitOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendLimitOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LimitSellPrice != nil {
+		{
+			size := m.LimitSellPrice.Size()
+			i -= size
+			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.AmountIn != nil {
+		{
+			size := m.AmountIn.Size()
+			i -= size
+			if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAmendLimitOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAmendLimitOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAmendLimitOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TakerFee.Size()
+		i -= size
+		if _, err := m.TakerFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MakerCoinOut.Size()
+		i -= size
+		if _, err := m.MakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TakerCoinOut.Size()
+		i -= size
+		if _, err := m.TakerCoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TrancheKey) > 0 {
+		i -= len(m.TrancheKey)
+		copy(dAtA[i:], m.TrancheKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TrancheKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CallbackData) > 0 {
+		i -= len(m.CallbackData)
+		copy(dAtA[i:], m.CallbackData)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CallbackData)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.LimitSellPrice != nil {
+		{
+			size := m.LimitSellPrice.Size()
+			i -= size
+			if _, err := m.LimitSellPrice.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TokenOut) > 0 {
+		i -= len(m.TokenOut)
+		copy(dAtA[i:], m.TokenOut)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOut)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFlashSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFlashSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFlashSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.EndTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StakeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StakeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StakeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiHopRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiHopRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiHopRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Hops[iNdEx])
+			copy(dAtA[i:], m.Hops[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Hops[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultiHopRouteAllocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiHopRouteAllocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiHopRouteAllocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiHopSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiHopSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiHopSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SplitRoutes {
+		i--
+		if m.SplitRoutes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PickBestRoute {
+		i--
+		if m.PickBestRoute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.ExitLimitPrice.Size()
+		i -= size
+		if _, err := m.ExitLimitPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AmountIn.Size()
+		i -= size
+		if _, err := m.AmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiHopSwapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiHopSwapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiHopSwapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RouteAllocations) > 0 {
+		for iNdEx := len(m.RouteAllocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteAllocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Dust[iNdEx].Size()
+				i -= size
+				if _, err := m.Dust[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiHopSwapExactOut) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiHopSwapExactOut) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiHopSwapExactOut) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PickBestRoute {
+		i--
+		if m.PickBestRoute {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.MaxAmountIn.Size()
+		i -= size
+		if _, err := m.MaxAmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.AmountOut.Size()
+		i -= size
+		if _, err := m.AmountOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiHopSwapExactOutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiHopSwapExactOutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiHopSwapExactOutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Route != nil {
+		{
+			size, err := m.Route.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.CoinOut.Size()
+		i -= size
+		if _, err := m.CoinOut.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.CoinIn.Size()
+		i -= size
+		if _, err := m.CoinIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Options != nil {
+		{
+			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.Shape != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Shape))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.Fee != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Fee))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UpperTickIndexAToB != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTickIndexAToB))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.LowerTickIndexAToB != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTickIndexAToB))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.AmountB.Size()
+		i -= size
+		if _, err := m.AmountB.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.AmountA.Size()
+		i -= size
+		if _, err := m.AmountA.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenB) > 0 {
+		i -= len(m.TokenB)
+		copy(dAtA[i:], m.TokenB)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenB)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TokenA) > 0 {
+		i -= len(m.TokenA)
+		copy(dAtA[i:], m.TokenA)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenA)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SharesIssued) > 0 {
+		for iNdEx := len(m.SharesIssued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesIssued[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesIssued[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FailedDeposits) > 0 {
+		for iNdEx := len(m.FailedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Reserve1Deposited.Size()
+		i -= size
+		if _, err := m.Reserve1Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Reserve0Deposited.Size()
+		i -= size
+		if _, err := m.Reserve0Deposited.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SharesBurned) > 0 {
+		for iNdEx := len(m.SharesBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.SharesBurned[iNdEx].Size()
+				i -= size
+				if _, err := m.SharesBurned[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Reserve1Withdrawn.Size()
+		i -= size
+		if _, err := m.Reserve1Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Reserve0Withdrawn.Size()
+		i -= size
+		if _, err := m.Reserve0Withdrawn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPairPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPairPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPairPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPairPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPairPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPairPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPairBatchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPairBatchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPairBatchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPairBatchAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPairBatchAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPairBatchAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomPaused) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomPaused) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPaused) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomPausedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomPausedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreaker) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreaker) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreaker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PairId != nil {
+		{
+			size, err := m.PairId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCircuitBreakerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MultiHopSwap != nil {
+		{
+			size, err := m.MultiHopSwap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CancelLimitOrder != nil {
+		{
+			size, err := m.CancelLimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WithdrawFilledLimitOrder != nil {
+		{
+			size, err := m.WithdrawFilledLimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.PlaceLimitOrder != nil {
+		{
+			size, err := m.PlaceLimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Withdrawal != nil {
+		{
+			size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AllowFailure {
+		i--
+		if m.AllowFailure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchOps) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchOps) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchOps) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ops) > 0 {
+		for iNdEx := len(m.Ops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchOpResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchOpResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchOpResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MultiHopSwap != nil {
+		{
+			size, err := m.MultiHopSwap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CancelLimitOrder != nil {
+		{
+			size, err := m.CancelLimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.WithdrawFilledLimitOrder != nil {
+		{
+			size, err := m.WithdrawFilledLimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PlaceLimitOrder != nil {
+		{
+			size, err := m.PlaceLimitOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Withdrawal != nil {
+		{
+			size, err := m.Withdrawal.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Deposit != nil {
+		{
+			size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FailedBatchOp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FailedBatchOp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FailedBatchOp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OpIdx != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OpIdx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchOpsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchOpsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchOpsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FailedOps) > 0 {
+		for iNdEx := len(m.FailedOps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FailedOps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DepositOptions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisableAutoswap {
+		n += 2
+	}
+	if m.FailTxOnBel {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.AmountsA) > 0 {
+		for _, e := range m.AmountsA {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.AmountsB) > 0 {
+		for _, e := range m.AmountsB {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		l = 0
+		for _, e := range m.TickIndexesAToB {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Fees) > 0 {
+		l = 0
+		for _, e := range m.Fees {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Options) > 0 {
+		for _, e := range m.Options {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FailedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositIdx != 0 {
+		n += 1 + sovTx(uint64(m.DepositIdx))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reserve0Deposited) > 0 {
+		for _, e := range m.Reserve0Deposited {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Reserve1Deposited) > 0 {
+		for _, e := range m.Reserve1Deposited {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FailedDeposits) > 0 {
+		for _, e := range m.FailedDeposits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SharesIssued) > 0 {
+		for _, e := range m.SharesIssued {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SharesToRemove) > 0 {
+		for _, e := range m.SharesToRemove {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.TickIndexesAToB) > 0 {
+		l = 0
+		for _, e := range m.TickIndexesAToB {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if len(m.Fees) > 0 {
+		l = 0
+		for _, e := range m.Fees {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgWithdrawalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve0Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Reserve1Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SharesBurned) > 0 {
+		for _, e := range m.SharesBurned {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPlaceLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TickIndexInToOut != 0 {
+		n += 1 + sovTx(uint64(m.TickIndexInToOut))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.OrderType != 0 {
+		n += 1 + sovTx(uint64(m.OrderType))
+	}
+	if m.ExpirationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpirationTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxAmountOut != nil {
+		l = m.MaxAmountOut.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSellPrice != nil {
+		l = m.LimitSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinAverageSellPrice != nil {
+		l = m.MinAverageSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TriggerSellPrice != nil {
+		l = m.TriggerSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OraclePeg != nil {
+		l = m.OraclePeg.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpirationHeight))
+	}
+	if m.AutoWithdraw {
+		n += 3
+	}
+	return n
+}
+
+func (m *MsgPlaceLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerCoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawFilledLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawFilledLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAmendLimitOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AmountIn != nil {
+		l = m.AmountIn.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LimitSellPrice != nil {
+		l = m.LimitSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAmendLimitOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TrancheKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MakerCoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TakerFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFlashSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LimitSellPrice != nil {
+		l = m.LimitSellPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CallbackData)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFlashSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovTx(uint64(m.StartTick))
+	}
+	if m.EndTick != 0 {
+		n += 1 + sovTx(uint64(m.EndTick))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.NumEpochs != 0 {
+		n += 1 + sovTx(uint64(m.NumEpochs))
+	}
+	return n
+}
+
+func (m *MsgCreateGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *MsgStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgStakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StakeId != 0 {
+		n += 1 + sovTx(uint64(m.StakeId))
+	}
+	return n
+}
+
+func (m *MsgUnstake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StakeId != 0 {
+		n += 1 + sovTx(uint64(m.StakeId))
+	}
+	return n
+}
+
+func (m *MsgUnstakeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiHopRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, s := range m.Hops {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MultiHopRouteAllocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgMultiHopSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.AmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExitLimitPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PickBestRoute {
+		n += 2
+	}
+	if m.SplitRoutes {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMultiHopSwapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RouteAllocations) > 0 {
+		for _, e := range m.RouteAllocations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiHopSwapExactOut) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.AmountOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxAmountIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.PickBestRoute {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgMultiHopSwapExactOutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CoinIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.CoinOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Route != nil {
+		l = m.Route.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenA)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TokenB)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.AmountA.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.AmountB.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LowerTickIndexAToB != 0 {
+		n += 1 + sovTx(uint64(m.LowerTickIndexAToB))
+	}
+	if m.UpperTickIndexAToB != 0 {
+		n += 1 + sovTx(uint64(m.UpperTickIndexAToB))
+	}
+	if m.Fee != 0 {
+		n += 1 + sovTx(uint64(m.Fee))
+	}
+	if m.Shape != 0 {
+		n += 1 + sovTx(uint64(m.Shape))
+	}
+	if m.Options != nil {
+		l = m.Options.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Reserve0Deposited.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Reserve1Deposited.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.FailedDeposits) > 0 {
+		for _, e := range m.FailedDeposits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SharesIssued) > 0 {
+		for _, e := range m.SharesIssued {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reserve0Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Reserve1Withdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.SharesBurned) > 0 {
+		for _, e := range m.SharesBurned {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPairPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetPairPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPairBatchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetPairBatchAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomPaused) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetDenomPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetCircuitBreaker) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PairId != nil {
+		l = m.PairId.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetCircuitBreakerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AllowFailure {
+		n += 2
+	}
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Withdrawal != nil {
+		l = m.Withdrawal.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PlaceLimitOrder != nil {
+		l = m.PlaceLimitOrder.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WithdrawFilledLimitOrder != nil {
+		l = m.WithdrawFilledLimitOrder.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CancelLimitOrder != nil {
+		l = m.CancelLimitOrder.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MultiHopSwap != nil {
+		l = m.MultiHopSwap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchOps) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Ops) > 0 {
+		for _, e := range m.Ops {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BatchOpResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Deposit != nil {
+		l = m.Deposit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Withdrawal != nil {
+		l = m.Withdrawal.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PlaceLimitOrder != nil {
+		l = m.PlaceLimitOrder.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WithdrawFilledLimitOrder != nil {
+		l = m.WithdrawFilledLimitOrder.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CancelLimitOrder != nil {
+		l = m.CancelLimitOrder.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MultiHopSwap != nil {
+		l = m.MultiHopSwap.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *FailedBatchOp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OpIdx != 0 {
+		n += 1 + sovTx(uint64(m.OpIdx))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchOpsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.FailedOps) > 0 {
+		for _, e := range m.FailedOps {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DepositOptions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DepositOptions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DepositOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoswap", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisableAutoswap = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailTxOnBel", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FailTxOnBel = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountsA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.AmountsA = append(m.AmountsA, v)
+			if err := m.AmountsA[len(m.AmountsA)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountsB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.AmountsB = append(m.AmountsB, v)
+			if err := m.AmountsB[len(m.AmountsB)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
+					m.TickIndexesAToB = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
+			}
+		case 8:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fees = append(m.Fees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Fees) == 0 {
+					m.Fees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fees = append(m.Fees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Options = append(m.Options, &DepositOptions{})
+			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FailedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FailedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositIdx", wireType)
+			}
+			m.DepositIdx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositIdx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Deposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Reserve0Deposited = append(m.Reserve0Deposited, v)
+			if err := m.Reserve0Deposited[len(m.Reserve0Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Deposited", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.Reserve1Deposited = append(m.Reserve1Deposited, v)
+			if err := m.Reserve1Deposited[len(m.Reserve1Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FailedDeposits = append(m.FailedDeposits, &FailedDeposit{})
+			if err := m.FailedDeposits[len(m.FailedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesIssued", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharesIssued = append(m.SharesIssued, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.SharesIssued[len(m.SharesIssued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesToRemove", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.SharesToRemove = append(m.SharesToRemove, v)
+			if err := m.SharesToRemove[len(m.SharesToRemove)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
+					m.TickIndexesAToB = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
+			}
+		case 7:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Fees = append(m.Fees, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Fees) == 0 {
+					m.Fees = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Fees = append(m.Fees, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Withdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve0Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Withdrawn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reserve1Withdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharesBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SharesBurned = append(m.SharesBurned, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.SharesBurned[len(m.SharesBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
+			}
+			m.TickIndexInToOut = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndexInToOut |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
+			}
+			m.OrderType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderType |= LimitOrderType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpirationTime == nil {
+				m.ExpirationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxAmountOut = &v
+			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.LimitSellPrice = &v
+			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.MinAverageSellPrice = &v
+			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TriggerSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.TriggerSellPrice = &v
+			if err := m.TriggerSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePeg", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OraclePeg == nil {
+				m.OraclePeg = &OraclePeg{}
+			}
+			if err := m.OraclePeg.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoWithdraw", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoWithdraw = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendLimitOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendLimitOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.AmountIn = &v
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.LimitSellPrice = &v
+			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAmendLimitOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAmendLimitOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAmendLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakerFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TakerFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFlashSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFlashSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFlashSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
+			m.LimitSellPrice = &v
+			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CallbackData", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CallbackData = append(m.CallbackData[:0], dAtA[iNdEx:postIndex]...)
+			if m.CallbackData == nil {
+				m.CallbackData = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFlashSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFlashSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFlashSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+			}
+			m.StartTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTick", wireType)
+			}
+			m.EndTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochs", wireType)
+			}
+			m.NumEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeId", wireType)
+			}
+			m.StakeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeId", wireType)
+			}
+			m.StakeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, types.Coin{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaim) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiHopRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiHopRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultiHopRouteAllocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiHopRouteAllocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiHopRouteAllocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &MultiHopRoute{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiHopSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiHopSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &MultiHopRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitLimitPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExitLimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PickBestRoute", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.PickBestRoute = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SplitRoutes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SplitRoutes = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiHopSwapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiHopSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Route == nil {
+				m.Route = &MultiHopRoute{}
+			}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dust = append(m.Dust, github_com_cosmos_cosmos_sdk_types.Coin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteAllocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RouteAllocations = append(m.RouteAllocations, &MultiHopRouteAllocation{})
+			if err := m.RouteAllocations[len(m.RouteAllocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *DepositOptions) Unmarshal(dAtA []byte) error {
+func (m *MsgMultiHopSwapExactOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2943,17 +12578,17 @@ func (m *DepositOptions) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositOptions: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMultiHopSwapExactOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositOptions: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMultiHopSwapExactOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DisableAutoswap", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2963,15 +12598,161 @@ func (m *DepositOptions) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DisableAutoswap = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, &MultiHopRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FailTxOnBel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PickBestRoute", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -2988,7 +12769,7 @@ func (m *DepositOptions) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.FailTxOnBel = bool(v != 0)
+			m.PickBestRoute = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3010,7 +12791,7 @@ func (m *DepositOptions) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgMultiHopSwapExactOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3033,17 +12814,17 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMultiHopSwapExactOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMultiHopSwapExactOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3053,29 +12834,30 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3085,29 +12867,30 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3117,59 +12900,81 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenA = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			if m.Route == nil {
+				m.Route = &MultiHopRoute{}
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenB = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountsA", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3197,15 +13002,11 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.AmountsA = append(m.AmountsA, v)
-			if err := m.AmountsA[len(m.AmountsA)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountsB", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3233,169 +13034,77 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.AmountsB = append(m.AmountsB, v)
-			if err := m.AmountsB[len(m.AmountsB)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
-					m.TickIndexesAToB = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
 			}
-		case 8:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Fees = append(m.Fees, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Fees) == 0 {
-					m.Fees = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Fees = append(m.Fees, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
-		case 9:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountA", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3405,81 +13114,84 @@ func (m *MsgDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Options = append(m.Options, &DepositOptions{})
-			if err := m.Options[len(m.Options)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AmountA.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountB", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if err := m.AmountB.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTickIndexAToB", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.LowerTickIndexAToB = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTickIndexAToB |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FailedDeposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FailedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DepositIdx", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTickIndexAToB", wireType)
 			}
-			m.DepositIdx = 0
+			m.UpperTickIndexAToB = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3489,16 +13201,54 @@ func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DepositIdx |= uint64(b&0x7F) << shift
+				m.UpperTickIndexAToB |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			m.Fee = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fee |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shape", wireType)
+			}
+			m.Shape = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Shape |= RangeShape(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Options", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3508,23 +13258,27 @@ func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			if m.Options == nil {
+				m.Options = &DepositOptions{}
+			}
+			if err := m.Options.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3547,7 +13301,7 @@ func (m *FailedDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3570,13 +13324,32 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reserve0Deposited", wireType)
 			}
@@ -3606,13 +13379,11 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Reserve0Deposited = append(m.Reserve0Deposited, v)
-			if err := m.Reserve0Deposited[len(m.Reserve0Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reserve0Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Reserve1Deposited", wireType)
 			}
@@ -3642,13 +13413,11 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.Reserve1Deposited = append(m.Reserve1Deposited, v)
-			if err := m.Reserve1Deposited[len(m.Reserve1Deposited)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Reserve1Deposited.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FailedDeposits", wireType)
 			}
@@ -3682,7 +13451,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field SharesIssued", wireType)
 			}
@@ -3737,7 +13506,7 @@ func (m *MsgDepositResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRange) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3760,10 +13529,10 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawal: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRange: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3829,258 +13598,25 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			m.Receiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenA", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenA = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenB", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenB = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SharesToRemove", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v cosmossdk_io_math.Int
-			m.SharesToRemove = append(m.SharesToRemove, v)
-			if err := m.SharesToRemove[len(m.SharesToRemove)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType == 0 {
-				var v int64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= int64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.TickIndexesAToB) == 0 {
-					m.TickIndexesAToB = make([]int64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v int64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= int64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.TickIndexesAToB = append(m.TickIndexesAToB, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexesAToB", wireType)
-			}
-		case 7:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Fees = append(m.Fees, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Fees) == 0 {
-					m.Fees = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Fees = append(m.Fees, v)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -4103,7 +13639,7 @@ func (m *MsgWithdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4126,10 +13662,10 @@ func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawalResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4255,7 +13791,7 @@ func (m *MsgWithdrawalResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4278,15 +13814,15 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4314,13 +13850,13 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4330,27 +13866,128 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPairPaused) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPairPaused: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPairPaused: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4378,13 +14015,13 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4394,29 +14031,33 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = string(dAtA[iNdEx:postIndex])
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndexInToOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			m.TickIndexInToOut = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4426,14 +14067,115 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TickIndexInToOut |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPairPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPairPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPairPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPairBatchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPairBatchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPairBatchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4461,32 +14203,11 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderType", wireType)
-			}
-			m.OrderType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OrderType |= LimitOrderType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4513,18 +14234,18 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ExpirationTime == nil {
-				m.ExpirationTime = new(time.Time)
+			if m.PairId == nil {
+				m.PairId = &PairID{}
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpirationTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountOut", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4534,100 +14255,62 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v cosmossdk_io_math.Int
-			m.MaxAmountOut = &v
-			if err := m.MaxAmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LimitSellPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
-			m.LimitSellPrice = &v
-			if err := m.LimitSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinAverageSellPrice", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPairBatchAuctionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_neutron_org_neutron_v5_utils_math.PrecDec
-			m.MinAverageSellPrice = &v
-			if err := m.MinAverageSellPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPairBatchAuctionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPairBatchAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4649,7 +14332,7 @@ func (m *MsgPlaceLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomPaused) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4672,15 +14355,15 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomPaused: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPlaceLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomPaused: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4708,13 +14391,13 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4724,30 +14407,29 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4757,58 +14439,62 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinIn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDenomPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerCoinIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDenomPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDenomPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4830,7 +14516,7 @@ func (m *MsgPlaceLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreaker) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4853,15 +14539,15 @@ func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreaker: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4889,13 +14575,13 @@ func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PairId", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4905,23 +14591,27 @@ func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
+			if m.PairId == nil {
+				m.PairId = &PairID{}
+			}
+			if err := m.PairId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4944,7 +14634,7 @@ func (m *MsgWithdrawFilledLimitOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResetCircuitBreakerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4967,15 +14657,85 @@ func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawFilledLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResetCircuitBreakerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchOp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchOp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchOp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowFailure", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowFailure = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5002,13 +14762,16 @@ func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Deposit == nil {
+				m.Deposit = &MsgDeposit{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5035,65 +14798,18 @@ func (m *MsgWithdrawFilledLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Withdrawal == nil {
+				m.Withdrawal = &MsgWithdrawal{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelLimitOrder: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelLimitOrder: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaceLimitOrder", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5103,29 +14819,33 @@ func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if m.PlaceLimitOrder == nil {
+				m.PlaceLimitOrder = &MsgPlaceLimitOrder{}
+			}
+			if err := m.PlaceLimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrancheKey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFilledLimitOrder", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5135,77 +14855,31 @@ func (m *MsgCancelLimitOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TrancheKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if m.WithdrawFilledLimitOrder == nil {
+				m.WithdrawFilledLimitOrder = &MsgWithdrawFilledLimitOrder{}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.WithdrawFilledLimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelLimitOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelLimitOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5232,13 +14906,16 @@ func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CancelLimitOrder == nil {
+				m.CancelLimitOrder = &MsgCancelLimitOrder{}
+			}
+			if err := m.CancelLimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MakerCoinOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiHopSwap", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5265,7 +14942,10 @@ func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MakerCoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.MultiHopSwap == nil {
+				m.MultiHopSwap = &MsgMultiHopSwap{}
+			}
+			if err := m.MultiHopSwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5290,7 +14970,7 @@ func (m *MsgCancelLimitOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchOps) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5313,15 +14993,15 @@ func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MultiHopRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchOps: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MultiHopRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchOps: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5349,7 +15029,41 @@ func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hops = append(m.Hops, string(dAtA[iNdEx:postIndex]))
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ops = append(m.Ops, &BatchOp{})
+			if err := m.Ops[len(m.Ops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5372,7 +15086,7 @@ func (m *MultiHopRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
+func (m *BatchOpResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5395,17 +15109,17 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiHopSwap: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchOpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiHopSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchOpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5415,29 +15129,33 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			if m.Deposit == nil {
+				m.Deposit = &MsgDepositResponse{}
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5447,27 +15165,31 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			if m.Withdrawal == nil {
+				m.Withdrawal = &MsgWithdrawalResponse{}
+			}
+			if err := m.Withdrawal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlaceLimitOrder", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5494,16 +15216,18 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, &MultiHopRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.PlaceLimitOrder == nil {
+				m.PlaceLimitOrder = &MsgPlaceLimitOrderResponse{}
+			}
+			if err := m.PlaceLimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawFilledLimitOrder", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5513,31 +15237,33 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.WithdrawFilledLimitOrder == nil {
+				m.WithdrawFilledLimitOrder = &MsgWithdrawFilledLimitOrderResponse{}
+			}
+			if err := m.WithdrawFilledLimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExitLimitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelLimitOrder", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5547,31 +15273,33 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ExitLimitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CancelLimitOrder == nil {
+				m.CancelLimitOrder = &MsgCancelLimitOrderResponse{}
+			}
+			if err := m.CancelLimitOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PickBestRoute", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiHopSwap", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5581,12 +15309,28 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.PickBestRoute = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MultiHopSwap == nil {
+				m.MultiHopSwap = &MsgMultiHopSwapResponse{}
+			}
+			if err := m.MultiHopSwap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5608,7 +15352,7 @@ func (m *MsgMultiHopSwap) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
+func (m *FailedBatchOp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5631,17 +15375,17 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgMultiHopSwapResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FailedBatchOp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgMultiHopSwapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FailedBatchOp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CoinOut", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpIdx", wireType)
 			}
-			var msglen int
+			m.OpIdx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5651,66 +15395,16 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.OpIdx |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.CoinOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Route == nil {
-				m.Route = &MultiHopRoute{}
-			}
-			if err := m.Route.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5720,25 +15414,23 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Dust = append(m.Dust, github_com_cosmos_cosmos_sdk_types.Coin{})
-			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5761,7 +15453,7 @@ func (m *MsgMultiHopSwapResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgBatchOpsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5784,17 +15476,17 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBatchOpsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBatchOpsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5804,27 +15496,29 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, &BatchOpResponse{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FailedOps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5851,7 +15545,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FailedOps = append(m.FailedOps, &FailedBatchOp{})
+			if err := m.FailedOps[len(m.FailedOps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5876,56 +15571,6 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -27,6 +27,9 @@ type (
 		accountKeeper types.AccountKeeper
 		bankKeeper    types.BankKeeper
 		authority     string
+
+		// protocolFeeCollector is the module account the dex sends its protocol fees to; empty disables collection
+		protocolFeeCollector string
 	}
 )
 
@@ -38,15 +41,17 @@ func NewKeeper(
 	memKey storetypes.StoreKey,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
+	protocolFeeCollector string,
 	authority string,
 ) *Keeper {
 	return &Keeper{
-		cdc:           cdc,
-		storeKey:      storeKey,
-		memKey:        memKey,
-		accountKeeper: accountKeeper,
-		bankKeeper:    bankKeeper,
-		authority:     authority,
+		cdc:                  cdc,
+		storeKey:             storeKey,
+		memKey:               memKey,
+		accountKeeper:        accountKeeper,
+		bankKeeper:           bankKeeper,
+		authority:            authority,
+		protocolFeeCollector: protocolFeeCollector,
 	}
 }
 
@@ -99,6 +104,8 @@ func (k Keeper) BurnAndDistribute(ctx sdk.Context) {
 		panic("ConsumerRedistributeName must have module address")
 	}
 
+	k.collectDexProtocolFees(ctx)
+
 	params := k.GetParams(ctx)
 	balances := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
 	fundsForReserve := make(sdk.Coins, 0, len(balances))
@@ -141,6 +148,30 @@ func (k Keeper) BurnAndDistribute(ctx sdk.Context) {
 	}
 }
 
+// collectDexProtocolFees moves the protocol fees taken on dex swaps to the ConsumerRedistributeName module
+// so that they are burned or sent to the Treasury along with the rest of the fees. If the transfer fails the
+// fees are left in the collector and picked up on a later block.
+func (k Keeper) collectDexProtocolFees(ctx sdk.Context) {
+	if k.protocolFeeCollector == "" {
+		return
+	}
+
+	collectorAddr := k.accountKeeper.GetModuleAddress(k.protocolFeeCollector)
+	if collectorAddr == nil {
+		return
+	}
+
+	protocolFees := k.bankKeeper.GetAllBalances(ctx, collectorAddr)
+	if protocolFees.IsZero() {
+		return
+	}
+
+	err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.protocolFeeCollector, consumertypes.ConsumerRedistributeName, protocolFees)
+	if err != nil {
+		k.Logger(ctx).Error("failed to collect dex protocol fees", "fees", protocolFees, "error", err)
+	}
+}
+
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -162,13 +162,61 @@ func TestKeeper_BurnAndDistribute_NtrnAndNonNtrn(t *testing.T) {
 	require.Equal(t, burnedAmount.Coin.Amount, math.NewInt(70))
 }
 
+func TestKeeper_BurnAndDistribute_DexProtocolFees(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	redistrAddr := sdk.AccAddress("neutronabcdasdf")
+	dexCollectorAddr := sdk.AccAddress("dexfeecollector")
+	mockAccountKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	mockBankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	feeKeeper, ctx := feekeeperutil.FeeburnerKeeperWithDeps(t, mockAccountKeeper, mockBankKeeper)
+	protocolFees := sdk.Coins{sdk.NewCoin(feetypes.DefaultNeutronDenom, math.NewInt(30)), sdk.NewCoin("nonntrn", math.NewInt(10))}
+
+	mockAccountKeeper.EXPECT().GetModuleAddress(consumertypes.ConsumerRedistributeName).Return(redistrAddr)
+	mockAccountKeeper.EXPECT().GetModuleAddress(feekeeperutil.ProtocolFeeCollectorName).Return(dexCollectorAddr)
+	mockBankKeeper.EXPECT().GetAllBalances(ctx, dexCollectorAddr).Return(protocolFees)
+	mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, feekeeperutil.ProtocolFeeCollectorName, consumertypes.ConsumerRedistributeName, protocolFees)
+	mockBankKeeper.EXPECT().GetAllBalances(ctx, redistrAddr).Return(protocolFees)
+	mockBankKeeper.EXPECT().BurnCoins(ctx, consumertypes.ConsumerRedistributeName, sdk.Coins{sdk.NewCoin(feetypes.DefaultNeutronDenom, math.NewInt(30))})
+	mockBankKeeper.EXPECT().SendCoins(ctx, redistrAddr, sdk.MustAccAddressFromBech32(feeKeeper.GetParams(ctx).TreasuryAddress), sdk.Coins{sdk.NewCoin("nonntrn", math.NewInt(10))})
+
+	feeKeeper.BurnAndDistribute(ctx)
+	burnedAmount := feeKeeper.GetTotalBurnedNeutronsAmount(ctx)
+	require.Equal(t, burnedAmount.Coin.Amount, math.NewInt(30))
+}
+
+func TestKeeper_BurnAndDistribute_DexProtocolFeesSendFails(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	redistrAddr := sdk.AccAddress("neutronabcdasdf")
+	dexCollectorAddr := sdk.AccAddress("dexfeecollector")
+	mockAccountKeeper := mock_types.NewMockAccountKeeper(ctrl)
+	mockBankKeeper := mock_types.NewMockBankKeeper(ctrl)
+	feeKeeper, ctx := feekeeperutil.FeeburnerKeeperWithDeps(t, mockAccountKeeper, mockBankKeeper)
+	protocolFees := sdk.Coins{sdk.NewCoin(feetypes.DefaultNeutronDenom, math.NewInt(30))}
+
+	mockAccountKeeper.EXPECT().GetModuleAddress(consumertypes.ConsumerRedistributeName).Return(redistrAddr)
+	mockAccountKeeper.EXPECT().GetModuleAddress(feekeeperutil.ProtocolFeeCollectorName).Return(dexCollectorAddr)
+	mockBankKeeper.EXPECT().GetAllBalances(ctx, dexCollectorAddr).Return(protocolFees)
+	mockBankKeeper.EXPECT().SendCoinsFromModuleToModule(ctx, feekeeperutil.ProtocolFeeCollectorName, consumertypes.ConsumerRedistributeName, protocolFees).Return(fmt.Errorf("send failed"))
+	mockBankKeeper.EXPECT().GetAllBalances(ctx, redistrAddr).Return(sdk.Coins{})
+
+	// the fees stay in the collector and the rest of the fees are still processed
+	require.NotPanics(t, func() { feeKeeper.BurnAndDistribute(ctx) })
+	burnedAmount := feeKeeper.GetTotalBurnedNeutronsAmount(ctx)
+	require.True(t, burnedAmount.Coin.Amount.IsZero())
+}
+
 func setupBurnAndDistribute(t *testing.T, ctrl *gomock.Controller, coins sdk.Coins) (*keeper.Keeper, sdk.Context, *mock_types.MockBankKeeper, sdk.AccAddress) {
 	redistrAddr := sdk.AccAddress("neutronabcdasdf")
+	dexCollectorAddr := sdk.AccAddress("dexfeecollector")
 	mockAccountKeeper := mock_types.NewMockAccountKeeper(ctrl)
 	mockBankKeeper := mock_types.NewMockBankKeeper(ctrl)
 	feeKeeper, ctx := feekeeperutil.FeeburnerKeeperWithDeps(t, mockAccountKeeper, mockBankKeeper)
 
 	mockAccountKeeper.EXPECT().GetModuleAddress(consumertypes.ConsumerRedistributeName).Return(redistrAddr)
+	mockAccountKeeper.EXPECT().GetModuleAddress(feekeeperutil.ProtocolFeeCollectorName).Return(dexCollectorAddr)
+	mockBankKeeper.EXPECT().GetAllBalances(ctx, dexCollectorAddr).Return(sdk.Coins{})
 	mockBankKeeper.EXPECT().GetAllBalances(ctx, redistrAddr).Return(coins)
 
 	return feeKeeper, ctx, mockBankKeeper, redistrAddr